@@ -75,7 +75,7 @@ func (client *Client) initPsClient(conf *config.Config) error {
 }
 
 func (client *Client) initMasterClient(conf *config.Config) error {
-	openStore, err := store.OpenStore("etcd", conf.GetEtcdAddress())
+	openStore, err := store.OpenStore(conf.GetMetaStore())
 	if err != nil {
 		return err
 	}
@@ -126,6 +126,19 @@ type routerRequest struct {
 	sendMap map[entity.PartitionID]*vearchpb.PartitionData
 	// Err if error else nil
 	Err error
+
+	statLock sync.Mutex
+	stat     SearchStat
+}
+
+// SearchStat is what a search learned from the partitions it queried
+type SearchStat struct {
+	// PartitionTook is the rpc took in ms of each partition
+	PartitionTook map[entity.PartitionID]int64
+	// FilterScanned and FilterMatched sum the docs checked and kept by the
+	// bool filters of the partitions
+	FilterScanned int64
+	FilterMatched int64
 }
 
 // GetMD
@@ -159,6 +172,8 @@ func (r *routerRequest) SetMethod(method string) *routerRequest {
 // SetHead Set head
 func (r *routerRequest) SetHead(head *vearchpb.RequestHead) *routerRequest {
 	r.head = head
+	r.md[HeadDbName] = head.DbName
+	r.md[HeadSpaceName] = head.SpaceName
 	return r
 }
 
@@ -168,9 +183,44 @@ func (r *routerRequest) SetSpace() *routerRequest {
 		return r
 	}
 	r.space, r.Err = r.client.Space(r.ctx, r.head.DbName, r.head.SpaceName)
+	if r.Err == nil && r.space.SlowLog != nil && r.space.SlowLog.ThresholdMs > 0 {
+		r.md[SlowLogThreshold] = strconv.FormatInt(r.space.SlowLog.ThresholdMs, 10)
+	}
 	return r
 }
 
+// GetSpace returns the space set by SetSpace
+func (r *routerRequest) GetSpace() *entity.Space {
+	return r.space
+}
+
+// SearchStat returns the stat of the partitions searched so far
+func (r *routerRequest) SearchStat() SearchStat {
+	r.statLock.Lock()
+	defer r.statLock.Unlock()
+	stat := r.stat
+	stat.PartitionTook = make(map[entity.PartitionID]int64, len(r.stat.PartitionTook))
+	for pid, took := range r.stat.PartitionTook {
+		stat.PartitionTook[pid] = took
+	}
+	return stat
+}
+
+func (r *routerRequest) addSearchStat(partitionID entity.PartitionID, took int64, head *vearchpb.ResponseHead) {
+	r.statLock.Lock()
+	defer r.statLock.Unlock()
+	if r.stat.PartitionTook == nil {
+		r.stat.PartitionTook = make(map[entity.PartitionID]int64)
+	}
+	r.stat.PartitionTook[partitionID] = took
+	if head != nil && head.Params != nil {
+		scanned, _ := strconv.ParseInt(head.Params[FilterScanned], 10, 64)
+		matched, _ := strconv.ParseInt(head.Params[FilterMatched], 10, 64)
+		r.stat.FilterScanned += scanned
+		r.stat.FilterMatched += matched
+	}
+}
+
 // SetDocs set docs
 func (r *routerRequest) SetDocs(docs []*vearchpb.Document) *routerRequest {
 	if r.Err != nil {
@@ -475,6 +525,12 @@ func (r *routerRequest) searchFromPartition(ctx context.Context, partitionID ent
 		replyPartition.SearchResponse.Head.Params["pidCacheTime"] = pidCacheTimeStr
 	}
 
+	if timeout := pd.SearchRequest.PartitionTimeoutMs; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = withPartitionTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	clientType := pd.SearchRequest.Head.ClientType
 	// ensure node is alive
 	servers := r.client.Master().Cache().serverCache
@@ -482,8 +538,13 @@ func (r *routerRequest) searchFromPartition(ctx context.Context, partitionID ent
 	rpcEnd, rpcStart := time.Now(), time.Now()
 	nodeID := GetNodeIdsByClientType(clientType, partition, servers, r.client)
 
+	var (
+		refused map[entity.NodeID]bool
+		rpcErr  error
+	)
 	for len(partition.Replicas) > r.client.PS().faultyList.ItemCount() {
-		if r.client.PS().TestFaulty(nodeID) {
+		// adaptive only chooses a faulty node when the others are slower
+		if clientType != AdaptiveLoadBalance && r.client.PS().TestFaulty(nodeID) {
 			nodeID = GetNodeIdsByClientType(clientType, partition, servers, r.client)
 			continue
 		}
@@ -577,22 +638,41 @@ func (r *routerRequest) searchFromPartition(ctx context.Context, partitionID ent
 			replyPartition.SearchResponse.Head.Params["rpcBeforeTime"] = rpcBeforeTimeStr
 		}
 
-		err := rpcClient.Execute(ctx, UnaryHandler, pd, replyPartition)
+		nodeID, rpcErr = r.executeSearch(ctx, rpcClient, nodeID, partition, servers, pd, replyPartition)
 		rpcEnd = time.Now()
-		if err == nil {
+		if rpcErr == nil {
 			break
 		}
 
-		if strings.Contains(err.Error(), "connect: connection refused") {
+		if strings.Contains(rpcErr.Error(), "connect: connection refused") {
 			r.client.PS().AddFaulty(nodeID, time.Second*30)
+			if refused == nil {
+				refused = make(map[entity.NodeID]bool)
+			}
+			refused[nodeID] = true
 			nodeID = GetNodeIdsByClientType(clientType, partition, servers, r.client)
+			// adaptive may choose a faulty node again
+			if refused[nodeID] {
+				break
+			}
 		} else {
-			log.Error("rpc err [%v], nodeID %v", err, nodeID)
+			log.Error("rpc err [%v], nodeID %v", rpcErr, nodeID)
 			r.client.PS().AddFaulty(nodeID, time.Second*5)
 			break
 		}
 	}
 
+	replyPartition.PartitionID = partitionID
+	if rpcErr != nil {
+		// the reply of a failed rpc is not a search response
+		code := vearchpb.ErrorEnum_ROUTER_CALL_PS_RPC_ERR
+		if ctx.Err() == context.DeadlineExceeded {
+			code = vearchpb.ErrorEnum_TIMEOUT
+		}
+		head := &vearchpb.ResponseHead{Err: &vearchpb.Error{Code: code, Msg: rpcErr.Error()}, Params: make(map[string]string)}
+		replyPartition.SearchResponse = &vearchpb.SearchResponse{Head: head}
+	}
+
 	sortFieldMap := pd.SearchRequest.SortFieldMap
 	isIsLong := false
 	if idIsLong(space) {
@@ -615,34 +695,45 @@ func (r *routerRequest) searchFromPartition(ctx context.Context, partitionID ent
 			}
 		}
 
+		// search_after requests are deserialized and filtered by ps, so the
+		// results may already be there without flat bytes
 		flatBytes := searchResponse.FlatBytes
+		deSerializeStartTime := time.Now()
+		deSerializeEndTime := deSerializeStartTime
 		if flatBytes != nil {
-			deSerializeStartTime := time.Now()
 			gamma.DeSerialize(flatBytes, searchResponse)
-			deSerializeEndTime := time.Now()
+			deSerializeEndTime = time.Now()
 			if config.LogInfoPrintSwitch {
 				deSerializeCostTime := deSerializeEndTime.Sub(deSerializeStartTime).Seconds() * 1000
 				deSerializeCostTimeStr := strconv.FormatFloat(deSerializeCostTime, 'f', -1, 64)
 				searchResponse.Head.Params["deSerializeCostTime"] = deSerializeCostTimeStr
 			}
-			searchResults := searchResponse.Results
-			if searchResults != nil && len(searchResults) > 0 {
-				for i, searchResult := range searchResults {
-					searchItems := searchResult.ResultItems
-					for _, item := range searchItems {
-						source, sortValues, pkey, err := GetSource(item, space, isIsLong, sortFieldMap, pd.SearchRequest.SortFields)
-						if err != nil {
-							err := &vearchpb.Error{Code: vearchpb.ErrorEnum_PARSING_RESULT_ERROR, Msg: "router call ps rpc service err nodeID:" + fmt.Sprint(nodeID)}
-							replyPartition.SearchResponse.Head.Err = err
-						}
-						item.PKey = pkey
-						item.Source = source
-						index := strconv.Itoa(i)
-						sortValueMap[item.PKey+"_"+index] = sortValues
+		}
+		for _, profile := range searchResponse.Profiles {
+			profile.RpcMs = rpcEnd.Sub(rpcStart).Seconds() * 1000
+			profile.DeserializeMs = deSerializeEndTime.Sub(deSerializeStartTime).Seconds() * 1000
+		}
+		took := rpcEnd.Sub(rpcStart).Milliseconds()
+		r.addSearchStat(partitionID, took, searchResponse.Head)
+		searchResults := searchResponse.Results
+		if searchResults != nil && len(searchResults) > 0 {
+			for i, searchResult := range searchResults {
+				searchResult.MaxTook = took
+				searchResult.MaxTookId = uint32(partitionID)
+				searchItems := searchResult.ResultItems
+				for _, item := range searchItems {
+					source, sortValues, pkey, err := GetSource(item, space, isIsLong, sortFieldMap, pd.SearchRequest.SortFields)
+					if err != nil {
+						err := &vearchpb.Error{Code: vearchpb.ErrorEnum_PARSING_RESULT_ERROR, Msg: "router call ps rpc service err nodeID:" + fmt.Sprint(nodeID)}
+						replyPartition.SearchResponse.Head.Err = err
 					}
+					item.PKey = pkey
+					item.Source = source
+					index := strconv.Itoa(i)
+					sortValueMap[item.PKey+"_"+index] = sortValues
 				}
 			}
-			if config.LogInfoPrintSwitch {
+			if config.LogInfoPrintSwitch && searchResponse.Head != nil && searchResponse.Head.Params != nil {
 				fieldParsingTime := time.Since(deSerializeEndTime).Seconds() * 1000
 				fieldParsingTimeStr := strconv.FormatFloat(fieldParsingTime, 'f', -1, 64)
 				searchResponse.Head.Params["fieldParsingTime"] = fieldParsingTimeStr
@@ -727,8 +818,16 @@ func (r *routerRequest) SearchFieldSortExecute(sortOrder sortorder.SortOrder) *v
 	var searchResponse *vearchpb.SearchResponse
 
 	rpcCostTime, deSerializeCostTime, fieldParsingTime, gammaCostTime, serializeCostTime, pidCacheTime, nodeIdTime, rpcClientTime, normalTime, rpcBeforeTime, rpcTotalTime := decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0), decimal.NewFromFloat(0.0)
+	var profiles []*vearchpb.SearchProfile
+	partial := newPartialResults(len(sendPartitionMap))
 	mergeStartTime := time.Now()
 	for r := range respChain {
+		if r != nil {
+			partial.add(r.PartitionData)
+		}
+		if r != nil && r.PartitionData.SearchResponse != nil {
+			profiles = append(profiles, r.PartitionData.SearchResponse.Profiles...)
+		}
 		if result == nil && r != nil {
 			searchResponse = r.PartitionData.SearchResponse
 			if config.LogInfoPrintSwitch && searchResponse != nil && searchResponse.Head != nil && searchResponse.Head.Params != nil {
@@ -833,6 +932,7 @@ func (r *routerRequest) SearchFieldSortExecute(sortOrder sortorder.SortOrder) *v
 		mergeCostTimeStr = strconv.FormatFloat(mergeCostTime, 'f', -1, 64)
 	}
 
+	isIdLong := idIsLong(r.space)
 	if len(result) > 1 {
 		var wg sync.WaitGroup
 		respChain := make(chan map[string]*vearchpb.SearchResult, len(result))
@@ -841,7 +941,7 @@ func (r *routerRequest) SearchFieldSortExecute(sortOrder sortorder.SortOrder) *v
 			index := strconv.Itoa(i)
 			high := len(resp.ResultItems) - 1
 			go func(result *vearchpb.SearchResult, sortValueMap map[string][]sortorder.SortValue, low, high int, so sortorder.SortOrder, index string) {
-				quickSort(result.ResultItems, sortValueMap, low, high, so, index)
+				quickSort(result.ResultItems, sortValueMap, low, high, so, index, isIdLong)
 				sortMap := make(map[string]*vearchpb.SearchResult)
 				sortMap[index] = result
 				respChain <- sortMap
@@ -859,7 +959,7 @@ func (r *routerRequest) SearchFieldSortExecute(sortOrder sortorder.SortOrder) *v
 	} else {
 		for i, resp := range result {
 			index := strconv.Itoa(i)
-			quickSort(resp.ResultItems, sortValueMap, 0, len(resp.ResultItems)-1, sortOrder, index)
+			quickSort(resp.ResultItems, sortValueMap, 0, len(resp.ResultItems)-1, sortOrder, index, isIdLong)
 		}
 	}
 
@@ -878,6 +978,12 @@ func (r *routerRequest) SearchFieldSortExecute(sortOrder sortorder.SortOrder) *v
 		responseHead := &vearchpb.ResponseHead{Err: err}
 		searchResponse.Head = responseHead
 	}
+	if err := partial.apply(result, !searchReq.DenyPartialResults); err != nil {
+		if searchResponse.Head == nil {
+			searchResponse.Head = &vearchpb.ResponseHead{}
+		}
+		searchResponse.Head.Err = err
+	}
 	sortCostTime := time.Since(mergeStartTime).Seconds() * 1000
 	sortCostTimeStr := strconv.FormatFloat(sortCostTime, 'f', -1, 64)
 	if config.LogInfoPrintSwitch && searchResponse.Head != nil && searchResponse.Head.Params != nil {
@@ -900,28 +1006,36 @@ func (r *routerRequest) SearchFieldSortExecute(sortOrder sortorder.SortOrder) *v
 		searchResponse.Head.Params["rpcBeforeTime"] = rpcBeforeTime.String()
 		searchResponse.Head.Params["rpcTotalTime"] = rpcTotalTime.String()
 	}
+	if searchReq.Profile {
+		searchResponse.Profiles = profiles
+		searchResponse.RouterProfile = &vearchpb.RouterProfile{
+			MergeMs: sortCostTime,
+			TookMs:  time.Since(startTime).Seconds() * 1000,
+		}
+	}
 	searchResponse.Results = result
 	return searchResponse
 }
 
-func quickSort(items []*vearchpb.ResultItem, sortValueMap map[string][]sortorder.SortValue, low, high int, so sortorder.SortOrder, index string) {
+func quickSort(items []*vearchpb.ResultItem, sortValueMap map[string][]sortorder.SortValue, low, high int, so sortorder.SortOrder, index string, idIsLong bool) {
 	if low < high {
-		var pivot = partition(items, sortValueMap, low, high, so, index)
-		quickSort(items, sortValueMap, low, pivot, so, index)
-		quickSort(items, sortValueMap, pivot+1, high, so, index)
+		var pivot = partition(items, sortValueMap, low, high, so, index, idIsLong)
+		quickSort(items, sortValueMap, low, pivot, so, index, idIsLong)
+		quickSort(items, sortValueMap, pivot+1, high, so, index, idIsLong)
 	}
 }
 
-func partition(arr []*vearchpb.ResultItem, sortValueMap map[string][]sortorder.SortValue, low, high int, so sortorder.SortOrder, index string) int {
+func partition(arr []*vearchpb.ResultItem, sortValueMap map[string][]sortorder.SortValue, low, high int, so sortorder.SortOrder, index string, idIsLong bool) int {
 	var pivot = arr[low]
 	var pivotSort = sortValueMap[pivot.PKey+"_"+index]
+	var pivotKey = PKeySortValue(pivot.PKey, idIsLong)
 	var i = low
 	var j = high
 	for i < j {
-		for so.Compare(sortValueMap[arr[j].PKey+"_"+index], pivotSort) >= 0 && j > low {
+		for so.CompareWithKey(sortValueMap[arr[j].PKey+"_"+index], PKeySortValue(arr[j].PKey, idIsLong), pivotSort, pivotKey) >= 0 && j > low {
 			j--
 		}
-		for so.Compare(sortValueMap[arr[i].PKey+"_"+index], pivotSort) <= 0 && i < high {
+		for so.CompareWithKey(sortValueMap[arr[i].PKey+"_"+index], PKeySortValue(arr[i].PKey, idIsLong), pivotSort, pivotKey) <= 0 && i < high {
 			i++
 		}
 		if i < j {
@@ -993,6 +1107,84 @@ func generateUUID(key string, idIsLong bool) (string, error) {
 	return key, nil
 }
 
+// CountExecute sends a count or field stats request to every partition and
+// merges the exact counts and field statistics.
+func (r *routerRequest) CountExecute() *vearchpb.CountResponse {
+	var wg sync.WaitGroup
+	respChain := make(chan *vearchpb.PartitionData, len(r.sendMap))
+	for partitionID, pData := range r.sendMap {
+		wg.Add(1)
+		c := context.WithValue(r.ctx, share.ReqMetaDataKey, util.CopyMap(r.md))
+		go func(ctx context.Context, pid entity.PartitionID, d *vearchpb.PartitionData) {
+			defer wg.Done()
+			replyPartition := new(vearchpb.PartitionData)
+			defer func() {
+				if r := recover(); r != nil {
+					replyPartition.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_RECOVER, Msg: fmt.Sprintf("[Recover] partitionID: [%v], err: [%s]", pid, cast.ToString(r))}
+					respChain <- replyPartition
+				}
+			}()
+			partition, e := r.client.Master().Cache().PartitionByCache(ctx, r.space.Name, pid)
+			if e != nil {
+				panic(e.Error())
+			}
+			servers := r.client.Master().Cache().serverCache
+			nodeID := GetNodeIdsByClientType(d.SearchRequest.Head.ClientType, partition, servers, r.client)
+			err := r.client.PS().GetOrCreateRPCClient(ctx, nodeID).Execute(ctx, UnaryHandler, d, replyPartition)
+			if err != nil {
+				replyPartition.Err = vearchpb.NewError(vearchpb.ErrorEnum_ROUTER_CALL_PS_RPC_ERR, err).GetError()
+			}
+			respChain <- replyPartition
+		}(c, partitionID, pData)
+	}
+	wg.Wait()
+	close(respChain)
+
+	spaceProperties := r.space.SpaceProperties
+	if spaceProperties == nil {
+		spaceProperties, _ = entity.UnmarshalPropertyJSON(r.space.Properties)
+	}
+	countResponse := &vearchpb.CountResponse{}
+	collectors := make(map[string]*FieldStatsCollector)
+	var fields []string
+	for _, pData := range r.sendMap {
+		fields = pData.SearchRequest.Fields
+		break
+	}
+	for resp := range respChain {
+		if resp.Err != nil {
+			countResponse.Head = &vearchpb.ResponseHead{Err: resp.Err}
+			return countResponse
+		}
+		if resp.CountResponse == nil {
+			continue
+		}
+		if head := resp.CountResponse.Head; head != nil && head.Err != nil && head.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+			countResponse.Head = head
+			return countResponse
+		}
+		countResponse.Count += resp.CountResponse.Count
+		for _, stats := range resp.CountResponse.FieldStats {
+			c := collectors[stats.Field]
+			if c == nil {
+				var fieldType entity.FieldType
+				if p := spaceProperties[stats.Field]; p != nil {
+					fieldType = p.FieldType
+				}
+				c = NewFieldStatsCollector(stats.Field, fieldType)
+				collectors[stats.Field] = c
+			}
+			c.Merge(stats)
+		}
+	}
+	for _, field := range fields {
+		if c := collectors[field]; c != nil {
+			countResponse.FieldStats = append(countResponse.FieldStats, c.Stats())
+		}
+	}
+	return countResponse
+}
+
 func (r *routerRequest) SearchByPartitions(searchReq *vearchpb.SearchRequest) *routerRequest {
 	if r.Err != nil {
 		return r
@@ -1029,7 +1221,13 @@ func (r *routerRequest) BulkSearchByPartitions(searchReq []*vearchpb.SearchReque
 	return r
 }
 
-var replicaRoundRobin = NewReplicaRoundRobin()
+var (
+	replicaRoundRobin = NewReplicaRoundRobin()
+	replicaLatency    = NewReplicaLatency()
+)
+
+// AdaptiveLoadBalance chooses the replica with the lowest expected latency
+const AdaptiveLoadBalance = "adaptive"
 
 func GetNodeIdsByClientType(clientType string, partition *entity.Partition, servers *cache.Cache, client *Client) entity.NodeID {
 	nodeId := uint64(0)
@@ -1073,6 +1271,21 @@ func GetNodeIdsByClientType(clientType string, partition *entity.Partition, serv
 		if log.IsDebugEnabled() {
 			log.Debug("search by partition:%v by random model ID:[%d]", randIDs, nodeId)
 		}
+	case AdaptiveLoadBalance:
+		replicaIDs := make([]entity.NodeID, 0)
+		for _, nodeID := range partition.Replicas {
+			_, serverExist := servers.Get(cast.ToString(nodeID))
+			if !serverExist {
+				continue
+			}
+			if !config.Conf().Global.RaftConsistent || partition.ReStatusMap[nodeID] == entity.ReplicasOK {
+				replicaIDs = append(replicaIDs, nodeID)
+			}
+		}
+		nodeId = replicaLatency.Next(partition.Id, replicaIDs, client.PS().TestFaulty)
+		if log.IsDebugEnabled() {
+			log.Debug("search by partition:%v by adaptive model ID:[%d]", replicaIDs, nodeId)
+		}
 	case "least_connection":
 		leastId := uint64(0)
 		most := 1<<32 - 1
@@ -1327,6 +1540,77 @@ func GetSource(doc *vearchpb.ResultItem, space *entity.Space, idIsLong bool, sor
 	return marshal, sortValues, pKey, nil
 }
 
+// PKeySortValue returns the primary key of a hit as a sort value, it breaks
+// ties between hits that are equal on every sort.
+func PKeySortValue(pKey string, idIsLong bool) sortorder.SortValue {
+	if idIsLong {
+		id, _ := strconv.ParseInt(pKey, 10, 64)
+		return &sortorder.IntSortValue{Val: id, SortName: mapping.IdField}
+	}
+	return &sortorder.StringSortValue{Val: pKey, SortName: mapping.IdField}
+}
+
+// GetSearchAfter converts the search_after cursor of a request into sort
+// values typed like the ones GetSource produces for sortFields.
+func GetSearchAfter(searchAfter *vearchpb.SearchAfter, space *entity.Space, idIsLong bool, sortFields []*vearchpb.SortField) (*sortorder.SearchAfter, error) {
+	if len(searchAfter.SortValues) != len(sortFields) {
+		return nil, fmt.Errorf("search_after has %d sort values but sort has %d fields", len(searchAfter.SortValues), len(sortFields))
+	}
+	spaceProperties := space.SpaceProperties
+	if spaceProperties == nil {
+		spacePro, _ := entity.UnmarshalPropertyJSON(space.Properties)
+		spaceProperties = spacePro
+	}
+
+	values := make(sortorder.SortValues, len(sortFields))
+	for i, sortField := range sortFields {
+		name := sortField.Field
+		value := searchAfter.SortValues[i]
+		switch name {
+		case "_score":
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("search_after value [%s] of _score is not a number", value)
+			}
+			values[i] = &sortorder.FloatSortValue{Val: f, SortName: name}
+		case mapping.IdField:
+			if idIsLong {
+				if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+					return nil, fmt.Errorf("search_after value [%s] of %s is not a long", value, name)
+				}
+			}
+			values[i] = PKeySortValue(value, idIsLong)
+		default:
+			field := spaceProperties[name]
+			if field == nil {
+				return nil, fmt.Errorf("search_after sort field [%s] not space field", name)
+			}
+			switch field.FieldType {
+			case entity.FieldType_STRING:
+				if field.Array {
+					return nil, fmt.Errorf("search_after not support array field [%s]", name)
+				}
+				values[i] = &sortorder.StringSortValue{Val: value, SortName: name}
+			case entity.FieldType_INT, entity.FieldType_LONG:
+				v, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("search_after value [%s] of %s is not an integer", value, name)
+				}
+				values[i] = &sortorder.IntSortValue{Val: v, SortName: name}
+			case entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+				v, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, fmt.Errorf("search_after value [%s] of %s is not a number", value, name)
+				}
+				values[i] = &sortorder.FloatSortValue{Val: v, SortName: name}
+			default:
+				return nil, fmt.Errorf("search_after not support sort field [%s] type [%v]", name, field.FieldType)
+			}
+		}
+	}
+	return &sortorder.SearchAfter{Values: values, Key: PKeySortValue(searchAfter.PKey, idIsLong)}, nil
+}
+
 func MergeArrForField(dest []*vearchpb.SearchResult, src []*vearchpb.SearchResult, firstSortValue map[string][]sortorder.SortValue, so sortorder.SortOrder, size int32) error {
 
 	if len(dest) != len(src) {
@@ -1402,6 +1686,7 @@ func MergeForField(old *vearchpb.SearchResult, other *vearchpb.SearchResult, fir
 	}
 
 	old.Timeout = old.Timeout && other.Timeout
+	old.Truncated = old.Truncated || other.Truncated
 
 	if len(old.ResultItems) > 0 || len(other.ResultItems) > 0 {
 		old.ResultItems = HitsMergeForField(old.ResultItems, firstSortValue, old.PID, other.PID, other.ResultItems, sortOrder, from, size)
@@ -1520,6 +1805,7 @@ func AddMerge(sr *vearchpb.SearchResult, other *vearchpb.SearchResult) (err erro
 	}
 
 	sr.Timeout = sr.Timeout && other.Timeout
+	sr.Truncated = sr.Truncated || other.Truncated
 
 	if len(sr.ResultItems) > 0 || len(other.ResultItems) > 0 {
 		sr.ResultItems = append(sr.ResultItems, other.ResultItems...)
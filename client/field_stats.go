@@ -0,0 +1,151 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+// sketchRegisters is the number of registers of the HyperLogLog sketch of
+// the values of a field the engine returns, 2^12 of them give an error of
+// about 1.6% in the distinct count
+const sketchRegisters = 1 << 12
+
+// FieldStatsCollector merges the statistics of one field collected by the
+// engines of the partitions.
+type FieldStatsCollector struct {
+	fieldType entity.FieldType
+	stats     *vearchpb.FieldStats
+}
+
+func NewFieldStatsCollector(field string, fieldType entity.FieldType) *FieldStatsCollector {
+	return &FieldStatsCollector{
+		fieldType: fieldType,
+		stats:     &vearchpb.FieldStats{Field: field},
+	}
+}
+
+// FieldValueStrings decodes a field value returned by the engine, an array
+// field gives one string per element.
+func FieldValueStrings(value []byte, field *entity.SpaceProperties) []string {
+	if len(value) == 0 {
+		return nil
+	}
+	switch field.FieldType {
+	case entity.FieldType_STRING:
+		if field.Array {
+			return strings.Split(string(value), string([]byte{'\001'}))
+		}
+		return []string{string(value)}
+	case entity.FieldType_INT:
+		return []string{strconv.FormatInt(int64(cbbytes.Bytes2Int32(value)), 10)}
+	case entity.FieldType_LONG, entity.FieldType_DATE:
+		return []string{strconv.FormatInt(cbbytes.Bytes2Int(value), 10)}
+	case entity.FieldType_BOOL:
+		return []string{strconv.FormatBool(cbbytes.Bytes2Int(value) != 0)}
+	case entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+		return []string{strconv.FormatFloat(cbbytes.ByteToFloat64(value), 'f', -1, 64)}
+	}
+	return nil
+}
+
+// Merge adds the statistics of a partition.
+func (c *FieldStatsCollector) Merge(stats *vearchpb.FieldStats) {
+	if stats.Count == 0 {
+		return
+	}
+	if c.stats.Count == 0 {
+		c.stats.Min, c.stats.Max = stats.Min, stats.Max
+	} else {
+		if c.compare(stats.Min, c.stats.Min) < 0 {
+			c.stats.Min = stats.Min
+		}
+		if c.compare(stats.Max, c.stats.Max) > 0 {
+			c.stats.Max = stats.Max
+		}
+	}
+	c.stats.Count += stats.Count
+	if len(stats.Sketch) != sketchRegisters {
+		return
+	}
+	if c.stats.Sketch == nil {
+		c.stats.Sketch = make([]byte, sketchRegisters)
+	}
+	for i, r := range stats.Sketch {
+		if r > c.stats.Sketch[i] {
+			c.stats.Sketch[i] = r
+		}
+	}
+}
+
+func (c *FieldStatsCollector) compare(a, b string) int {
+	switch c.fieldType {
+	case entity.FieldType_INT, entity.FieldType_LONG, entity.FieldType_DATE:
+		x, _ := strconv.ParseInt(a, 10, 64)
+		y, _ := strconv.ParseInt(b, 10, 64)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+		x, _ := strconv.ParseFloat(a, 64)
+		y, _ := strconv.ParseFloat(b, 64)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+// Stats returns the merged statistics with the distinct count estimated from
+// the merged sketch.
+func (c *FieldStatsCollector) Stats() *vearchpb.FieldStats {
+	c.stats.Distinct = EstimateDistinct(c.stats.Sketch)
+	return c.stats
+}
+
+// EstimateDistinct is the HyperLogLog estimate of the number of distinct
+// values of sketch, with linear counting while registers are still empty.
+func EstimateDistinct(sketch []byte) int64 {
+	if len(sketch) == 0 {
+		return 0
+	}
+	m := float64(len(sketch))
+	sum, zeros := 0.0, 0
+	for _, r := range sketch {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(math.Round(estimate))
+}
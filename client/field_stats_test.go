@@ -0,0 +1,74 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"math/bits"
+	"strconv"
+	"testing"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+// sketchOf builds the sketch of values as the engine does
+func sketchOf(values []string) []byte {
+	sketch := make([]byte, sketchRegisters)
+	for _, v := range values {
+		h := uint64(14695981039346656037)
+		for i := 0; i < len(v); i++ {
+			h ^= uint64(v[i])
+			h *= 1099511628211
+		}
+		h ^= h >> 33
+		h *= 0xff51afd7ed558ccd
+		h ^= h >> 33
+		h *= 0xc4ceb9fe1a85ec53
+		h ^= h >> 33
+		rank := byte(bits.LeadingZeros64(h<<12|1<<11) + 1)
+		if idx := h >> 52; sketch[idx] < rank {
+			sketch[idx] = rank
+		}
+	}
+	return sketch
+}
+
+func TestFieldStatsMerge(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 60000; i++ {
+		a = append(a, strconv.Itoa(i))
+		// half of the values of b are in a too
+		b = append(b, strconv.Itoa(i+30000))
+	}
+	c := NewFieldStatsCollector("f", entity.FieldType_INT)
+	c.Merge(&vearchpb.FieldStats{Field: "f", Count: 60000, Min: "0", Max: "59999", Sketch: sketchOf(a)})
+	c.Merge(&vearchpb.FieldStats{Field: "f"})
+	c.Merge(&vearchpb.FieldStats{Field: "f", Count: 60000, Min: "30000", Max: "89999", Sketch: sketchOf(b)})
+	stats := c.Stats()
+	if stats.Count != 120000 || stats.Min != "0" || stats.Max != "89999" {
+		t.Fatalf("stats: count %d, min %s, max %s", stats.Count, stats.Min, stats.Max)
+	}
+	if d := float64(stats.Distinct); d < 90000*0.95 || d > 90000*1.05 {
+		t.Fatalf("distinct %d, want about 90000", stats.Distinct)
+	}
+
+	// a few values are counted by linear counting, almost exactly
+	if d := EstimateDistinct(sketchOf([]string{"a", "b", "c", "a"})); d != 3 {
+		t.Fatalf("distinct %d, want 3", d)
+	}
+	if d := EstimateDistinct(nil); d != 0 {
+		t.Fatalf("distinct of no sketch %d", d)
+	}
+}
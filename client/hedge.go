@@ -0,0 +1,226 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/smallnest/rpcx/share"
+	"github.com/spf13/cast"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util"
+	"github.com/vearch/vearch/util/log"
+)
+
+const (
+	// latencies of the latest searches of a partition kept for the percentile
+	hedgeSamples = 128
+	// no hedging until a partition has this many samples
+	hedgeMinSamples   = 20
+	defaultHedgeRatio = 0.05
+	// hedges that may be sent at once when the budget is full
+	hedgeBurst = 10
+)
+
+// partitionLatency is a ring of the latest search latencies of a partition
+type partitionLatency struct {
+	mu      sync.Mutex
+	samples [hedgeSamples]time.Duration
+	next    int
+	full    bool
+}
+
+func (p *partitionLatency) add(took time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.samples[p.next] = took
+	p.next++
+	if p.next == hedgeSamples {
+		p.next = 0
+		p.full = true
+	}
+}
+
+// percentile returns 0 if there are not enough samples
+func (p *partitionLatency) percentile(percent float64) time.Duration {
+	p.mu.Lock()
+	n := p.next
+	if p.full {
+		n = hedgeSamples
+	}
+	if n < hedgeMinSamples {
+		p.mu.Unlock()
+		return 0
+	}
+	sorted := make([]time.Duration, n)
+	copy(sorted, p.samples[:n])
+	p.mu.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	i := int(float64(n)*percent/100+0.5) - 1
+	if i < 0 {
+		i = 0
+	} else if i >= n {
+		i = n - 1
+	}
+	return sorted[i]
+}
+
+// searchHedger sends a search to a second replica of a partition when the
+// first one is slower than usual. Every search adds ratio to the budget and
+// every hedge takes one from it, so hedges are at most ratio of the searches.
+type searchHedger struct {
+	// key: partition id, value: *partitionLatency
+	latencies sync.Map
+
+	mu     sync.Mutex
+	budget float64
+}
+
+var hedger = &searchHedger{}
+
+func (h *searchHedger) latency(pid entity.PartitionID) *partitionLatency {
+	l, _ := h.latencies.LoadOrStore(pid, &partitionLatency{})
+	return l.(*partitionLatency)
+}
+
+// delay returns how long to wait for a partition before hedging, 0 when the
+// search must not be hedged.
+func (h *searchHedger) delay(pid entity.PartitionID) time.Duration {
+	cfg := config.Conf().Router
+	if cfg == nil || cfg.HedgePercentile <= 0 {
+		return 0
+	}
+	ratio := cfg.HedgeBudget
+	if ratio <= 0 {
+		ratio = defaultHedgeRatio
+	}
+	h.mu.Lock()
+	if h.budget += ratio; h.budget > hedgeBurst {
+		h.budget = hedgeBurst
+	}
+	h.mu.Unlock()
+	return h.latency(pid).percentile(cfg.HedgePercentile)
+}
+
+// take spends one hedge of the budget
+func (h *searchHedger) take() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.budget < 1 {
+		return false
+	}
+	h.budget--
+	return true
+}
+
+// hedgeReplica returns a healthy replica of partition other than nodeID, 0 if there is none
+func (r *routerRequest) hedgeReplica(partition *entity.Partition, nodeID entity.NodeID, servers *cache.Cache) entity.NodeID {
+	replicaIDs := make([]entity.NodeID, 0, len(partition.Replicas))
+	for _, id := range partition.Replicas {
+		if id == nodeID || r.client.PS().TestFaulty(id) {
+			continue
+		}
+		if _, ok := servers.Get(cast.ToString(id)); !ok {
+			continue
+		}
+		if !config.Conf().Global.RaftConsistent || partition.ReStatusMap[id] == entity.ReplicasOK {
+			replicaIDs = append(replicaIDs, id)
+		}
+	}
+	return replicaLatency.Next(partition.Id, replicaIDs, nil)
+}
+
+type hedgeResult struct {
+	nodeID entity.NodeID
+	reply  *vearchpb.PartitionData
+	err    error
+}
+
+// executeSearch sends pd to nodeID, and to another replica too when nodeID
+// has not answered within the hedging delay of the partition. The first
+// successful reply is copied into reply and the other search is cancelled.
+// It returns the node that answered.
+func (r *routerRequest) executeSearch(ctx context.Context, cli *rpcClient, nodeID entity.NodeID, partition *entity.Partition, servers *cache.Cache, pd, reply *vearchpb.PartitionData) (entity.NodeID, error) {
+	delay := hedger.delay(partition.Id)
+	if delay <= 0 {
+		start := time.Now()
+		replicaLatency.Begin(nodeID)
+		err := cli.Execute(ctx, UnaryHandler, pd, reply)
+		replicaLatency.End(nodeID, time.Since(start), err)
+		if err == nil {
+			hedger.latency(partition.Id).add(time.Since(start))
+		}
+		return nodeID, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan *hedgeResult, 2)
+	start := time.Now()
+	// the metadata of a request is written by the rpc client, so each
+	// search has its own copy
+	md, _ := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	send := func(nodeID entity.NodeID, cli *rpcClient) {
+		res := &hedgeResult{nodeID: nodeID, reply: new(vearchpb.PartitionData)}
+		sendStart := time.Now()
+		replicaLatency.Begin(nodeID)
+		res.err = cli.Execute(context.WithValue(ctx, share.ReqMetaDataKey, util.CopyMap(md)), UnaryHandler, pd, res.reply)
+		replicaLatency.End(nodeID, time.Since(sendStart), res.err)
+		results <- res
+	}
+	go send(nodeID, cli)
+
+	pending := 1
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	var first *hedgeResult
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				hedger.latency(partition.Id).add(time.Since(start))
+				*reply = *res.reply
+				return res.nodeID, nil
+			}
+			if first == nil {
+				first = res
+			}
+		case <-timer.C:
+			hedgeID := r.hedgeReplica(partition, nodeID, servers)
+			if hedgeID == 0 || !hedger.take() {
+				continue
+			}
+			hedgeClient := r.client.PS().GetOrCreateRPCClient(ctx, hedgeID)
+			if hedgeClient == nil || hedgeClient == nilClient {
+				continue
+			}
+			if log.IsDebugEnabled() {
+				log.Debug("hedge search of partition:[%d] from node:[%d] to node:[%d] after %v", partition.Id, nodeID, hedgeID, delay)
+			}
+			pending++
+			go send(hedgeID, hedgeClient)
+		}
+	}
+	*reply = *first.reply
+	return first.nodeID, first.err
+}
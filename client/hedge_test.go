@@ -0,0 +1,112 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vearch/vearch/config"
+)
+
+func initHedgeConfig(t *testing.T, percentile, budget string) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	conf := "[router]\nhedge_percentile = " + percentile + "\nhedge_budget = " + budget + "\n"
+	if err := os.WriteFile(path, []byte(conf), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	config.InitConfig(path)
+}
+
+func TestPartitionLatencyPercentile(t *testing.T) {
+	p := &partitionLatency{}
+	for i := 1; i < hedgeMinSamples; i++ {
+		p.add(time.Duration(i) * time.Millisecond)
+	}
+	if got := p.percentile(90); got != 0 {
+		t.Fatalf("percentile of %d samples: %v, want 0", hedgeMinSamples-1, got)
+	}
+	for i := hedgeMinSamples; i <= 100; i++ {
+		p.add(time.Duration(i) * time.Millisecond)
+	}
+	for _, tt := range []struct {
+		percent float64
+		want    time.Duration
+	}{
+		{0, time.Millisecond},
+		{50, 50 * time.Millisecond},
+		{90, 90 * time.Millisecond},
+		{99.5, 100 * time.Millisecond},
+		{100, 100 * time.Millisecond},
+	} {
+		if got := p.percentile(tt.percent); got != tt.want {
+			t.Errorf("p%v of 1..100ms: %v, want %v", tt.percent, got, tt.want)
+		}
+	}
+
+	// the ring keeps the latest samples only
+	for i := 0; i < hedgeSamples; i++ {
+		p.add(time.Second)
+	}
+	if got := p.percentile(0); got != time.Second {
+		t.Fatalf("p0 after the ring is overwritten: %v", got)
+	}
+}
+
+func TestHedgeBudget(t *testing.T) {
+	initHedgeConfig(t, "90", "0.5")
+	h := &searchHedger{}
+
+	// every search adds the ratio, a hedge takes a whole one
+	h.delay(1)
+	if h.take() {
+		t.Fatalf("hedge taken from a budget of 0.5")
+	}
+	h.delay(1)
+	if !h.take() || h.take() {
+		t.Fatalf("budget of 1 did not give exactly one hedge")
+	}
+
+	// the budget caps at hedgeBurst however many searches there were
+	for i := 0; i < 10*hedgeBurst; i++ {
+		h.delay(1)
+	}
+	taken := 0
+	for h.take() {
+		taken++
+	}
+	if taken != hedgeBurst {
+		t.Fatalf("%d hedges taken at once, want %d", taken, hedgeBurst)
+	}
+
+	// no delay before the partition has enough samples
+	if d := h.delay(2); d != 0 {
+		t.Fatalf("delay without samples: %v", d)
+	}
+	for i := 0; i < hedgeMinSamples; i++ {
+		h.latency(2).add(10 * time.Millisecond)
+	}
+	if d := h.delay(2); d != 10*time.Millisecond {
+		t.Fatalf("delay: %v, want 10ms", d)
+	}
+
+	// hedging is off without a percentile
+	initHedgeConfig(t, "0", "0.5")
+	if d := h.delay(2); d != 0 {
+		t.Fatalf("delay with hedging off: %v", d)
+	}
+}
@@ -33,7 +33,6 @@ import (
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/netutil"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 const (
@@ -372,7 +371,7 @@ func (m *masterClient) QuerySpaceByName(ctx context.Context, dbID int64, spaceNa
 // to the channel are not consumed promptly the channel may become full. When full, the lease
 // client will continue sending keep alive requests to the etcd server, but will drop responses
 // until there is capacity on the channel to send more responses.
-func (m *masterClient) KeepAlive(ctx context.Context, server *entity.Server) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (m *masterClient) KeepAlive(ctx context.Context, server *entity.Server) (<-chan *store.LeaseKeepAliveResponse, error) {
 	bytes, err := json.Marshal(server)
 	if err != nil {
 		return nil, err
@@ -385,7 +384,7 @@ func (m *masterClient) KeepAlive(ctx context.Context, server *entity.Server) (<-
 }
 
 // PutServerWithLeaseID PutServerWithLeaseID
-func (m *masterClient) PutServerWithLeaseID(ctx context.Context, server *entity.Server, leaseID clientv3.LeaseID) error {
+func (m *masterClient) PutServerWithLeaseID(ctx context.Context, server *entity.Server, leaseID store.LeaseID) error {
 	bytes, err := json.Marshal(server)
 	if err != nil {
 		return err
@@ -26,13 +26,13 @@ import (
 	"github.com/patrickmn/go-cache"
 	"github.com/spf13/cast"
 	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/util/cbjson"
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/vearchlog"
-	"go.etcd.io/etcd/api/v3/mvccpb"
 )
 
 const retryNum = 3
@@ -837,13 +837,13 @@ func (wj *watcherJob) start() {
 
 					for _, event := range reps.Events {
 						switch event.Type {
-						case mvccpb.PUT:
+						case store.PUT:
 							err := wj.put(event.Kv.Value)
 							if err != nil {
 								log.Error("change cache %s, err: %s , content: %s", wj.prefix, err.Error(), string(event.Kv.Value))
 							}
 
-						case mvccpb.DELETE:
+						case store.DELETE:
 							err := wj.delete(string(event.Kv.Key))
 							if err != nil {
 								log.Error("delete cache %s, err: %s , content: %s", wj.prefix, err.Error(), string(event.Kv.Value))
@@ -0,0 +1,125 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+// withPartitionTimeout bounds the search of one partition, the deadline of
+// the request is kept when it is earlier.
+func withPartitionTimeout(ctx context.Context, timeoutMs int64) (context.Context, context.CancelFunc) {
+	endTime := time.Now().Add(time.Duration(timeoutMs) * time.Millisecond)
+	if t, ok := ctx.Value(entity.RPC_TIME_OUT).(time.Time); !ok || endTime.Before(t) {
+		ctx = context.WithValue(ctx, entity.RPC_TIME_OUT, endTime)
+	}
+	return context.WithDeadline(ctx, endTime)
+}
+
+// partialResults collects the partitions a search failed on
+type partialResults struct {
+	total int
+	// failed for all the queries of the request
+	failures []*vearchpb.PartitionFailure
+	// key: index of the query, failed by the engine for that query only
+	queryFailures map[int][]*vearchpb.PartitionFailure
+}
+
+func newPartialResults(total int) *partialResults {
+	return &partialResults{total: total, queryFailures: make(map[int][]*vearchpb.PartitionFailure)}
+}
+
+func (p *partialResults) add(pd *vearchpb.PartitionData) {
+	resp := pd.SearchResponse
+	if resp == nil {
+		p.failures = append(p.failures, &vearchpb.PartitionFailure{
+			PartitionId: pd.PartitionID,
+			Code:        vearchpb.ErrorEnum_ROUTER_CALL_PS_RPC_ERR,
+			Reason:      "no search response",
+		})
+		return
+	}
+	if resp.Head != nil && resp.Head.Err != nil && resp.Head.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		err := resp.Head.Err
+		p.failures = append(p.failures, &vearchpb.PartitionFailure{
+			PartitionId: pd.PartitionID,
+			Code:        err.Code,
+			Reason:      err.Msg,
+			Timeout:     err.Code == vearchpb.ErrorEnum_TIMEOUT,
+		})
+		return
+	}
+	for i, result := range resp.Results {
+		if result.Status != nil && result.Status.Failed > 0 {
+			p.queryFailures[i] = append(p.queryFailures[i], &vearchpb.PartitionFailure{
+				PartitionId: pd.PartitionID,
+				Code:        vearchpb.ErrorEnum_GAMMA_SEARCH_OTHER_ERR,
+				Reason:      result.Msg,
+				Timeout:     result.Timeout,
+			})
+		}
+	}
+}
+
+// apply sets the status of each merged result. It returns an error when no
+// partition answered, or when some failed and partial results are not allowed.
+func (p *partialResults) apply(results []*vearchpb.SearchResult, allowPartial bool) *vearchpb.Error {
+	var failed *vearchpb.PartitionFailure
+	if len(p.failures) > 0 {
+		failed = p.failures[0]
+	}
+	for i, result := range results {
+		failures := append(append([]*vearchpb.PartitionFailure(nil), p.failures...), p.queryFailures[i]...)
+		if failed == nil && len(failures) > 0 {
+			failed = failures[0]
+		}
+		if result.Status == nil {
+			result.Status = &vearchpb.SearchStatus{}
+		}
+		status := result.Status
+		status.Total = int32(p.total)
+		status.Failed = int32(len(failures))
+		status.Successful = status.Total - status.Failed
+		status.Failures = failures
+		if p.total > 0 {
+			status.Coverage = float64(status.Successful) / float64(p.total)
+		}
+		for _, f := range failures {
+			result.Timeout = result.Timeout || f.Timeout
+		}
+	}
+	if failed == nil {
+		return nil
+	}
+	if len(results) > 0 && len(p.failures) < p.total && allowPartial {
+		return nil
+	}
+	failedIDs := make(map[uint32]bool)
+	for _, f := range p.failures {
+		failedIDs[f.PartitionId] = true
+	}
+	for _, failures := range p.queryFailures {
+		for _, f := range failures {
+			failedIDs[f.PartitionId] = true
+		}
+	}
+	msg := fmt.Sprintf("search failed on %d of %d partitions, partition [%d]: %s", len(failedIDs), p.total, failed.PartitionId, failed.Reason)
+	return &vearchpb.Error{Code: vearchpb.ErrorEnum_PARTIAL_RESULTS, Msg: msg}
+}
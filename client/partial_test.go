@@ -0,0 +1,131 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+func okPartition(pid uint32, queries int) *vearchpb.PartitionData {
+	resp := &vearchpb.SearchResponse{}
+	for i := 0; i < queries; i++ {
+		resp.Results = append(resp.Results, &vearchpb.SearchResult{})
+	}
+	return &vearchpb.PartitionData{PartitionID: pid, SearchResponse: resp}
+}
+
+func timedOutPartition(pid uint32) *vearchpb.PartitionData {
+	return &vearchpb.PartitionData{PartitionID: pid, SearchResponse: &vearchpb.SearchResponse{
+		Head: &vearchpb.ResponseHead{Err: &vearchpb.Error{Code: vearchpb.ErrorEnum_TIMEOUT, Msg: "timeout"}},
+	}}
+}
+
+func mergedResults(n int) []*vearchpb.SearchResult {
+	results := make([]*vearchpb.SearchResult, n)
+	for i := range results {
+		results[i] = &vearchpb.SearchResult{}
+	}
+	return results
+}
+
+func TestPartialResultsOneFailed(t *testing.T) {
+	for _, allowPartial := range []bool{true, false} {
+		p := newPartialResults(4)
+		for pid := uint32(1); pid <= 3; pid++ {
+			p.add(okPartition(pid, 1))
+		}
+		p.add(timedOutPartition(4))
+
+		results := mergedResults(1)
+		err := p.apply(results, allowPartial)
+		if allowPartial && err != nil {
+			t.Fatalf("partial results allowed: %v", err)
+		}
+		if !allowPartial && (err == nil || err.Code != vearchpb.ErrorEnum_PARTIAL_RESULTS) {
+			t.Fatalf("deny_partial_results: %v, want PARTIAL_RESULTS", err)
+		}
+		status := results[0].Status
+		if status.Total != 4 || status.Successful != 3 || status.Failed != 1 || status.Coverage != 0.75 {
+			t.Fatalf("status: %v", status)
+		}
+		if len(status.Failures) != 1 || status.Failures[0].PartitionId != 4 || !status.Failures[0].Timeout || !results[0].Timeout {
+			t.Fatalf("failures: %v, timeout: %v", status.Failures, results[0].Timeout)
+		}
+	}
+}
+
+func TestPartialResultsQueryFailures(t *testing.T) {
+	p := newPartialResults(2)
+	p.add(okPartition(1, 2))
+	failed := okPartition(2, 2)
+	failed.SearchResponse.Results[1].Status = &vearchpb.SearchStatus{Failed: 1}
+	failed.SearchResponse.Results[1].Msg = "engine error"
+	p.add(failed)
+
+	results := mergedResults(2)
+	if err := p.apply(results, true); err != nil {
+		t.Fatalf("partial results allowed: %v", err)
+	}
+	if c := results[0].Status.Coverage; c != 1 {
+		t.Fatalf("coverage of the query answered everywhere: %v", c)
+	}
+	if c := results[1].Status.Coverage; c != 0.5 {
+		t.Fatalf("coverage of the query failed on one partition: %v", c)
+	}
+	if err := p.apply(mergedResults(2), false); err == nil || err.Code != vearchpb.ErrorEnum_PARTIAL_RESULTS {
+		t.Fatalf("deny_partial_results: %v, want PARTIAL_RESULTS", err)
+	}
+}
+
+func TestPartialResultsAllFailed(t *testing.T) {
+	p := newPartialResults(2)
+	p.add(timedOutPartition(1))
+	p.add(&vearchpb.PartitionData{PartitionID: 2})
+
+	results := mergedResults(1)
+	if err := p.apply(results, true); err == nil || err.Code != vearchpb.ErrorEnum_PARTIAL_RESULTS {
+		t.Fatalf("no partition answered: %v", err)
+	}
+	if status := results[0].Status; status.Coverage != 0 || status.Failed != 2 {
+		t.Fatalf("status: %v", status)
+	}
+}
+
+func TestWithPartitionTimeout(t *testing.T) {
+	// the partition timeout is earlier than the request deadline
+	end := time.Now().Add(time.Hour)
+	ctx, cancel := withPartitionTimeout(context.WithValue(context.Background(), entity.RPC_TIME_OUT, end), 100)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > 100*time.Millisecond {
+		t.Fatalf("deadline in %v", time.Until(deadline))
+	}
+	if got := ctx.Value(entity.RPC_TIME_OUT).(time.Time); !got.Equal(deadline) {
+		t.Fatalf("rpc timeout %v, want the partition deadline %v", got, deadline)
+	}
+
+	// the request deadline is earlier and kept
+	end = time.Now().Add(10 * time.Millisecond)
+	ctx, cancel = withPartitionTimeout(context.WithValue(context.Background(), entity.RPC_TIME_OUT, end), 1000)
+	defer cancel()
+	if got := ctx.Value(entity.RPC_TIME_OUT).(time.Time); !got.Equal(end) {
+		t.Fatalf("rpc timeout %v, want the request deadline %v", got, end)
+	}
+}
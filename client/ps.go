@@ -49,6 +49,16 @@ const (
 	HandlerType  = "type"
 	UnaryHandler = "UnaryHandler"
 
+	// db and space of the request, used by ps metrics
+	HeadDbName    = "db_name"
+	HeadSpaceName = "space_name"
+	// slow log threshold in ms of the space, set when it is enabled
+	SlowLogThreshold = "slow_log_threshold"
+
+	// search counters a ps returns in the response head params
+	FilterScanned = "filterScanned"
+	FilterMatched = "filterMatched"
+
 	SearchHandler        = "SearchHandler"
 	BulkSearchHandler    = "BulkSearchHandler"
 	DeleteByQueryHandler = "DeleteByQueryHandler"
@@ -57,6 +67,8 @@ const (
 	MSearchForIDsHandler = "MSearchForIDsHandler"
 	MSearchNewHandler    = "MSearchNewHandler"
 	StreamSearchHandler  = "StreamSearchHandler"
+	CountHandler         = "CountHandler"
+	FieldStatsHandler    = "FieldStatsHandler"
 
 	GetDocHandler             = "GetDocHandler"
 	GetDocsHandler            = "GetDocsHandler"
@@ -78,6 +90,12 @@ const (
 	PartitionInfoHandler   = "PartitionInfoHandler"
 	ChangeMemberHandler    = "ChangeMemberHandler"
 	EngineCfgHandler       = "EngineCfgHandler"
+	SlowLogHandler         = "SlowLogHandler"
+	SnapshotLimitHandler   = "SnapshotLimitHandler"
+	SnapshotResumeHandler  = "SnapshotResumeHandler"
+	MovePartitionHandler   = "MovePartitionHandler"
+	ReplicaCheckHandler    = "ReplicaCheckHandler"
+	LocalPartitionsHandler = "LocalPartitionsHandler"
 )
 
 type psClient struct {
@@ -23,6 +23,7 @@ import (
 	"github.com/vearch/vearch/util/cbjson"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/metrics/mserver"
+	"github.com/vearch/vearch/util/slowlog"
 )
 
 func operatePartition(method, addr string, space *entity.Space, pid uint32) error {
@@ -136,6 +137,47 @@ func ServerStats(addr string) *mserver.ServerStats {
 
 }
 
+// SlowLog returns the slow log entries kept by the ps of addr, newest first
+func SlowLog(addr string) ([]*slowlog.Entry, error) {
+	reply := new(vearchpb.PartitionData)
+	if err := Execute(addr, SlowLogHandler, new(vearchpb.PartitionData), reply); err != nil {
+		return nil, err
+	} else if reply.Err != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return nil, vearchpb.NewError(reply.Err.Code, nil)
+	}
+	entries := make([]*slowlog.Entry, 0)
+	if len(reply.Data) > 0 {
+		if err := json.Unmarshal(reply.Data, &entries); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// SnapshotLimit sets the snapshot rates of the ps of addr when limit is not
+// nil, and returns its snapshot stats
+func SnapshotLimit(addr string, limit *entity.SnapshotLimit) (*entity.SnapshotStats, error) {
+	args := &vearchpb.PartitionData{Type: vearchpb.OpType_GET}
+	if limit != nil {
+		value, err := json.Marshal(limit)
+		if err != nil {
+			return nil, err
+		}
+		args.Type, args.Data = vearchpb.OpType_CREATE, value
+	}
+	reply := new(vearchpb.PartitionData)
+	if err := Execute(addr, SnapshotLimitHandler, args, reply); err != nil {
+		return nil, err
+	} else if reply.Err != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return nil, vearchpb.NewError(reply.Err.Code, nil)
+	}
+	stats := new(entity.SnapshotStats)
+	if err := json.Unmarshal(reply.Data, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
 func IsLive(addr string) bool {
 	err := Execute(addr, IsLiveHandler, new(vearchpb.PartitionData), new(vearchpb.PartitionData))
 	return err == nil
@@ -178,6 +220,95 @@ func _partitionsInfo(addr string, pid entity.PartitionID, detail_info bool) (val
 	return value, nil
 }
 
+// ResumeSnapshot reports the files of snapshot resume.SnapshotID a follower
+// has received to the ps of addr sending it
+func ResumeSnapshot(addr string, resume *entity.SnapshotResume) error {
+	value, err := json.Marshal(resume)
+	if err != nil {
+		return err
+	}
+	args := &vearchpb.PartitionData{PartitionID: resume.PartitionID, Data: value}
+	reply := new(vearchpb.PartitionData)
+	if err = Execute(addr, SnapshotResumeHandler, args, reply); err != nil {
+		return err
+	} else if reply.Err != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return vearchpb.NewErrorInfo(reply.Err.Code, reply.Err.Msg)
+	}
+	return nil
+}
+
+// MovePartition moves a partition of the ps of addr to another of its data dirs
+func MovePartition(addr string, move *entity.MovePartition) error {
+	value, err := cbjson.Marshal(move)
+	if err != nil {
+		return err
+	}
+	args := &vearchpb.PartitionData{PartitionID: move.PartitionID, Data: value}
+	reply := new(vearchpb.PartitionData)
+	err = Execute(addr, MovePartitionHandler, args, reply)
+	if err != nil {
+		return err
+	} else if reply != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return vearchpb.NewError(reply.Err.Code, nil)
+	}
+	return nil
+}
+
+// ReplicaChecksum gets the checksum of the replica at check.Index, it is nil
+// when the replica has not applied it yet. A leader proposes a new check when
+// check.Index is 0.
+func ReplicaChecksum(addr string, check *entity.ReplicaCheck) (*entity.ReplicaChecksum, error) {
+	value, err := cbjson.Marshal(check)
+	if err != nil {
+		return nil, err
+	}
+	args := &vearchpb.PartitionData{PartitionID: check.PartitionID, Data: value}
+	reply := new(vearchpb.PartitionData)
+	if err = Execute(addr, ReplicaCheckHandler, args, reply); err != nil {
+		return nil, err
+	} else if reply.Err != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return nil, vearchpb.NewErrorInfo(reply.Err.Code, reply.Err.Msg)
+	}
+	var sum *entity.ReplicaChecksum
+	if err := json.Unmarshal(reply.Data, &sum); err != nil {
+		return nil, err
+	}
+	return sum, nil
+}
+
+// LocalPartitions returns the partitions in the data dirs of the ps of addr
+func LocalPartitions(addr string) ([]*entity.PartitionMeta, error) {
+	args := &vearchpb.PartitionData{Type: vearchpb.OpType_GET}
+	reply := new(vearchpb.PartitionData)
+	if err := Execute(addr, LocalPartitionsHandler, args, reply); err != nil {
+		return nil, err
+	} else if reply.Err != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return nil, vearchpb.NewErrorInfo(reply.Err.Code, reply.Err.Msg)
+	}
+	metas := make([]*entity.PartitionMeta, 0)
+	if err := cbjson.Unmarshal(reply.Data, &metas); err != nil {
+		return nil, err
+	}
+	return metas, nil
+}
+
+// AdoptPartition makes the ps of addr load partition pid left in its data
+// dirs with space
+func AdoptPartition(addr string, space *entity.Space, pid entity.PartitionID) error {
+	value, err := cbjson.Marshal(space)
+	if err != nil {
+		return err
+	}
+	args := &vearchpb.PartitionData{Type: vearchpb.OpType_CREATE, PartitionID: pid, Data: value}
+	reply := new(vearchpb.PartitionData)
+	if err = Execute(addr, LocalPartitionsHandler, args, reply); err != nil {
+		return err
+	} else if reply.Err != nil && reply.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return vearchpb.NewErrorInfo(reply.Err.Code, reply.Err.Msg)
+	}
+	return nil
+}
+
 func ChangeMember(addr string, changeMember *entity.ChangeMember) error {
 	value, err := cbjson.Marshal(changeMember)
 	if err != nil {
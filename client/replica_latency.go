@@ -0,0 +1,116 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+)
+
+const (
+	// weight of the latest response time and queue depth in their moving average
+	latencyDecay = 0.3
+	// faulty nodes are still chosen, but only when the others are this much slower
+	faultyPenalty = 10
+)
+
+// nodeLatency is what the router observed of the searches sent to one ps
+type nodeLatency struct {
+	mu       sync.Mutex
+	ewmaMs   float64
+	ewmaQ    float64
+	inflight int64
+	sampled  bool
+}
+
+// expected is the time a new search is expected to take on the node
+func (n *nodeLatency) expected() float64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	// requests already waiting are served first
+	queue := n.ewmaQ
+	if q := float64(n.inflight); q > queue {
+		queue = q
+	}
+	return n.ewmaMs * (1 + queue)
+}
+
+// ReplicaLatency ranks the replicas of a partition by the moving average of
+// their response time and queue depth, it is safe for concurrent use.
+type ReplicaLatency struct {
+	// key: node id, value: *nodeLatency
+	nodes sync.Map
+}
+
+func NewReplicaLatency() *ReplicaLatency {
+	return &ReplicaLatency{}
+}
+
+func (rl *ReplicaLatency) node(nodeID entity.NodeID) *nodeLatency {
+	n, _ := rl.nodes.LoadOrStore(nodeID, &nodeLatency{})
+	return n.(*nodeLatency)
+}
+
+// Begin is called before a search is sent to nodeID, End must follow it.
+func (rl *ReplicaLatency) Begin(nodeID entity.NodeID) {
+	n := rl.node(nodeID)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.ewmaQ = latencyDecay*float64(n.inflight) + (1-latencyDecay)*n.ewmaQ
+	n.inflight++
+}
+
+// End records the response time of a search sent to nodeID. Failed searches
+// are not sampled, the node is marked faulty by the caller instead.
+func (rl *ReplicaLatency) End(nodeID entity.NodeID, took time.Duration, err error) {
+	n := rl.node(nodeID)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.inflight--
+	if err != nil {
+		return
+	}
+	ms := took.Seconds() * 1000
+	if !n.sampled {
+		n.ewmaMs, n.sampled = ms, true
+		return
+	}
+	n.ewmaMs = latencyDecay*ms + (1-latencyDecay)*n.ewmaMs
+}
+
+// Next returns the replica with the lowest expected latency. Nodes never
+// searched come first so that every replica gets sampled, ties are broken by
+// round robin and faulty nodes are penalized rather than excluded.
+func (rl *ReplicaLatency) Next(pid entity.PartitionID, replicas []entity.NodeID, faulty func(entity.NodeID) bool) entity.NodeID {
+	if len(replicas) <= 0 {
+		return 0
+	}
+	best := make([]entity.NodeID, 0, len(replicas))
+	bestScore := 0.0
+	for _, nodeID := range replicas {
+		score := rl.node(nodeID).expected()
+		if faulty != nil && faulty(nodeID) {
+			score = (score + 1) * faultyPenalty
+		}
+		if len(best) == 0 || score < bestScore {
+			best, bestScore = append(best[:0], nodeID), score
+		} else if score == bestScore {
+			best = append(best, nodeID)
+		}
+	}
+	return replicaRoundRobin.Next(pid, best)
+}
@@ -16,10 +16,14 @@ package config
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
@@ -116,6 +120,49 @@ func (con *Config) GetEtcdAddress() []string {
 	}
 }
 
+// GetStandaloneStore is the bolt file of the metadata of a standalone cluster
+func (c *Config) GetStandaloneStore() string {
+	return filepath.Join(c.GetDataDir(), "standalone", "meta.db")
+}
+
+// GetMetaStore returns the store implementation of the metadata and the
+// addresses to open it with
+func (c *Config) GetMetaStore() (string, []string) {
+	switch {
+	case c.Global.Standalone:
+		return "bolt", []string{c.GetStandaloneStore()}
+	case c.Global.MetaStore == "raft":
+		addrs := make([]string, len(c.Masters))
+		for i, m := range c.Masters {
+			addrs[i] = m.ApiUrl()
+		}
+		return "raft", addrs
+	default:
+		return "etcd", c.GetEtcdAddress()
+	}
+}
+
+// MetaStoreUser is the user ps, router and masters call the store api of
+// the masters as
+const MetaStoreUser = "meta_store"
+
+// GetMetaStoreToken returns the password of MetaStoreUser, meta_store_token
+// or else one derived from the signkey, so that the root password is not
+// sent with each call of the store api
+func (c *Config) GetMetaStoreToken() string {
+	if c.Global.MetaStoreToken != "" {
+		return c.Global.MetaStoreToken
+	}
+	mac := hmac.New(sha256.New, []byte(c.Global.Signkey))
+	mac.Write([]byte(MetaStoreUser))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GetMetaRaftDir is the raft log and the database of the metadata of a master
+func (c *Config) GetMetaRaftDir() string {
+	return filepath.Join(c.GetDataDir(), "meta_raft")
+}
+
 func (c *Config) GetLogDir() string {
 	return c.Global.Log
 }
@@ -167,6 +214,17 @@ type GlobalCfg struct {
 	RaftConsistent    bool   `toml:"raft_consistent,omitempty" json:"raft_consistent"`
 	LimitedDBNum      bool   `toml:"limited_db_num,omitempty" json:"limited_db_num"`
 	LimitedReplicaNum bool   `toml:"limited_replica_num,omitempty" json:"limited_replica_num"`
+	// seconds between two checks of the replicas of each partition, 0 disables it
+	ConsistencyCheckInterval int64 `toml:"consistency_check_interval,omitempty" json:"consistency_check_interval"`
+	// run master, ps and router in one process on a local bolt store, without
+	// etcd and raft
+	Standalone bool `toml:"standalone,omitempty" json:"standalone"`
+	// the store of the metadata, etcd or raft, raft keeps it in an embedded
+	// database replicated by the raft of the masters
+	MetaStore string `toml:"meta_store,omitempty" json:"meta_store"`
+	// the password of the store api of the masters when meta_store is raft,
+	// derived from the signkey when empty
+	MetaStoreToken string `toml:"meta_store_token,omitempty" json:"meta_store_token"`
 }
 
 type EtcdCfg struct {
@@ -177,6 +235,8 @@ type EtcdCfg struct {
 }
 
 type TracerCfg struct {
+	// Exporter is otlp or stdout, Host is the otlp grpc endpoint
+	Exporter    string  `toml:"exporter,omitempty" json:"exporter"`
 	Host        string  `toml:"host,omitempty" json:"host"`
 	SampleType  string  `toml:"sample_type,omitempty" json:"sample_type"`
 	SampleParam float64 `toml:"sample_param,omitempty" json:"sample_param"`
@@ -216,6 +276,9 @@ type MasterCfg struct {
 	MonitorPort    uint16 `toml:"monitor_port" json:"monitor_port"`
 	ClusterState   string `toml:"cluster_state,omitempty" json:"cluster_state"`
 	CheckRestart   bool   `toml:"check_restart,omitempty" json:"check_restart"`
+	// the raft ports of the metadata when the meta store is raft
+	RaftHeartbeatPort uint16 `toml:"raft_heartbeat_port,omitempty" json:"raft_heartbeat_port"`
+	RaftReplicatePort uint16 `toml:"raft_replicate_port,omitempty" json:"raft_replicate_port"`
 }
 
 func (m *MasterCfg) ApiUrl() string {
@@ -341,6 +404,11 @@ type RouterCfg struct {
 	RouterIPS     []string `toml:"router_ips" json:"router_ips"`
 	ConcurrentNum int      `toml:"concurrent_num" json:"concurrent_num"`
 	RpcTimeOut    int      `toml:"rpc_timeout" json:"rpc_timeout"` //ms
+	// a search is also sent to another replica of a partition that has not
+	// answered within this percentile of its recent latency, 0 disables it
+	HedgePercentile float64 `toml:"hedge_percentile" json:"hedge_percentile"`
+	// the ratio of extra searches hedging may send, default 0.05
+	HedgeBudget float64 `toml:"hedge_budget" json:"hedge_budget"`
 }
 
 func (routerCfg *RouterCfg) ApiUrl(keyNumber int) string {
@@ -367,11 +435,42 @@ type PSCfg struct {
 	RaftDiffCount          uint64 `toml:"raft_diff_count" json:"raft_diff_count"`
 	EngineDWPTNum          uint64 `toml:"engine_dwpt_num" json:"engine-dwpt-num"`
 	PprofPort              uint16 `toml:"pprof_port" json:"pprof_port"`
+	MonitorPort            uint16 `toml:"monitor_port" json:"monitor_port"`
 	Private                bool   `toml:"private" json:"private"`                         //this ps is private if true you must set machine by dbConfig
 	FlushTimeInterval      uint32 `toml:"flush_time_interval" json:"flush_time_interval"` // seconds
 	FlushCountThreshold    uint32 `toml:"flush_count_threshold" json:"flush_count_threshold"`
 	ConcurrentNum          int    `toml:"concurrent_num" json:"concurrent_num"`
 	RpcTimeOut             int    `toml:"rpc_timeout" json:"rpc_timeout"`
+	// compression of the files sent in raft snapshots, "zstd" or empty for none
+	SnapshotCompression string `toml:"snapshot_compression" json:"snapshot_compression"`
+	// bytes per second of the raft snapshots sent and received, 0 is unlimited
+	SnapshotSendRate int64 `toml:"snapshot_send_rate" json:"snapshot_send_rate"`
+	SnapshotRecvRate int64 `toml:"snapshot_recv_rate" json:"snapshot_recv_rate"`
+	// used percent of the disk of a data dir, 0 for the default
+	DiskLowWatermark   float64 `toml:"disk_low_watermark" json:"disk_low_watermark"`
+	DiskHighWatermark  float64 `toml:"disk_high_watermark" json:"disk_high_watermark"`
+	DiskFloodWatermark float64 `toml:"disk_flood_watermark" json:"disk_flood_watermark"`
+}
+
+const (
+	DefaultDiskLowWatermark   = 85
+	DefaultDiskHighWatermark  = 90
+	DefaultDiskFloodWatermark = 95
+)
+
+// DiskWatermarks returns the low, high and flood stage disk used percent
+func (c *PSCfg) DiskWatermarks() (low, high, flood float64) {
+	low, high, flood = c.DiskLowWatermark, c.DiskHighWatermark, c.DiskFloodWatermark
+	if low <= 0 {
+		low = DefaultDiskLowWatermark
+	}
+	if high <= 0 {
+		high = DefaultDiskHighWatermark
+	}
+	if flood <= 0 {
+		flood = DefaultDiskFloodWatermark
+	}
+	return
 }
 
 func InitConfig(path string) {
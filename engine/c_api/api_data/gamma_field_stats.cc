@@ -0,0 +1,55 @@
+/**
+ * Copyright 2019 The Gamma Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+#include "gamma_field_stats.h"
+
+namespace tig_gamma {
+
+int FieldStats::Serialize(char **out, int *out_len) {
+  flatbuffers::FlatBufferBuilder builder;
+  std::vector<flatbuffers::Offset<gamma_api::FieldStat>> stats;
+  for (struct FieldStat &stat : stats_) {
+    auto bytes = [&builder](const std::string &s) {
+      return builder.CreateVector(reinterpret_cast<const uint8_t *>(s.data()),
+                                  s.size());
+    };
+    stats.emplace_back(gamma_api::CreateFieldStat(
+        builder, builder.CreateString(stat.field), stat.count,
+        bytes(stat.min), bytes(stat.max), bytes(stat.sketch)));
+  }
+  auto table = gamma_api::CreateFieldStats(builder, count_,
+                                           builder.CreateVector(stats));
+  builder.Finish(table);
+  *out_len = builder.GetSize();
+  *out = (char *)malloc(*out_len * sizeof(char));
+  memcpy(*out, (char *)builder.GetBufferPointer(), *out_len);
+  return 0;
+}
+
+void FieldStats::Deserialize(const char *data, int len) {
+  auto field_stats = gamma_api::GetFieldStats(data);
+  count_ = field_stats->count();
+  stats_.clear();
+  if (field_stats->stats() == nullptr) return;
+
+  auto bytes = [](const flatbuffers::Vector<uint8_t> *v) {
+    if (v == nullptr) return std::string();
+    return std::string(reinterpret_cast<const char *>(v->Data()), v->size());
+  };
+  for (size_t i = 0; i < field_stats->stats()->size(); ++i) {
+    auto fbs_stat = field_stats->stats()->Get(i);
+    struct FieldStat stat;
+    stat.field = fbs_stat->field() ? fbs_stat->field()->str() : "";
+    stat.count = fbs_stat->count();
+    stat.min = bytes(fbs_stat->min());
+    stat.max = bytes(fbs_stat->max());
+    stat.sketch = bytes(fbs_stat->sketch());
+    stats_.emplace_back(stat);
+  }
+}
+
+}  // namespace tig_gamma
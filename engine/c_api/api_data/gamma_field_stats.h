@@ -0,0 +1,45 @@
+/**
+ * Copyright 2019 The Gamma Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+#pragma once
+
+#include <string>
+#include <vector>
+
+#include "gamma_raw_data.h"
+#include "idl/fbs-gen/c/field_stats_generated.h"
+
+namespace tig_gamma {
+
+struct FieldStat {
+  std::string field;
+  long count;           // docs with a value of the field
+  std::string min;      // raw values, empty if count is 0
+  std::string max;
+  std::string sketch;   // HyperLogLog registers of the values
+};
+
+class FieldStats : public RawData {
+ public:
+  FieldStats() { count_ = 0; }
+
+  virtual int Serialize(char **out, int *out_len);
+
+  virtual void Deserialize(const char *data, int len);
+
+  long Count() { return count_; }
+
+  void SetCount(long count) { count_ = count; }
+
+  std::vector<struct FieldStat> &Stats() { return stats_; }
+
+ private:
+  long count_;
+  std::vector<struct FieldStat> stats_;
+};
+
+}  // namespace tig_gamma
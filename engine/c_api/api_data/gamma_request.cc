@@ -9,6 +9,68 @@
 
 namespace tig_gamma {
 
+static flatbuffers::Offset<gamma_api::BoolFilter> SerializeBoolFilter(
+    flatbuffers::FlatBufferBuilder &builder, struct BoolFilter &bool_filter) {
+  flatbuffers::Offset<gamma_api::FilterClause> clause = 0;
+  if (bool_filter.has_clause) {
+    struct FilterClause &c = bool_filter.clause;
+    std::vector<flatbuffers::Offset<flatbuffers::String>> values;
+    for (std::string &value : c.values) {
+      values.emplace_back(builder.CreateString(value));
+    }
+    clause = gamma_api::CreateFilterClause(
+        builder, c.type, builder.CreateString(c.field),
+        builder.CreateVector(values), c.include_lower, c.include_upper);
+  }
+
+  auto children = [&](std::vector<struct BoolFilter> &filters) {
+    std::vector<flatbuffers::Offset<gamma_api::BoolFilter>> offsets;
+    for (struct BoolFilter &filter : filters) {
+      offsets.emplace_back(SerializeBoolFilter(builder, filter));
+    }
+    return builder.CreateVector(offsets);
+  };
+  auto must = children(bool_filter.must);
+  auto should = children(bool_filter.should);
+  auto must_not = children(bool_filter.must_not);
+
+  return gamma_api::CreateBoolFilter(builder, clause, must, should, must_not,
+                                     bool_filter.minimum_should_match);
+}
+
+static void DeserializeBoolFilter(const gamma_api::BoolFilter *fbs_filter,
+                                  struct BoolFilter &bool_filter) {
+  if (fbs_filter->clause()) {
+    auto fbs_clause = fbs_filter->clause();
+    bool_filter.has_clause = true;
+    bool_filter.clause.type = fbs_clause->type();
+    bool_filter.clause.field =
+        fbs_clause->field() ? fbs_clause->field()->str() : "";
+    if (fbs_clause->values()) {
+      for (size_t i = 0; i < fbs_clause->values()->size(); ++i) {
+        bool_filter.clause.values.emplace_back(
+            fbs_clause->values()->Get(i)->str());
+      }
+    }
+    bool_filter.clause.include_lower = fbs_clause->include_lower();
+    bool_filter.clause.include_upper = fbs_clause->include_upper();
+  }
+
+  auto children = [](const flatbuffers::Vector<
+                         flatbuffers::Offset<gamma_api::BoolFilter>> *fbs,
+                     std::vector<struct BoolFilter> &filters) {
+    if (fbs == nullptr) return;
+    filters.resize(fbs->size());
+    for (size_t i = 0; i < fbs->size(); ++i) {
+      DeserializeBoolFilter(fbs->Get(i), filters[i]);
+    }
+  };
+  children(fbs_filter->must(), bool_filter.must);
+  children(fbs_filter->should(), bool_filter.should);
+  children(fbs_filter->must_not(), bool_filter.must_not);
+  bool_filter.minimum_should_match = fbs_filter->minimum_should_match();
+}
+
 int Request::Serialize(char **out, int *out_len) {
   flatbuffers::FlatBufferBuilder builder;
   std::vector<flatbuffers::Offset<gamma_api::VectorQuery>> vec_fields_vector;
@@ -66,6 +128,11 @@ int Request::Serialize(char **out, int *out_len) {
                                     builder.CreateVector(value), is_union));
   }
 
+  flatbuffers::Offset<gamma_api::BoolFilter> bool_filter = 0;
+  if (has_bool_filter_) {
+    bool_filter = SerializeBoolFilter(builder, bool_filter_);
+  }
+
   auto res = gamma_api::CreateRequest(
       builder, req_num_, topn_, brute_force_search_,
       builder.CreateVector(vec_fields_vector),
@@ -73,7 +140,8 @@ int Request::Serialize(char **out, int *out_len) {
       builder.CreateVector(range_filter_vector),
       builder.CreateVector(term_filter_vector),
       builder.CreateString(retrieval_params_), has_rank_,
-      builder.CreateString(online_log_level_), multi_vector_rank_, l2_sqrt_);
+      builder.CreateString(online_log_level_), multi_vector_rank_, l2_sqrt_,
+      bool_filter);
 
   builder.Finish(res);
   *out_len = builder.GetSize();
@@ -144,6 +212,11 @@ void Request::Deserialize(const char *data, int len) {
   has_rank_ = request_->has_rank();
   multi_vector_rank_ = request_->multi_vector_rank();
   l2_sqrt_ = request_->l2_sqrt();
+
+  if (request_->bool_filter()) {
+    has_bool_filter_ = true;
+    DeserializeBoolFilter(request_->bool_filter(), bool_filter_);
+  }
 }
 
 int Request::ReqNum() {
@@ -199,6 +272,15 @@ std::vector<struct RangeFilter> &Request::RangeFilters() {
 
 std::vector<struct TermFilter> &Request::TermFilters() { return term_filters_; }
 
+struct BoolFilter *Request::GetBoolFilter() {
+  return has_bool_filter_ ? &bool_filter_ : nullptr;
+}
+
+void Request::SetBoolFilter(struct BoolFilter &bool_filter) {
+  has_bool_filter_ = true;
+  bool_filter_ = bool_filter;
+}
+
 const std::string &Request::RetrievalParams() { return retrieval_params_; }
 
 void Request::SetRetrievalParams(const std::string &retrieval_params) {
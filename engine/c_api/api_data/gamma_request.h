@@ -44,6 +44,7 @@ class Request : public RawData {
     request_ = nullptr;
     req_num_ = 0;
     topn_ = 0;
+    has_bool_filter_ = false;
   }
 
   virtual ~Request() {}
@@ -80,6 +81,11 @@ class Request : public RawData {
 
   std::vector<struct TermFilter> &TermFilters();
 
+  // nullptr if the request has no bool filter
+  struct BoolFilter *GetBoolFilter();
+
+  void SetBoolFilter(struct BoolFilter &bool_filter);
+
   const std::string &RetrievalParams();
 
   void SetRetrievalParams(const std::string &retrieval_params);
@@ -113,6 +119,8 @@ class Request : public RawData {
 
   std::vector<struct RangeFilter> range_filters_;
   std::vector<struct TermFilter> term_filters_;
+  bool has_bool_filter_;
+  struct BoolFilter bool_filter_;
 
   std::string retrieval_params_;
   std::string online_log_level_;
@@ -34,6 +34,7 @@ Response::~Response() {
 int Response::Serialize(const std::string &space_name,
                         std::vector<std::string> &fields_name, char **out,
                         int *out_len) {
+  double fetch_start = utils::getmillisecs();
   std::vector<std::string> vec_fields;
   std::map<std::string, int> attr_idx;
   Table *table = static_cast<Table *>(table_);
@@ -137,6 +138,18 @@ int Response::Serialize(const std::string &space_name,
 
   auto result_vec = builder.CreateVector(search_results);
 
+  if (profile_stats_.size() > 0) {
+    AddProfileStat("fetch_ms", utils::getmillisecs() - fetch_start);
+    cJSON *profile_json = cJSON_CreateObject();
+    for (const auto &stat : profile_stats_) {
+      cJSON_AddNumberToObject(profile_json, stat.first.c_str(), stat.second);
+    }
+    char *profile_data = cJSON_PrintUnformatted(profile_json);
+    online_log_message_ = std::string(profile_data, std::strlen(profile_data));
+    free(profile_data);
+    cJSON_Delete(profile_json);
+  }
+
   flatbuffers::Offset<flatbuffers::String> message =
       builder.CreateString(online_log_message_);
   auto res = gamma_api::CreateResponse(builder, result_vec, message);
@@ -212,6 +225,10 @@ void Response::SetOnlineLogMessage(const std::string &msg) {
   online_log_message_ = msg;
 }
 
+void Response::AddProfileStat(const std::string &name, double value) {
+  profile_stats_.emplace_back(name, value);
+}
+
 void Response::SetEngineInfo(void *table, void *vector_mgr,
                              GammaResult *gamma_results, int req_num) {
   gamma_results_ = gamma_results;
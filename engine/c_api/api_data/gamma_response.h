@@ -117,6 +117,10 @@ class Response {
 
   void SetOnlineLogMessage(const std::string &msg);
 
+  // AddProfileStat records a stage of a profiled search, Serialize adds the
+  // fetch time and writes them as json to the online log message
+  void AddProfileStat(const std::string &name, double value);
+
   void SetEngineInfo(void *table, void *vector_mgr, GammaResult *gamma_results,
                      int req_num);
 
@@ -132,6 +136,7 @@ class Response {
   gamma_api::Response *response_;
   std::vector<struct SearchResult> results_;
   std::string online_log_message_;
+  std::vector<std::pair<std::string, double>> profile_stats_;
   GammaResult *gamma_results_ = nullptr;
   void *table_ = nullptr;
   void *vector_mgr_ = nullptr;
@@ -11,6 +11,8 @@
 #include <sys/stat.h>
 
 #include <chrono>
+#include <cstdlib>
+#include <cstring>
 #include <iostream>
 #include <sstream>
 #include <string>
@@ -19,6 +21,7 @@
 #include "api_data/gamma_config.h"
 #include "api_data/gamma_doc.h"
 #include "api_data/gamma_engine_status.h"
+#include "api_data/gamma_field_stats.h"
 #include "api_data/gamma_memory_info.h"
 #include "api_data/gamma_response.h"
 #include "api_data/gamma_table.h"
@@ -189,6 +192,40 @@ int Search(void *engine, const char *request_str, int req_len,
   return ret;
 }
 
+int Count(void *engine, const char *request_str, int req_len, long *count) {
+  tig_gamma::Request request;
+  request.Deserialize(request_str, req_len);
+  return static_cast<tig_gamma::GammaEngine *>(engine)->Filter(
+      request, 0, 0, nullptr, count);
+}
+
+int FilterDocIDs(void *engine, const char *request_str, int req_len,
+                 int start_docid, int limit, int **docids, int *num) {
+  tig_gamma::Request request;
+  request.Deserialize(request_str, req_len);
+  std::vector<int> ids;
+  long count = 0;
+  int ret = static_cast<tig_gamma::GammaEngine *>(engine)->Filter(
+      request, start_docid, limit, &ids, &count);
+  *num = ids.size();
+  *docids = static_cast<int *>(malloc(sizeof(int) * (ids.size() + 1)));
+  if (ids.size() > 0) {
+    memcpy(*docids, ids.data(), sizeof(int) * ids.size());
+  }
+  return ret;
+}
+
+int GetFieldStats(void *engine, const char *request_str, int req_len,
+                  char **stats_str, int *len) {
+  tig_gamma::Request request;
+  request.Deserialize(request_str, req_len);
+  tig_gamma::FieldStats field_stats;
+  int ret = static_cast<tig_gamma::GammaEngine *>(engine)->GetFieldStats(
+      request, field_stats);
+  field_stats.Serialize(stats_str, len);
+  return ret;
+}
+
 int DeleteDoc(void *engine, const char *docid, int docid_len) {
   std::string id = std::string(docid, docid_len);
   int ret = static_cast<tig_gamma::GammaEngine *>(engine)->Delete(id);
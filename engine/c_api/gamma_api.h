@@ -160,6 +160,40 @@ int Load(void *engine);
 int Search(void *engine, const char *request_str, int req_len,
            char **response_str, int *res_len);
 
+/** count the docs matching the range and term filters of a search request
+ *
+ * @param engine    search engine pointer
+ * @param request   search request pointer
+ * @param count     the number of docs matched
+ * @return 0 successed, other failed
+ */
+int Count(void *engine, const char *request_str, int req_len, long *count);
+
+/** docids matching the range and term filters of a search request
+ *
+ * @param engine      search engine pointer
+ * @param request     search request pointer
+ * @param start_docid the first docid checked
+ * @param limit       at most limit docids are returned
+ * @param docids      the docids in increasing order, freed by the caller
+ * @param num         the number of docids
+ * @return 0 successed, other failed
+ */
+int FilterDocIDs(void *engine, const char *request_str, int req_len,
+                 int start_docid, int limit, int **docids, int *num);
+
+/** count, min, max and distinct sketch of the fields of a search request
+ * over the docs matching its filters
+ *
+ * @param engine    search engine pointer
+ * @param request   search request pointer
+ * @param stats_str the serialized FieldStats, freed by the caller
+ * @param len       the length of stats_str
+ * @return 0 successed, other failed
+ */
+int GetFieldStats(void *engine, const char *request_str, int req_len,
+                  char **stats_str, int *len);
+
 /** alter all cache size by query
  *
  * @param engine  search engine pointer
@@ -20,6 +20,27 @@ struct RangeFilter {
   bool include_upper;
 };
 
+// FilterClause is a leaf of a BoolFilter, the type is one of FilterType
+struct FilterClause {
+  int type;
+  std::string field;
+  std::vector<std::string> values;
+  bool include_lower;
+  bool include_upper;
+};
+
+// BoolFilter is either a clause or a combination of sub filters
+struct BoolFilter {
+  BoolFilter() : has_clause(false), minimum_should_match(0) {}
+
+  bool has_clause;
+  FilterClause clause;
+  std::vector<BoolFilter> must;
+  std::vector<BoolFilter> should;
+  std::vector<BoolFilter> must_not;
+  int minimum_should_match;
+};
+
 struct VectorQuery {
   std::string name;
   std::string value;
@@ -0,0 +1,214 @@
+// automatically generated by the FlatBuffers compiler, do not modify
+
+
+#ifndef FLATBUFFERS_GENERATED_FIELDSTATS_GAMMA_API_H_
+#define FLATBUFFERS_GENERATED_FIELDSTATS_GAMMA_API_H_
+
+#include "flatbuffers/flatbuffers.h"
+
+namespace gamma_api {
+
+struct FieldStat;
+
+struct FieldStats;
+
+struct FieldStat FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
+  enum FlatBuffersVTableOffset FLATBUFFERS_VTABLE_UNDERLYING_TYPE {
+    VT_FIELD = 4,
+    VT_COUNT = 6,
+    VT_MIN = 8,
+    VT_MAX = 10,
+    VT_SKETCH = 12
+  };
+  const flatbuffers::String *field() const {
+    return GetPointer<const flatbuffers::String *>(VT_FIELD);
+  }
+  int64_t count() const {
+    return GetField<int64_t>(VT_COUNT, 0);
+  }
+  const flatbuffers::Vector<uint8_t> *min() const {
+    return GetPointer<const flatbuffers::Vector<uint8_t> *>(VT_MIN);
+  }
+  const flatbuffers::Vector<uint8_t> *max() const {
+    return GetPointer<const flatbuffers::Vector<uint8_t> *>(VT_MAX);
+  }
+  const flatbuffers::Vector<uint8_t> *sketch() const {
+    return GetPointer<const flatbuffers::Vector<uint8_t> *>(VT_SKETCH);
+  }
+  bool Verify(flatbuffers::Verifier &verifier) const {
+    return VerifyTableStart(verifier) &&
+           VerifyOffset(verifier, VT_FIELD) &&
+           verifier.VerifyString(field()) &&
+           VerifyField<int64_t>(verifier, VT_COUNT) &&
+           VerifyOffset(verifier, VT_MIN) &&
+           verifier.VerifyVector(min()) &&
+           VerifyOffset(verifier, VT_MAX) &&
+           verifier.VerifyVector(max()) &&
+           VerifyOffset(verifier, VT_SKETCH) &&
+           verifier.VerifyVector(sketch()) &&
+           verifier.EndTable();
+  }
+};
+
+struct FieldStatBuilder {
+  flatbuffers::FlatBufferBuilder &fbb_;
+  flatbuffers::uoffset_t start_;
+  void add_field(flatbuffers::Offset<flatbuffers::String> field) {
+    fbb_.AddOffset(FieldStat::VT_FIELD, field);
+  }
+  void add_count(int64_t count) {
+    fbb_.AddElement<int64_t>(FieldStat::VT_COUNT, count, 0);
+  }
+  void add_min(flatbuffers::Offset<flatbuffers::Vector<uint8_t>> min) {
+    fbb_.AddOffset(FieldStat::VT_MIN, min);
+  }
+  void add_max(flatbuffers::Offset<flatbuffers::Vector<uint8_t>> max) {
+    fbb_.AddOffset(FieldStat::VT_MAX, max);
+  }
+  void add_sketch(flatbuffers::Offset<flatbuffers::Vector<uint8_t>> sketch) {
+    fbb_.AddOffset(FieldStat::VT_SKETCH, sketch);
+  }
+  explicit FieldStatBuilder(flatbuffers::FlatBufferBuilder &_fbb)
+        : fbb_(_fbb) {
+    start_ = fbb_.StartTable();
+  }
+  FieldStatBuilder &operator=(const FieldStatBuilder &);
+  flatbuffers::Offset<FieldStat> Finish() {
+    const auto end = fbb_.EndTable(start_);
+    auto o = flatbuffers::Offset<FieldStat>(end);
+    return o;
+  }
+};
+
+inline flatbuffers::Offset<FieldStat> CreateFieldStat(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    flatbuffers::Offset<flatbuffers::String> field = 0,
+    int64_t count = 0,
+    flatbuffers::Offset<flatbuffers::Vector<uint8_t>> min = 0,
+    flatbuffers::Offset<flatbuffers::Vector<uint8_t>> max = 0,
+    flatbuffers::Offset<flatbuffers::Vector<uint8_t>> sketch = 0) {
+  FieldStatBuilder builder_(_fbb);
+  builder_.add_count(count);
+  builder_.add_sketch(sketch);
+  builder_.add_max(max);
+  builder_.add_min(min);
+  builder_.add_field(field);
+  return builder_.Finish();
+}
+
+inline flatbuffers::Offset<FieldStat> CreateFieldStatDirect(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    const char *field = nullptr,
+    int64_t count = 0,
+    const std::vector<uint8_t> *min = nullptr,
+    const std::vector<uint8_t> *max = nullptr,
+    const std::vector<uint8_t> *sketch = nullptr) {
+  auto field__ = field ? _fbb.CreateString(field) : 0;
+  auto min__ = min ? _fbb.CreateVector<uint8_t>(*min) : 0;
+  auto max__ = max ? _fbb.CreateVector<uint8_t>(*max) : 0;
+  auto sketch__ = sketch ? _fbb.CreateVector<uint8_t>(*sketch) : 0;
+  return gamma_api::CreateFieldStat(
+      _fbb,
+      field__,
+      count,
+      min__,
+      max__,
+      sketch__);
+}
+
+struct FieldStats FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
+  enum FlatBuffersVTableOffset FLATBUFFERS_VTABLE_UNDERLYING_TYPE {
+    VT_COUNT = 4,
+    VT_STATS = 6
+  };
+  int64_t count() const {
+    return GetField<int64_t>(VT_COUNT, 0);
+  }
+  const flatbuffers::Vector<flatbuffers::Offset<FieldStat>> *stats() const {
+    return GetPointer<const flatbuffers::Vector<flatbuffers::Offset<FieldStat>> *>(VT_STATS);
+  }
+  bool Verify(flatbuffers::Verifier &verifier) const {
+    return VerifyTableStart(verifier) &&
+           VerifyField<int64_t>(verifier, VT_COUNT) &&
+           VerifyOffset(verifier, VT_STATS) &&
+           verifier.VerifyVector(stats()) &&
+           verifier.VerifyVectorOfTables(stats()) &&
+           verifier.EndTable();
+  }
+};
+
+struct FieldStatsBuilder {
+  flatbuffers::FlatBufferBuilder &fbb_;
+  flatbuffers::uoffset_t start_;
+  void add_count(int64_t count) {
+    fbb_.AddElement<int64_t>(FieldStats::VT_COUNT, count, 0);
+  }
+  void add_stats(flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<FieldStat>>> stats) {
+    fbb_.AddOffset(FieldStats::VT_STATS, stats);
+  }
+  explicit FieldStatsBuilder(flatbuffers::FlatBufferBuilder &_fbb)
+        : fbb_(_fbb) {
+    start_ = fbb_.StartTable();
+  }
+  FieldStatsBuilder &operator=(const FieldStatsBuilder &);
+  flatbuffers::Offset<FieldStats> Finish() {
+    const auto end = fbb_.EndTable(start_);
+    auto o = flatbuffers::Offset<FieldStats>(end);
+    return o;
+  }
+};
+
+inline flatbuffers::Offset<FieldStats> CreateFieldStats(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    int64_t count = 0,
+    flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<FieldStat>>> stats = 0) {
+  FieldStatsBuilder builder_(_fbb);
+  builder_.add_count(count);
+  builder_.add_stats(stats);
+  return builder_.Finish();
+}
+
+inline flatbuffers::Offset<FieldStats> CreateFieldStatsDirect(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    int64_t count = 0,
+    const std::vector<flatbuffers::Offset<FieldStat>> *stats = nullptr) {
+  auto stats__ = stats ? _fbb.CreateVector<flatbuffers::Offset<FieldStat>>(*stats) : 0;
+  return gamma_api::CreateFieldStats(
+      _fbb,
+      count,
+      stats__);
+}
+
+inline const gamma_api::FieldStats *GetFieldStats(const void *buf) {
+  return flatbuffers::GetRoot<gamma_api::FieldStats>(buf);
+}
+
+inline const gamma_api::FieldStats *GetSizePrefixedFieldStats(const void *buf) {
+  return flatbuffers::GetSizePrefixedRoot<gamma_api::FieldStats>(buf);
+}
+
+inline bool VerifyFieldStatsBuffer(
+    flatbuffers::Verifier &verifier) {
+  return verifier.VerifyBuffer<gamma_api::FieldStats>(nullptr);
+}
+
+inline bool VerifySizePrefixedFieldStatsBuffer(
+    flatbuffers::Verifier &verifier) {
+  return verifier.VerifySizePrefixedBuffer<gamma_api::FieldStats>(nullptr);
+}
+
+inline void FinishFieldStatsBuffer(
+    flatbuffers::FlatBufferBuilder &fbb,
+    flatbuffers::Offset<gamma_api::FieldStats> root) {
+  fbb.Finish(root);
+}
+
+inline void FinishSizePrefixedFieldStatsBuffer(
+    flatbuffers::FlatBufferBuilder &fbb,
+    flatbuffers::Offset<gamma_api::FieldStats> root) {
+  fbb.FinishSizePrefixed(root);
+}
+
+}  // namespace gamma_api
+
+#endif  // FLATBUFFERS_GENERATED_FIELDSTATS_GAMMA_API_H_
@@ -12,6 +12,10 @@ struct TermFilter;
 
 struct RangeFilter;
 
+struct FilterClause;
+
+struct BoolFilter;
+
 struct VectorQuery;
 
 struct Request;
@@ -194,6 +198,213 @@ inline flatbuffers::Offset<RangeFilter> CreateRangeFilterDirect(
       include_upper);
 }
 
+struct FilterClause FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
+  enum FlatBuffersVTableOffset FLATBUFFERS_VTABLE_UNDERLYING_TYPE {
+    VT_TYPE = 4,
+    VT_FIELD = 6,
+    VT_VALUES = 8,
+    VT_INCLUDE_LOWER = 10,
+    VT_INCLUDE_UPPER = 12
+  };
+  int32_t type() const {
+    return GetField<int32_t>(VT_TYPE, 0);
+  }
+  const flatbuffers::String *field() const {
+    return GetPointer<const flatbuffers::String *>(VT_FIELD);
+  }
+  const flatbuffers::Vector<flatbuffers::Offset<flatbuffers::String>> *values() const {
+    return GetPointer<const flatbuffers::Vector<flatbuffers::Offset<flatbuffers::String>> *>(VT_VALUES);
+  }
+  bool include_lower() const {
+    return GetField<uint8_t>(VT_INCLUDE_LOWER, 0) != 0;
+  }
+  bool include_upper() const {
+    return GetField<uint8_t>(VT_INCLUDE_UPPER, 0) != 0;
+  }
+  bool Verify(flatbuffers::Verifier &verifier) const {
+    return VerifyTableStart(verifier) &&
+           VerifyField<int32_t>(verifier, VT_TYPE) &&
+           VerifyOffset(verifier, VT_FIELD) &&
+           verifier.VerifyString(field()) &&
+           VerifyOffset(verifier, VT_VALUES) &&
+           verifier.VerifyVector(values()) &&
+           verifier.VerifyVectorOfStrings(values()) &&
+           VerifyField<uint8_t>(verifier, VT_INCLUDE_LOWER) &&
+           VerifyField<uint8_t>(verifier, VT_INCLUDE_UPPER) &&
+           verifier.EndTable();
+  }
+};
+
+struct FilterClauseBuilder {
+  flatbuffers::FlatBufferBuilder &fbb_;
+  flatbuffers::uoffset_t start_;
+  void add_type(int32_t type) {
+    fbb_.AddElement<int32_t>(FilterClause::VT_TYPE, type, 0);
+  }
+  void add_field(flatbuffers::Offset<flatbuffers::String> field) {
+    fbb_.AddOffset(FilterClause::VT_FIELD, field);
+  }
+  void add_values(flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<flatbuffers::String>>> values) {
+    fbb_.AddOffset(FilterClause::VT_VALUES, values);
+  }
+  void add_include_lower(bool include_lower) {
+    fbb_.AddElement<uint8_t>(FilterClause::VT_INCLUDE_LOWER, static_cast<uint8_t>(include_lower), 0);
+  }
+  void add_include_upper(bool include_upper) {
+    fbb_.AddElement<uint8_t>(FilterClause::VT_INCLUDE_UPPER, static_cast<uint8_t>(include_upper), 0);
+  }
+  explicit FilterClauseBuilder(flatbuffers::FlatBufferBuilder &_fbb)
+        : fbb_(_fbb) {
+    start_ = fbb_.StartTable();
+  }
+  FilterClauseBuilder &operator=(const FilterClauseBuilder &);
+  flatbuffers::Offset<FilterClause> Finish() {
+    const auto end = fbb_.EndTable(start_);
+    auto o = flatbuffers::Offset<FilterClause>(end);
+    return o;
+  }
+};
+
+inline flatbuffers::Offset<FilterClause> CreateFilterClause(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    int32_t type = 0,
+    flatbuffers::Offset<flatbuffers::String> field = 0,
+    flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<flatbuffers::String>>> values = 0,
+    bool include_lower = false,
+    bool include_upper = false) {
+  FilterClauseBuilder builder_(_fbb);
+  builder_.add_values(values);
+  builder_.add_field(field);
+  builder_.add_type(type);
+  builder_.add_include_upper(include_upper);
+  builder_.add_include_lower(include_lower);
+  return builder_.Finish();
+}
+
+inline flatbuffers::Offset<FilterClause> CreateFilterClauseDirect(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    int32_t type = 0,
+    const char *field = nullptr,
+    const std::vector<flatbuffers::Offset<flatbuffers::String>> *values = nullptr,
+    bool include_lower = false,
+    bool include_upper = false) {
+  auto field__ = field ? _fbb.CreateString(field) : 0;
+  auto values__ = values ? _fbb.CreateVector<flatbuffers::Offset<flatbuffers::String>>(*values) : 0;
+  return gamma_api::CreateFilterClause(
+      _fbb,
+      type,
+      field__,
+      values__,
+      include_lower,
+      include_upper);
+}
+
+struct BoolFilter FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
+  enum FlatBuffersVTableOffset FLATBUFFERS_VTABLE_UNDERLYING_TYPE {
+    VT_CLAUSE = 4,
+    VT_MUST = 6,
+    VT_SHOULD = 8,
+    VT_MUST_NOT = 10,
+    VT_MINIMUM_SHOULD_MATCH = 12
+  };
+  const gamma_api::FilterClause *clause() const {
+    return GetPointer<const gamma_api::FilterClause *>(VT_CLAUSE);
+  }
+  const flatbuffers::Vector<flatbuffers::Offset<BoolFilter>> *must() const {
+    return GetPointer<const flatbuffers::Vector<flatbuffers::Offset<BoolFilter>> *>(VT_MUST);
+  }
+  const flatbuffers::Vector<flatbuffers::Offset<BoolFilter>> *should() const {
+    return GetPointer<const flatbuffers::Vector<flatbuffers::Offset<BoolFilter>> *>(VT_SHOULD);
+  }
+  const flatbuffers::Vector<flatbuffers::Offset<BoolFilter>> *must_not() const {
+    return GetPointer<const flatbuffers::Vector<flatbuffers::Offset<BoolFilter>> *>(VT_MUST_NOT);
+  }
+  int32_t minimum_should_match() const {
+    return GetField<int32_t>(VT_MINIMUM_SHOULD_MATCH, 0);
+  }
+  bool Verify(flatbuffers::Verifier &verifier) const {
+    return VerifyTableStart(verifier) &&
+           VerifyOffset(verifier, VT_CLAUSE) &&
+           verifier.VerifyTable(clause()) &&
+           VerifyOffset(verifier, VT_MUST) &&
+           verifier.VerifyVector(must()) &&
+           verifier.VerifyVectorOfTables(must()) &&
+           VerifyOffset(verifier, VT_SHOULD) &&
+           verifier.VerifyVector(should()) &&
+           verifier.VerifyVectorOfTables(should()) &&
+           VerifyOffset(verifier, VT_MUST_NOT) &&
+           verifier.VerifyVector(must_not()) &&
+           verifier.VerifyVectorOfTables(must_not()) &&
+           VerifyField<int32_t>(verifier, VT_MINIMUM_SHOULD_MATCH) &&
+           verifier.EndTable();
+  }
+};
+
+struct BoolFilterBuilder {
+  flatbuffers::FlatBufferBuilder &fbb_;
+  flatbuffers::uoffset_t start_;
+  void add_clause(flatbuffers::Offset<FilterClause> clause) {
+    fbb_.AddOffset(BoolFilter::VT_CLAUSE, clause);
+  }
+  void add_must(flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<BoolFilter>>> must) {
+    fbb_.AddOffset(BoolFilter::VT_MUST, must);
+  }
+  void add_should(flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<BoolFilter>>> should) {
+    fbb_.AddOffset(BoolFilter::VT_SHOULD, should);
+  }
+  void add_must_not(flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<BoolFilter>>> must_not) {
+    fbb_.AddOffset(BoolFilter::VT_MUST_NOT, must_not);
+  }
+  void add_minimum_should_match(int32_t minimum_should_match) {
+    fbb_.AddElement<int32_t>(BoolFilter::VT_MINIMUM_SHOULD_MATCH, minimum_should_match, 0);
+  }
+  explicit BoolFilterBuilder(flatbuffers::FlatBufferBuilder &_fbb)
+        : fbb_(_fbb) {
+    start_ = fbb_.StartTable();
+  }
+  BoolFilterBuilder &operator=(const BoolFilterBuilder &);
+  flatbuffers::Offset<BoolFilter> Finish() {
+    const auto end = fbb_.EndTable(start_);
+    auto o = flatbuffers::Offset<BoolFilter>(end);
+    return o;
+  }
+};
+
+inline flatbuffers::Offset<BoolFilter> CreateBoolFilter(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    flatbuffers::Offset<FilterClause> clause = 0,
+    flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<BoolFilter>>> must = 0,
+    flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<BoolFilter>>> should = 0,
+    flatbuffers::Offset<flatbuffers::Vector<flatbuffers::Offset<BoolFilter>>> must_not = 0,
+    int32_t minimum_should_match = 0) {
+  BoolFilterBuilder builder_(_fbb);
+  builder_.add_minimum_should_match(minimum_should_match);
+  builder_.add_must_not(must_not);
+  builder_.add_should(should);
+  builder_.add_must(must);
+  builder_.add_clause(clause);
+  return builder_.Finish();
+}
+
+inline flatbuffers::Offset<BoolFilter> CreateBoolFilterDirect(
+    flatbuffers::FlatBufferBuilder &_fbb,
+    flatbuffers::Offset<FilterClause> clause = 0,
+    const std::vector<flatbuffers::Offset<BoolFilter>> *must = nullptr,
+    const std::vector<flatbuffers::Offset<BoolFilter>> *should = nullptr,
+    const std::vector<flatbuffers::Offset<BoolFilter>> *must_not = nullptr,
+    int32_t minimum_should_match = 0) {
+  auto must__ = must ? _fbb.CreateVector<flatbuffers::Offset<BoolFilter>>(*must) : 0;
+  auto should__ = should ? _fbb.CreateVector<flatbuffers::Offset<BoolFilter>>(*should) : 0;
+  auto must_not__ = must_not ? _fbb.CreateVector<flatbuffers::Offset<BoolFilter>>(*must_not) : 0;
+  return gamma_api::CreateBoolFilter(
+      _fbb,
+      clause,
+      must__,
+      should__,
+      must_not__,
+      minimum_should_match);
+}
+
 struct VectorQuery FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
   enum FlatBuffersVTableOffset FLATBUFFERS_VTABLE_UNDERLYING_TYPE {
     VT_NAME = 4,
@@ -333,7 +544,8 @@ struct Request FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
     VT_HAS_RANK = 20,
     VT_ONLINE_LOG_LEVEL = 22,
     VT_MULTI_VECTOR_RANK = 24,
-    VT_L2_SQRT = 26
+    VT_L2_SQRT = 26,
+    VT_BOOL_FILTER = 28
   };
   int32_t req_num() const {
     return GetField<int32_t>(VT_REQ_NUM, 0);
@@ -371,6 +583,9 @@ struct Request FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
   bool l2_sqrt() const {
     return GetField<uint8_t>(VT_L2_SQRT, 0) != 0;
   }
+  const gamma_api::BoolFilter *bool_filter() const {
+    return GetPointer<const gamma_api::BoolFilter *>(VT_BOOL_FILTER);
+  }
   bool Verify(flatbuffers::Verifier &verifier) const {
     return VerifyTableStart(verifier) &&
            VerifyField<int32_t>(verifier, VT_REQ_NUM) &&
@@ -395,6 +610,8 @@ struct Request FLATBUFFERS_FINAL_CLASS : private flatbuffers::Table {
            verifier.VerifyString(online_log_level()) &&
            VerifyField<int32_t>(verifier, VT_MULTI_VECTOR_RANK) &&
            VerifyField<uint8_t>(verifier, VT_L2_SQRT) &&
+           VerifyOffset(verifier, VT_BOOL_FILTER) &&
+           verifier.VerifyTable(bool_filter()) &&
            verifier.EndTable();
   }
 };
@@ -438,6 +655,9 @@ struct RequestBuilder {
   void add_l2_sqrt(bool l2_sqrt) {
     fbb_.AddElement<uint8_t>(Request::VT_L2_SQRT, static_cast<uint8_t>(l2_sqrt), 0);
   }
+  void add_bool_filter(flatbuffers::Offset<BoolFilter> bool_filter) {
+    fbb_.AddOffset(Request::VT_BOOL_FILTER, bool_filter);
+  }
   explicit RequestBuilder(flatbuffers::FlatBufferBuilder &_fbb)
         : fbb_(_fbb) {
     start_ = fbb_.StartTable();
@@ -463,8 +683,10 @@ inline flatbuffers::Offset<Request> CreateRequest(
     bool has_rank = false,
     flatbuffers::Offset<flatbuffers::String> online_log_level = 0,
     int32_t multi_vector_rank = 0,
-    bool l2_sqrt = false) {
+    bool l2_sqrt = false,
+    flatbuffers::Offset<BoolFilter> bool_filter = 0) {
   RequestBuilder builder_(_fbb);
+  builder_.add_bool_filter(bool_filter);
   builder_.add_multi_vector_rank(multi_vector_rank);
   builder_.add_online_log_level(online_log_level);
   builder_.add_retrieval_params(retrieval_params);
@@ -493,7 +715,8 @@ inline flatbuffers::Offset<Request> CreateRequestDirect(
     bool has_rank = false,
     const char *online_log_level = nullptr,
     int32_t multi_vector_rank = 0,
-    bool l2_sqrt = false) {
+    bool l2_sqrt = false,
+    flatbuffers::Offset<BoolFilter> bool_filter = 0) {
   auto vec_fields__ = vec_fields ? _fbb.CreateVector<flatbuffers::Offset<VectorQuery>>(*vec_fields) : 0;
   auto fields__ = fields ? _fbb.CreateVector<flatbuffers::Offset<flatbuffers::String>>(*fields) : 0;
   auto range_filters__ = range_filters ? _fbb.CreateVector<flatbuffers::Offset<RangeFilter>>(*range_filters) : 0;
@@ -513,7 +736,8 @@ inline flatbuffers::Offset<Request> CreateRequestDirect(
       has_rank,
       online_log_level__,
       multi_vector_rank,
-      l2_sqrt);
+      l2_sqrt,
+      bool_filter);
 }
 
 inline const gamma_api::Request *GetRequest(const void *buf) {
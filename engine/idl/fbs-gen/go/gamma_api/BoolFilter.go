@@ -0,0 +1,143 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package gamma_api
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type BoolFilter struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsBoolFilter(buf []byte, offset flatbuffers.UOffsetT) *BoolFilter {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &BoolFilter{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func (rcv *BoolFilter) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *BoolFilter) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *BoolFilter) Clause(obj *FilterClause) *FilterClause {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(FilterClause)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *BoolFilter) Must(obj *BoolFilter, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *BoolFilter) MustLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *BoolFilter) Should(obj *BoolFilter, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *BoolFilter) ShouldLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *BoolFilter) MustNot(obj *BoolFilter, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *BoolFilter) MustNotLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *BoolFilter) MinimumShouldMatch() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *BoolFilter) MutateMinimumShouldMatch(n int32) bool {
+	return rcv._tab.MutateInt32Slot(12, n)
+}
+
+func BoolFilterStart(builder *flatbuffers.Builder) {
+	builder.StartObject(5)
+}
+func BoolFilterAddClause(builder *flatbuffers.Builder, clause flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(clause), 0)
+}
+func BoolFilterAddMust(builder *flatbuffers.Builder, must flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(must), 0)
+}
+func BoolFilterStartMustVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func BoolFilterAddShould(builder *flatbuffers.Builder, should flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(should), 0)
+}
+func BoolFilterStartShouldVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func BoolFilterAddMustNot(builder *flatbuffers.Builder, mustNot flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(3, flatbuffers.UOffsetT(mustNot), 0)
+}
+func BoolFilterStartMustNotVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func BoolFilterAddMinimumShouldMatch(builder *flatbuffers.Builder, minimumShouldMatch int32) {
+	builder.PrependInt32Slot(4, minimumShouldMatch, 0)
+}
+func BoolFilterEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
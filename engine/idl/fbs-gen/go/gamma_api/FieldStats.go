@@ -0,0 +1,75 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package gamma_api
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type FieldStats struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsFieldStats(buf []byte, offset flatbuffers.UOffsetT) *FieldStats {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &FieldStats{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func (rcv *FieldStats) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *FieldStats) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *FieldStats) Count() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *FieldStats) MutateCount(n int64) bool {
+	return rcv._tab.MutateInt64Slot(4, n)
+}
+
+func (rcv *FieldStats) Stats(obj *FieldStat, j int) bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		return true
+	}
+	return false
+}
+
+func (rcv *FieldStats) StatsLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func FieldStatsStart(builder *flatbuffers.Builder) {
+	builder.StartObject(2)
+}
+func FieldStatsAddCount(builder *flatbuffers.Builder, count int64) {
+	builder.PrependInt64Slot(0, count, 0)
+}
+func FieldStatsAddStats(builder *flatbuffers.Builder, stats flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(stats), 0)
+}
+func FieldStatsStartStatsVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func FieldStatsEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -0,0 +1,113 @@
+// Code generated by the FlatBuffers compiler. DO NOT EDIT.
+
+package gamma_api
+
+import (
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+type FilterClause struct {
+	_tab flatbuffers.Table
+}
+
+func GetRootAsFilterClause(buf []byte, offset flatbuffers.UOffsetT) *FilterClause {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	x := &FilterClause{}
+	x.Init(buf, n+offset)
+	return x
+}
+
+func (rcv *FilterClause) Init(buf []byte, i flatbuffers.UOffsetT) {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+}
+
+func (rcv *FilterClause) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *FilterClause) Type() int32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.GetInt32(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *FilterClause) MutateType(n int32) bool {
+	return rcv._tab.MutateInt32Slot(4, n)
+}
+
+func (rcv *FilterClause) Field() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *FilterClause) Values(j int) []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		a := rcv._tab.Vector(o)
+		return rcv._tab.ByteVector(a + flatbuffers.UOffsetT(j*4))
+	}
+	return nil
+}
+
+func (rcv *FilterClause) ValuesLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(8))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+func (rcv *FilterClause) IncludeLower() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(10))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *FilterClause) MutateIncludeLower(n bool) bool {
+	return rcv._tab.MutateBoolSlot(10, n)
+}
+
+func (rcv *FilterClause) IncludeUpper() bool {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(12))
+	if o != 0 {
+		return rcv._tab.GetBool(o + rcv._tab.Pos)
+	}
+	return false
+}
+
+func (rcv *FilterClause) MutateIncludeUpper(n bool) bool {
+	return rcv._tab.MutateBoolSlot(12, n)
+}
+
+func FilterClauseStart(builder *flatbuffers.Builder) {
+	builder.StartObject(5)
+}
+func FilterClauseAddType(builder *flatbuffers.Builder, type_ int32) {
+	builder.PrependInt32Slot(0, type_, 0)
+}
+func FilterClauseAddField(builder *flatbuffers.Builder, field flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(field), 0)
+}
+func FilterClauseAddValues(builder *flatbuffers.Builder, values flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(2, flatbuffers.UOffsetT(values), 0)
+}
+func FilterClauseStartValuesVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
+func FilterClauseAddIncludeLower(builder *flatbuffers.Builder, includeLower bool) {
+	builder.PrependBoolSlot(3, includeLower, false)
+}
+func FilterClauseAddIncludeUpper(builder *flatbuffers.Builder, includeUpper bool) {
+	builder.PrependBoolSlot(4, includeUpper, false)
+}
+func FilterClauseEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -191,8 +191,21 @@ func (rcv *Request) MutateL2Sqrt(n bool) bool {
 	return rcv._tab.MutateBoolSlot(26, n)
 }
 
+func (rcv *Request) BoolFilter(obj *BoolFilter) *BoolFilter {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(28))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(BoolFilter)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
 func RequestStart(builder *flatbuffers.Builder) {
-	builder.StartObject(12)
+	builder.StartObject(13)
 }
 func RequestAddReqNum(builder *flatbuffers.Builder, reqNum int32) {
 	builder.PrependInt32Slot(0, reqNum, 0)
@@ -242,6 +255,9 @@ func RequestAddMultiVectorRank(builder *flatbuffers.Builder, multiVectorRank int
 func RequestAddL2Sqrt(builder *flatbuffers.Builder, l2Sqrt bool) {
 	builder.PrependBoolSlot(11, l2Sqrt, false)
 }
+func RequestAddBoolFilter(builder *flatbuffers.Builder, boolFilter flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(12, flatbuffers.UOffsetT(boolFilter), 0)
+}
 func RequestEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
@@ -0,0 +1,108 @@
+# automatically generated by the FlatBuffers compiler, do not modify
+
+# namespace: gamma_api
+
+import flatbuffers
+
+class BoolFilter(object):
+    __slots__ = ['_tab']
+
+    @classmethod
+    def GetRootAsBoolFilter(cls, buf, offset):
+        n = flatbuffers.encode.Get(flatbuffers.packer.uoffset, buf, offset)
+        x = BoolFilter()
+        x.Init(buf, n + offset)
+        return x
+
+    # BoolFilter
+    def Init(self, buf, pos):
+        self._tab = flatbuffers.table.Table(buf, pos)
+
+    # BoolFilter
+    def Clause(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(4))
+        if o != 0:
+            x = self._tab.Indirect(o + self._tab.Pos)
+            from .FilterClause import FilterClause
+            obj = FilterClause()
+            obj.Init(self._tab.Bytes, x)
+            return obj
+        return None
+
+    # BoolFilter
+    def Must(self, j):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(6))
+        if o != 0:
+            x = self._tab.Vector(o)
+            x += flatbuffers.number_types.UOffsetTFlags.py_type(j) * 4
+            x = self._tab.Indirect(x)
+            from .BoolFilter import BoolFilter
+            obj = BoolFilter()
+            obj.Init(self._tab.Bytes, x)
+            return obj
+        return None
+
+    # BoolFilter
+    def MustLength(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(6))
+        if o != 0:
+            return self._tab.VectorLen(o)
+        return 0
+
+    # BoolFilter
+    def Should(self, j):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(8))
+        if o != 0:
+            x = self._tab.Vector(o)
+            x += flatbuffers.number_types.UOffsetTFlags.py_type(j) * 4
+            x = self._tab.Indirect(x)
+            from .BoolFilter import BoolFilter
+            obj = BoolFilter()
+            obj.Init(self._tab.Bytes, x)
+            return obj
+        return None
+
+    # BoolFilter
+    def ShouldLength(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(8))
+        if o != 0:
+            return self._tab.VectorLen(o)
+        return 0
+
+    # BoolFilter
+    def MustNot(self, j):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(10))
+        if o != 0:
+            x = self._tab.Vector(o)
+            x += flatbuffers.number_types.UOffsetTFlags.py_type(j) * 4
+            x = self._tab.Indirect(x)
+            from .BoolFilter import BoolFilter
+            obj = BoolFilter()
+            obj.Init(self._tab.Bytes, x)
+            return obj
+        return None
+
+    # BoolFilter
+    def MustNotLength(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(10))
+        if o != 0:
+            return self._tab.VectorLen(o)
+        return 0
+
+    # BoolFilter
+    def MinimumShouldMatch(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(12))
+        if o != 0:
+            return self._tab.Get(flatbuffers.number_types.Int32Flags, o + self._tab.Pos)
+        return 0
+
+def BoolFilterStart(builder): builder.StartObject(5)
+def BoolFilterAddClause(builder, clause): builder.PrependUOffsetTRelativeSlot(0, flatbuffers.number_types.UOffsetTFlags.py_type(clause), 0)
+def BoolFilterAddMust(builder, must): builder.PrependUOffsetTRelativeSlot(1, flatbuffers.number_types.UOffsetTFlags.py_type(must), 0)
+def BoolFilterStartMustVector(builder, numElems): return builder.StartVector(4, numElems, 4)
+def BoolFilterAddShould(builder, should): builder.PrependUOffsetTRelativeSlot(2, flatbuffers.number_types.UOffsetTFlags.py_type(should), 0)
+def BoolFilterStartShouldVector(builder, numElems): return builder.StartVector(4, numElems, 4)
+def BoolFilterAddMustNot(builder, mustNot): builder.PrependUOffsetTRelativeSlot(3, flatbuffers.number_types.UOffsetTFlags.py_type(mustNot), 0)
+def BoolFilterStartMustNotVector(builder, numElems): return builder.StartVector(4, numElems, 4)
+def BoolFilterAddMinimumShouldMatch(builder, minimumShouldMatch): builder.PrependInt32Slot(4, minimumShouldMatch, 0)
+def BoolFilterEnd(builder): return builder.EndObject()
@@ -0,0 +1,57 @@
+# automatically generated by the FlatBuffers compiler, do not modify
+
+# namespace: gamma_api
+
+import flatbuffers
+
+class FieldStats(object):
+    __slots__ = ['_tab']
+
+    @classmethod
+    def GetRootAsFieldStats(cls, buf, offset):
+        n = flatbuffers.encode.Get(flatbuffers.packer.uoffset, buf, offset)
+        x = FieldStats()
+        x.Init(buf, n + offset)
+        return x
+
+    # FieldStats
+    def Init(self, buf, pos):
+        self._tab = flatbuffers.table.Table(buf, pos)
+
+    # FieldStats
+    def Count(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(4))
+        if o != 0:
+            return self._tab.Get(flatbuffers.number_types.Int64Flags, o + self._tab.Pos)
+        return 0
+
+    # FieldStats
+    def Stats(self, j):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(6))
+        if o != 0:
+            x = self._tab.Vector(o)
+            x += flatbuffers.number_types.UOffsetTFlags.py_type(j) * 4
+            x = self._tab.Indirect(x)
+            from .FieldStat import FieldStat
+            obj = FieldStat()
+            obj.Init(self._tab.Bytes, x)
+            return obj
+        return None
+
+    # FieldStats
+    def StatsLength(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(6))
+        if o != 0:
+            return self._tab.VectorLen(o)
+        return 0
+
+    # FieldStats
+    def StatsIsNone(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(6))
+        return o == 0
+
+def FieldStatsStart(builder): builder.StartObject(2)
+def FieldStatsAddCount(builder, count): builder.PrependInt64Slot(0, count, 0)
+def FieldStatsAddStats(builder, stats): builder.PrependUOffsetTRelativeSlot(1, flatbuffers.number_types.UOffsetTFlags.py_type(stats), 0)
+def FieldStatsStartStatsVector(builder, numElems): return builder.StartVector(4, numElems, 4)
+def FieldStatsEnd(builder): return builder.EndObject()
@@ -0,0 +1,71 @@
+# automatically generated by the FlatBuffers compiler, do not modify
+
+# namespace: gamma_api
+
+import flatbuffers
+
+class FilterClause(object):
+    __slots__ = ['_tab']
+
+    @classmethod
+    def GetRootAsFilterClause(cls, buf, offset):
+        n = flatbuffers.encode.Get(flatbuffers.packer.uoffset, buf, offset)
+        x = FilterClause()
+        x.Init(buf, n + offset)
+        return x
+
+    # FilterClause
+    def Init(self, buf, pos):
+        self._tab = flatbuffers.table.Table(buf, pos)
+
+    # FilterClause
+    def Type(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(4))
+        if o != 0:
+            return self._tab.Get(flatbuffers.number_types.Int32Flags, o + self._tab.Pos)
+        return 0
+
+    # FilterClause
+    def Field(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(6))
+        if o != 0:
+            return self._tab.String(o + self._tab.Pos)
+        return None
+
+    # FilterClause
+    def Values(self, j):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(8))
+        if o != 0:
+            a = self._tab.Vector(o)
+            return self._tab.String(a + flatbuffers.number_types.UOffsetTFlags.py_type(j * 4))
+        return ""
+
+    # FilterClause
+    def ValuesLength(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(8))
+        if o != 0:
+            return self._tab.VectorLen(o)
+        return 0
+
+    # FilterClause
+    def IncludeLower(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(10))
+        if o != 0:
+            return bool(self._tab.Get(flatbuffers.number_types.BoolFlags, o + self._tab.Pos))
+        return False
+
+    # FilterClause
+    def IncludeUpper(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(12))
+        if o != 0:
+            return bool(self._tab.Get(flatbuffers.number_types.BoolFlags, o + self._tab.Pos))
+        return False
+
+def FilterClauseStart(builder): builder.StartObject(5)
+def FilterClauseAddType(builder, type): builder.PrependInt32Slot(0, type, 0)
+def FilterClauseAddField(builder, field): builder.PrependUOffsetTRelativeSlot(1, flatbuffers.number_types.UOffsetTFlags.py_type(field), 0)
+def FilterClauseAddValues(builder, values): builder.PrependUOffsetTRelativeSlot(2, flatbuffers.number_types.UOffsetTFlags.py_type(values), 0)
+def FilterClauseStartValuesVector(builder, numElems): return builder.StartVector(4, numElems, 4)
+def FilterClauseAddIncludeLower(builder, includeLower): builder.PrependBoolSlot(3, includeLower, 0)
+def FilterClauseAddIncludeUpper(builder, includeUpper): builder.PrependBoolSlot(4, includeUpper, 0)
+def FilterClauseEnd(builder): return builder.EndObject()
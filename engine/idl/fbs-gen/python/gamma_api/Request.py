@@ -149,7 +149,18 @@ class Request(object):
             return bool(self._tab.Get(flatbuffers.number_types.BoolFlags, o + self._tab.Pos))
         return False
 
-def RequestStart(builder): builder.StartObject(12)
+    # Request
+    def BoolFilter(self):
+        o = flatbuffers.number_types.UOffsetTFlags.py_type(self._tab.Offset(28))
+        if o != 0:
+            x = self._tab.Indirect(o + self._tab.Pos)
+            from .BoolFilter import BoolFilter
+            obj = BoolFilter()
+            obj.Init(self._tab.Bytes, x)
+            return obj
+        return None
+
+def RequestStart(builder): builder.StartObject(13)
 def RequestAddReqNum(builder, reqNum): builder.PrependInt32Slot(0, reqNum, 0)
 def RequestAddTopn(builder, topn): builder.PrependInt32Slot(1, topn, 0)
 def RequestAddBruteForceSearch(builder, bruteForceSearch): builder.PrependInt32Slot(2, bruteForceSearch, 0)
@@ -166,4 +177,5 @@ def RequestAddHasRank(builder, hasRank): builder.PrependBoolSlot(8, hasRank, 0)
 def RequestAddOnlineLogLevel(builder, onlineLogLevel): builder.PrependUOffsetTRelativeSlot(9, flatbuffers.number_types.UOffsetTFlags.py_type(onlineLogLevel), 0)
 def RequestAddMultiVectorRank(builder, multiVectorRank): builder.PrependInt32Slot(10, multiVectorRank, 0)
 def RequestAddL2Sqrt(builder, l2Sqrt): builder.PrependBoolSlot(11, l2Sqrt, 0)
+def RequestAddBoolFilter(builder, boolFilter): builder.PrependUOffsetTRelativeSlot(12, flatbuffers.number_types.UOffsetTFlags.py_type(boolFilter), 0)
 def RequestEnd(builder): return builder.EndObject()
@@ -140,6 +140,9 @@ int GammaFLATIndex::Search(RetrievalContext *retrieval_context, int n,
   }
 
   int num_vectors = vector_->MetaInfo()->Size();
+  if (retrieval_context->profile_) {
+    retrieval_context->scanned_ += (long)n * num_vectors;
+  }
 
   int d = vector_->MetaInfo()->Dimension();
 
@@ -562,6 +562,9 @@ int GammaIVFPQIndex::Search(RetrievalContext *retrieval_context, int n,
   } else {
     retrieval_params->SetNprobe(this->nprobe);
   }
+  if (retrieval_context->profile_) {
+    retrieval_context->used_params_["nprobe"] = nprobe;
+  }
 
   const float *xq = reinterpret_cast<const float *>(x);
   const float *applied_xq = nullptr;
@@ -906,6 +909,9 @@ void GammaIVFPQIndex::search_preassigned(
       }
     }
   }  // parallel
+  if (retrieval_context->profile_) {
+    retrieval_context->scanned_ += ndis;
+  }
   if (retrieval_params->CollectMetrics()) {
     LOG(TRACE) << "parallel_mode: " << parallel_mode << ", nprobe: " << nprobe << ", ndis: " << ndis;
   }
@@ -365,6 +365,10 @@ int GammaIndexHNSWLIB::Search(RetrievalContext *retrieval_context, int n,
     return ret;
   }
 
+  if (retrieval_context->profile_) {
+    retrieval_context->used_params_["efSearch"] = retrieval_params->EfSearch();
+  }
+
   DISTFUNC<float> fstdistfunc;
   if (retrieval_params->GetDistanceComputeType() ==
       DistanceComputeType::INNER_PRODUCT) {
@@ -9,6 +9,8 @@
 
 #include <tbb/concurrent_queue.h>
 
+#include <map>
+#include <string>
 #include <vector>
 
 // #include "concurrentqueue/concurrentqueue.h"
@@ -83,6 +85,8 @@ class RetrievalContext {
   RetrievalContext() { 
     retrieval_params_ = nullptr;
     perf_tool_ = nullptr;
+    profile_ = false;
+    scanned_ = 0;
   }
 
   virtual ~RetrievalContext() {
@@ -104,6 +108,12 @@ class RetrievalContext {
 
   RetrievalParameters *retrieval_params_;
   PerfTool *perf_tool_;
+
+  // set when the search is profiled, models then record the parameters they
+  // searched with and the number of vectors they compared with the queries
+  bool profile_;
+  long scanned_;
+  std::map<std::string, int> used_params_;
 };
 
 // Store vector meta infos
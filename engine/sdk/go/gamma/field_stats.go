@@ -0,0 +1,44 @@
+/**
+ * Copyright 2019 The Vearch Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+package gamma
+
+import (
+	"github.com/vearch/vearch/engine/idl/fbs-gen/go/gamma_api"
+)
+
+// FieldStat is the statistics of a field over the docs matching a request,
+// Min and Max are raw values and Sketch the HyperLogLog registers of them
+type FieldStat struct {
+	Field  string
+	Count  int64
+	Min    []byte
+	Max    []byte
+	Sketch []byte
+}
+
+type FieldStats struct {
+	Count int64
+	Stats []*FieldStat
+}
+
+func (stats *FieldStats) DeSerialize(buffer []byte) {
+	fieldStats := gamma_api.GetRootAsFieldStats(buffer, 0)
+	stats.Count = fieldStats.Count()
+	stats.Stats = make([]*FieldStat, 0, fieldStats.StatsLength())
+	for i := 0; i < fieldStats.StatsLength(); i++ {
+		var stat gamma_api.FieldStat
+		fieldStats.Stats(&stat, i)
+		stats.Stats = append(stats.Stats, &FieldStat{
+			Field:  string(stat.Field()),
+			Count:  stat.Count(),
+			Min:    stat.MinBytes(),
+			Max:    stat.MaxBytes(),
+			Sketch: stat.SketchBytes(),
+		})
+	}
+}
@@ -164,6 +164,42 @@ func Search(engine unsafe.Pointer, reqByte []byte) (int, []byte) {
 	return ret, respByte
 }
 
+func Count(engine unsafe.Pointer, reqByte []byte) (int, int64) {
+	var count C.long
+	ret := int(C.Count(engine,
+		(*C.char)(unsafe.Pointer(&reqByte[0])), C.int(len(reqByte)),
+		&count))
+	return ret, int64(count)
+}
+
+func FilterDocIDs(engine unsafe.Pointer, reqByte []byte, startDocID, limit int) (int, []int32) {
+	var CDocIDs *C.int
+	var num C.int
+	ret := int(C.FilterDocIDs(engine,
+		(*C.char)(unsafe.Pointer(&reqByte[0])), C.int(len(reqByte)),
+		C.int(startDocID), C.int(limit),
+		(**C.int)(unsafe.Pointer(&CDocIDs)), &num))
+	defer C.free(unsafe.Pointer(CDocIDs))
+	docIDs := make([]int32, int(num))
+	if num > 0 {
+		copy(docIDs, (*[1 << 28]int32)(unsafe.Pointer(CDocIDs))[:num:num])
+	}
+	return ret, docIDs
+}
+
+func GetFieldStats(engine unsafe.Pointer, reqByte []byte, stats *FieldStats) int {
+	var CBuffer *C.char
+	zero := 0
+	length := &zero
+	ret := int(C.GetFieldStats(engine,
+		(*C.char)(unsafe.Pointer(&reqByte[0])), C.int(len(reqByte)),
+		(**C.char)(unsafe.Pointer(&CBuffer)), (*C.int)(unsafe.Pointer(length))))
+	defer C.free(unsafe.Pointer(CBuffer))
+	buffer := C.GoBytes(unsafe.Pointer(CBuffer), C.int(*length))
+	stats.DeSerialize(buffer)
+	return ret
+}
+
 func SetEngineCfg(engine unsafe.Pointer, config *Config) int {
 	var buffer []byte
 	config.Serialize(&buffer)
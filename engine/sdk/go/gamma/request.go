@@ -269,6 +269,11 @@ func SearchRequestSerialize(request *vearchpb.SearchRequest) []byte {
 	}
 	t := builder.EndVector(len(request.TermFilters))
 
+	var boolFilter flatbuffers.UOffsetT
+	if request.BoolFilter != nil {
+		boolFilter = boolFilterSerialize(builder, request.BoolFilter)
+	}
+
 	gamma_api.RequestStart(builder)
 	gamma_api.RequestAddReqNum(builder, request.ReqNum)
 	gamma_api.RequestAddTopn(builder, request.TopN)
@@ -282,7 +287,61 @@ func SearchRequestSerialize(request *vearchpb.SearchRequest) []byte {
 	gamma_api.RequestAddHasRank(builder, request.HasRank)
 	gamma_api.RequestAddMultiVectorRank(builder, request.MultiVectorRank)
 	gamma_api.RequestAddL2Sqrt(builder, request.L2Sqrt)
+	if request.BoolFilter != nil {
+		gamma_api.RequestAddBoolFilter(builder, boolFilter)
+	}
 
 	builder.Finish(builder.EndObject())
 	return builder.FinishedBytes()
 }
+
+// boolFilterSerialize builds the boolean filter tree bf, the children before
+// their parent as flatbuffers nests no tables.
+func boolFilterSerialize(builder *flatbuffers.Builder, bf *vearchpb.BoolFilter) flatbuffers.UOffsetT {
+	var clause flatbuffers.UOffsetT
+	if bf.Clause != nil {
+		field := builder.CreateString(bf.Clause.Field)
+		values := make([]flatbuffers.UOffsetT, len(bf.Clause.Values))
+		for i, value := range bf.Clause.Values {
+			values[i] = builder.CreateString(value)
+		}
+		gamma_api.FilterClauseStartValuesVector(builder, len(values))
+		for i := len(values) - 1; i >= 0; i-- {
+			builder.PrependUOffsetT(values[i])
+		}
+		v := builder.EndVector(len(values))
+
+		gamma_api.FilterClauseStart(builder)
+		gamma_api.FilterClauseAddType(builder, int32(bf.Clause.Type))
+		gamma_api.FilterClauseAddField(builder, field)
+		gamma_api.FilterClauseAddValues(builder, v)
+		gamma_api.FilterClauseAddIncludeLower(builder, bf.Clause.IncludeLower)
+		gamma_api.FilterClauseAddIncludeUpper(builder, bf.Clause.IncludeUpper)
+		clause = gamma_api.FilterClauseEnd(builder)
+	}
+
+	children := func(bfs []*vearchpb.BoolFilter, start func(*flatbuffers.Builder, int) flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+		offsets := make([]flatbuffers.UOffsetT, len(bfs))
+		for i, child := range bfs {
+			offsets[i] = boolFilterSerialize(builder, child)
+		}
+		start(builder, len(offsets))
+		for i := len(offsets) - 1; i >= 0; i-- {
+			builder.PrependUOffsetT(offsets[i])
+		}
+		return builder.EndVector(len(offsets))
+	}
+	must := children(bf.Must, gamma_api.BoolFilterStartMustVector)
+	should := children(bf.Should, gamma_api.BoolFilterStartShouldVector)
+	mustNot := children(bf.MustNot, gamma_api.BoolFilterStartMustNotVector)
+
+	gamma_api.BoolFilterStart(builder)
+	if bf.Clause != nil {
+		gamma_api.BoolFilterAddClause(builder, clause)
+	}
+	gamma_api.BoolFilterAddMust(builder, must)
+	gamma_api.BoolFilterAddShould(builder, should)
+	gamma_api.BoolFilterAddMustNot(builder, mustNot)
+	gamma_api.BoolFilterAddMinimumShouldMatch(builder, bf.MinimumShouldMatch)
+	return gamma_api.BoolFilterEnd(builder)
+}
@@ -8,11 +8,17 @@
 package gamma
 
 import (
+	"encoding/json"
+
 	flatbuffers "github.com/google/flatbuffers/go"
 	"github.com/vearch/vearch/engine/idl/fbs-gen/go/gamma_api"
 	"github.com/vearch/vearch/proto/vearchpb"
 )
 
+// OnlineLogLevelProfile asks the engine to put the time of each search stage
+// into the online log message of the response as a json object
+const OnlineLogLevelProfile = "profile"
+
 type SearchResultCode uint8
 
 const (
@@ -204,3 +210,59 @@ func DeSerialize(buffer []byte, resp *vearchpb.SearchResponse) {
 		resp.Results = append(resp.Results, &respResult)
 	}
 }
+
+// DeSerializeProfile reads the search stages written by the engine for a
+// request with OnlineLogLevelProfile. Stats other than the known stages are
+// the index parameters used, like nprobe or efSearch.
+func DeSerializeProfile(buffer []byte, profile *vearchpb.SearchProfile) error {
+	if len(buffer) == 0 {
+		return nil
+	}
+	message := gamma_api.GetRootAsResponse(buffer, 0).OnlineLogMessage()
+	if len(message) == 0 {
+		return nil
+	}
+	stats := make(map[string]float64)
+	if err := json.Unmarshal(message, &stats); err != nil {
+		return err
+	}
+	for name, value := range stats {
+		switch name {
+		case "filter_ms":
+			profile.FilterMs = value
+		case "filter_docs":
+			profile.FilterDocs = int64(value)
+		case "ann_ms":
+			profile.AnnMs = value
+		case "candidates":
+			profile.Candidates = int64(value)
+		case "fetch_ms":
+			profile.FetchMs = value
+		case "filter_scanned", "filter_matched":
+			// read by DeSerializeFilterStats
+		default:
+			if profile.IndexParams == nil {
+				profile.IndexParams = make(map[string]int64)
+			}
+			profile.IndexParams[name] = int64(value)
+		}
+	}
+	return nil
+}
+
+// DeSerializeFilterStats returns the docs the engine checked and kept by the
+// bool filter of a search, zero when the search had none.
+func DeSerializeFilterStats(buffer []byte) (scanned, matched int64) {
+	if len(buffer) == 0 {
+		return 0, 0
+	}
+	message := gamma_api.GetRootAsResponse(buffer, 0).OnlineLogMessage()
+	if len(message) == 0 {
+		return 0, 0
+	}
+	stats := make(map[string]float64)
+	if err := json.Unmarshal(message, &stats); err != nil {
+		return 0, 0
+	}
+	return int64(stats["filter_scanned"]), int64(stats["filter_matched"])
+}
@@ -0,0 +1,273 @@
+/**
+ * Copyright 2019 The Gamma Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+#include "bool_filter.h"
+
+#include <algorithm>
+#include <cerrno>
+#include <cstdlib>
+#include <cstring>
+
+#include "util/log.h"
+
+namespace tig_gamma {
+
+namespace {
+
+bool ParseLong(const std::string &s, long &value) {
+  char *end = nullptr;
+  errno = 0;
+  value = strtol(s.c_str(), &end, 10);
+  return errno == 0 && !s.empty() && *end == '\0';
+}
+
+bool ParseDouble(const std::string &s, double &value) {
+  char *end = nullptr;
+  errno = 0;
+  value = strtod(s.c_str(), &end);
+  return errno == 0 && !s.empty() && *end == '\0';
+}
+
+bool IsInteger(DataType data_type) {
+  return data_type == DataType::INT || data_type == DataType::LONG;
+}
+
+template <typename T>
+bool InRange(T v, const std::vector<T> &bounds, bool has_lower, bool has_upper,
+             bool include_lower, bool include_upper) {
+  size_t i = 0;
+  if (has_lower) {
+    if (v < bounds[i] || (v == bounds[i] && !include_lower)) return false;
+    ++i;
+  }
+  if (has_upper) {
+    if (v > bounds[i] || (v == bounds[i] && !include_upper)) return false;
+  }
+  return true;
+}
+
+}  // namespace
+
+int BoolFilterMatcher::Compile(const struct BoolFilter &bool_filter) {
+  return CompileNode(bool_filter, root_);
+}
+
+int BoolFilterMatcher::CompileNode(const struct BoolFilter &bool_filter,
+                                   Node &node) {
+  node.has_clause = bool_filter.has_clause;
+  node.minimum_should_match = bool_filter.minimum_should_match;
+  if (node.has_clause) {
+    return CompileClause(bool_filter.clause, node.clause);
+  }
+  if (node.minimum_should_match == 0 && bool_filter.should.size() > 0) {
+    node.minimum_should_match = 1;
+  }
+
+  auto children = [this](const std::vector<struct BoolFilter> &filters,
+                         std::vector<Node> &nodes) {
+    nodes.resize(filters.size());
+    for (size_t i = 0; i < filters.size(); ++i) {
+      if (CompileNode(filters[i], nodes[i]) != 0) return -1;
+    }
+    return 0;
+  };
+  if (children(bool_filter.must, node.must) != 0 ||
+      children(bool_filter.should, node.should) != 0 ||
+      children(bool_filter.must_not, node.must_not) != 0) {
+    return -1;
+  }
+  return 0;
+}
+
+int BoolFilterMatcher::CompileClause(const struct FilterClause &filter_clause,
+                                     Clause &clause) {
+  clause.type = static_cast<FilterClauseType>(filter_clause.type);
+  clause.field_id = table_->GetAttrIdx(filter_clause.field);
+  if (clause.field_id < 0 ||
+      table_->GetFieldType(filter_clause.field, clause.data_type) != 0 ||
+      clause.data_type == DataType::VECTOR) {
+    LOG(ERROR) << "bool filter field [" << filter_clause.field
+               << "] not found";
+    return -1;
+  }
+  clause.has_lower = false;
+  clause.has_upper = false;
+  clause.include_lower = filter_clause.include_lower;
+  clause.include_upper = filter_clause.include_upper;
+
+  const std::vector<std::string> &values = filter_clause.values;
+  if (clause.type == FilterClauseType::EXISTS ||
+      clause.type == FilterClauseType::MISSING) {
+    return 0;
+  }
+  if (clause.type == FilterClauseType::RANGE) {
+    if (values.size() != 2) {
+      LOG(ERROR) << "range filter field [" << filter_clause.field
+                 << "] should have lower and upper bound";
+      return -1;
+    }
+    clause.has_lower = !values[0].empty();
+    clause.has_upper = !values[1].empty();
+  }
+  if (clause.data_type == DataType::STRING) {
+    clause.strs = values;
+    return 0;
+  }
+
+  for (const std::string &value : values) {
+    if (value.empty()) continue;  // an unbounded side of a range
+    if (IsInteger(clause.data_type)) {
+      long v = 0;
+      if (!ParseLong(value, v)) {
+        LOG(ERROR) << "filter field [" << filter_clause.field << "] value ["
+                   << value << "] is not an integer";
+        return -1;
+      }
+      clause.longs.push_back(v);
+      continue;
+    }
+    double v = 0;
+    if (!ParseDouble(value, v)) {
+      LOG(ERROR) << "filter field [" << filter_clause.field << "] value ["
+                 << value << "] is not a number";
+      return -1;
+    }
+    if (clause.data_type == DataType::FLOAT) {
+      // float fields are stored as float, compare at that precision
+      v = static_cast<double>(static_cast<float>(v));
+    }
+    clause.doubles.push_back(v);
+  }
+  return 0;
+}
+
+bool BoolFilterMatcher::Match(int docid) {
+  const uint8_t *doc = table_->GetDocBuffer(docid);
+  if (doc == nullptr) {
+    return false;
+  }
+  bool ret = MatchNode(root_, docid, doc);
+  delete[] doc;
+  return ret;
+}
+
+bool BoolFilterMatcher::MatchNode(const Node &node, int docid,
+                                  const uint8_t *doc) {
+  if (node.has_clause) {
+    return MatchClause(node.clause, docid, doc);
+  }
+  for (const Node &child : node.must) {
+    if (!MatchNode(child, docid, doc)) return false;
+  }
+  for (const Node &child : node.must_not) {
+    if (MatchNode(child, docid, doc)) return false;
+  }
+  if (node.should.size() > 0) {
+    int matched = 0;
+    for (const Node &child : node.should) {
+      if (MatchNode(child, docid, doc) &&
+          ++matched >= node.minimum_should_match) {
+        return true;
+      }
+    }
+    return false;
+  }
+  return true;
+}
+
+bool BoolFilterMatcher::MatchClause(const Clause &clause, int docid,
+                                    const uint8_t *doc) {
+  std::string raw;
+  if (table_->GetFieldRawValue(docid, clause.field_id, raw, doc) != 0) {
+    return clause.type == FilterClauseType::MISSING;
+  }
+
+  if (clause.data_type == DataType::STRING) {
+    std::vector<std::string> strs;
+    size_t begin = 0;
+    while (!raw.empty()) {
+      size_t end = raw.find('\001', begin);
+      if (end == std::string::npos) {
+        strs.emplace_back(raw.substr(begin));
+        break;
+      }
+      strs.emplace_back(raw.substr(begin, end - begin));
+      begin = end + 1;
+    }
+    auto contains = [&strs](const std::string &s) {
+      return std::find(strs.begin(), strs.end(), s) != strs.end();
+    };
+
+    switch (clause.type) {
+      case FilterClauseType::EXISTS:
+        return strs.size() > 0;
+      case FilterClauseType::MISSING:
+        return strs.size() == 0;
+      case FilterClauseType::PREFIX:
+        for (const std::string &s : strs) {
+          if (s.compare(0, clause.strs[0].size(), clause.strs[0]) == 0) {
+            return true;
+          }
+        }
+        return false;
+      case FilterClauseType::CONTAINS_ALL:
+        for (const std::string &s : clause.strs) {
+          if (!contains(s)) return false;
+        }
+        return true;
+      default:
+        for (const std::string &s : clause.strs) {
+          if (contains(s)) return true;
+        }
+        return false;
+    }
+  }
+
+  // a numeric field always has a value
+  switch (clause.type) {
+    case FilterClauseType::EXISTS:
+      return true;
+    case FilterClauseType::MISSING:
+      return false;
+    default:
+      break;
+  }
+
+  if (IsInteger(clause.data_type)) {
+    long v = 0;
+    if (clause.data_type == DataType::INT) {
+      int i = 0;
+      memcpy(&i, raw.data(), sizeof(i));
+      v = i;
+    } else {
+      memcpy(&v, raw.data(), sizeof(v));
+    }
+    if (clause.type == FilterClauseType::RANGE) {
+      return InRange(v, clause.longs, clause.has_lower, clause.has_upper,
+                     clause.include_lower, clause.include_upper);
+    }
+    return std::find(clause.longs.begin(), clause.longs.end(), v) !=
+           clause.longs.end();
+  }
+
+  double v = 0;
+  if (clause.data_type == DataType::FLOAT) {
+    float f = 0;
+    memcpy(&f, raw.data(), sizeof(f));
+    v = f;
+  } else {
+    memcpy(&v, raw.data(), sizeof(v));
+  }
+  if (clause.type == FilterClauseType::RANGE) {
+    return InRange(v, clause.doubles, clause.has_lower, clause.has_upper,
+                   clause.include_lower, clause.include_upper);
+  }
+  return std::find(clause.doubles.begin(), clause.doubles.end(), v) !=
+         clause.doubles.end();
+}
+
+}  // namespace tig_gamma
@@ -0,0 +1,80 @@
+/**
+ * Copyright 2019 The Gamma Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+#pragma once
+
+#include <string>
+#include <vector>
+
+#include "common/common_query_data.h"
+#include "table/table.h"
+
+namespace tig_gamma {
+
+// the type of a FilterClause, the same as FilterClause.Type of vearch
+enum class FilterClauseType : int {
+  TERM = 0,
+  RANGE,
+  EXISTS,
+  MISSING,
+  PREFIX,
+  IN,
+  CONTAINS_ALL,
+  CONTAINS_ANY
+};
+
+/**
+ * BoolFilterMatcher evaluates a bool filter against the fields of a doc in
+ * the table, strings are split by '\001' like the field range index does
+ */
+class BoolFilterMatcher {
+ public:
+  explicit BoolFilterMatcher(Table *table) : table_(table) {}
+
+  /**
+   * @return 0 if successed, -1 if a field or a value of the filter is invalid
+   */
+  int Compile(const struct BoolFilter &bool_filter);
+
+  bool Match(int docid);
+
+ private:
+  struct Clause {
+    FilterClauseType type;
+    int field_id;
+    DataType data_type;
+    std::vector<std::string> strs;
+    std::vector<long> longs;
+    std::vector<double> doubles;
+    bool has_lower;
+    bool has_upper;
+    bool include_lower;
+    bool include_upper;
+  };
+
+  struct Node {
+    bool has_clause;
+    Clause clause;
+    std::vector<Node> must;
+    std::vector<Node> should;
+    std::vector<Node> must_not;
+    int minimum_should_match;
+  };
+
+  int CompileNode(const struct BoolFilter &bool_filter, Node &node);
+
+  int CompileClause(const struct FilterClause &filter_clause, Clause &clause);
+
+  bool MatchNode(const Node &node, int docid, const uint8_t *doc);
+
+  bool MatchClause(const Clause &clause, int docid, const uint8_t *doc);
+
+  Table *table_;
+  Node root_;
+};
+
+}  // namespace tig_gamma
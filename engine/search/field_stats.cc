@@ -0,0 +1,149 @@
+/**
+ * Copyright 2019 The Gamma Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+#include "field_stats.h"
+
+#include <algorithm>
+#include <cstring>
+
+#include "util/log.h"
+
+namespace tig_gamma {
+
+namespace {
+
+// FNV-1a mixed by the finalizer of MurmurHash3, so that the first bits,
+// which select the register, are well distributed
+uint64_t Hash(const std::string &value) {
+  uint64_t h = 14695981039346656037ULL;
+  for (unsigned char c : value) {
+    h ^= c;
+    h *= 1099511628211ULL;
+  }
+  h ^= h >> 33;
+  h *= 0xff51afd7ed558ccdULL;
+  h ^= h >> 33;
+  h *= 0xc4ceb9fe1a85ec53ULL;
+  h ^= h >> 33;
+  return h;
+}
+
+template <typename T>
+T RawTo(const std::string &raw) {
+  T v = 0;
+  memcpy(&v, raw.data(), std::min(sizeof(T), raw.size()));
+  return v;
+}
+
+template <typename T>
+int CompareRaw(const std::string &a, const std::string &b) {
+  T x = RawTo<T>(a), y = RawTo<T>(b);
+  return x < y ? -1 : (x > y ? 1 : 0);
+}
+
+}  // namespace
+
+int FieldStatsCollector::Init(const std::vector<std::string> &fields) {
+  collectors_.resize(fields.size());
+  for (size_t i = 0; i < fields.size(); ++i) {
+    Collector &c = collectors_[i];
+    c.field_id = table_->GetAttrIdx(fields[i]);
+    if (c.field_id < 0 ||
+        table_->GetFieldType(fields[i], c.data_type) != 0 ||
+        c.data_type == DataType::VECTOR) {
+      LOG(ERROR) << "field stats field [" << fields[i] << "] not found";
+      return -1;
+    }
+    c.has_value = false;
+    c.stat.field = fields[i];
+    c.stat.count = 0;
+    c.stat.sketch.assign(1 << kSketchPrecision, 0);
+  }
+  return 0;
+}
+
+void FieldStatsCollector::Add(int docid) {
+  const uint8_t *doc = table_->GetDocBuffer(docid);
+  if (doc == nullptr) {
+    return;
+  }
+  std::string raw;
+  for (Collector &c : collectors_) {
+    if (table_->GetFieldRawValue(docid, c.field_id, raw, doc) != 0) {
+      continue;
+    }
+    if (c.data_type != DataType::STRING) {
+      ++c.stat.count;
+      AddValue(c, raw);
+      continue;
+    }
+    if (raw.empty()) {
+      continue;
+    }
+    ++c.stat.count;
+    size_t begin = 0;
+    while (true) {
+      size_t end = raw.find('\001', begin);
+      if (end == std::string::npos) {
+        AddValue(c, raw.substr(begin));
+        break;
+      }
+      AddValue(c, raw.substr(begin, end - begin));
+      begin = end + 1;
+    }
+  }
+  delete[] doc;
+}
+
+void FieldStatsCollector::AddValue(Collector &c, const std::string &value) {
+  struct FieldStat &stat = c.stat;
+  if (not c.has_value) {
+    c.has_value = true;
+    stat.min = value;
+    stat.max = value;
+  } else {
+    if (Compare(c.data_type, value, stat.min) < 0) stat.min = value;
+    if (Compare(c.data_type, value, stat.max) > 0) stat.max = value;
+  }
+
+  uint64_t h = Hash(value);
+  size_t idx = h >> (64 - kSketchPrecision);
+  uint64_t w = h << kSketchPrecision;
+  // the position of the first set bit of the rest of the hash
+  uint8_t rank = 1;
+  while (rank <= 64 - kSketchPrecision && (w & (1ULL << 63)) == 0) {
+    ++rank;
+    w <<= 1;
+  }
+  if (static_cast<uint8_t>(stat.sketch[idx]) < rank) {
+    stat.sketch[idx] = static_cast<char>(rank);
+  }
+}
+
+int FieldStatsCollector::Compare(DataType data_type, const std::string &a,
+                                 const std::string &b) {
+  switch (data_type) {
+    case DataType::INT:
+      return CompareRaw<int>(a, b);
+    case DataType::LONG:
+      return CompareRaw<long>(a, b);
+    case DataType::FLOAT:
+      return CompareRaw<float>(a, b);
+    case DataType::DOUBLE:
+      return CompareRaw<double>(a, b);
+    default:
+      return a.compare(b) < 0 ? -1 : (a.compare(b) > 0 ? 1 : 0);
+  }
+}
+
+void FieldStatsCollector::Stats(FieldStats &field_stats) {
+  for (Collector &c : collectors_) {
+    field_stats.Stats().emplace_back(c.stat);
+  }
+}
+
+}  // namespace tig_gamma
@@ -0,0 +1,57 @@
+/**
+ * Copyright 2019 The Gamma Authors.
+ *
+ * This source code is licensed under the Apache License, Version 2.0 license
+ * found in the LICENSE file in the root directory of this source tree.
+ */
+
+#pragma once
+
+#include <string>
+#include <vector>
+
+#include "c_api/api_data/gamma_field_stats.h"
+#include "table/table.h"
+
+namespace tig_gamma {
+
+// the registers of a HyperLogLog sketch are indexed by the first
+// kSketchPrecision bits of the hash of a value
+const int kSketchPrecision = 12;
+
+/**
+ * FieldStatsCollector collects the count, min, max and a HyperLogLog sketch
+ * of the values of fields over docs of the table, strings are split by
+ * '\001' like the field range index does
+ */
+class FieldStatsCollector {
+ public:
+  explicit FieldStatsCollector(Table *table) : table_(table) {}
+
+  /**
+   * @return 0 if successed, -1 if a field is not a scalar field of the table
+   */
+  int Init(const std::vector<std::string> &fields);
+
+  void Add(int docid);
+
+  void Stats(FieldStats &field_stats);
+
+ private:
+  struct Collector {
+    int field_id;
+    DataType data_type;
+    bool has_value;
+    struct FieldStat stat;
+  };
+
+  void AddValue(Collector &collector, const std::string &value);
+
+  // -1, 0 or 1 as a is less than, equal to or greater than b
+  int Compare(DataType data_type, const std::string &a, const std::string &b);
+
+  Table *table_;
+  std::vector<Collector> collectors_;
+};
+
+}  // namespace tig_gamma
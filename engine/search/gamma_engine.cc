@@ -347,19 +347,34 @@ int GammaEngine::Search(Request &request, Response &response_results) {
   gamma_query.condition->term_filters = request.TermFilters();
   gamma_query.condition->table = table_;
 
+  // online_log_level "profile" returns the time of each stage in the online
+  // log message, it is set by ps for searches with "profile": true
+  bool profile = request.OnlineLogLevel() == "profile";
+  gamma_query.condition->profile_ = profile;
+  double stage_start = utils::getmillisecs();
+
   MultiRangeQueryResults range_query_result;
   std::vector<struct RangeFilter> &range_filters = request.RangeFilters();
   size_t range_filters_num = range_filters.size();
 
   std::vector<struct TermFilter> &term_filters = request.TermFilters();
   size_t term_filters_num = term_filters.size();
-  if (range_filters_num > 0 || term_filters_num > 0) {
+  if (range_filters_num > 0 || term_filters_num > 0 ||
+      request.GetBoolFilter() != nullptr) {
     int num = MultiRangeQuery(request, gamma_query.condition, response_results,
                               &range_query_result);
     if (num == 0) {
       RequestConcurrentController::GetInstance().Release(req_num);
       return 0;
     }
+    if (profile) {
+      response_results.AddProfileStat("filter_docs", num);
+    }
+  }
+  if (profile) {
+    double now = utils::getmillisecs();
+    response_results.AddProfileStat("filter_ms", now - stage_start);
+    stage_start = now;
   }
 #ifdef PERFORMANCE_TESTING
   gamma_query.condition->GetPerfTool().Perf("filter");
@@ -391,6 +406,15 @@ int GammaEngine::Search(Request &request, Response &response_results) {
 #ifdef PERFORMANCE_TESTING
     gamma_query.condition->GetPerfTool().Perf("search total");
 #endif
+    if (profile) {
+      response_results.AddProfileStat("ann_ms",
+                                      utils::getmillisecs() - stage_start);
+      response_results.AddProfileStat("candidates",
+                                      gamma_query.condition->scanned_);
+      for (const auto &param : gamma_query.condition->used_params_) {
+        response_results.AddProfileStat(param.first, param.second);
+      }
+    }
     response_results.SetEngineInfo(table_, vec_manager_, gamma_results,
                                    req_num);
   } else {
@@ -426,6 +450,55 @@ int GammaEngine::MultiRangeQuery(Request &request,
                                  GammaSearchCondition *condition,
                                  Response &response_results,
                                  MultiRangeQueryResults *range_query_result) {
+  int retval = -1;
+  if (request.RangeFilters().size() > 0 || request.TermFilters().size() > 0) {
+    retval = FieldRangeQuery(request, range_query_result);
+    if (retval < 0) {
+      // filters the index can not answer leave every doc
+      range_query_result->Clear();
+    }
+  }
+
+  struct BoolFilter *bool_filter = request.GetBoolFilter();
+  if (retval != 0 && bool_filter != nullptr) {
+    BoolFilterMatcher matcher(table_);
+    if (matcher.Compile(*bool_filter) != 0) {
+      string msg = space_name_ + " search error: invalid bool filter";
+      LOG(ERROR) << msg;
+      for (int i = 0; i < request.ReqNum(); ++i) {
+        SearchResult result;
+        result.msg = msg;
+        result.result_code = SearchResultCode::SEARCH_ERROR;
+        response_results.AddResults(std::move(result));
+      }
+      return 0;
+    }
+    int scanned = 0;
+    retval = BoolFilterQuery(matcher, 0, range_query_result, &scanned);
+    // returned with every bool filter search, ps logs them as its selectivity
+    response_results.AddProfileStat("filter_scanned", scanned);
+    response_results.AddProfileStat("filter_matched", retval);
+  }
+
+  if (retval == 0) {
+    string msg = space_name_ + " no result: numeric filter return 0 result";
+    LOG(INFO) << msg;
+    for (int i = 0; i < request.ReqNum(); ++i) {
+      SearchResult result;
+      result.msg = msg;
+      result.result_code = SearchResultCode::SUCCESS;
+      response_results.AddResults(std::move(result));
+    }
+  } else if (retval < 0) {
+    condition->range_query_result = nullptr;
+  } else {
+    condition->range_query_result = range_query_result;
+  }
+  return retval;
+}
+
+int GammaEngine::FieldRangeQuery(Request &request,
+                                 MultiRangeQueryResults *range_query_result) {
   std::vector<FilterInfo> filters;
   std::vector<struct RangeFilter> &range_filters = request.RangeFilters();
   std::vector<struct TermFilter> &term_filters = request.TermFilters();
@@ -456,23 +529,126 @@ int GammaEngine::MultiRangeQuery(Request &request,
     ++idx;
   }
 
-  int retval = field_range_index_->Search(filters, range_query_result);
+  return field_range_index_->Search(filters, range_query_result);
+}
+
+int GammaEngine::BoolFilterQuery(BoolFilterMatcher &matcher, int start_docid,
+                                 MultiRangeQueryResults *range_query_result,
+                                 int *scanned) {
+  *scanned = 0;
+  int max_docid = max_docid_;
+  if (start_docid >= max_docid) {
+    return 0;
+  }
+  bool has_range = range_query_result->Size() > 0;
 
-  if (retval == 0) {
-    string msg = space_name_ + " no result: numeric filter return 0 result";
-    LOG(INFO) << msg;
-    for (int i = 0; i < request.ReqNum(); ++i) {
-      SearchResult result;
-      result.msg = msg;
-      result.result_code = SearchResultCode::SUCCESS;
-      response_results.AddResults(std::move(result));
+  RangeQueryResult result;
+  result.SetRange(start_docid, max_docid - 1);
+  result.Resize();
+  int num = 0;
+  for (int docid = start_docid; docid < max_docid; ++docid) {
+    if (docids_bitmap_->Test(docid)) {
+      continue;
+    }
+    if (has_range && not range_query_result->Has(docid)) {
+      continue;
+    }
+    ++(*scanned);
+    if (matcher.Match(docid)) {
+      result.Set(docid - result.MinAligned());
+      ++num;
     }
-  } else if (retval < 0) {
-    condition->range_query_result = nullptr;
-  } else {
-    condition->range_query_result = range_query_result;
   }
-  return retval;
+  result.SetDocNum(num);
+  range_query_result->Add(std::move(result));
+  return num;
+}
+
+int GammaEngine::ForEachFilteredDoc(Request &request, int start_docid,
+                                    const std::function<bool(int)> &visit) {
+  bool has_range =
+      request.RangeFilters().size() > 0 || request.TermFilters().size() > 0;
+  struct BoolFilter *bool_filter = request.GetBoolFilter();
+
+  MultiRangeQueryResults range_query_result;
+  // like search, filters the index can not answer leave every doc
+  int retval = -1;
+  if (has_range) {
+    retval = FieldRangeQuery(request, &range_query_result);
+    if (retval == 0) {
+      return 0;
+    }
+    if (retval < 0) {
+      range_query_result.Clear();
+    }
+  }
+  if (bool_filter != nullptr) {
+    BoolFilterMatcher matcher(table_);
+    if (matcher.Compile(*bool_filter) != 0) {
+      return -1;
+    }
+    int scanned = 0;
+    retval =
+        BoolFilterQuery(matcher, start_docid, &range_query_result, &scanned);
+    if (retval == 0) {
+      return 0;
+    }
+  }
+
+  for (int docid = start_docid; docid < max_docid_; ++docid) {
+    if (docids_bitmap_->Test(docid)) {
+      continue;
+    }
+    if (retval > 0 && not range_query_result.Has(docid)) {
+      continue;
+    }
+    if (not visit(docid)) {
+      break;
+    }
+  }
+  return 0;
+}
+
+int GammaEngine::Filter(Request &request, int start_docid, int limit,
+                        std::vector<int> *docids, long *count) {
+  *count = 0;
+  bool has_filter = request.RangeFilters().size() > 0 ||
+                    request.TermFilters().size() > 0 ||
+                    request.GetBoolFilter() != nullptr;
+  if (not has_filter && docids == nullptr) {
+    *count = GetDocsNum();
+    return 0;
+  }
+
+  return ForEachFilteredDoc(request, start_docid, [&](int docid) {
+    ++(*count);
+    if (docids != nullptr) {
+      docids->push_back(docid);
+      if ((int)docids->size() >= limit) {
+        return false;
+      }
+    }
+    return true;
+  });
+}
+
+int GammaEngine::GetFieldStats(Request &request, FieldStats &field_stats) {
+  FieldStatsCollector collector(table_);
+  if (collector.Init(request.Fields()) != 0) {
+    return -1;
+  }
+  long count = 0;
+  int ret = ForEachFilteredDoc(request, 0, [&](int docid) {
+    ++count;
+    collector.Add(docid);
+    return true;
+  });
+  if (ret != 0) {
+    return ret;
+  }
+  field_stats.SetCount(count);
+  collector.Stats(field_stats);
+  return 0;
 }
 
 int GammaEngine::CreateTable(TableInfo &table) {
@@ -8,6 +8,7 @@
 #pragma once
 
 #include <condition_variable>
+#include <functional>
 #include <string>
 
 #include "c_api/api_data/gamma_batch_result.h"
@@ -19,6 +20,8 @@
 #include "c_api/api_data/gamma_response.h"
 #include "c_api/api_data/gamma_table.h"
 #include "io/async_flush.h"
+#include "search/bool_filter.h"
+#include "search/field_stats.h"
 #include "table/field_range_index.h"
 #include "table/table.h"
 #include "util/bitmap_manager.h"
@@ -39,6 +42,22 @@ class GammaEngine {
 
   int Search(Request &request, Response &response_results);
 
+  /**
+   * Filter counts the live docs matching the range, term and bool filters
+   * of request, from start_docid. With docids it also returns their docids in
+   * increasing order and stops after limit of them.
+   * @return 0 if successed
+   */
+  int Filter(Request &request, int start_docid, int limit,
+             std::vector<int> *docids, long *count);
+
+  /**
+   * GetFieldStats counts the live docs matching the filters of request and
+   * collects the statistics of request.Fields() over them
+   * @return 0 if successed
+   */
+  int GetFieldStats(Request &request, FieldStats &field_stats);
+
   int CreateTable(TableInfo &table);
 
   int AddOrUpdate(Doc &doc);
@@ -130,6 +149,28 @@ class GammaEngine {
                       Response &response_results,
                       MultiRangeQueryResults *range_query_result);
 
+  int FieldRangeQuery(Request &request,
+                      MultiRangeQueryResults *range_query_result);
+
+  /**
+   * BoolFilterQuery adds the live docs from start_docid that match the bool
+   * filter, and the results already in range_query_result if any, to it.
+   * scanned is set to the number of docs the matcher checked.
+   * @return the number of matched docs
+   */
+  int BoolFilterQuery(BoolFilterMatcher &matcher, int start_docid,
+                      MultiRangeQueryResults *range_query_result,
+                      int *scanned);
+
+  /**
+   * ForEachFilteredDoc calls visit with the live docs from start_docid
+   * matching the filters of request in increasing order, until it returns
+   * false
+   * @return 0 if successed
+   */
+  int ForEachFilteredDoc(Request &request, int start_docid,
+                         const std::function<bool(int)> &visit);
+
  private:
   std::string index_root_path_;
   std::string dump_path_;
@@ -67,6 +67,8 @@ func ExportToClusterHandler(router *gin.Engine, masterService *masterService, se
 
 	// cluster handler
 	router.Handle(http.MethodGet, "/clean_lock", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.cleanLock, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/_cluster/fsck", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.fsck, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/_cluster/fsck", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.fsck, dh.TimeOutEndHandler)
 
 	// db,servers handler
 	router.Handle(http.MethodGet, "/list/server", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.serverList, dh.TimeOutEndHandler)
@@ -95,9 +97,14 @@ func ExportToClusterHandler(router *gin.Engine, masterService *masterService, se
 	// modify engine config handler
 	router.Handle(http.MethodPost, "/config/:"+dbName+"/:"+spaceName, dh.PaincHandler, dh.TimeOutHandler, c.auth, c.modifyEngineCfg, dh.TimeOutEndHandler)
 	router.Handle(http.MethodGet, "/config/:"+dbName+"/:"+spaceName, dh.PaincHandler, dh.TimeOutHandler, c.auth, c.getEngineCfg, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/config/:"+dbName+"/:"+spaceName+"/_slowlog", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.modifySlowLogCfg, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/config/:"+dbName+"/:"+spaceName+"/_slowlog", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.getSlowLogCfg, dh.TimeOutEndHandler)
 
 	// partition handler
 	router.Handle(http.MethodPost, "/partition/change_member", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.changeMember, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/partition/move_data_dir", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.movePartition, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/partition/consistency", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.checkConsistency, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/partition/consistency", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.consistencyReports, dh.TimeOutEndHandler)
 
 	// schedule
 	router.Handle(http.MethodPost, "/schedule/recover_server", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.RecoverFailServer, dh.TimeOutEndHandler)
@@ -105,9 +112,13 @@ func ExportToClusterHandler(router *gin.Engine, masterService *masterService, se
 	router.Handle(http.MethodGet, "/schedule/fail_server/list", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.FailServerList, dh.TimeOutEndHandler)
 	router.Handle(http.MethodDelete, "/schedule/fail_server/:"+NodeID, dh.PaincHandler, dh.TimeOutHandler, c.auth, c.FailServerClear, dh.TimeOutEndHandler)
 	router.Handle(http.MethodGet, "/schedule/clean_task", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.CleanTask, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/schedule/snapshot_limit", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.modifySnapshotLimit, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/schedule/snapshot_limit", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.getSnapshotLimit, dh.TimeOutEndHandler)
 
 	// remove server metadata
 	router.Handle(http.MethodPost, "/meta/remove_server", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.RemoveServerMeta, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/meta/export", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.ExportMeta, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/meta/import", dh.PaincHandler, dh.TimeOutHandler, c.auth, c.ImportMeta, dh.TimeOutEndHandler)
 }
 
 func (ca *clusterAPI) handleClusterInfo(c *gin.Context) {
@@ -144,6 +155,22 @@ func (ca *clusterAPI) cleanLock(c *gin.Context) {
 	}
 }
 
+// fsck checks the cluster metadata, a post applies the fixes of the problems
+// of the comma separated kinds, or of all of them when kind is not set, the
+// orphan replicas are only deleted with delete_orphans=true
+func (ca *clusterAPI) fsck(c *gin.Context) {
+	var kinds []string
+	if kind := c.Query("kind"); kind != "" {
+		kinds = strings.Split(kind, ",")
+	}
+	deleteOrphans := c.Query("delete_orphans") == "true"
+	if report, err := ca.masterService.Fsck(c, c.Request.Method == http.MethodPost, kinds, deleteOrphans); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(report)
+	}
+}
+
 // for ps startup to register self and get ip response
 func (ca *clusterAPI) register(c *gin.Context) {
 	ip := c.ClientIP()
@@ -409,6 +436,37 @@ func (ca *clusterAPI) modifyEngineCfg(c *gin.Context) {
 	}
 }
 
+// get slow log config of space
+func (ca *clusterAPI) getSlowLogCfg(c *gin.Context) {
+	dbName := c.Param(dbName)
+	spaceName := c.Param(spaceName)
+	if cfg, err := ca.masterService.GetSlowLogCfg(c, dbName, spaceName); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(cfg)
+	}
+}
+
+// modify slow log config of space, {"threshold_ms": 0} disables it
+func (ca *clusterAPI) modifySlowLogCfg(c *gin.Context) {
+	dbName := c.Param(dbName)
+	spaceName := c.Param(spaceName)
+	cfg := &entity.SlowLogCfg{}
+	if err := c.ShouldBindJSON(cfg); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	if cfg.ThresholdMs < 0 {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(fmt.Errorf("threshold_ms [%d] should not be negative", cfg.ThresholdMs))
+		return
+	}
+	if err := ca.masterService.ModifySlowLogCfg(c, dbName, spaceName, cfg); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(cfg)
+	}
+}
+
 // serverList list servers
 func (ca *clusterAPI) serverList(c *gin.Context) {
 	servers, err := ca.masterService.Master().QueryServers(c)
@@ -515,6 +573,40 @@ func (ca *clusterAPI) partitionList(c *gin.Context) {
 	}
 }
 
+// snapshotLimitRequest sets the snapshot rates of node_id, or of all ps when it is 0
+type snapshotLimitRequest struct {
+	NodeID entity.NodeID `json:"node_id"`
+	entity.SnapshotLimit
+}
+
+// get snapshot rates and traffic of ps, ?node_id= for one of them
+func (ca *clusterAPI) getSnapshotLimit(c *gin.Context) {
+	nodeID := entity.NodeID(cast.ToUint64(c.Query("node_id")))
+	if stats, err := ca.masterService.SnapshotLimit(c, nodeID, nil); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(stats)
+	}
+}
+
+// modify snapshot rates of ps in bytes per second, 0 is unlimited
+func (ca *clusterAPI) modifySnapshotLimit(c *gin.Context) {
+	req := &snapshotLimitRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	if req.SendRate < 0 || req.RecvRate < 0 {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(fmt.Errorf("send_rate [%d] and recv_rate [%d] should not be negative", req.SendRate, req.RecvRate))
+		return
+	}
+	if stats, err := ca.masterService.SnapshotLimit(c, req.NodeID, &req.SnapshotLimit); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(stats)
+	}
+}
+
 // list fail servers
 func (cluster *clusterAPI) FailServerList(c *gin.Context) {
 
@@ -605,6 +697,33 @@ func (cluster *clusterAPI) RemoveServerMeta(c *gin.Context) {
 	ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(fmt.Sprintf("nodeid [%d], server [%v] remove node success!", nodeID, failServer))
 }
 
+// export the dbs, spaces, users and partition assignments of the cluster
+func (cluster *clusterAPI) ExportMeta(c *gin.Context) {
+	ctx, _ := c.Get(vearchhttp.Ctx)
+	if meta, err := cluster.masterService.ExportMeta(ctx.(context.Context)); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(meta)
+	}
+}
+
+// import an exported metadata document into an empty cluster, mode is remap
+// to create new ids or adopt to reuse the partitions found in ps data dirs
+func (cluster *clusterAPI) ImportMeta(c *gin.Context) {
+	ctx, _ := c.Get(vearchhttp.Ctx)
+	meta := &entity.ClusterMeta{}
+	if err := c.ShouldBindJSON(meta); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	mode := c.DefaultQuery("mode", entity.ImportRemap)
+	if report, err := cluster.masterService.ImportMeta(ctx.(context.Context), meta, mode); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(report)
+	}
+}
+
 // recover the failserver by a newserver
 func (cluster *clusterAPI) RecoverFailServer(c *gin.Context) {
 	ctx, _ := c.Get(vearchhttp.Ctx)
@@ -647,6 +766,53 @@ func (cluster *clusterAPI) ChangeReplicas(c *gin.Context) {
 	}
 }
 
+// move a partition of a ps to another of its data dirs
+func (ca *clusterAPI) movePartition(c *gin.Context) {
+	mp := &entity.MovePartition{}
+	if err := c.ShouldBindJSON(mp); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	if mp.DataDir == "" {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(fmt.Errorf("data_dir should not be empty"))
+		return
+	}
+	if err := ca.masterService.MovePartition(c, mp); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(nil)
+	}
+}
+
+// consistencyCheckRequest checks the replicas of a partition now
+type consistencyCheckRequest struct {
+	PartitionID entity.PartitionID `json:"partition_id"`
+	Repair      bool               `json:"repair"`
+}
+
+// compare the replicas of a partition and rebuild the divergent ones on repair
+func (ca *clusterAPI) checkConsistency(c *gin.Context) {
+	req := &consistencyCheckRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	if report, err := ca.masterService.CheckConsistency(c, req.PartitionID, req.Repair); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(report)
+	}
+}
+
+// list the partitions whose replicas diverged or could not be compared
+func (ca *clusterAPI) consistencyReports(c *gin.Context) {
+	if reports, err := ca.masterService.ConsistencyReports(c); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	} else {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(reports)
+	}
+}
+
 func (ca *clusterAPI) changeMember(c *gin.Context) {
 	cm := &entity.ChangeMember{}
 
@@ -28,6 +28,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/ps/engine/mapping"
@@ -36,7 +37,6 @@ import (
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/slice"
-	"go.etcd.io/etcd/client/v3/concurrency"
 )
 
 // masterService is used for master administrator purpose.It should not be used by router and partition server program
@@ -109,7 +109,7 @@ func (ms *masterService) createDBService(ctx context.Context, db *entity.DB) (er
 		return err
 	}
 
-	err = ms.Master().STM(context.Background(), func(stm concurrency.STM) error {
+	err = ms.Master().STM(context.Background(), func(stm store.STM) error {
 		idKey, nameKey, bodyKey := ms.Master().DBKeys(db.Id, db.Name)
 
 		if stm.Get(nameKey) != "" {
@@ -180,7 +180,7 @@ func (ms *masterService) deleteDBService(ctx context.Context, dbstr string) (err
 	}
 
 	err = ms.Master().STM(context.Background(),
-		func(stm concurrency.STM) error {
+		func(stm store.STM) error {
 			idKey, nameKey, bodyKey := ms.Master().DBKeys(db.Id, db.Name)
 			stm.Del(idKey)
 			stm.Del(nameKey)
@@ -519,6 +519,11 @@ func (ms *masterService) filterAndSortServer(ctx context.Context, space *entity.
 			if s.ResourceName != space.ResourceName {
 				continue
 			}
+			// no new partition on a ps above the low disk watermark
+			if s.DiskWatermark != "" {
+				log.Warn("skip server:[%d] above the %s disk watermark", s.ID, s.DiskWatermark)
+				continue
+			}
 			if !s.Private {
 				serverPartitions[i] = 0
 				serverIndex[s.ID] = i
@@ -531,6 +536,10 @@ func (ms *masterService) filterAndSortServer(ctx context.Context, space *entity.
 				psMap[s.Ip] = false
 				continue
 			}
+			if s.DiskWatermark != "" {
+				log.Warn("skip server:[%d] above the %s disk watermark", s.ID, s.DiskWatermark)
+				continue
+			}
 			if psMap[s.Ip] {
 				serverPartitions[i] = 0
 				serverIndex[s.ID] = i
@@ -685,6 +694,46 @@ func (ms *masterService) ModifyEngineCfg(ctx context.Context, dbName,
 	return nil
 }
 
+func (ms *masterService) GetSlowLogCfg(ctx context.Context, dbName, spaceName string) (*entity.SlowLogCfg, error) {
+	dbId, err := ms.Master().QueryDBName2Id(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	space, err := ms.Master().QuerySpaceByName(ctx, dbId, spaceName)
+	if err != nil {
+		return nil, err
+	}
+	if space.SlowLog == nil {
+		return &entity.SlowLogCfg{}, nil
+	}
+	return space.SlowLog, nil
+}
+
+// ModifySlowLogCfg saves cfg in the space, routers pick it up with the space
+// cache and pass the threshold to ps with each search
+func (ms *masterService) ModifySlowLogCfg(ctx context.Context, dbName, spaceName string, cfg *entity.SlowLogCfg) error {
+	mutex := ms.Master().NewLock(ctx, entity.LockSpaceKey(dbName, spaceName), time.Second*30)
+	if err := mutex.Lock(); err != nil {
+		return err
+	}
+	defer func() {
+		if err := mutex.Unlock(); err != nil {
+			log.Error("failed to unlock space,the Error is:%v ", err)
+		}
+	}()
+
+	dbId, err := ms.Master().QueryDBName2Id(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	space, err := ms.Master().QuerySpaceByName(ctx, dbId, spaceName)
+	if err != nil {
+		return err
+	}
+	space.SlowLog = cfg
+	return ms.updateSpace(ctx, space)
+}
+
 func (this *masterService) updateSpaceService(ctx context.Context, dbName, spaceName string, temp *entity.Space) (*entity.Space, error) {
 
 	//it will lock cluster ,to create space
@@ -924,6 +973,60 @@ func (ms *masterService) ChangeMember(ctx context.Context, cm *entity.ChangeMemb
 	return nil
 }
 
+// ServerSnapshotStats is the snapshot stats of a ps, or why it was not got
+type ServerSnapshotStats struct {
+	NodeID   entity.NodeID         `json:"node_id"`
+	Addr     string                `json:"addr"`
+	Snapshot *entity.SnapshotStats `json:"snapshot,omitempty"`
+	Err      string                `json:"err,omitempty"`
+}
+
+// SnapshotLimit sets the snapshot rates of nodeID, or of all ps when it is 0,
+// limit nil only gets them. The rates are kept by ps until they restart.
+func (ms *masterService) SnapshotLimit(ctx context.Context, nodeID entity.NodeID, limit *entity.SnapshotLimit) ([]*ServerSnapshotStats, error) {
+	servers, err := ms.Master().QueryServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*ServerSnapshotStats, 0, len(servers))
+	for _, server := range servers {
+		if nodeID != 0 && server.ID != nodeID {
+			continue
+		}
+		stats := &ServerSnapshotStats{NodeID: server.ID, Addr: server.RpcAddr()}
+		if stats.Snapshot, err = client.SnapshotLimit(server.RpcAddr(), limit); err != nil {
+			log.Error("snapshot limit of server:[%d] addr:[%s] err:[%v]", server.ID, server.RpcAddr(), err)
+			stats.Err = err.Error()
+		}
+		result = append(result, stats)
+	}
+	if nodeID != 0 && len(result) == 0 {
+		return nil, vearchpb.NewError(vearchpb.ErrorEnum_PS_NOTEXISTS, fmt.Errorf("server [%d] not found", nodeID))
+	}
+	return result, nil
+}
+
+// MovePartition moves a partition of a ps to another of its data dirs
+func (ms *masterService) MovePartition(ctx context.Context, mp *entity.MovePartition) error {
+	partition, err := ms.Master().QueryPartition(ctx, mp.PartitionID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, nodeID := range partition.Replicas {
+		found = found || nodeID == mp.NodeID
+	}
+	if !found {
+		return fmt.Errorf("partition [%d] has no replica on node [%d]", mp.PartitionID, mp.NodeID)
+	}
+	server, err := ms.Master().QueryServer(ctx, mp.NodeID)
+	if err != nil {
+		return err
+	}
+	log.Info("move partition [%d] of node [%d] to data dir [%s]", mp.PartitionID, mp.NodeID, mp.DataDir)
+	return client.MovePartition(server.RpcAddr(), mp)
+}
+
 // recover fail node
 func (ms *masterService) RecoverFailServer(ctx context.Context, rs *entity.RecoverFailServer) (e error) {
 	// painc process
@@ -0,0 +1,199 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/util/log"
+)
+
+// ConsistencyBuckets is the number of buckets the documents of a partition
+// are checksummed in when the replicas have the same document count
+const ConsistencyBuckets = 64
+
+// consistencyWait is the seconds a check waits for the followers to apply it
+const consistencyWait = 60
+
+// CheckConsistency compares the replicas of a partition when they apply the
+// same raft index, by document count first and then by the checksums of the
+// buckets of their documents. With repair the divergent followers are
+// rebuilt from a snapshot of the leader. The report is kept until the
+// replicas are found consistent again.
+func (ms *masterService) CheckConsistency(ctx context.Context, pid entity.PartitionID, repair bool) (*entity.ConsistencyReport, error) {
+	partition, err := ms.Master().QueryPartition(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	report := &entity.ConsistencyReport{
+		PartitionID: pid,
+		DBId:        partition.DBId,
+		SpaceId:     partition.SpaceId,
+		Time:        time.Now().Unix(),
+	}
+	err = ms.compareReplicas(ctx, partition, report)
+	if err == nil && len(report.Divergent) == 0 {
+		report.Buckets = ConsistencyBuckets
+		err = ms.compareReplicas(ctx, partition, report)
+	}
+	if err != nil {
+		report.Error = err.Error()
+	} else if len(report.Divergent) > 0 {
+		log.Error("partition:[%d] replicas:%v diverge from the majority", pid, report.Divergent)
+		if repair {
+			ms.repairReplicas(ctx, partition, report)
+		}
+	}
+
+	key := entity.ConsistencyKey(pid)
+	if report.Error == "" && len(report.Divergent) == 0 {
+		if value, err := ms.Master().Get(ctx, key); err != nil || value == nil {
+			return report, err
+		}
+		return report, ms.Master().Delete(ctx, key)
+	}
+	value, err := json.Marshal(report)
+	if err != nil {
+		return nil, err
+	}
+	return report, ms.Master().Put(ctx, key, value)
+}
+
+// ConsistencyReports returns the reports of the partitions whose replicas
+// diverged or could not be compared by their last check
+func (ms *masterService) ConsistencyReports(ctx context.Context) ([]*entity.ConsistencyReport, error) {
+	_, values, err := ms.Master().PrefixScan(ctx, entity.PrefixConsistency)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entity.ConsistencyReport, 0, len(values))
+	for _, value := range values {
+		report := &entity.ConsistencyReport{}
+		if err := json.Unmarshal(value, report); err != nil {
+			log.Error("decode consistency report err: %s", err.Error())
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// compareReplicas proposes a check through the leader of the partition and
+// waits for the checksums of all its replicas. The divergent replicas are the
+// ones which differ from the most replicas, the leader wins a tie.
+func (ms *masterService) compareReplicas(ctx context.Context, partition *entity.Partition, report *entity.ConsistencyReport) error {
+	leader, err := ms.Master().QueryServer(ctx, partition.LeaderID)
+	if err != nil {
+		return err
+	}
+	leaderSum, err := client.ReplicaChecksum(leader.RpcAddr(), &entity.ReplicaCheck{PartitionID: partition.Id, Buckets: report.Buckets})
+	if err != nil {
+		return fmt.Errorf("check on leader:[%d] err:[%v]", partition.LeaderID, err)
+	}
+
+	report.Replicas = []*entity.ReplicaChecksum{leaderSum}
+	for _, nodeID := range partition.Replicas {
+		if nodeID != partition.LeaderID {
+			check := &entity.ReplicaCheck{PartitionID: partition.Id, Index: leaderSum.Index, Buckets: report.Buckets}
+			report.Replicas = append(report.Replicas, ms.waitChecksum(ctx, nodeID, check))
+		}
+	}
+	for _, sum := range report.Replicas {
+		if sum.Error != "" {
+			return fmt.Errorf("checksum of replica:[%d] err:[%s]", sum.NodeID, sum.Error)
+		}
+	}
+
+	majority, most := leaderSum, 0
+	for _, sum := range report.Replicas {
+		n := 0
+		for _, other := range report.Replicas {
+			if sum.Equal(other) {
+				n++
+			}
+		}
+		if n > most {
+			majority, most = sum, n
+		}
+	}
+	report.Divergent = nil
+	for _, sum := range report.Replicas {
+		if !sum.Equal(majority) {
+			report.Divergent = append(report.Divergent, sum.NodeID)
+		}
+	}
+	return nil
+}
+
+// waitChecksum returns the checksum of a follower at check.Index, or why it
+// was not got
+func (ms *masterService) waitChecksum(ctx context.Context, nodeID entity.NodeID, check *entity.ReplicaCheck) *entity.ReplicaChecksum {
+	sum := &entity.ReplicaChecksum{NodeID: nodeID, Index: check.Index}
+	server, err := ms.Master().QueryServer(ctx, nodeID)
+	if err != nil {
+		sum.Error = err.Error()
+		return sum
+	}
+	for i := 0; i < consistencyWait; i++ {
+		got, err := client.ReplicaChecksum(server.RpcAddr(), check)
+		if err != nil {
+			sum.Error = err.Error()
+			return sum
+		}
+		if got != nil {
+			return got
+		}
+		time.Sleep(time.Second)
+	}
+	sum.Error = fmt.Sprintf("index:[%d] not applied in %d seconds", check.Index, consistencyWait)
+	return sum
+}
+
+// repairReplicas removes the divergent followers from the partition and adds
+// them back, so they install a snapshot of the leader. The partition has less
+// replicas until they are added back and have installed it. Nothing is done
+// when the leader is not agreed by a majority of the replicas.
+func (ms *masterService) repairReplicas(ctx context.Context, partition *entity.Partition, report *entity.ConsistencyReport) {
+	agree := 0
+	for _, sum := range report.Replicas {
+		if sum.Equal(report.Replicas[0]) {
+			agree++
+		}
+	}
+	if agree*2 <= len(report.Replicas) {
+		report.Error = fmt.Sprintf("leader:[%d] is not agreed by a majority, replicas are not repaired", partition.LeaderID)
+		return
+	}
+	for _, nodeID := range report.Divergent {
+		log.Warn("rebuild replica:[%d] of partition:[%d] from the leader:[%d]", nodeID, partition.Id, partition.LeaderID)
+		cm := &entity.ChangeMember{PartitionID: partition.Id, NodeID: nodeID, Method: proto.ConfRemoveNode}
+		if err := ms.ChangeMember(ctx, cm); err != nil {
+			report.Error = fmt.Sprintf("remove replica:[%d] err:[%v]", nodeID, err)
+			return
+		}
+		cm.Method = proto.ConfAddNode
+		if err := ms.ChangeMember(ctx, cm); err != nil {
+			report.Error = fmt.Sprintf("add back replica:[%d] err:[%v]", nodeID, err)
+			return
+		}
+		report.Repaired = append(report.Repaired, nodeID)
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/master/store"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/log"
+)
+
+// fsckProblem is a problem found by fsck and the function fixing it
+type fsckProblem struct {
+	*entity.FsckProblem
+	fix func(ctx context.Context) error
+}
+
+// fsckState is the cluster metadata read once by fsck
+type fsckState struct {
+	dbs         map[entity.DBID]*entity.DB
+	spaces      []*entity.Space
+	partitions  map[entity.PartitionID]*entity.Partition
+	servers     map[entity.NodeID]*entity.Server
+	failServers map[entity.NodeID]*entity.FailServer
+	problems    []*fsckProblem
+}
+
+func (st *fsckState) add(kind, key, fix string, fixFunc func(ctx context.Context) error, format string, args ...interface{}) {
+	st.problems = append(st.problems, &fsckProblem{
+		FsckProblem: &entity.FsckProblem{Kind: kind, Key: key, Detail: fmt.Sprintf(format, args...), Fix: fix},
+		fix:         fixFunc,
+	})
+}
+
+// fsckOrphanGrace is how long the meta of a partition held by a ps must be
+// left unchanged before fsck deletes it as an orphan replica, a partition
+// being created is on the ps before it is stored
+const fsckOrphanGrace = 30 * time.Minute
+
+// Fsck checks the whole cluster metadata and the partitions held by the ps.
+// With fix the fixes of the problems of kinds, or of all of them when kinds
+// is empty, are applied. Orphan replicas hold data, they are only deleted
+// with deleteOrphans.
+func (ms *masterService) Fsck(ctx context.Context, fix bool, kinds []string, deleteOrphans bool) (*entity.FsckReport, error) {
+	st, err := ms.loadFsckState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ms.fsckSpaces(st)
+	ms.fsckPartitions(ctx, st)
+	ms.fsckServers(st)
+	if err := ms.fsckLocks(ctx, st); err != nil {
+		return nil, err
+	}
+	if err := ms.fsckSequences(ctx, st); err != nil {
+		return nil, err
+	}
+
+	report := &entity.FsckReport{Time: time.Now().Unix(), Fix: fix, Problems: make([]*entity.FsckProblem, 0, len(st.problems))}
+	for _, p := range st.problems {
+		report.Problems = append(report.Problems, p.FsckProblem)
+		if !fix || (len(kinds) > 0 && !fsckKindIn(p.Kind, kinds)) {
+			continue
+		}
+		if p.Kind == entity.FsckOrphanReplica && !deleteOrphans {
+			continue
+		}
+		log.Info("fsck fix %s [%s]: %s", p.Kind, p.Detail, p.Fix)
+		if err := p.fix(ctx); err != nil {
+			log.Error("fsck fix %s [%s] err:[%v]", p.Kind, p.Detail, err)
+			p.Error = err.Error()
+		} else {
+			p.Fixed = true
+		}
+	}
+	return report, nil
+}
+
+func fsckKindIn(kind string, kinds []string) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (ms *masterService) loadFsckState(ctx context.Context) (*fsckState, error) {
+	st := &fsckState{
+		dbs:         make(map[entity.DBID]*entity.DB),
+		partitions:  make(map[entity.PartitionID]*entity.Partition),
+		servers:     make(map[entity.NodeID]*entity.Server),
+		failServers: make(map[entity.NodeID]*entity.FailServer),
+	}
+	dbs, err := ms.Master().QueryDBs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range dbs {
+		st.dbs[db.Id] = db
+	}
+	if st.spaces, err = ms.Master().QuerySpacesByKey(ctx, entity.PrefixSpace); err != nil {
+		return nil, err
+	}
+	partitions, err := ms.Master().QueryPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, partition := range partitions {
+		st.partitions[partition.Id] = partition
+	}
+	servers, err := ms.Master().QueryServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, server := range servers {
+		st.servers[server.ID] = server
+	}
+	failServers, err := ms.Master().QueryAllFailServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fs := range failServers {
+		st.failServers[fs.ID] = fs
+	}
+	return st, nil
+}
+
+// fsckSpaces finds the spaces of missing dbs and the partitions of spaces
+// which are not stored
+func (ms *masterService) fsckSpaces(st *fsckState) {
+	for _, space := range st.spaces {
+		space := space
+		if _, ok := st.dbs[space.DBId]; !ok {
+			key := entity.SpaceKey(space.DBId, space.Id)
+			st.add(entity.FsckOrphanSpace, key, "delete the space", func(ctx context.Context) error {
+				return ms.Master().Delete(ctx, key)
+			}, "space:[%s] id:[%d] of missing db:[%d]", space.Name, space.Id, space.DBId)
+			continue
+		}
+		for _, sp := range space.Partitions {
+			if _, ok := st.partitions[sp.Id]; ok {
+				continue
+			}
+			partition := &entity.Partition{Id: sp.Id, SpaceId: space.Id, DBId: space.DBId, Slot: sp.Slot, LeaderID: sp.LeaderID, Replicas: sp.Replicas}
+			key := entity.PartitionKey(sp.Id)
+			st.add(entity.FsckMissingPartition, key, "store the partition as recorded by its space", func(ctx context.Context) error {
+				value, err := json.Marshal(partition)
+				if err != nil {
+					return err
+				}
+				return ms.Master().Put(ctx, key, value)
+			}, "partition:[%d] of space:[%s] id:[%d] is not stored", sp.Id, space.Name, space.Id)
+		}
+	}
+}
+
+// fsckPartitions finds the partitions of missing spaces, their replicas on
+// unknown servers and their leaders out of their replicas
+func (ms *masterService) fsckPartitions(ctx context.Context, st *fsckState) {
+	spaces := make(map[entity.SpaceID]bool, len(st.spaces))
+	for _, space := range st.spaces {
+		spaces[space.Id] = true
+	}
+	for _, partition := range st.partitions {
+		partition := partition
+		key := entity.PartitionKey(partition.Id)
+		if !spaces[partition.SpaceId] {
+			st.add(entity.FsckOrphanPartition, key, "delete the partition", func(ctx context.Context) error {
+				return ms.Master().Delete(ctx, key)
+			}, "partition:[%d] of missing space:[%d]", partition.Id, partition.SpaceId)
+			continue
+		}
+
+		isReplica := false
+		for _, nodeID := range partition.Replicas {
+			nodeID := nodeID
+			isReplica = isReplica || nodeID == partition.LeaderID
+			if st.servers[nodeID] != nil || st.failServers[nodeID] != nil {
+				continue
+			}
+			st.add(entity.FsckUnknownReplica, key, fmt.Sprintf("remove node:[%d] from the replicas", nodeID), func(ctx context.Context) error {
+				return ms.ChangeMember(ctx, &entity.ChangeMember{PartitionID: partition.Id, NodeID: nodeID, Method: proto.ConfRemoveNode})
+			}, "replica of partition:[%d] on node:[%d] which is neither alive nor failed", partition.Id, nodeID)
+		}
+		if !isReplica {
+			st.add(entity.FsckLeaderNotReplica, key, "set the leader reported by raft", func(ctx context.Context) error {
+				return ms.fixPartitionLeader(ctx, st, partition)
+			}, "leader:[%d] of partition:[%d] is not in its replicas:%v", partition.LeaderID, partition.Id, partition.Replicas)
+		}
+	}
+}
+
+// fixPartitionLeader stores the raft leader reported by a replica
+func (ms *masterService) fixPartitionLeader(ctx context.Context, st *fsckState, partition *entity.Partition) error {
+	for _, nodeID := range partition.Replicas {
+		server := st.servers[nodeID]
+		if server == nil {
+			continue
+		}
+		info, err := client.PartitionInfo(server.RpcAddr(), partition.Id, true)
+		if err != nil || info.RaftStatus == nil {
+			log.Warn("get raft status of partition:[%d] from node:[%d] err:[%v]", partition.Id, nodeID, err)
+			continue
+		}
+		for _, replica := range partition.Replicas {
+			if uint64(replica) == info.RaftStatus.Leader {
+				partition.LeaderID = replica
+				value, err := json.Marshal(partition)
+				if err != nil {
+					return err
+				}
+				return ms.Master().Put(ctx, entity.PartitionKey(partition.Id), value)
+			}
+		}
+	}
+	return fmt.Errorf("no replica of partition:[%d] reports a leader in its replicas", partition.Id)
+}
+
+// fsckServers finds the partitions held by ps which are not stored
+func (ms *masterService) fsckServers(st *fsckState) {
+	for _, server := range st.servers {
+		server := server
+		for _, pid := range server.PartitionIds {
+			pid := pid
+			if _, ok := st.partitions[pid]; ok {
+				continue
+			}
+			st.add(entity.FsckOrphanReplica, entity.ServerKey(server.ID), "delete the partition from the ps with delete_orphans", func(ctx context.Context) error {
+				return ms.deleteOrphanReplica(ctx, server, pid)
+			}, "node:[%d] addr:[%s] holds partition:[%d] which is not stored", server.ID, server.RpcAddr(), pid)
+		}
+	}
+}
+
+// deleteOrphanReplica deletes a partition from a ps once its meta on the ps
+// is older than fsckOrphanGrace and the metadata, read again, still has no
+// partition nor space for it
+func (ms *masterService) deleteOrphanReplica(ctx context.Context, server *entity.Server, pid entity.PartitionID) error {
+	metas, err := client.LocalPartitions(server.RpcAddr())
+	if err != nil {
+		return err
+	}
+	var meta *entity.PartitionMeta
+	for _, m := range metas {
+		if m.PartitionID == pid {
+			meta = m
+			break
+		}
+	}
+	if meta == nil {
+		return fmt.Errorf("partition:[%d] is not in the data dirs of the ps anymore", pid)
+	}
+	if age := time.Since(time.Unix(meta.ModTime, 0)); age < fsckOrphanGrace {
+		return fmt.Errorf("meta of partition:[%d] changed %v ago, within the grace period of %v", pid, age.Truncate(time.Second), fsckOrphanGrace)
+	}
+
+	if _, err := ms.Master().QueryPartition(ctx, pid); err == nil {
+		return fmt.Errorf("partition:[%d] is stored now", pid)
+	} else if vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError().Code != vearchpb.ErrorEnum_PARTITION_NOT_EXIST {
+		return err
+	}
+	spaces, err := ms.Master().QuerySpacesByKey(ctx, entity.PrefixSpace)
+	if err != nil {
+		return err
+	}
+	for _, space := range spaces {
+		for _, partition := range space.Partitions {
+			if partition.Id == pid {
+				return fmt.Errorf("partition:[%d] is in space:[%s] now", pid, space.Name)
+			}
+		}
+	}
+	return client.DeletePartition(server.RpcAddr(), pid)
+}
+
+// fsckLocks finds the locks without a lease, nothing ever releases them
+func (ms *masterService) fsckLocks(ctx context.Context, st *fsckState) error {
+	keys, _, err := ms.Master().PrefixScan(ctx, entity.PrefixLock)
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		key := string(k)
+		ttl, err := ms.Master().TTL(ctx, key)
+		if err != nil {
+			log.Warn("get ttl of lock:[%s] err:[%v]", key, err)
+			continue
+		}
+		if ttl >= 0 {
+			continue
+		}
+		st.add(entity.FsckStaleLock, key, "delete the lock", func(ctx context.Context) error {
+			return ms.Master().Delete(ctx, key)
+		}, "lock:[%s] has no lease", strings.TrimPrefix(key, entity.PrefixLock))
+	}
+	return nil
+}
+
+// fsckSequences finds the id sequences behind the largest id in use, they
+// would give out ids which are taken
+func (ms *masterService) fsckSequences(ctx context.Context, st *fsckState) error {
+	var maxDB, maxSpace, maxPartition, maxNode int64
+	for id := range st.dbs {
+		maxDB = maxInt64(maxDB, id)
+	}
+	for _, space := range st.spaces {
+		maxSpace = maxInt64(maxSpace, space.Id)
+		for _, sp := range space.Partitions {
+			maxPartition = maxInt64(maxPartition, int64(sp.Id))
+		}
+	}
+	for id := range st.partitions {
+		maxPartition = maxInt64(maxPartition, int64(id))
+	}
+	for id := range st.servers {
+		maxNode = maxInt64(maxNode, int64(id))
+	}
+	for id := range st.failServers {
+		maxNode = maxInt64(maxNode, int64(id))
+	}
+
+	sequences := []struct {
+		key string
+		max int64
+	}{
+		{entity.DBIdSequence, maxDB},
+		{entity.SpaceIdSequence, maxSpace},
+		{entity.PartitionIdSequence, maxPartition},
+		{entity.NodeIdSequence, maxNode},
+	}
+	for _, seq := range sequences {
+		key, max := seq.key, seq.max
+		value, err := ms.Master().Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		current := int64(0)
+		if value != nil {
+			if current, err = strconv.ParseInt(string(value), 10, 64); err != nil {
+				return fmt.Errorf("sequence:[%s] value:[%s] err:[%v]", key, value, err)
+			}
+		}
+		if current >= max {
+			continue
+		}
+		st.add(entity.FsckSequenceDrift, key, fmt.Sprintf("move the sequence to %d", max), func(ctx context.Context) error {
+			return ms.raiseSequence(ctx, key, max)
+		}, "sequence:[%s] is %d but id %d is in use", key, current, max)
+	}
+	return nil
+}
+
+// raiseSequence moves the id sequence of key to max when it is behind, so
+// the next id is after max
+func (ms *masterService) raiseSequence(ctx context.Context, key string, max int64) error {
+	return ms.Master().STM(ctx, func(stm store.STM) error {
+		if v, err := strconv.ParseInt(stm.Get(key), 10, 64); err == nil && v >= max {
+			return nil
+		}
+		stm.Put(key, strconv.FormatInt(max, 10))
+		return nil
+	})
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
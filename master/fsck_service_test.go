@@ -0,0 +1,229 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smallnest/rpcx/server"
+	"github.com/spf13/cast"
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbjson"
+)
+
+// newTestMasterService is a master service of a standalone cluster, its
+// metadata is in a bolt store of a temp dir
+func newTestMasterService(t *testing.T) *masterService {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	conf := fmt.Sprintf("[global]\nname = \"test\"\nstandalone = true\ndata = [%q]\n\n[router]\nconcurrent_num = 8\n", dir)
+	if err := os.WriteFile(path, []byte(conf), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	config.InitConfig(path)
+	cli, err := client.NewClient(config.Conf())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ms, err := newMasterService(cli)
+	if err != nil {
+		t.Fatalf("new master service: %v", err)
+	}
+	return ms
+}
+
+// psHandler answers the rpc of a ps admin handler
+type psHandler func(args, reply *vearchpb.PartitionData)
+
+func (h psHandler) Execute(ctx context.Context, args *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	h(args, reply)
+	return nil
+}
+
+// startFakePs serves handlers as the live ps of node id and stores the server
+func startFakePs(t *testing.T, ms *masterService, id entity.NodeID, pids []entity.PartitionID, handlers map[string]psHandler) *entity.Server {
+	s := server.NewServer()
+	handlers[client.IsLiveHandler] = func(args, reply *vearchpb.PartitionData) {}
+	for name, h := range handlers {
+		if err := s.RegisterName(name, h, ""); err != nil {
+			t.Fatalf("register %s: %v", name, err)
+		}
+	}
+	go s.Serve("tcp", "127.0.0.1:0")
+	t.Cleanup(func() { s.Close() })
+	deadline := time.Now().Add(5 * time.Second)
+	for s.Address() == nil {
+		if time.Now().After(deadline) {
+			t.Fatalf("fake ps is not listening")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	addr := strings.Split(s.Address().String(), ":")
+	ps := &entity.Server{ID: id, Ip: addr[0], RpcPort: cast.ToUint16(addr[1]), PartitionIds: pids}
+	value, err := json.Marshal(ps)
+	if err != nil {
+		t.Fatalf("marshal server: %v", err)
+	}
+	if err := ms.Master().Put(context.Background(), entity.ServerKey(id), value); err != nil {
+		t.Fatalf("put server: %v", err)
+	}
+	return ps
+}
+
+func fsckProblems(report *entity.FsckReport, kind string) map[string]*entity.FsckProblem {
+	problems := make(map[string]*entity.FsckProblem)
+	for _, p := range report.Problems {
+		if p.Kind == kind {
+			problems[p.Key] = p
+		}
+	}
+	return problems
+}
+
+func TestFsckSequenceDrift(t *testing.T) {
+	ctx := context.Background()
+	ms := newTestMasterService(t)
+
+	// ids put as an import in adopt mode does, the sequences are not moved
+	if err := ms.putDB(ctx, &entity.DB{Id: 7, Name: "db"}); err != nil {
+		t.Fatalf("put db: %v", err)
+	}
+	space := &entity.Space{Id: 5, Name: "space", DBId: 7, Partitions: []*entity.Partition{{Id: 9, SpaceId: 5, DBId: 7}}}
+	if err := ms.putSpace(ctx, space); err != nil {
+		t.Fatalf("put space: %v", err)
+	}
+	if _, err := ms.Master().NewIDGenerate(ctx, entity.SpaceIdSequence, 1, time.Second); err != nil {
+		t.Fatalf("new space id: %v", err)
+	}
+
+	report, err := ms.Fsck(ctx, false, nil, false)
+	if err != nil {
+		t.Fatalf("fsck: %v", err)
+	}
+	drifts := fsckProblems(report, entity.FsckSequenceDrift)
+	for key, max := range map[string]int{entity.DBIdSequence: 7, entity.SpaceIdSequence: 5, entity.PartitionIdSequence: 9} {
+		p := drifts[key]
+		if p == nil {
+			t.Fatalf("no drift of sequence %s in %v", key, report.Problems)
+		}
+		if p.Fixed || !strings.Contains(p.Fix, fmt.Sprint(max)) {
+			t.Errorf("drift of sequence %s: %+v", key, p)
+		}
+	}
+	if len(drifts) != 3 {
+		t.Fatalf("%d sequence drifts, want 3", len(drifts))
+	}
+
+	report, err = ms.Fsck(ctx, true, []string{entity.FsckSequenceDrift}, false)
+	if err != nil {
+		t.Fatalf("fsck fix: %v", err)
+	}
+	for key, p := range fsckProblems(report, entity.FsckSequenceDrift) {
+		if !p.Fixed || p.Error != "" {
+			t.Errorf("fix of sequence %s: %+v", key, p)
+		}
+	}
+	// the other kinds are only reported
+	for _, p := range report.Problems {
+		if p.Kind != entity.FsckSequenceDrift && p.Fixed {
+			t.Errorf("fixed %+v", p)
+		}
+	}
+
+	// the next ids are after the ones in use
+	for key, want := range map[string]int64{entity.DBIdSequence: 8, entity.SpaceIdSequence: 6, entity.PartitionIdSequence: 10} {
+		if id, err := ms.Master().NewIDGenerate(ctx, key, 1, time.Second); err != nil || id != want {
+			t.Errorf("next id of %s: %d, err: %v, want %d", key, id, err, want)
+		}
+	}
+	if report, err = ms.Fsck(ctx, false, nil, false); err != nil {
+		t.Fatalf("fsck: %v", err)
+	}
+	if drifts := fsckProblems(report, entity.FsckSequenceDrift); len(drifts) != 0 {
+		t.Fatalf("sequence drifts after the fix: %v", drifts)
+	}
+}
+
+func TestFsckOrphanGrace(t *testing.T) {
+	ctx := context.Background()
+	ms := newTestMasterService(t)
+
+	const pid = entity.PartitionID(42)
+	modTime := time.Now().Unix()
+	var deletes int32
+	startFakePs(t, ms, 1, []entity.PartitionID{pid}, map[string]psHandler{
+		client.LocalPartitionsHandler: func(args, reply *vearchpb.PartitionData) {
+			metas := []*entity.PartitionMeta{{PartitionID: pid, ModTime: atomic.LoadInt64(&modTime)}}
+			reply.Data, _ = cbjson.Marshal(metas)
+		},
+		client.DeletePartitionHandler: func(args, reply *vearchpb.PartitionData) {
+			if args.PartitionID == pid {
+				atomic.AddInt32(&deletes, 1)
+			}
+		},
+	})
+	orphan := func(report *entity.FsckReport) *entity.FsckProblem {
+		problems := fsckProblems(report, entity.FsckOrphanReplica)
+		if len(problems) != 1 {
+			t.Fatalf("orphan replicas: %v", report.Problems)
+		}
+		return problems[entity.ServerKey(1)]
+	}
+	kinds := []string{entity.FsckOrphanReplica}
+
+	// orphans hold data, a fix leaves them without delete_orphans
+	report, err := ms.Fsck(ctx, true, kinds, false)
+	if err != nil {
+		t.Fatalf("fsck: %v", err)
+	}
+	if p := orphan(report); p == nil || p.Fixed || p.Error != "" {
+		t.Fatalf("orphan without delete_orphans: %+v", p)
+	}
+
+	// a partition being created is on the ps before it is stored
+	if report, err = ms.Fsck(ctx, true, kinds, true); err != nil {
+		t.Fatalf("fsck: %v", err)
+	}
+	if p := orphan(report); p.Fixed || !strings.Contains(p.Error, "grace period") {
+		t.Fatalf("orphan within the grace period: %+v", p)
+	}
+	if n := atomic.LoadInt32(&deletes); n != 0 {
+		t.Fatalf("orphan within the grace period deleted %d times", n)
+	}
+
+	atomic.StoreInt64(&modTime, time.Now().Add(-2*fsckOrphanGrace).Unix())
+	if report, err = ms.Fsck(ctx, true, kinds, true); err != nil {
+		t.Fatalf("fsck: %v", err)
+	}
+	if p := orphan(report); !p.Fixed || p.Error != "" {
+		t.Fatalf("orphan after the grace period: %+v", p)
+	}
+	if n := atomic.LoadInt32(&deletes); n != 1 {
+		t.Fatalf("orphan after the grace period deleted %d times", n)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/util"
+	"github.com/vearch/vearch/util/log"
+)
+
+// ExportMeta returns the dbs, spaces, users, engine configs and replica
+// assignments of the cluster as one document
+func (ms *masterService) ExportMeta(ctx context.Context) (*entity.ClusterMeta, error) {
+	meta := &entity.ClusterMeta{
+		Version: entity.ClusterMetaVersion,
+		Cluster: config.Conf().Global.Name,
+		Time:    time.Now().Unix(),
+	}
+	var err error
+	if meta.DBs, err = ms.Master().QueryDBs(ctx); err != nil {
+		return nil, err
+	}
+	dbNames := make(map[entity.DBID]string, len(meta.DBs))
+	for _, db := range meta.DBs {
+		dbNames[db.Id] = db.Name
+	}
+
+	spaces, err := ms.Master().QuerySpacesByKey(ctx, entity.PrefixSpace)
+	if err != nil {
+		return nil, err
+	}
+	for _, space := range spaces {
+		sm := &entity.SpaceMeta{DBName: dbNames[space.DBId], Space: space}
+		if sm.EngineCfg, err = ms.GetEngineCfg(ctx, sm.DBName, space.Name); err != nil {
+			log.Warn("export engine config of space:[%s/%s] err:[%v]", sm.DBName, space.Name, err)
+		}
+		meta.Spaces = append(meta.Spaces, sm)
+	}
+
+	_, users, err := ms.Master().PrefixScan(ctx, entity.PrefixUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range users {
+		user := &entity.User{}
+		if err := json.Unmarshal(value, user); err != nil {
+			log.Error("decode user err: %s", err.Error())
+			continue
+		}
+		meta.Users = append(meta.Users, user)
+	}
+
+	if meta.Servers, err = ms.Master().QueryServers(ctx); err != nil {
+		return nil, err
+	}
+	failServers, err := ms.Master().QueryAllFailServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fs := range failServers {
+		if fs.Node != nil {
+			meta.Servers = append(meta.Servers, fs.Node)
+		}
+	}
+	return meta, nil
+}
+
+// ImportMeta recreates the dbs, spaces, users and engine configs of meta in
+// a cluster without dbs and spaces. In remap mode they get new ids and new
+// empty partitions. In adopt mode they keep their ids and the partitions left
+// in the data dirs of the ps are loaded, spaces only found there are adopted
+// too.
+func (ms *masterService) ImportMeta(ctx context.Context, meta *entity.ClusterMeta, mode string) (*entity.ImportReport, error) {
+	if meta.Version < 1 || meta.Version > entity.ClusterMetaVersion {
+		return nil, fmt.Errorf("unsupported cluster meta version:[%d], the latest is %d", meta.Version, entity.ClusterMetaVersion)
+	}
+	if mode != entity.ImportRemap && mode != entity.ImportAdopt {
+		return nil, fmt.Errorf("unknown import mode:[%s], it should be %s or %s", mode, entity.ImportRemap, entity.ImportAdopt)
+	}
+	dbs, err := ms.Master().QueryDBs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spaces, err := ms.Master().QuerySpacesByKey(ctx, entity.PrefixSpace)
+	if err != nil {
+		return nil, err
+	}
+	if len(dbs) > 0 || len(spaces) > 0 {
+		return nil, fmt.Errorf("cluster is not empty, it has %d dbs and %d spaces", len(dbs), len(spaces))
+	}
+
+	report := &entity.ImportReport{Mode: mode}
+	if err := ms.importUsers(ctx, meta.Users, report); err != nil {
+		return report, err
+	}
+	if mode == entity.ImportRemap {
+		ms.importRemap(ctx, meta, report)
+		return report, nil
+	}
+	return report, ms.importAdopt(ctx, meta, report)
+}
+
+// importUsers stores the users which do not exist yet
+func (ms *masterService) importUsers(ctx context.Context, users []*entity.User, report *entity.ImportReport) error {
+	for _, user := range users {
+		key := entity.UserKey(user.Name)
+		if value, err := ms.Master().Get(ctx, key); err != nil {
+			return err
+		} else if value != nil {
+			continue
+		}
+		value, err := json.Marshal(user)
+		if err != nil {
+			return err
+		}
+		if err := ms.Master().Put(ctx, key, value); err != nil {
+			return err
+		}
+		report.Users = append(report.Users, user.Name)
+	}
+	return nil
+}
+
+// importRemap creates the dbs and spaces as new ones, the ps a db is limited
+// to are dropped as they are hosts of the exported cluster
+func (ms *masterService) importRemap(ctx context.Context, meta *entity.ClusterMeta, report *entity.ImportReport) {
+	for _, db := range meta.DBs {
+		if err := ms.createDBService(ctx, &entity.DB{Name: db.Name}); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("create db:[%s] err:[%v]", db.Name, err))
+			continue
+		}
+		report.DBs = append(report.DBs, db.Name)
+	}
+	for _, sm := range meta.Spaces {
+		space := *sm.Space
+		space.Id, space.Partitions, space.Enabled, space.Version = 0, nil, nil, 1
+		if err := ms.createSpaceService(ctx, sm.DBName, &space); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("create space:[%s/%s] err:[%v]", sm.DBName, space.Name, err))
+			continue
+		}
+		report.Spaces = append(report.Spaces, sm.DBName+"/"+space.Name)
+		ms.importEngineCfg(ctx, sm, report)
+	}
+}
+
+func (ms *masterService) importEngineCfg(ctx context.Context, sm *entity.SpaceMeta, report *entity.ImportReport) {
+	if sm.EngineCfg == nil || sm.EngineCfg.CacheModels == nil {
+		return
+	}
+	if err := ms.ModifyEngineCfg(ctx, sm.DBName, sm.Space.Name, sm.EngineCfg); err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("set engine config of space:[%s/%s] err:[%v]", sm.DBName, sm.Space.Name, err))
+	}
+}
+
+// importAdopt stores the dbs, spaces and partitions with their ids. The
+// replicas of a partition are the ps holding it in their data dirs, which
+// are made to load it.
+func (ms *masterService) importAdopt(ctx context.Context, meta *entity.ClusterMeta, report *entity.ImportReport) error {
+	servers, err := ms.Master().QueryServers(ctx)
+	if err != nil {
+		return err
+	}
+	held := make(map[entity.PartitionID][]*entity.Server)
+	found := make(map[entity.PartitionID]*entity.PartitionMeta)
+	for _, server := range servers {
+		metas, err := client.LocalPartitions(server.RpcAddr())
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("list partitions of node:[%d] err:[%v]", server.ID, err))
+			continue
+		}
+		for _, pm := range metas {
+			held[pm.PartitionID] = append(held[pm.PartitionID], server)
+			if f := found[pm.PartitionID]; f == nil || pm.Space.Version > f.Space.Version {
+				found[pm.PartitionID] = pm
+			}
+		}
+	}
+
+	// the spaces only found in the data dirs are adopted with their saved meta
+	dbs := make(map[entity.DBID]*entity.DB, len(meta.DBs))
+	for _, db := range meta.DBs {
+		dbs[db.Id] = db
+	}
+	spaces := make(map[entity.SpaceID]*entity.SpaceMeta, len(meta.Spaces))
+	for _, sm := range meta.Spaces {
+		spaces[sm.Space.Id] = sm
+	}
+	onDisk := make(map[entity.SpaceID]bool)
+	for _, pm := range found {
+		if sm := spaces[pm.Space.Id]; sm != nil && (!onDisk[pm.Space.Id] || sm.Space.Version >= pm.Space.Version) {
+			continue
+		}
+		onDisk[pm.Space.Id] = true
+		db := dbs[pm.Space.DBId]
+		if db == nil {
+			db = &entity.DB{Id: pm.Space.DBId, Name: fmt.Sprintf("db_%d", pm.Space.DBId)}
+			dbs[db.Id] = db
+		}
+		spaces[pm.Space.Id] = &entity.SpaceMeta{DBName: db.Name, Space: pm.Space}
+	}
+
+	var maxDB, maxSpace, maxPartition, maxNode int64
+	for _, db := range dbs {
+		if err := ms.putDB(ctx, db); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("create db:[%s] err:[%v]", db.Name, err))
+			continue
+		}
+		report.DBs = append(report.DBs, db.Name)
+		maxDB = maxInt64(maxDB, db.Id)
+	}
+
+	spaceIDs := make([]entity.SpaceID, 0, len(spaces))
+	for id := range spaces {
+		spaceIDs = append(spaceIDs, id)
+	}
+	sort.Slice(spaceIDs, func(i, j int) bool { return spaceIDs[i] < spaceIDs[j] })
+	adopted := make([]*entity.SpaceMeta, 0, len(spaceIDs))
+	for _, id := range spaceIDs {
+		sm := spaces[id]
+		space := sm.Space
+		maxSpace = maxInt64(maxSpace, space.Id)
+		if dbs[space.DBId] == nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("space:[%s] id:[%d] of db:[%d] which is not in the document", space.Name, space.Id, space.DBId))
+			continue
+		}
+		space.Enabled = util.PBool(true)
+		for _, partition := range space.Partitions {
+			partition.SpaceId, partition.DBId = space.Id, space.DBId
+			maxPartition = maxInt64(maxPartition, int64(partition.Id))
+			if len(held[partition.Id]) == 0 {
+				report.Errors = append(report.Errors, fmt.Sprintf("partition:[%d] of space:[%s] is in no data dir of the live ps, its replicas are kept as exported", partition.Id, space.Name))
+				continue
+			}
+			replicas, leader := make([]entity.NodeID, 0, len(held[partition.Id])), false
+			for _, server := range held[partition.Id] {
+				replicas = append(replicas, server.ID)
+				leader = leader || server.ID == partition.LeaderID
+			}
+			partition.Replicas = replicas
+			if !leader {
+				partition.LeaderID = replicas[0]
+			}
+		}
+		if err := ms.putSpace(ctx, space); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("create space:[%s/%s] err:[%v]", sm.DBName, space.Name, err))
+			continue
+		}
+		report.Spaces = append(report.Spaces, sm.DBName+"/"+space.Name)
+		adopted = append(adopted, sm)
+	}
+
+	// the ids given out later must be after the adopted ones
+	for _, server := range append(servers, meta.Servers...) {
+		maxNode = maxInt64(maxNode, int64(server.ID))
+	}
+	for key, max := range map[string]int64{
+		entity.DBIdSequence:        maxDB,
+		entity.SpaceIdSequence:     maxSpace,
+		entity.PartitionIdSequence: maxPartition,
+		entity.NodeIdSequence:      maxNode,
+	} {
+		if err := ms.raiseSequence(ctx, key, max); err != nil {
+			return err
+		}
+	}
+
+	report.Adopted = make(map[entity.NodeID][]entity.PartitionID)
+	for _, sm := range adopted {
+		for _, partition := range sm.Space.Partitions {
+			for _, server := range held[partition.Id] {
+				if err := client.AdoptPartition(server.RpcAddr(), sm.Space, partition.Id); err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("adopt partition:[%d] on node:[%d] err:[%v]", partition.Id, server.ID, err))
+					continue
+				}
+				report.Adopted[server.ID] = append(report.Adopted[server.ID], partition.Id)
+			}
+		}
+		ms.importEngineCfg(ctx, sm, report)
+	}
+	return nil
+}
+
+// putDB stores a db keeping its id
+func (ms *masterService) putDB(ctx context.Context, db *entity.DB) error {
+	return ms.Master().STM(ctx, func(stm store.STM) error {
+		idKey, nameKey, bodyKey := ms.Master().DBKeys(db.Id, db.Name)
+		if stm.Get(nameKey) != "" {
+			return fmt.Errorf("dbname %s is exists", db.Name)
+		}
+		if stm.Get(idKey) != "" {
+			return fmt.Errorf("dbID %d is exists", db.Id)
+		}
+		value, err := json.Marshal(db)
+		if err != nil {
+			return err
+		}
+		stm.Put(nameKey, cast.ToString(db.Id))
+		stm.Put(idKey, db.Name)
+		stm.Put(bodyKey, string(value))
+		return nil
+	})
+}
+
+// putSpace stores a space and its partitions keeping their ids
+func (ms *masterService) putSpace(ctx context.Context, space *entity.Space) error {
+	value, err := json.Marshal(space)
+	if err != nil {
+		return err
+	}
+	if err := ms.Master().Create(ctx, entity.SpaceKey(space.DBId, space.Id), value); err != nil {
+		return err
+	}
+	for _, partition := range space.Partitions {
+		value, err := json.Marshal(partition)
+		if err != nil {
+			return err
+		}
+		if err := ms.Master().Put(ctx, entity.PartitionKey(partition.Id), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package master
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbjson"
+)
+
+func TestImportRemap(t *testing.T) {
+	ctx := context.Background()
+	ms := newTestMasterService(t)
+
+	// the ps registers the partitions it creates as a real one does
+	startFakePs(t, ms, 1, nil, map[string]psHandler{
+		client.CreatePartitionHandler: func(args, reply *vearchpb.PartitionData) {
+			space := &entity.Space{}
+			if err := cbjson.Unmarshal(args.Data, space); err != nil {
+				reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_PARAM_ERROR, Msg: err.Error()}
+				return
+			}
+			for _, p := range space.Partitions {
+				if p.Id != args.PartitionID {
+					continue
+				}
+				p.LeaderID = 1
+				value, _ := json.Marshal(p)
+				if err := ms.Master().Put(ctx, entity.PartitionKey(p.Id), value); err != nil {
+					reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_INTERNAL_ERROR, Msg: err.Error()}
+				}
+			}
+		},
+	})
+
+	enabled := true
+	meta := &entity.ClusterMeta{
+		Version: entity.ClusterMetaVersion,
+		DBs:     []*entity.DB{{Id: 7, Name: "db", Ps: []string{"10.0.0.1"}}},
+		Spaces: []*entity.SpaceMeta{{DBName: "db", Space: &entity.Space{
+			Id: 12, Name: "space", DBId: 7, Version: 9, Enabled: &enabled,
+			PartitionNum: 2, ReplicaNum: 1,
+			Partitions: []*entity.Partition{{Id: 30, SpaceId: 12, DBId: 7, Replicas: []entity.NodeID{5}}},
+			Properties: json.RawMessage(`{"name":{"type":"keyword"},"vec":{"type":"vector","dimension":4}}`),
+		}}},
+		Users: []*entity.User{{Name: "reader", Password: "secret"}},
+	}
+	report, err := ms.ImportMeta(ctx, meta, entity.ImportRemap)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if len(report.Errors) > 0 || len(report.DBs) != 1 || len(report.Spaces) != 1 || report.Spaces[0] != "db/space" || len(report.Users) != 1 {
+		t.Fatalf("import report: %+v", report)
+	}
+
+	// the db and space get new ids and the ps of the exported cluster go
+	dbID, err := ms.Master().QueryDBName2Id(ctx, "db")
+	if err != nil || dbID == 7 {
+		t.Fatalf("db id: %d, err: %v", dbID, err)
+	}
+	db, err := ms.Master().QueryDBId2Name(ctx, dbID)
+	if err != nil || db != "db" {
+		t.Fatalf("db name of id %d: %s, err: %v", dbID, db, err)
+	}
+	space, err := ms.Master().QuerySpaceByName(ctx, dbID, "space")
+	if err != nil {
+		t.Fatalf("query space: %v", err)
+	}
+	if space.Id == 12 || space.DBId != dbID || space.Enabled == nil || !*space.Enabled {
+		t.Fatalf("space: %+v", space)
+	}
+	if len(space.Partitions) != 2 {
+		t.Fatalf("space has %d partitions, want 2", len(space.Partitions))
+	}
+	for _, sp := range space.Partitions {
+		if sp.Id == 30 || sp.SpaceId != space.Id || len(sp.Replicas) != 1 || sp.Replicas[0] != 1 {
+			t.Fatalf("partition of the space: %+v", sp)
+		}
+		if _, err := ms.Master().QueryPartition(ctx, sp.Id); err != nil {
+			t.Fatalf("query partition %d: %v", sp.Id, err)
+		}
+	}
+	if _, err := ms.Master().QueryPartition(ctx, 30); err == nil {
+		t.Fatalf("partition of the exported cluster is stored")
+	}
+
+	// an import only goes into an empty cluster
+	if _, err := ms.ImportMeta(ctx, meta, entity.ImportRemap); err == nil {
+		t.Fatalf("import into a cluster with dbs succeeded")
+	}
+}
@@ -121,6 +121,15 @@ func (ms *monitorService) partitionInfo(ctx context.Context, dbName string, spac
 		detail_info = true
 	}
 
+	watermarks := make(map[entity.NodeID]string)
+	if servers, err := ms.Master().QueryServers(ctx); err != nil {
+		errors = append(errors, "find servers err: "+err.Error())
+	} else {
+		for _, server := range servers {
+			watermarks[server.ID] = server.DiskWatermark
+		}
+	}
+
 	resultInsideDbs := make([]map[string]interface{}, 0)
 	for i := range dbNames {
 		dbName := dbNames[i]
@@ -160,6 +169,7 @@ func (ms *monitorService) partitionInfo(ctx context.Context, dbName string, spac
 			}
 
 			spaceStatus := 0
+			spaceReadOnly := false
 			resultInsidePartition := make([]*entity.PartitionInfo, 0)
 			for _, spacePartition := range space.Partitions {
 				p, err := ms.Master().QueryPartition(ctx, spacePartition.Id)
@@ -204,6 +214,20 @@ func (ms *monitorService) partitionInfo(ctx context.Context, dbName string, spac
 					}
 				}
 
+				for _, nodeID := range p.Replicas {
+					if entity.DiskWatermarkLevel(watermarks[nodeID]) > entity.DiskWatermarkLevel(partitionInfo.DiskWatermark) {
+						partitionInfo.DiskWatermark = watermarks[nodeID]
+					}
+				}
+				if spacePartition.ReadOnly {
+					errors = append(errors, fmt.Sprintf("partition:[%d] of space:[%s] is read only, disk of a replica is above the flood stage watermark", spacePartition.Id, spaceName))
+					partitionInfo.ReadOnly = true
+					spaceReadOnly = true
+					if pStatus < 1 {
+						pStatus = 1
+					}
+				}
+
 				//this must from space.Partitions
 				partitionInfo.PartitionID = spacePartition.Id
 				partitionInfo.Color = color[pStatus]
@@ -233,6 +257,7 @@ func (ms *monitorService) partitionInfo(ctx context.Context, dbName string, spac
 			resultSpace["size"] = size
 			resultSpace["partitions"] = resultInsidePartition
 			resultSpace["status"] = color[spaceStatus]
+			resultSpace["read_only"] = spaceReadOnly
 			resultInsideSpaces = append(resultInsideSpaces, resultSpace)
 
 			if spaceStatus > dbStatus {
@@ -278,6 +303,10 @@ func (ms *monitorService) monitorCallBack(masterMonitor *monitoring.MasterMonito
 		log.Error("got server by prefix err:[%s]", err.Error())
 	}
 	masterMonitor.ServerNum.Set(float64(len(servers)))
+	for _, s := range servers {
+		masterMonitor.DiskWatermark.WithLabelValues(s.Ip).Set(float64(entity.DiskWatermarkLevel(s.DiskWatermark)))
+		masterMonitor.DiskUsedPercent.WithLabelValues(s.Ip).Set(s.DiskUsedPercent)
+	}
 
 	dbs, err := ms.masterService.queryDBs(ctx)
 	if err != nil {
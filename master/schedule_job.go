@@ -18,13 +18,16 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
 	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/util/log"
-	"go.etcd.io/etcd/client/v3/concurrency"
 )
 
 const CronInterval = 60
@@ -102,7 +105,7 @@ var errSkipJob = errors.New("skip job")
 
 func CleanTask(masterServer *Server) {
 
-	var err = masterServer.client.Master().STM(masterServer.ctx, func(stm concurrency.STM) error {
+	var err = masterServer.client.Master().STM(masterServer.ctx, func(stm store.STM) error {
 		timeBytes := stm.Get(entity.ClusterCleanJobKey)
 		if len(timeBytes) == 0 {
 			return nil
@@ -158,3 +161,224 @@ func (s *Server) WatchServerJob(ctx context.Context, cli *client.Client) error {
 	}
 	return nil
 }
+
+// DiskWatermarkInterval is the seconds between two runs of the disk watermark job
+const DiskWatermarkInterval = 60
+
+// DiskWatermarkJob sets the partitions with a replica above the flood stage
+// disk watermark read only and moves replicas away from the ps above the high
+// one
+func (s *Server) DiskWatermarkJob(ms *masterService) {
+	ticker := time.NewTicker(DiskWatermarkInterval * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// only one master moves replicas
+		mutex := ms.Master().NewLock(s.ctx, entity.ClusterDiskWatermarkKey, time.Second*DiskWatermarkInterval)
+		if getLock, err := mutex.TryLock(); !getLock || err != nil {
+			continue
+		}
+		if err := markFloodPartitions(s.ctx, ms); err != nil {
+			log.Error("disk watermark job err:[%v]", err)
+		}
+		if err := moveReplicasOffFullDisk(s.ctx, ms); err != nil {
+			log.Error("disk watermark job err:[%v]", err)
+		}
+		if err := mutex.Unlock(); err != nil {
+			log.Error("failed to unlock disk watermark job, err:[%v]", err)
+		}
+	}
+}
+
+// markFloodPartitions sets the partitions in a data dir above the flood stage
+// watermark of any of their replicas read only in their spaces, and clears it
+// when all of them are below. The leaders reject upserts into them.
+func markFloodPartitions(ctx context.Context, ms *masterService) error {
+	servers, err := ms.Master().QueryServers(ctx)
+	if err != nil {
+		return err
+	}
+	flood := make(map[entity.PartitionID]bool)
+	for _, server := range servers {
+		for _, pid := range server.FloodPartitionIds {
+			flood[pid] = true
+		}
+	}
+	dbs, err := ms.queryDBs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		spaces, err := ms.Master().QuerySpaces(ctx, db.Id)
+		if err != nil {
+			return err
+		}
+		for _, space := range spaces {
+			changed := false
+			for _, p := range space.Partitions {
+				if p.ReadOnly != flood[p.Id] {
+					log.Warn("set partition:[%d] of space:[%s] read only:[%v] for the flood stage disk watermark", p.Id, space.Name, flood[p.Id])
+					p.ReadOnly = flood[p.Id]
+					changed = true
+				}
+			}
+			if !changed {
+				continue
+			}
+			if _, err := ms.updateSpaceService(ctx, db.Name, space.Name, space); err != nil {
+				log.Error("update read only partitions of space:[%s] err:[%v]", space.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// moveReplicasOffFullDisk moves one partition of each ps above the high disk
+// watermark. A replica is added on the ps with the fewest partitions below
+// the low watermark first, and the one of the full ps is removed by a later
+// run once all the replicas are ready.
+func moveReplicasOffFullDisk(ctx context.Context, ms *masterService) error {
+	servers, err := ms.Master().QueryServers(ctx)
+	if err != nil {
+		return err
+	}
+	partitionNum := make(map[entity.NodeID]int)
+	for _, server := range servers {
+		partitionNum[server.ID] = len(server.PartitionIds)
+	}
+	for _, server := range servers {
+		if entity.DiskWatermarkLevel(server.DiskWatermark) < entity.DiskWatermarkLevel(entity.DiskWatermarkHigh) {
+			continue
+		}
+		for _, pid := range server.PartitionIds {
+			moved, err := moveReplicaOffServer(ctx, ms, server, pid, servers, partitionNum)
+			if err != nil {
+				log.Error("move partition:[%d] off server:[%d] above the %s disk watermark err:[%v]", pid, server.ID, server.DiskWatermark, err)
+				continue
+			}
+			if moved {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// moveReplicaOffServer returns true when a replica of pid was added or removed
+func moveReplicaOffServer(ctx context.Context, ms *masterService, full *entity.Server, pid entity.PartitionID, servers []*entity.Server, partitionNum map[entity.NodeID]int) (bool, error) {
+	partition, err := ms.Master().QueryPartition(ctx, pid)
+	if err != nil {
+		return false, err
+	}
+	space, err := ms.Master().QuerySpaceByID(ctx, partition.DBId, partition.SpaceId)
+	if err != nil {
+		return false, err
+	}
+
+	// a replica was added by a former run
+	if len(partition.Replicas) > int(space.ReplicaNum) {
+		for _, nodeID := range partition.Replicas {
+			if nodeID != full.ID && partition.ReStatusMap[uint64(nodeID)] != entity.ReplicasOK {
+				log.Info("partition:[%d] moving off server:[%d] waits for replica on server:[%d]", pid, full.ID, nodeID)
+				return true, nil
+			}
+		}
+		log.Info("remove partition:[%d] from server:[%d] above the %s disk watermark", pid, full.ID, full.DiskWatermark)
+		return true, ms.ChangeMember(ctx, &entity.ChangeMember{PartitionID: pid, NodeID: full.ID, Method: proto.ConfRemoveNode})
+	}
+
+	var target *entity.Server
+	for _, server := range servers {
+		if server.DiskWatermark != "" || server.Private || server.ResourceName != full.ResourceName {
+			continue
+		}
+		if target != nil && partitionNum[server.ID] >= partitionNum[target.ID] {
+			continue
+		}
+		has := false
+		for _, nodeID := range partition.Replicas {
+			has = has || nodeID == server.ID
+		}
+		if !has && client.IsLive(server.RpcAddr()) {
+			target = server
+		}
+	}
+	if target == nil {
+		return false, fmt.Errorf("no server below the low disk watermark")
+	}
+	log.Info("add partition:[%d] to server:[%d] to move it off server:[%d] above the %s disk watermark", pid, target.ID, full.ID, full.DiskWatermark)
+	if err = ms.ChangeMember(ctx, &entity.ChangeMember{PartitionID: pid, NodeID: target.ID, Method: proto.ConfAddNode}); err != nil {
+		return false, err
+	}
+	partitionNum[target.ID]++
+	return true, nil
+}
+
+// ConsistencyJob checks the replicas of all the partitions one after another
+// every consistency_check_interval seconds
+func (s *Server) ConsistencyJob(ms *masterService) {
+	interval := time.Duration(config.Conf().Global.ConsistencyCheckInterval) * time.Second
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// only one master checks replicas, the lock is kept alive between partitions
+		mutex := ms.Master().NewLock(s.ctx, entity.ClusterConsistencyKey, interval)
+		if getLock, err := mutex.TryLock(); !getLock || err != nil {
+			continue
+		}
+		if err := checkPartitionsConsistency(s.ctx, ms, mutex.KeepAliveOnce); err != nil {
+			log.Error("consistency job err:[%v]", err)
+		}
+		if err := mutex.Unlock(); err != nil {
+			log.Error("failed to unlock consistency job, err:[%v]", err)
+		}
+	}
+}
+
+// checkPartitionsConsistency checks the replicas of all the partitions and
+// drops the reports of the deleted ones. It never repairs them, a repair
+// drops replicas for a while and is only made by an explicit request.
+func checkPartitionsConsistency(ctx context.Context, ms *masterService, keepAlive func()) error {
+	partitions, err := ms.Master().QueryPartitions(ctx)
+	if err != nil {
+		return err
+	}
+	exist := make(map[entity.PartitionID]bool, len(partitions))
+	for _, partition := range partitions {
+		exist[partition.Id] = true
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if report, err := ms.CheckConsistency(ctx, partition.Id, false); err != nil {
+			log.Error("check consistency of partition:[%d] err:[%v]", partition.Id, err)
+		} else if report.Error != "" {
+			log.Error("check consistency of partition:[%d] err:[%s]", partition.Id, report.Error)
+		}
+		keepAlive()
+	}
+
+	reports, err := ms.ConsistencyReports(ctx)
+	if err != nil {
+		return err
+	}
+	for _, report := range reports {
+		if !exist[report.PartitionID] {
+			if err := ms.Master().Delete(ctx, entity.ConsistencyKey(report.PartitionID)); err != nil {
+				log.Error("delete consistency report of partition:[%d] err:[%v]", report.PartitionID, err)
+			}
+		}
+	}
+	return nil
+}
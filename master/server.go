@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/log"
 	"go.etcd.io/etcd/server/v3/embed"
@@ -34,6 +35,7 @@ type Server struct {
 	etcCfg     *embed.Config
 	client     *client.Client
 	etcdServer *embed.Etcd
+	raftStore  *store.RaftStore
 	ctx        context.Context
 }
 
@@ -45,8 +47,8 @@ func NewServer(ctx context.Context) (*Server, error) {
 	}
 
 	var server *Server
-	// manage etcd by yourself
-	if config.Conf().Global.SelfManageEtcd {
+	// manage etcd by yourself, or no etcd in standalone or on the raft store
+	if !manageEtcd() {
 		// no vearch etcd cfg
 		server = &Server{ctx: ctx}
 	} else {
@@ -70,7 +72,7 @@ func (s *Server) Start() (err error) {
 	log.Debug("master start ...")
 
 	// if vearch manage etcd then start it
-	if !config.Conf().Global.SelfManageEtcd {
+	if manageEtcd() {
 		//start etcd server
 		s.etcdServer, err = embed.StartEtcd(s.etcCfg)
 		if err != nil {
@@ -88,6 +90,15 @@ func (s *Server) Start() (err error) {
 		}
 	}
 
+	// the metadata is replicated by the raft of the masters
+	if config.Conf().Global.MetaStore == "raft" && !config.Conf().Global.Standalone {
+		s.raftStore, err = store.StartRaftStore(config.Conf())
+		if err != nil {
+			log.Error(err.Error())
+			return err
+		}
+	}
+
 	s.client, err = client.NewClient(config.Conf())
 	if err != nil {
 		return err
@@ -98,7 +109,7 @@ func (s *Server) Start() (err error) {
 	}
 
 	monitorService := &monitorService{}
-	if config.Conf().Global.SelfManageEtcd {
+	if !manageEtcd() {
 		monitorService = newMonitorService(service, &etcdserver.EtcdServer{})
 	} else {
 		monitorService = newMonitorService(service, s.etcdServer.Server)
@@ -114,6 +125,9 @@ func (s *Server) Start() (err error) {
 
 	ExportToClusterHandler(engine, service, s)
 	ExportToMonitorHandler(engine, monitorService)
+	if s.raftStore != nil {
+		ExportToStoreHandler(engine, s.raftStore)
+	}
 
 	//register monitor
 
@@ -123,11 +137,24 @@ func (s *Server) Start() (err error) {
 		}
 	}()
 
+	if s.raftStore != nil {
+		if err = s.raftStore.WaitReady(s.ctx, 60*time.Second); err != nil {
+			return err
+		}
+		log.Info("raft store is ready!")
+	}
+
 	// start watch server
 	err = s.WatchServerJob(s.ctx, s.client)
 	errutil.ThrowError(err)
 	log.Debug("start WatchServerJob success!")
-	if !config.Conf().Global.SelfManageEtcd {
+	// a standalone ps has no other ps to move replicas to, it rejects upserts
+	// into its data dirs above the flood stage itself
+	if !config.Conf().Global.Standalone {
+		go s.DiskWatermarkJob(service)
+	}
+	go s.ConsistencyJob(service)
+	if manageEtcd() {
 		return <-s.etcdServer.Err()
 	}
 	return nil
@@ -135,6 +162,17 @@ func (s *Server) Start() (err error) {
 
 func (s *Server) Stop() {
 	log.Info("master shutdown... start")
-	s.etcdServer.Server.Stop()
+	if s.etcdServer != nil {
+		s.etcdServer.Server.Stop()
+	}
+	if s.raftStore != nil {
+		s.raftStore.Stop()
+	}
 	log.Info("master shutdown... end")
 }
+
+// manageEtcd is whether the master runs the embedded etcd
+func manageEtcd() bool {
+	global := config.Conf().Global
+	return !global.SelfManageEtcd && !global.Standalone && global.MetaStore != "raft"
+}
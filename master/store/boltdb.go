@@ -0,0 +1,560 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/vearch/vearch/util/log"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// keys and their values
+	boltKVBucket = []byte("kv")
+	// the revision a key was last changed at
+	boltRevBucket = []byte("rev")
+	// the lease a key is bound to
+	boltLeaseBucket = []byte("lease")
+	// the ttl of each lease
+	boltLeasesBucket = []byte("leases")
+	// the revision of the store and the raft index it has applied
+	boltMetaBucket = []byte("meta")
+	boltRevKey     = []byte("revision")
+	boltAppliedKey = []byte("applied")
+
+	boltBuckets = [][]byte{boltKVBucket, boltRevBucket, boltLeaseBucket, boltLeasesBucket, boltMetaBucket}
+)
+
+const (
+	// the events kept for the watchers to catch up from
+	boltHistorySize = 10000
+	// the size of a chunk of a snapshot
+	boltSnapshotChunk = 1 << 20
+)
+
+// boltDB keeps the keys in a bbolt file and applies the transactions to it.
+// Applying is deterministic so the replicas of a raft log end the same, each
+// transaction that changes something takes the next revision.
+type boltDB struct {
+	path string
+	db   *bolt.DB
+
+	// mu orders the transactions with the events they publish
+	mu      sync.Mutex
+	rev     int64
+	applied uint64
+
+	history *eventHistory
+}
+
+func openBoltDB(path string) (*boltDB, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("open bolt store %s error: %v", path, err)
+	}
+	d := &boltDB{path: path, db: db}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range boltBuckets {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		d.rev, d.applied = readMeta(tx)
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	d.history = newEventHistory(d.rev)
+	return d, nil
+}
+
+func (d *boltDB) close() error {
+	return d.db.Close()
+}
+
+func readMeta(tx *bolt.Tx) (rev int64, applied uint64) {
+	meta := tx.Bucket(boltMetaBucket)
+	if v := meta.Get(boltRevKey); len(v) == 8 {
+		rev = int64(binary.BigEndian.Uint64(v))
+	}
+	if v := meta.Get(boltAppliedKey); len(v) == 8 {
+		applied = binary.BigEndian.Uint64(v)
+	}
+	return rev, applied
+}
+
+func uint64Bytes(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func bytesUint64(b []byte) uint64 {
+	if len(b) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// boltTxn is a transaction, it records the events it makes
+type boltTxn struct {
+	tx     *bolt.Tx
+	rev    int64
+	events []*Event
+}
+
+func (t *boltTxn) get(key []byte) *KeyValue {
+	v := t.tx.Bucket(boltKVBucket).Get(key)
+	if v == nil {
+		return nil
+	}
+	return &KeyValue{
+		Key:         append([]byte(nil), key...),
+		Value:       append(make([]byte, 0, len(v)), v...),
+		ModRevision: int64(bytesUint64(t.tx.Bucket(boltRevBucket).Get(key))),
+		Lease:       LeaseID(bytesUint64(t.tx.Bucket(boltLeaseBucket).Get(key))),
+	}
+}
+
+func (t *boltTxn) scan(prefix []byte) []*KeyValue {
+	var kvs []*KeyValue
+	c := t.tx.Bucket(boltKVBucket).Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		kvs = append(kvs, t.get(k))
+	}
+	return kvs
+}
+
+func (t *boltTxn) compare(cmps []*Compare) bool {
+	for _, cmp := range cmps {
+		if cmp.Prefix {
+			prefix := []byte(cmp.Key)
+			if k, _ := t.tx.Bucket(boltKVBucket).Cursor().Seek(prefix); k != nil && bytes.HasPrefix(k, prefix) {
+				return false
+			}
+			continue
+		}
+		if int64(bytesUint64(t.tx.Bucket(boltRevBucket).Get([]byte(cmp.Key)))) != cmp.ModRevision {
+			return false
+		}
+	}
+	return true
+}
+
+// put binds key to lease, or to none when lease is 0
+func (t *boltTxn) put(key, value []byte, lease LeaseID) error {
+	if lease != 0 && t.tx.Bucket(boltLeasesBucket).Get(uint64Bytes(uint64(lease))) == nil {
+		return fmt.Errorf("requested lease:[%d] not found", lease)
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	if err := t.tx.Bucket(boltKVBucket).Put(key, value); err != nil {
+		return err
+	}
+	if err := t.tx.Bucket(boltRevBucket).Put(key, uint64Bytes(uint64(t.rev))); err != nil {
+		return err
+	}
+	if lease == 0 {
+		if err := t.tx.Bucket(boltLeaseBucket).Delete(key); err != nil {
+			return err
+		}
+	} else if err := t.tx.Bucket(boltLeaseBucket).Put(key, uint64Bytes(uint64(lease))); err != nil {
+		return err
+	}
+	t.events = append(t.events, &Event{
+		Type: PUT,
+		Kv: &KeyValue{
+			Key:         append([]byte(nil), key...),
+			Value:       append([]byte(nil), value...),
+			ModRevision: t.rev,
+			Lease:       lease,
+		},
+	})
+	return nil
+}
+
+func (t *boltTxn) del(key []byte) (int, error) {
+	if t.tx.Bucket(boltKVBucket).Get(key) == nil {
+		return 0, nil
+	}
+	for _, name := range [][]byte{boltKVBucket, boltRevBucket, boltLeaseBucket} {
+		if err := t.tx.Bucket(name).Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	t.events = append(t.events, &Event{
+		Type: DELETE,
+		Kv:   &KeyValue{Key: append([]byte(nil), key...), ModRevision: t.rev},
+	})
+	return 1, nil
+}
+
+func (t *boltTxn) grant(lease LeaseID, ttl int64) error {
+	id := uint64Bytes(uint64(lease))
+	if t.tx.Bucket(boltLeasesBucket).Get(id) != nil {
+		return fmt.Errorf("lease:[%d] already exists", lease)
+	}
+	return t.tx.Bucket(boltLeasesBucket).Put(id, uint64Bytes(uint64(ttl)))
+}
+
+// revoke removes the lease and deletes the keys bound to it
+func (t *boltTxn) revoke(lease LeaseID) (int, error) {
+	id := uint64Bytes(uint64(lease))
+	if t.tx.Bucket(boltLeasesBucket).Get(id) == nil {
+		return 0, fmt.Errorf("requested lease:[%d] not found", lease)
+	}
+	var keys [][]byte
+	if err := t.tx.Bucket(boltLeaseBucket).ForEach(func(k, v []byte) error {
+		if bytes.Equal(v, id) {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, k := range keys {
+		n, err := t.del(k)
+		if err != nil {
+			return 0, err
+		}
+		deleted += n
+	}
+	return deleted, t.tx.Bucket(boltLeasesBucket).Delete(id)
+}
+
+func (t *boltTxn) apply(ops []*Op) ([]int, error) {
+	deleted := make([]int, len(ops))
+	for i, op := range ops {
+		var err error
+		switch op.Type {
+		case OpPut:
+			err = t.put([]byte(op.Key), op.Value, op.Lease)
+		case OpDelete:
+			deleted[i], err = t.del([]byte(op.Key))
+		case OpGrant:
+			err = t.grant(op.Lease, op.TTL)
+		case OpRevoke:
+			deleted[i], err = t.revoke(op.Lease)
+		default:
+			err = fmt.Errorf("unknown store op type:[%d]", op.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return deleted, nil
+}
+
+// apply applies the transaction at raft index, one applied before is skipped.
+// index is 0 when the transactions are not in a raft log.
+func (d *boltDB) apply(req *TxnRequest, index uint64) *TxnResponse {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if index != 0 && index <= d.applied {
+		return &TxnResponse{Revision: d.rev}
+	}
+
+	resp := &TxnResponse{}
+	var t *boltTxn
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		t = &boltTxn{tx: tx, rev: d.rev + 1}
+		if resp.Succeeded = t.compare(req.Compares); resp.Succeeded {
+			deleted, err := t.apply(req.Ops)
+			if err != nil {
+				return err
+			}
+			resp.Deleted = deleted
+		}
+		return t.commit(index)
+	})
+	if err != nil {
+		// nothing of the transaction is kept but that it was applied
+		t = &boltTxn{rev: d.rev}
+		resp = &TxnResponse{Err: err.Error()}
+		if index != 0 {
+			if err := d.db.Update(func(tx *bolt.Tx) error {
+				t.tx = tx
+				return t.commit(index)
+			}); err != nil {
+				log.Error("bolt store %s save applied index:[%d] err: %v", d.path, index, err)
+			}
+		}
+	}
+
+	if index != 0 {
+		d.applied = index
+	}
+	if len(t.events) > 0 {
+		d.rev = t.rev
+		d.history.add(t.rev, t.events)
+	}
+	resp.Revision = d.rev
+	return resp
+}
+
+func (t *boltTxn) commit(index uint64) error {
+	meta := t.tx.Bucket(boltMetaBucket)
+	if len(t.events) > 0 {
+		if err := meta.Put(boltRevKey, uint64Bytes(uint64(t.rev))); err != nil {
+			return err
+		}
+	}
+	if index != 0 {
+		return meta.Put(boltAppliedKey, uint64Bytes(index))
+	}
+	return nil
+}
+
+func (d *boltDB) get(key string) (kv *KeyValue, err error) {
+	err = d.db.View(func(tx *bolt.Tx) error {
+		kv = (&boltTxn{tx: tx}).get([]byte(key))
+		return nil
+	})
+	return kv, err
+}
+
+func (d *boltDB) scan(prefix string) (kvs []*KeyValue, err error) {
+	err = d.db.View(func(tx *bolt.Tx) error {
+		kvs = (&boltTxn{tx: tx}).scan([]byte(prefix))
+		return nil
+	})
+	return kvs, err
+}
+
+// leases returns the ttl of each lease
+func (d *boltDB) leases() (map[LeaseID]int64, error) {
+	leases := make(map[LeaseID]int64)
+	err := d.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltLeasesBucket).ForEach(func(k, v []byte) error {
+			leases[LeaseID(bytesUint64(k))] = int64(bytesUint64(v))
+			return nil
+		})
+	})
+	return leases, err
+}
+
+// boltSnapshot reads all the buckets in a read transaction, each chunk is a
+// list of bucket index, key and value, the lengths as uvarints
+type boltSnapshot struct {
+	tx      *bolt.Tx
+	applied uint64
+	bucket  int
+	cursor  *bolt.Cursor
+	key     []byte
+	value   []byte
+}
+
+func (d *boltDB) snapshot() (*boltSnapshot, error) {
+	tx, err := d.db.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+	_, applied := readMeta(tx)
+	return &boltSnapshot{tx: tx, applied: applied, bucket: -1}, nil
+}
+
+func (s *boltSnapshot) ApplyIndex() uint64 {
+	return s.applied
+}
+
+func (s *boltSnapshot) Close() {
+	if err := s.tx.Rollback(); err != nil {
+		log.Error("close bolt store snapshot err: %v", err)
+	}
+}
+
+// advance moves to the next key of all the buckets
+func (s *boltSnapshot) advance() bool {
+	if s.cursor != nil {
+		s.key, s.value = s.cursor.Next()
+	}
+	for s.key == nil {
+		if s.bucket+1 >= len(boltBuckets) {
+			return false
+		}
+		s.bucket++
+		s.cursor = s.tx.Bucket(boltBuckets[s.bucket]).Cursor()
+		s.key, s.value = s.cursor.First()
+	}
+	return true
+}
+
+func (s *boltSnapshot) Next() ([]byte, error) {
+	var chunk []byte
+	var n [binary.MaxVarintLen64]byte
+	for len(chunk) < boltSnapshotChunk && s.advance() {
+		chunk = append(chunk, byte(s.bucket))
+		chunk = append(chunk, n[:binary.PutUvarint(n[:], uint64(len(s.key)))]...)
+		chunk = append(chunk, s.key...)
+		chunk = append(chunk, n[:binary.PutUvarint(n[:], uint64(len(s.value)))]...)
+		chunk = append(chunk, s.value...)
+	}
+	if len(chunk) == 0 {
+		return nil, io.EOF
+	}
+	return chunk, nil
+}
+
+// restore replaces all the keys by those of a snapshot, the watchers can not
+// catch up across it
+func (d *boltDB) restore(next func() ([]byte, error)) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range boltBuckets {
+			if err := tx.DeleteBucket(name); err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+			if _, err := tx.CreateBucket(name); err != nil {
+				return err
+			}
+		}
+		for {
+			chunk, err := next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			for len(chunk) > 0 {
+				bucket := int(chunk[0])
+				if bucket >= len(boltBuckets) {
+					return fmt.Errorf("bolt store snapshot has unknown bucket:[%d]", bucket)
+				}
+				chunk = chunk[1:]
+				var kv [2][]byte
+				for i := range kv {
+					l, n := binary.Uvarint(chunk)
+					if n <= 0 || uint64(len(chunk)-n) < l {
+						return fmt.Errorf("bolt store snapshot is corrupted")
+					}
+					kv[i] = chunk[n : n+int(l)]
+					chunk = chunk[n+int(l):]
+				}
+				if err := tx.Bucket(boltBuckets[bucket]).Put(kv[0], kv[1]); err != nil {
+					return err
+				}
+			}
+		}
+		d.rev, d.applied = readMeta(tx)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	d.history.reset(d.rev)
+	return nil
+}
+
+// eventHistory keeps the last events for the watchers
+type eventHistory struct {
+	mu  sync.Mutex
+	rev int64
+	// the events up to this revision are no longer kept
+	compacted int64
+	events    []*Event
+	// closed when events are added
+	notify chan struct{}
+}
+
+func newEventHistory(rev int64) *eventHistory {
+	return &eventHistory{rev: rev, compacted: rev, notify: make(chan struct{})}
+}
+
+func (h *eventHistory) add(rev int64, events []*Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.rev = rev
+	h.events = append(h.events, events...)
+	if over := len(h.events) - boltHistorySize; over > 0 {
+		h.compacted = h.events[over-1].Kv.ModRevision
+		for over < len(h.events) && h.events[over].Kv.ModRevision == h.compacted {
+			over++
+		}
+		h.events = append([]*Event(nil), h.events[over:]...)
+	}
+	close(h.notify)
+	h.notify = make(chan struct{})
+}
+
+func (h *eventHistory) reset(rev int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.rev, h.compacted, h.events = rev, rev, nil
+	close(h.notify)
+	h.notify = make(chan struct{})
+}
+
+// since returns the events of prefix after rev, it waits for some up to wait,
+// only the revision when rev is negative
+func (h *eventHistory) since(ctx context.Context, prefix string, rev int64, wait time.Duration) (*EventsResponse, error) {
+	var timeout <-chan time.Time
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	p := []byte(prefix)
+	for {
+		h.mu.Lock()
+		resp := &EventsResponse{Revision: h.rev}
+		if rev < 0 {
+			h.mu.Unlock()
+			return resp, nil
+		}
+		if rev < h.compacted {
+			h.mu.Unlock()
+			resp.Compacted = true
+			return resp, nil
+		}
+		for _, e := range h.events {
+			if e.Kv.ModRevision > rev && bytes.HasPrefix(e.Kv.Key, p) {
+				resp.Events = append(resp.Events, e)
+			}
+		}
+		notify := h.notify
+		h.mu.Unlock()
+
+		if len(resp.Events) > 0 || wait <= 0 {
+			return resp, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return resp, nil
+		case <-notify:
+		}
+	}
+}
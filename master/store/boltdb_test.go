@@ -0,0 +1,83 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func putReq(key, value string) *TxnRequest {
+	return &TxnRequest{Ops: []*Op{{Type: OpPut, Key: key, Value: []byte(value)}}}
+}
+
+func TestApplySameIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "meta.db")
+	d, err := openBoltDB(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	if resp := d.apply(putReq("/a", "1"), 5); !resp.Succeeded || resp.Revision != 1 {
+		t.Fatalf("apply index 5: %v", resp)
+	}
+	// a raft log replayed after a restart applies its entries again
+	if resp := d.apply(putReq("/a", "2"), 5); resp.Succeeded || resp.Revision != 1 {
+		t.Fatalf("apply index 5 again: %v", resp)
+	}
+	if resp := d.apply(putReq("/b", "2"), 4); resp.Succeeded || resp.Revision != 1 {
+		t.Fatalf("apply index 4 after 5: %v", resp)
+	}
+	// a failed transaction is applied too
+	req := &TxnRequest{Ops: []*Op{{Type: OpPut, Key: "/b", Lease: newLeaseID()}}}
+	if resp := d.apply(req, 6); resp.Err == "" || resp.Revision != 1 {
+		t.Fatalf("apply failing index 6: %v", resp)
+	}
+	if kv, err := d.get("/a"); err != nil || kv == nil || string(kv.Value) != "1" || kv.ModRevision != 1 {
+		t.Fatalf("get /a: %v, err: %v", kv, err)
+	}
+	if kv, _ := d.get("/b"); kv != nil {
+		t.Fatalf("skipped index put /b: %v", kv)
+	}
+	if err := d.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	d, err = openBoltDB(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer d.close()
+	if d.rev != 1 || d.applied != 6 {
+		t.Fatalf("reopened at revision %d applied %d", d.rev, d.applied)
+	}
+	if resp := d.apply(putReq("/a", "3"), 6); resp.Succeeded || resp.Revision != 1 {
+		t.Fatalf("apply index 6 after reopen: %v", resp)
+	}
+	if resp := d.apply(putReq("/a", "3"), 7); !resp.Succeeded || resp.Revision != 2 {
+		t.Fatalf("apply index 7: %v", resp)
+	}
+
+	// the events before the reopen are gone, a watch from there starts over
+	resp, err := d.history.since(context.Background(), "/", 0, 0)
+	if err != nil || !resp.Compacted {
+		t.Fatalf("events after revision 0: %v, err: %v", resp, err)
+	}
+	resp, err = d.history.since(context.Background(), "/", 1, 0)
+	if err != nil || resp.Compacted || len(resp.Events) != 1 || resp.Events[0].Kv.ModRevision != 2 {
+		t.Fatalf("events after revision 1: %v, err: %v", resp, err)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vearch/vearch/util/log"
+)
+
+func init() {
+	Register("bolt", NewBoltStore)
+}
+
+var (
+	boltStoresMu sync.Mutex
+	boltStores   = make(map[string]Store)
+)
+
+// boltBackend applies the transactions straight to a local bbolt file, it
+// is the store of a standalone cluster where master, ps and router run in
+// one process and share it.
+type boltBackend struct {
+	db     *boltDB
+	leases *leaseKeeper
+}
+
+// NewBoltStore opens the bbolt file at serverAddrs[0], the store of a path is
+// opened once and shared by all the clients of the process. The leases of
+// the last run get their whole ttl again, the keys of owners gone with the
+// process are deleted when it runs out.
+func NewBoltStore(serverAddrs []string) (Store, error) {
+	if len(serverAddrs) != 1 || serverAddrs[0] == "" {
+		return nil, fmt.Errorf("bolt store needs one file path, got %v", serverAddrs)
+	}
+	path := serverAddrs[0]
+
+	boltStoresMu.Lock()
+	defer boltStoresMu.Unlock()
+	if s, ok := boltStores[path]; ok {
+		return s, nil
+	}
+
+	db, err := openBoltDB(path)
+	if err != nil {
+		return nil, err
+	}
+	leases, err := db.leases()
+	if err != nil {
+		db.close()
+		return nil, err
+	}
+	b := &boltBackend{db: db, leases: newLeaseKeeper()}
+	b.leases.reset(leases)
+	go expireLeases(context.Background(), b.leases, b, func() bool { return true })
+
+	s := &kvStore{b: b}
+	boltStores[path] = s
+	log.Info("bolt store opened at %s, revision: %d", path, db.rev)
+	return s, nil
+}
+
+func (b *boltBackend) Txn(ctx context.Context, req *TxnRequest) (*TxnResponse, error) {
+	resp := b.db.apply(req, 0)
+	b.leases.applied(req, resp)
+	return resp, nil
+}
+
+func (b *boltBackend) Get(ctx context.Context, key string) (*KeyValue, error) {
+	return b.db.get(key)
+}
+
+func (b *boltBackend) Scan(ctx context.Context, prefix string) ([]*KeyValue, error) {
+	return b.db.scan(prefix)
+}
+
+func (b *boltBackend) LeaseTTL(ctx context.Context, id LeaseID) (int64, error) {
+	return b.leases.ttl(id), nil
+}
+
+func (b *boltBackend) KeepAliveLease(ctx context.Context, id LeaseID) (int64, error) {
+	return b.leases.keepAlive(id)
+}
+
+func (b *boltBackend) Events(ctx context.Context, prefix string, rev int64, wait time.Duration) (*EventsResponse, error) {
+	return b.db.history.since(ctx, prefix, rev, wait)
+}
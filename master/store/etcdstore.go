@@ -42,7 +42,7 @@ func (store *EtcdStore) NewIDGenerate(ctx context.Context, key string, base int6
 		nextID = int64(0)
 		err    error
 	)
-	err = store.STM(ctx, func(stm concurrency.STM) error {
+	err = store.STM(ctx, func(stm STM) error {
 		v := stm.Get(key)
 		if len(v) == 0 {
 			stm.Put(key, fmt.Sprintf("%v", base))
@@ -66,7 +66,7 @@ func (store *EtcdStore) NewIDGenerate(ctx context.Context, key string, base int6
 	return nextID, nil
 }
 
-func (store *EtcdStore) NewLock(ctx context.Context, key string, timeout time.Duration) *DistLock {
+func (store *EtcdStore) NewLock(ctx context.Context, key string, timeout time.Duration) Lock {
 	return NewDistLock(ctx, store.cli, key, timeout)
 }
 
@@ -133,7 +133,7 @@ func (store *EtcdStore) CreateWithTTL(ctx context.Context, key string, value []b
 	return err
 }
 
-func (store *EtcdStore) KeepAlive(ctx context.Context, key string, value []byte, ttl time.Duration) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (store *EtcdStore) KeepAlive(ctx context.Context, key string, value []byte, ttl time.Duration) (<-chan *LeaseKeepAliveResponse, error) {
 	if ttl != 0 && int64(ttl.Seconds()) == 0 {
 		return nil, fmt.Errorf("ttl time must gather 1 sencod")
 	}
@@ -144,20 +144,30 @@ func (store *EtcdStore) KeepAlive(ctx context.Context, key string, value []byte,
 	}
 	_, err = store.cli.Put(ctx, key, cbbytes.ByteToString(value), clientv3.WithLease(grant.ID))
 
-	keepaliveC, err := store.cli.KeepAlive(ctx, grant.ID)
+	etcdKeepaliveC, err := store.cli.KeepAlive(ctx, grant.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	keepaliveC := make(chan *LeaseKeepAliveResponse, cap(etcdKeepaliveC))
+	go func() {
+		defer close(keepaliveC)
+		for ka := range etcdKeepaliveC {
+			select {
+			case keepaliveC <- &LeaseKeepAliveResponse{ID: LeaseID(ka.ID), TTL: ka.TTL}:
+			default:
+			}
+		}
+	}()
 	return keepaliveC, err
 }
 
-func (store *EtcdStore) PutWithLeaseId(ctx context.Context, key string, value []byte, ttl time.Duration, leaseId clientv3.LeaseID) error {
+func (store *EtcdStore) PutWithLeaseId(ctx context.Context, key string, value []byte, ttl time.Duration, leaseId LeaseID) error {
 	if ttl != 0 && int64(ttl.Seconds()) == 0 {
 		return fmt.Errorf("ttl time must gather 1 sencod")
 	}
 
-	_, err := store.cli.Put(ctx, key, cbbytes.ByteToString(value), clientv3.WithLease(leaseId))
+	_, err := store.cli.Put(ctx, key, cbbytes.ByteToString(value), clientv3.WithLease(clientv3.LeaseID(leaseId)))
 	if err != nil {
 		return err
 	}
@@ -182,6 +192,24 @@ func (store *EtcdStore) Get(ctx context.Context, key string) ([]byte, error) {
 	return resp.Kvs[0].Value, nil
 }
 
+func (store *EtcdStore) TTL(ctx context.Context, key string) (int64, error) {
+	resp, err := store.cli.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Kvs) < 1 {
+		return 0, fmt.Errorf("key not exist error, key:%v", key)
+	}
+	if resp.Kvs[0].Lease == 0 {
+		return -1, nil
+	}
+	lease, err := store.cli.TimeToLive(ctx, clientv3.LeaseID(resp.Kvs[0].Lease))
+	if err != nil {
+		return 0, err
+	}
+	return lease.TTL, nil
+}
+
 func (store *EtcdStore) PrefixScan(ctx context.Context, prefix string) ([][]byte, [][]byte, error) {
 	resp, err := store.cli.Get(ctx, prefix, clientv3.WithPrefix())
 	if err != nil {
@@ -208,8 +236,20 @@ func (store *EtcdStore) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
-func (store *EtcdStore) STM(ctx context.Context, apply func(stm concurrency.STM) error) error {
-	resp, err := concurrency.NewSTM(store.cli, apply)
+// etcdSTM is the STM of etcd behind the one of store
+type etcdSTM struct {
+	stm concurrency.STM
+}
+
+func (s *etcdSTM) Get(key ...string) string { return s.stm.Get(key...) }
+func (s *etcdSTM) Put(key, val string)      { s.stm.Put(key, val) }
+func (s *etcdSTM) Rev(key string) int64     { return s.stm.Rev(key) }
+func (s *etcdSTM) Del(key string)           { s.stm.Del(key) }
+
+func (store *EtcdStore) STM(ctx context.Context, apply func(stm STM) error) error {
+	resp, err := concurrency.NewSTM(store.cli, func(stm concurrency.STM) error {
+		return apply(&etcdSTM{stm: stm})
+	})
 	if err != nil {
 		return err
 	}
@@ -220,7 +260,7 @@ func (store *EtcdStore) STM(ctx context.Context, apply func(stm concurrency.STM)
 	return nil
 }
 
-func (store *EtcdStore) WatchPrefix(ctx context.Context, key string) (clientv3.WatchChan, error) {
+func (store *EtcdStore) WatchPrefix(ctx context.Context, key string) (WatchChan, error) {
 	startRevision := int64(0)
 	initial, err := store.cli.Get(ctx, key)
 	if err == nil {
@@ -231,5 +271,20 @@ func (store *EtcdStore) WatchPrefix(ctx context.Context, key string) (clientv3.W
 		return nil, fmt.Errorf("watch %v failed", key)
 	}
 
-	return watcher, nil
+	watchC := make(chan WatchResponse)
+	go func() {
+		defer close(watchC)
+		for resp := range watcher {
+			events := make([]*Event, len(resp.Events))
+			for i, e := range resp.Events {
+				events[i] = &Event{Type: EventType(e.Type), Kv: &KeyValue{Key: e.Kv.Key, Value: e.Kv.Value, ModRevision: e.Kv.ModRevision, Lease: LeaseID(e.Kv.Lease)}}
+			}
+			select {
+			case watchC <- WatchResponse{Revision: resp.Header.Revision, Events: events, Canceled: resp.Canceled, Err: resp.Err()}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return watchC, nil
 }
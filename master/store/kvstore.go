@@ -0,0 +1,626 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/util/log"
+)
+
+const (
+	// times an STM is applied again when what it read has changed
+	stmRetries = 32
+	// how long a watch waits for events in one call
+	watchWait = 10 * time.Second
+	// the leases are checked for expiry this often
+	leaseTicker = 500 * time.Millisecond
+)
+
+// Compare is a condition of a transaction on the revision of a key
+type Compare struct {
+	Key string `json:"key"`
+	// the revision the key was last changed at, 0 when it must not exist
+	ModRevision int64 `json:"mod_revision"`
+	// no key has Key as prefix, ModRevision is not checked
+	Prefix bool `json:"prefix,omitempty"`
+}
+
+type OpType int32
+
+const (
+	OpPut OpType = iota
+	OpDelete
+	// OpGrant makes Lease of TTL seconds
+	OpGrant
+	// OpRevoke removes Lease and deletes the keys bound to it
+	OpRevoke
+)
+
+type Op struct {
+	Type  OpType  `json:"type"`
+	Key   string  `json:"key,omitempty"`
+	Value []byte  `json:"value,omitempty"`
+	Lease LeaseID `json:"lease,omitempty"`
+	TTL   int64   `json:"ttl,omitempty"`
+}
+
+// TxnRequest applies all its ops when all its compares hold, or none
+type TxnRequest struct {
+	Compares []*Compare `json:"compares,omitempty"`
+	Ops      []*Op      `json:"ops"`
+}
+
+type TxnResponse struct {
+	Succeeded bool  `json:"succeeded"`
+	Revision  int64 `json:"revision"`
+	// the keys deleted by each op
+	Deleted []int `json:"deleted,omitempty"`
+	// an op could not be applied, none was
+	Err string `json:"err,omitempty"`
+}
+
+// EventsResponse has the events after a revision up to Revision, when
+// Compacted they are no longer all kept
+type EventsResponse struct {
+	Revision  int64    `json:"revision"`
+	Events    []*Event `json:"events,omitempty"`
+	Compacted bool     `json:"compacted,omitempty"`
+}
+
+// kvBackend is what a key value database gives for a Store to be built on
+// it, the database applies transactions in the order of their revisions
+type kvBackend interface {
+	Txn(ctx context.Context, req *TxnRequest) (*TxnResponse, error)
+	// Get returns nil when the key does not exist
+	Get(ctx context.Context, key string) (*KeyValue, error)
+	Scan(ctx context.Context, prefix string) ([]*KeyValue, error)
+	// LeaseTTL returns the seconds left of the lease, -1 when it does not exist
+	LeaseTTL(ctx context.Context, id LeaseID) (int64, error)
+	KeepAliveLease(ctx context.Context, id LeaseID) (int64, error)
+	// Events returns the events of the keys with prefix after rev, it waits
+	// for some up to wait. When rev is negative it returns the current revision.
+	Events(ctx context.Context, prefix string, rev int64, wait time.Duration) (*EventsResponse, error)
+}
+
+// kvStore is the Store on a kvBackend
+type kvStore struct {
+	b kvBackend
+}
+
+func newLeaseID() LeaseID {
+	var b [8]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			panic(err)
+		}
+		if id := LeaseID(binary.BigEndian.Uint64(b[:]) & math.MaxInt64); id != 0 {
+			return id
+		}
+	}
+}
+
+func ttlSeconds(ttl time.Duration) (int64, error) {
+	if ttl != 0 && int64(ttl.Seconds()) == 0 {
+		return 0, fmt.Errorf("ttl time must gather 1 sencod")
+	}
+	return int64(ttl.Seconds()), nil
+}
+
+func (s *kvStore) txn(ctx context.Context, req *TxnRequest) (*TxnResponse, error) {
+	resp, err := s.b.Txn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Err != "" {
+		return nil, fmt.Errorf(resp.Err)
+	}
+	return resp, nil
+}
+
+// put kv if already exits it will overwrite
+func (s *kvStore) Put(ctx context.Context, key string, value []byte) error {
+	_, err := s.txn(ctx, &TxnRequest{Ops: []*Op{{Type: OpPut, Key: key, Value: value}}})
+	return err
+}
+
+// Create puts the key only if it does not exist
+func (s *kvStore) Create(ctx context.Context, key string, value []byte) error {
+	resp, err := s.txn(ctx, &TxnRequest{
+		Compares: []*Compare{{Key: key}},
+		Ops:      []*Op{{Type: OpPut, Key: key, Value: value}},
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return fmt.Errorf("store key :%v error", key)
+	}
+	return nil
+}
+
+// CreateWithTTL puts the key bound to a new lease of ttl
+func (s *kvStore) CreateWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	seconds, err := ttlSeconds(ttl)
+	if err != nil {
+		return err
+	}
+	lease := newLeaseID()
+	_, err = s.txn(ctx, &TxnRequest{Ops: []*Op{
+		{Type: OpGrant, Lease: lease, TTL: seconds},
+		{Type: OpPut, Key: key, Value: value, Lease: lease},
+	}})
+	return err
+}
+
+// KeepAlive puts the key bound to a new lease of ttl and keeps the lease
+// alive until ctx is done, the channel is closed then or when the lease can
+// not be kept alive
+func (s *kvStore) KeepAlive(ctx context.Context, key string, value []byte, ttl time.Duration) (<-chan *LeaseKeepAliveResponse, error) {
+	seconds, err := ttlSeconds(ttl)
+	if err != nil {
+		return nil, err
+	}
+	lease := newLeaseID()
+	if _, err = s.txn(ctx, &TxnRequest{Ops: []*Op{
+		{Type: OpGrant, Lease: lease, TTL: seconds},
+		{Type: OpPut, Key: key, Value: value, Lease: lease},
+	}}); err != nil {
+		return nil, err
+	}
+
+	keepaliveC := make(chan *LeaseKeepAliveResponse, 16)
+	go func() {
+		defer close(keepaliveC)
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		left, kept := seconds, time.Now()
+		for {
+			select {
+			case keepaliveC <- &LeaseKeepAliveResponse{ID: lease, TTL: left}:
+			default:
+			}
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				ttl, err := s.b.KeepAliveLease(ctx, lease)
+				if err == nil {
+					left, kept = ttl, time.Now()
+					break
+				}
+				log.Warn("keep alive lease:[%d] of key:[%s] err: %v", lease, key, err)
+				// the lease has expired by now, or it is gone
+				if time.Since(kept) > time.Duration(seconds)*time.Second || strings.Contains(err.Error(), "not found") {
+					return
+				}
+			}
+		}
+	}()
+	return keepaliveC, nil
+}
+
+// PutWithLeaseId puts the key bound to a lease granted before
+func (s *kvStore) PutWithLeaseId(ctx context.Context, key string, value []byte, ttl time.Duration, leaseId LeaseID) error {
+	if _, err := ttlSeconds(ttl); err != nil {
+		return err
+	}
+	_, err := s.txn(ctx, &TxnRequest{Ops: []*Op{{Type: OpPut, Key: key, Value: value, Lease: leaseId}}})
+	return err
+}
+
+func (s *kvStore) Update(ctx context.Context, key string, value []byte) error {
+	return s.Put(ctx, key, value)
+}
+
+func (s *kvStore) Get(ctx context.Context, key string) ([]byte, error) {
+	kv, err := s.b.Get(ctx, key)
+	if err != nil || kv == nil {
+		return nil, err
+	}
+	return kv.Value, nil
+}
+
+func (s *kvStore) TTL(ctx context.Context, key string) (int64, error) {
+	kv, err := s.b.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if kv == nil {
+		return 0, fmt.Errorf("key not exist error, key:%v", key)
+	}
+	if kv.Lease == 0 {
+		return -1, nil
+	}
+	return s.b.LeaseTTL(ctx, kv.Lease)
+}
+
+func (s *kvStore) PrefixScan(ctx context.Context, prefix string) ([][]byte, [][]byte, error) {
+	kvs, err := s.b.Scan(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([][]byte, len(kvs))
+	values := make([][]byte, len(kvs))
+	for i, kv := range kvs {
+		keys[i] = kv.Key
+		values[i] = kv.Value
+	}
+	return keys, values, nil
+}
+
+func (s *kvStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.txn(ctx, &TxnRequest{Ops: []*Op{{Type: OpDelete, Key: key}}})
+	if err != nil {
+		return fmt.Errorf("Failed to delete %s from store!, the error is :%s", key, err.Error())
+	}
+	if len(resp.Deleted) == 0 || resp.Deleted[0] != 1 {
+		return fmt.Errorf("key not exist error, key:%v", key)
+	}
+	return nil
+}
+
+// kvSTM reads through the backend and keeps what it puts and deletes, they
+// are committed when none of the keys read has changed
+type kvSTM struct {
+	ctx    context.Context
+	b      kvBackend
+	reads  map[string]*KeyValue
+	writes map[string]*Op
+	order  []string
+	err    error
+}
+
+func (stm *kvSTM) read(key string) *KeyValue {
+	if kv, ok := stm.reads[key]; ok {
+		return kv
+	}
+	kv, err := stm.b.Get(stm.ctx, key)
+	if err != nil {
+		if stm.err == nil {
+			stm.err = err
+		}
+		return nil
+	}
+	if kv == nil {
+		kv = &KeyValue{Key: []byte(key)}
+	}
+	stm.reads[key] = kv
+	return kv
+}
+
+func (stm *kvSTM) Get(key ...string) string {
+	for _, k := range key {
+		if op, ok := stm.writes[k]; ok {
+			if op.Type == OpPut {
+				return string(op.Value)
+			}
+			continue
+		}
+		if kv := stm.read(k); kv != nil && kv.ModRevision != 0 {
+			return string(kv.Value)
+		}
+	}
+	return ""
+}
+
+func (stm *kvSTM) write(key string, op *Op) {
+	if _, ok := stm.writes[key]; !ok {
+		stm.order = append(stm.order, key)
+	}
+	stm.writes[key] = op
+}
+
+func (stm *kvSTM) Put(key, val string) {
+	stm.write(key, &Op{Type: OpPut, Key: key, Value: []byte(val)})
+}
+
+func (stm *kvSTM) Rev(key string) int64 {
+	if kv := stm.read(key); kv != nil {
+		return kv.ModRevision
+	}
+	return 0
+}
+
+func (stm *kvSTM) Del(key string) {
+	stm.write(key, &Op{Type: OpDelete, Key: key})
+}
+
+func (stm *kvSTM) commit() *TxnRequest {
+	req := &TxnRequest{}
+	for key, kv := range stm.reads {
+		req.Compares = append(req.Compares, &Compare{Key: key, ModRevision: kv.ModRevision})
+	}
+	for _, key := range stm.order {
+		req.Ops = append(req.Ops, stm.writes[key])
+	}
+	return req
+}
+
+func (s *kvStore) STM(ctx context.Context, apply func(stm STM) error) error {
+	for i := 0; i < stmRetries; i++ {
+		stm := &kvSTM{ctx: ctx, b: s.b, reads: make(map[string]*KeyValue), writes: make(map[string]*Op)}
+		if err := apply(stm); err != nil {
+			return err
+		}
+		if stm.err != nil {
+			return stm.err
+		}
+		if len(stm.order) == 0 {
+			return nil
+		}
+		resp, err := s.txn(ctx, stm.commit())
+		if err != nil {
+			return err
+		}
+		if resp.Succeeded {
+			return nil
+		}
+	}
+	return fmt.Errorf("store stm failed, the keys it read kept changing")
+}
+
+func (s *kvStore) NewLock(ctx context.Context, key string, timeout time.Duration) Lock {
+	return &leaseLock{s: s, path: entity.PrefixLock + key, ttl: timeout, ctx: ctx}
+}
+
+// NewIDGenerate create a global uniqueness id
+func (s *kvStore) NewIDGenerate(ctx context.Context, key string, base int64, timeout time.Duration) (int64, error) {
+	nextID := int64(0)
+	err := s.STM(ctx, func(stm STM) error {
+		v := stm.Get(key)
+		if len(v) == 0 {
+			stm.Put(key, strconv.FormatInt(base, 10))
+			nextID = base
+			return nil
+		}
+		intv, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("increment id error in storage :%v", v)
+		}
+		nextID = intv + 1
+		stm.Put(key, strconv.FormatInt(nextID, 10))
+		return nil
+	})
+	if err != nil {
+		return int64(0), err
+	}
+	return nextID, nil
+}
+
+// WatchPrefix sends the changes of the keys with the prefix made after it is
+// called, until ctx is done or the events are compacted
+func (s *kvStore) WatchPrefix(ctx context.Context, key string) (WatchChan, error) {
+	start, err := s.b.Events(ctx, key, -1, 0)
+	if err != nil {
+		return nil, err
+	}
+	watchC := make(chan WatchResponse)
+	go func() {
+		defer close(watchC)
+		rev := start.Revision
+		for {
+			resp, err := s.b.Events(ctx, key, rev, watchWait)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				log.Warn("watch prefix:[%s] from revision:[%d] err: %v", key, rev, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Second):
+				}
+				continue
+			}
+			if resp.Compacted {
+				select {
+				case watchC <- WatchResponse{Revision: resp.Revision, Canceled: true, Err: fmt.Errorf("events after revision %d are compacted", rev)}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			if len(resp.Events) > 0 {
+				select {
+				case watchC <- WatchResponse{Revision: resp.Revision, Events: resp.Events}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if resp.Revision > rev {
+				rev = resp.Revision
+			}
+		}
+	}()
+	return watchC, nil
+}
+
+// leaseLock holds the only key under its path, bound to a lease
+type leaseLock struct {
+	s     *kvStore
+	path  string
+	lease LeaseID
+	ttl   time.Duration
+	ctx   context.Context
+}
+
+func (l *leaseLock) TryLock() (bool, error) {
+	if l.ttl <= 0 {
+		l.ttl = 30 * time.Second
+	}
+	seconds, err := ttlSeconds(l.ttl)
+	if err != nil {
+		return false, err
+	}
+	lease := newLeaseID()
+	resp, err := l.s.txn(l.ctx, &TxnRequest{
+		Compares: []*Compare{{Key: l.path + "/", Prefix: true}},
+		Ops: []*Op{
+			{Type: OpGrant, Lease: lease, TTL: seconds},
+			{Type: OpPut, Key: fmt.Sprintf("%v/%v", l.path, lease), Lease: lease},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	if !resp.Succeeded {
+		return false, fmt.Errorf("lock hold by ohter process")
+	}
+	l.lease = lease
+	return true, nil
+}
+
+func (l *leaseLock) Lock() error {
+	for {
+		locked, err := l.TryLock()
+		if locked {
+			return nil
+		}
+		if err != nil && err.Error() != "lock hold by ohter process" {
+			return err
+		}
+		select {
+		case <-l.ctx.Done():
+			return fmt.Errorf("wait on lock ctx timeout")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (l *leaseLock) KeepAliveOnce() {
+	if _, err := l.s.b.KeepAliveLease(l.ctx, l.lease); err != nil {
+		log.Warn("keep alive lock:[%s] err: %v", l.path, err)
+	}
+}
+
+func (l *leaseLock) Unlock() error {
+	if _, err := l.s.txn(l.ctx, &TxnRequest{Ops: []*Op{{Type: OpRevoke, Lease: l.lease}}}); err != nil {
+		return fmt.Errorf("revoke lease %v error :%v", l.lease, err)
+	}
+	return nil
+}
+
+// leaseKeeper keeps when the leases expire, they are kept alive by the
+// process applying the transactions, or by the leader of them
+type leaseKeeper struct {
+	mu        sync.Mutex
+	ttls      map[LeaseID]int64
+	deadlines map[LeaseID]time.Time
+}
+
+func newLeaseKeeper() *leaseKeeper {
+	return &leaseKeeper{ttls: make(map[LeaseID]int64), deadlines: make(map[LeaseID]time.Time)}
+}
+
+// reset gives all the leases their whole ttl from now, as when a new leader
+// starts to keep them
+func (k *leaseKeeper) reset(leases map[LeaseID]int64) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := time.Now()
+	k.ttls = leases
+	k.deadlines = make(map[LeaseID]time.Time, len(leases))
+	for id, ttl := range leases {
+		k.deadlines[id] = now.Add(time.Duration(ttl) * time.Second)
+	}
+}
+
+// applied follows the leases granted and revoked by a transaction
+func (k *leaseKeeper) applied(req *TxnRequest, resp *TxnResponse) {
+	if !resp.Succeeded || resp.Err != "" {
+		return
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for _, op := range req.Ops {
+		switch op.Type {
+		case OpGrant:
+			k.ttls[op.Lease] = op.TTL
+			k.deadlines[op.Lease] = time.Now().Add(time.Duration(op.TTL) * time.Second)
+		case OpRevoke:
+			delete(k.ttls, op.Lease)
+			delete(k.deadlines, op.Lease)
+		}
+	}
+}
+
+func (k *leaseKeeper) keepAlive(id LeaseID) (int64, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	ttl, ok := k.ttls[id]
+	if !ok {
+		return 0, fmt.Errorf("requested lease:[%d] not found", id)
+	}
+	k.deadlines[id] = time.Now().Add(time.Duration(ttl) * time.Second)
+	return ttl, nil
+}
+
+func (k *leaseKeeper) ttl(id LeaseID) int64 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	deadline, ok := k.deadlines[id]
+	if !ok {
+		return -1
+	}
+	if left := int64(time.Until(deadline).Seconds()); left > 0 {
+		return left
+	}
+	return 0
+}
+
+func (k *leaseKeeper) expired() []LeaseID {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	now := time.Now()
+	var ids []LeaseID
+	for id, deadline := range k.deadlines {
+		if now.After(deadline) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// expireLeases revokes the expired leases while keep is true, until ctx is done
+func expireLeases(ctx context.Context, k *leaseKeeper, b kvBackend, keep func() bool) {
+	ticker := time.NewTicker(leaseTicker)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !keep() {
+			continue
+		}
+		for _, id := range k.expired() {
+			if _, err := b.Txn(ctx, &TxnRequest{Ops: []*Op{{Type: OpRevoke, Lease: id}}}); err != nil {
+				log.Error("revoke expired lease:[%d] err: %v", id, err)
+			}
+		}
+	}
+}
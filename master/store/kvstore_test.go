@@ -0,0 +1,194 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestKVStore(t *testing.T) *kvStore {
+	s, err := NewBoltStore([]string{filepath.Join(t.TempDir(), "meta.db")})
+	if err != nil {
+		t.Fatalf("open bolt store: %v", err)
+	}
+	return s.(*kvStore)
+}
+
+func TestTxnCompares(t *testing.T) {
+	ctx := context.Background()
+	s := newTestKVStore(t)
+
+	if err := s.Create(ctx, "/a", []byte("1")); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := s.Create(ctx, "/a", []byte("2")); err == nil {
+		t.Fatalf("create of an existing key succeeded")
+	}
+	kv, err := s.b.Get(ctx, "/a")
+	if err != nil || kv == nil || string(kv.Value) != "1" {
+		t.Fatalf("get: %v, err: %v", kv, err)
+	}
+
+	// a stale revision fails the transaction and applies none of its ops
+	resp, err := s.txn(ctx, &TxnRequest{
+		Compares: []*Compare{{Key: "/a", ModRevision: kv.ModRevision - 1}},
+		Ops:      []*Op{{Type: OpPut, Key: "/a", Value: []byte("3")}, {Type: OpPut, Key: "/b", Value: []byte("3")}},
+	})
+	if err != nil || resp.Succeeded || resp.Revision != kv.ModRevision {
+		t.Fatalf("txn on a stale revision: %v, err: %v", resp, err)
+	}
+	if b, _ := s.Get(ctx, "/b"); b != nil {
+		t.Fatalf("failed txn put /b: %s", b)
+	}
+
+	resp, err = s.txn(ctx, &TxnRequest{
+		Compares: []*Compare{{Key: "/a", ModRevision: kv.ModRevision}, {Key: "/b"}},
+		Ops:      []*Op{{Type: OpPut, Key: "/a", Value: []byte("3")}, {Type: OpPut, Key: "/b", Value: []byte("3")}},
+	})
+	if err != nil || !resp.Succeeded || resp.Revision != kv.ModRevision+1 {
+		t.Fatalf("txn on the revision: %v, err: %v", resp, err)
+	}
+
+	// a prefix compare holds while no key has the prefix
+	lock := &TxnRequest{
+		Compares: []*Compare{{Key: "/lock/", Prefix: true}},
+		Ops:      []*Op{{Type: OpPut, Key: "/lock/1"}},
+	}
+	if resp, err = s.txn(ctx, lock); err != nil || !resp.Succeeded {
+		t.Fatalf("first lock: %v, err: %v", resp, err)
+	}
+	if resp, err = s.txn(ctx, lock); err != nil || resp.Succeeded {
+		t.Fatalf("second lock: %v, err: %v", resp, err)
+	}
+
+	// an op which can not be applied undoes the ones before it
+	rev := resp.Revision
+	if _, err = s.txn(ctx, &TxnRequest{Ops: []*Op{
+		{Type: OpPut, Key: "/c", Value: []byte("1")},
+		{Type: OpPut, Key: "/d", Value: []byte("1"), Lease: newLeaseID()},
+	}}); err == nil {
+		t.Fatalf("put with a missing lease succeeded")
+	}
+	if c, _ := s.Get(ctx, "/c"); c != nil {
+		t.Fatalf("failed txn put /c: %s", c)
+	}
+	if events, _ := s.b.Events(ctx, "", -1, 0); events.Revision != rev {
+		t.Fatalf("failed txn moved the revision from %d to %d", rev, events.Revision)
+	}
+
+	if err := s.Delete(ctx, "/a"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := s.Delete(ctx, "/a"); err == nil {
+		t.Fatalf("delete of a missing key succeeded")
+	}
+}
+
+func TestLeaseExpiry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newTestKVStore(t)
+
+	if err := s.CreateWithTTL(ctx, "/expire", []byte("1"), time.Second); err != nil {
+		t.Fatalf("create with ttl: %v", err)
+	}
+	if _, err := s.KeepAlive(ctx, "/alive", []byte("1"), time.Second); err != nil {
+		t.Fatalf("keep alive: %v", err)
+	}
+	if ttl, err := s.TTL(ctx, "/expire"); err != nil || ttl < 0 || ttl > 1 {
+		t.Fatalf("ttl: %d, err: %v", ttl, err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if v, _ := s.Get(ctx, "/expire"); v == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("key of an expired lease is still there")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	// the kept alive lease outlives its ttl
+	time.Sleep(1500 * time.Millisecond)
+	if v, _ := s.Get(ctx, "/alive"); v == nil {
+		t.Fatalf("key of a kept alive lease expired")
+	}
+
+	// the key goes once it is no longer kept alive
+	cancel()
+	ctx = context.Background()
+	deadline = time.Now().Add(5 * time.Second)
+	for {
+		if v, _ := s.Get(ctx, "/alive"); v == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("key is still there after its lease is no longer kept alive")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestWatchFromRevision(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newTestKVStore(t)
+
+	for _, key := range []string{"/w/a", "/w/b", "/x/a"} {
+		if err := s.Put(ctx, key, []byte(key)); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+	}
+	start, err := s.b.Events(ctx, "/w/", -1, 0)
+	if err != nil || start.Revision != 3 || len(start.Events) != 0 {
+		t.Fatalf("revision: %v, err: %v", start, err)
+	}
+
+	resp, err := s.b.Events(ctx, "/w/", 1, 0)
+	if err != nil || resp.Revision != 3 || len(resp.Events) != 1 || string(resp.Events[0].Kv.Key) != "/w/b" {
+		t.Fatalf("events after revision 1: %v, err: %v", resp, err)
+	}
+
+	watchC, err := s.WatchPrefix(ctx, "/w/")
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	if err := s.Put(ctx, "/x/b", nil); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	if err := s.Delete(ctx, "/w/a"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	select {
+	case w := <-watchC:
+		if w.Canceled || w.Revision != 5 || len(w.Events) != 1 {
+			t.Fatalf("watch: %v", w)
+		}
+		if e := w.Events[0]; e.Type != DELETE || string(e.Kv.Key) != "/w/a" || e.Kv.ModRevision != 5 {
+			t.Fatalf("watch event: %v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("watch got no event")
+	}
+
+	// a wait returns when it times out without events
+	if resp, err = s.b.Events(ctx, "/w/", 5, 50*time.Millisecond); err != nil || len(resp.Events) != 0 || resp.Revision != 5 {
+		t.Fatalf("events after the last revision: %v, err: %v", resp, err)
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cubefs/cubefs/depends/tiglabs/raft"
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/storage/wal"
+	"github.com/spf13/cast"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/log"
+)
+
+func init() {
+	Register("raft", NewRaftStore)
+}
+
+const (
+	// the raft group of the metadata among the masters
+	metaRaftID = 1
+	// the raft logs kept behind the applied index
+	metaRaftRetainLogs = 20000
+	// how long a request waits for the masters to elect a leader
+	metaLeaderWait = 5 * time.Second
+)
+
+var (
+	localRaftStoreMu sync.Mutex
+	localRaftStore   *RaftStore
+)
+
+// RaftStore keeps the metadata in a bolt file on each master, the masters
+// replicate the transactions by their own raft. The leader applies them and
+// keeps the leases alive, the other masters forward it theirs and serve the
+// watches from what they have applied.
+type RaftStore struct {
+	db      *boltDB
+	leases  *leaseKeeper
+	nodeID  uint64
+	masters config.Masters
+	server  *raft.RaftServer
+	cancel  context.CancelFunc
+
+	mu      sync.Mutex
+	remotes map[uint64]*remoteBackend
+}
+
+// NewRaftStore returns the store of the master of this process when it runs
+// one, else a client of the store api of the masters at serverAddrs
+func NewRaftStore(serverAddrs []string) (Store, error) {
+	localRaftStoreMu.Lock()
+	rs := localRaftStore
+	localRaftStoreMu.Unlock()
+	if rs != nil {
+		return &kvStore{b: rs}, nil
+	}
+	if len(serverAddrs) == 0 {
+		return nil, fmt.Errorf("raft store needs the api addresses of the masters")
+	}
+	return &kvStore{b: newRemoteBackend(serverAddrs, false)}, nil
+}
+
+// StartRaftStore opens the metadata of this master and joins the raft of
+// the masters, the master is node index+1 of the masters in the config
+func StartRaftStore(cfg *config.Config) (*RaftStore, error) {
+	var nodeID uint64
+	for i, m := range cfg.Masters {
+		if m.Self {
+			nodeID = uint64(i + 1)
+		}
+	}
+	if nodeID == 0 {
+		return nil, fmt.Errorf("raft store can not find this master in the config")
+	}
+	for _, m := range cfg.Masters {
+		if m.RaftHeartbeatPort == 0 || m.RaftReplicatePort == 0 {
+			return nil, fmt.Errorf("raft store needs raft_heartbeat_port and raft_replicate_port of master %s", m.Name)
+		}
+	}
+	self := cfg.Masters[nodeID-1]
+
+	dir := cfg.GetMetaRaftDir()
+	db, err := openBoltDB(filepath.Join(dir, "meta.db"))
+	if err != nil {
+		return nil, err
+	}
+	rs := &RaftStore{
+		db:      db,
+		leases:  newLeaseKeeper(),
+		nodeID:  nodeID,
+		masters: cfg.Masters,
+		remotes: make(map[uint64]*remoteBackend),
+	}
+
+	rc := raft.DefaultConfig()
+	rc.NodeID = nodeID
+	rc.LeaseCheck = true
+	rc.HeartbeatAddr = self.Address + ":" + cast.ToString(self.RaftHeartbeatPort)
+	rc.ReplicateAddr = self.Address + ":" + cast.ToString(self.RaftReplicatePort)
+	rc.Resolver = masterResolver(cfg.Masters)
+	rc.TickInterval = 500 * time.Millisecond
+	rc.RetainLogs = metaRaftRetainLogs
+	if rs.server, err = raft.NewRaftServer(rc); err != nil {
+		db.close()
+		return nil, fmt.Errorf("raft store start raft server error: %v", err)
+	}
+
+	storage, err := wal.NewStorage(filepath.Join(dir, "wal"), nil)
+	if err != nil {
+		rs.server.Stop()
+		db.close()
+		return nil, fmt.Errorf("raft store open wal error: %v", err)
+	}
+	raftConf := &raft.RaftConfig{
+		ID:           metaRaftID,
+		Applied:      db.applied,
+		Peers:        make([]proto.Peer, 0, len(cfg.Masters)),
+		Storage:      storage,
+		StateMachine: rs,
+	}
+	for i := range cfg.Masters {
+		raftConf.Peers = append(raftConf.Peers, proto.Peer{Type: proto.PeerNormal, ID: uint64(i + 1)})
+	}
+	if err = rs.server.CreateRaft(raftConf); err != nil {
+		rs.server.Stop()
+		db.close()
+		return nil, fmt.Errorf("raft store create raft error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.cancel = cancel
+	go expireLeases(ctx, rs.leases, rs, rs.isLeader)
+	go rs.truncateJob(ctx)
+
+	localRaftStoreMu.Lock()
+	localRaftStore = rs
+	localRaftStoreMu.Unlock()
+	log.Info("raft store of node:[%d] started at %s, applied index: %d, revision: %d", nodeID, dir, db.applied, db.rev)
+	return rs, nil
+}
+
+func (rs *RaftStore) Stop() {
+	localRaftStoreMu.Lock()
+	if localRaftStore == rs {
+		localRaftStore = nil
+	}
+	localRaftStoreMu.Unlock()
+	rs.cancel()
+	rs.server.Stop()
+	if err := rs.db.close(); err != nil {
+		log.Error("raft store close err: %v", err)
+	}
+}
+
+func (rs *RaftStore) isLeader() bool {
+	return rs.server.IsLeader(metaRaftID)
+}
+
+// Leader returns the node of the leader, 0 when there is none
+func (rs *RaftStore) Leader() uint64 {
+	leader, _ := rs.server.LeaderTerm(metaRaftID)
+	return leader
+}
+
+// leaderBackend returns the store api of the leader, or nil when this master
+// is the leader
+func (rs *RaftStore) leaderBackend(ctx context.Context) (kvBackend, error) {
+	deadline := time.Now().Add(metaLeaderWait)
+	for {
+		leader := rs.Leader()
+		if leader == rs.nodeID {
+			return nil, nil
+		}
+		if leader != 0 && leader <= uint64(len(rs.masters)) {
+			if isForwarded(ctx) {
+				return nil, vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_NOT_LEADER, fmt.Errorf("raft store node:[%d] is not the leader, node:[%d] is", rs.nodeID, leader))
+			}
+			return rs.remote(leader), nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("raft store has no leader")
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// onLeader calls f with the store api of the leader, or with nil when this
+// master is the leader. A master which is no longer the leader did not apply
+// the request, it is sent again to the new leader.
+func (rs *RaftStore) onLeader(ctx context.Context, f func(b kvBackend) error) error {
+	deadline := time.Now().Add(metaLeaderWait)
+	for {
+		b, err := rs.leaderBackend(ctx)
+		if err != nil {
+			return err
+		}
+		if err = f(b); !errors.Is(err, errNotLeader) || time.Now().After(deadline) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (rs *RaftStore) remote(nodeID uint64) *remoteBackend {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	r, ok := rs.remotes[nodeID]
+	if !ok {
+		r = newRemoteBackend([]string{rs.masters[nodeID-1].ApiUrl()}, true)
+		rs.remotes[nodeID] = r
+	}
+	return r
+}
+
+func (rs *RaftStore) Txn(ctx context.Context, req *TxnRequest) (resp *TxnResponse, err error) {
+	err = rs.onLeader(ctx, func(b kvBackend) error {
+		if b != nil {
+			resp, err = b.Txn(ctx, req)
+			return err
+		}
+		data, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		r, err := rs.server.Submit(metaRaftID, data).Response()
+		if err != nil {
+			return err
+		}
+		resp = r.(*TxnResponse)
+		return nil
+	})
+	return resp, err
+}
+
+func (rs *RaftStore) Get(ctx context.Context, key string) (kv *KeyValue, err error) {
+	err = rs.onLeader(ctx, func(b kvBackend) error {
+		if b != nil {
+			kv, err = b.Get(ctx, key)
+		} else {
+			kv, err = rs.db.get(key)
+		}
+		return err
+	})
+	return kv, err
+}
+
+func (rs *RaftStore) Scan(ctx context.Context, prefix string) (kvs []*KeyValue, err error) {
+	err = rs.onLeader(ctx, func(b kvBackend) error {
+		if b != nil {
+			kvs, err = b.Scan(ctx, prefix)
+		} else {
+			kvs, err = rs.db.scan(prefix)
+		}
+		return err
+	})
+	return kvs, err
+}
+
+func (rs *RaftStore) LeaseTTL(ctx context.Context, id LeaseID) (ttl int64, err error) {
+	err = rs.onLeader(ctx, func(b kvBackend) error {
+		if b != nil {
+			ttl, err = b.LeaseTTL(ctx, id)
+		} else {
+			ttl = rs.leases.ttl(id)
+		}
+		return err
+	})
+	return ttl, err
+}
+
+func (rs *RaftStore) KeepAliveLease(ctx context.Context, id LeaseID) (ttl int64, err error) {
+	err = rs.onLeader(ctx, func(b kvBackend) error {
+		if b != nil {
+			ttl, err = b.KeepAliveLease(ctx, id)
+		} else {
+			ttl, err = rs.leases.keepAlive(id)
+		}
+		return err
+	})
+	return ttl, err
+}
+
+// Events are served from what this master has applied
+func (rs *RaftStore) Events(ctx context.Context, prefix string, rev int64, wait time.Duration) (*EventsResponse, error) {
+	return rs.db.history.since(ctx, prefix, rev, wait)
+}
+
+// truncateJob drops the raft logs applied long ago, the bolt file has them
+func (rs *RaftStore) truncateJob(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	var truncated uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		applied := rs.server.AppliedIndex(metaRaftID)
+		if applied > truncated+metaRaftRetainLogs {
+			truncated = applied - metaRaftRetainLogs
+			rs.server.Truncate(metaRaftID, truncated)
+			log.Info("raft store truncate logs to index:[%d]", truncated)
+		}
+	}
+}
+
+func (rs *RaftStore) Apply(command []byte, index uint64) (interface{}, error) {
+	req := &TxnRequest{}
+	if err := json.Unmarshal(command, req); err != nil {
+		log.Error("raft store decode command at index:[%d] err: %v", index, err)
+		return &TxnResponse{Err: err.Error()}, nil
+	}
+	resp := rs.db.apply(req, index)
+	rs.leases.applied(req, resp)
+	return resp, nil
+}
+
+func (rs *RaftStore) ApplyMemberChange(confChange *proto.ConfChange, index uint64) (interface{}, error) {
+	log.Warn("raft store does not change its members, they are the masters of the config")
+	return nil, nil
+}
+
+func (rs *RaftStore) Snapshot() (proto.Snapshot, error) {
+	return rs.db.snapshot()
+}
+
+func (rs *RaftStore) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) error {
+	if err := rs.db.restore(iter.Next); err != nil {
+		return err
+	}
+	leases, err := rs.db.leases()
+	if err != nil {
+		return err
+	}
+	rs.leases.reset(leases)
+	log.Info("raft store applied snapshot, applied index: %d, revision: %d", rs.db.applied, rs.db.rev)
+	return nil
+}
+
+func (rs *RaftStore) HandleFatalEvent(err *raft.FatalError) {
+	log.Error("raft store fatal err: %v", err.Err)
+}
+
+// HandleLeaderChange gives the leases their whole ttl on the new leader, the
+// holders keep them alive through it from then on
+func (rs *RaftStore) HandleLeaderChange(leader uint64) {
+	log.Info("raft store leader changed to node:[%d]", leader)
+	if leader != rs.nodeID {
+		return
+	}
+	leases, err := rs.db.leases()
+	if err != nil {
+		log.Error("raft store load leases err: %v", err)
+		return
+	}
+	rs.leases.reset(leases)
+}
+
+// masterResolver resolves the raft node of a master, node index+1 of them
+type masterResolver config.Masters
+
+func (ms masterResolver) NodeAddress(nodeID uint64, stype raft.SocketType) (string, error) {
+	if nodeID == 0 || nodeID > uint64(len(ms)) {
+		return "", fmt.Errorf("raft store has no master of node:[%d]", nodeID)
+	}
+	m := ms[nodeID-1]
+	switch stype {
+	case raft.HeartBeat:
+		return m.Address + ":" + cast.ToString(m.RaftHeartbeatPort), nil
+	case raft.Replicate:
+		return m.Address + ":" + cast.ToString(m.RaftReplicatePort), nil
+	default:
+		return "", fmt.Errorf("unknown socket type[%v]", stype)
+	}
+}
+
+// WaitReady waits until the masters have a leader and it answers
+func (rs *RaftStore) WaitReady(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		_, err := rs.Get(ctx, "/")
+		if err == nil {
+			return nil
+		}
+		log.Warn("raft store is not ready: %v", err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("raft store took too long to start: %v", err)
+		case <-time.After(time.Second):
+		}
+	}
+}
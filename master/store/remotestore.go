@@ -0,0 +1,223 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util"
+)
+
+const remoteTimeout = 30 * time.Second
+
+// errNotLeader is the reply of a master which is no longer the leader to a
+// forwarded request, it did not apply it
+var errNotLeader = errors.New("meta store master is not the leader")
+
+type forwardedKey struct{}
+
+// WithForwarded marks a request a master forwarded to the leader of the meta
+// store, the leader does not forward it again
+func WithForwarded(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forwardedKey{}, true)
+}
+
+func isForwarded(ctx context.Context) bool {
+	forwarded, _ := ctx.Value(forwardedKey{}).(bool)
+	return forwarded
+}
+
+// remoteBackend calls the store api of the masters, it goes on to the next
+// master when one can not be reached. A transaction may have been applied
+// when its reply is lost, it only goes on when it was not sent at all.
+type remoteBackend struct {
+	addrs []string
+	// the requests are forwarded by a master to the leader
+	forward bool
+
+	mu   sync.Mutex
+	next int
+}
+
+func newRemoteBackend(addrs []string, forward bool) *remoteBackend {
+	return &remoteBackend{addrs: addrs, forward: forward}
+}
+
+type remoteReply struct {
+	Code int64           `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+func (r *remoteBackend) addr() (int, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.next, r.addrs[r.next%len(r.addrs)]
+}
+
+func (r *remoteBackend) failed(i int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.next == i {
+		r.next = (i + 1) % len(r.addrs)
+	}
+}
+
+// call sends the request to a master and decodes the data of its reply into
+// data, it tries each master once while they can not be reached. Unless the
+// request is idempotent it is not sent again once a master may have got it.
+func (r *remoteBackend) call(ctx context.Context, method, path string, params url.Values, body interface{}, idempotent bool, timeout time.Duration, data interface{}) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	if r.forward {
+		if params == nil {
+			params = url.Values{}
+		}
+		params.Set("forwarded", "true")
+	}
+
+	var lastErr error
+	for range r.addrs {
+		i, addr := r.addr()
+		reply, err := r.do(ctx, method, addr+path+"?"+params.Encode(), reqBody, timeout)
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				return err
+			}
+			r.failed(i)
+			if !idempotent && !notSent(err) {
+				return err
+			}
+			continue
+		}
+		if reply.Code == int64(vearchpb.ErrCode(vearchpb.ErrorEnum_PARTITION_NOT_LEADER)) && r.forward {
+			return fmt.Errorf("%w, %s%s err: %s", errNotLeader, addr, path, reply.Msg)
+		}
+		if reply.Code != int64(vearchpb.ErrCode(vearchpb.ErrorEnum_SUCCESS)) {
+			return fmt.Errorf("meta store %s%s err: %s", addr, path, reply.Msg)
+		}
+		if data == nil || len(reply.Data) == 0 {
+			return nil
+		}
+		return json.Unmarshal(reply.Data, data)
+	}
+	return lastErr
+}
+
+// notSent tells the connection to the master failed, so the request was not
+// sent
+func notSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func (r *remoteBackend) do(ctx context.Context, method, url string, body []byte, timeout time.Duration) (*remoteReply, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", util.AuthEncrypt(config.MetaStoreUser, config.Conf().GetMetaStoreToken()))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	reply := &remoteReply{}
+	if err := json.Unmarshal(respBody, reply); err != nil {
+		return nil, fmt.Errorf("meta store %s reply status: %d, body: %s", url, resp.StatusCode, respBody)
+	}
+	return reply, nil
+}
+
+func (r *remoteBackend) Txn(ctx context.Context, req *TxnRequest) (*TxnResponse, error) {
+	resp := &TxnResponse{}
+	if err := r.call(ctx, http.MethodPost, "/store/txn", nil, req, false, remoteTimeout, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *remoteBackend) Get(ctx context.Context, key string) (*KeyValue, error) {
+	var kv *KeyValue
+	if err := r.call(ctx, http.MethodGet, "/store/get", url.Values{"key": {key}}, nil, true, remoteTimeout, &kv); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+func (r *remoteBackend) Scan(ctx context.Context, prefix string) ([]*KeyValue, error) {
+	var kvs []*KeyValue
+	if err := r.call(ctx, http.MethodGet, "/store/scan", url.Values{"prefix": {prefix}}, nil, true, remoteTimeout, &kvs); err != nil {
+		return nil, err
+	}
+	return kvs, nil
+}
+
+func (r *remoteBackend) LeaseTTL(ctx context.Context, id LeaseID) (int64, error) {
+	var ttl int64
+	params := url.Values{"id": {strconv.FormatInt(int64(id), 10)}}
+	if err := r.call(ctx, http.MethodGet, "/store/lease/ttl", params, nil, true, remoteTimeout, &ttl); err != nil {
+		return 0, err
+	}
+	return ttl, nil
+}
+
+func (r *remoteBackend) KeepAliveLease(ctx context.Context, id LeaseID) (int64, error) {
+	var ttl int64
+	params := url.Values{"id": {strconv.FormatInt(int64(id), 10)}}
+	if err := r.call(ctx, http.MethodPost, "/store/lease/keepalive", params, nil, true, remoteTimeout, &ttl); err != nil {
+		return 0, err
+	}
+	return ttl, nil
+}
+
+func (r *remoteBackend) Events(ctx context.Context, prefix string, rev int64, wait time.Duration) (*EventsResponse, error) {
+	resp := &EventsResponse{}
+	params := url.Values{
+		"prefix": {prefix},
+		"rev":    {strconv.FormatInt(rev, 10)},
+		"wait":   {strconv.FormatInt(wait.Milliseconds(), 10)},
+	}
+	if err := r.call(ctx, http.MethodGet, "/store/watch", params, nil, true, wait+remoteTimeout, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
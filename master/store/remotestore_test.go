@@ -0,0 +1,108 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package store
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/util"
+)
+
+func initTestConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte("[global]\nsignkey = \"secret\"\n"), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	config.InitConfig(path)
+}
+
+// storeServer answers the store api as a master, it counts the calls
+func storeServer(t *testing.T, calls *int32) *httptest.Server {
+	want := util.AuthEncrypt(config.MetaStoreUser, config.Conf().GetMetaStoreToken())
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if got := r.Header.Get("Authorization"); got != want {
+			t.Errorf("authorization %s, want %s", got, want)
+		}
+		w.Write([]byte(`{"code":200,"data":{"succeeded":true,"revision":1}}`))
+	}))
+}
+
+// lostServer reads the requests and closes the connection without a reply,
+// as a master going down after it got a request
+func lostServer(calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+}
+
+func closedAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := "http://" + l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func TestRemoteRetry(t *testing.T) {
+	initTestConfig(t)
+	ctx := context.Background()
+	var calls, lost int32
+	s := storeServer(t, &calls)
+	defer s.Close()
+	l := lostServer(&lost)
+	defer l.Close()
+
+	// a master which can not be connected to did not get the transaction
+	r := newRemoteBackend([]string{closedAddr(t), s.URL}, false)
+	if resp, err := r.Txn(ctx, &TxnRequest{Ops: []*Op{{Type: OpPut, Key: "/a"}}}); err != nil || !resp.Succeeded {
+		t.Fatalf("txn: %v, err: %v", resp, err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("%d calls, want 1", n)
+	}
+
+	// a master which got the transaction may have applied it
+	r = newRemoteBackend([]string{l.URL, s.URL}, false)
+	if _, err := r.Txn(ctx, &TxnRequest{Ops: []*Op{{Type: OpPut, Key: "/a"}}}); err == nil {
+		t.Fatalf("txn with a lost reply succeeded")
+	}
+	if ln, n := atomic.LoadInt32(&lost), atomic.LoadInt32(&calls); ln != 1 || n != 1 {
+		t.Fatalf("txn sent %d times to the lost master and %d to the next one", ln, n-1)
+	}
+
+	// a read goes on to the next master
+	r = newRemoteBackend([]string{l.URL, s.URL}, false)
+	if _, err := r.Get(ctx, "/a"); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if ln, n := atomic.LoadInt32(&lost), atomic.LoadInt32(&calls); ln != 2 || n != 2 {
+		t.Fatalf("get sent %d times to the lost master and %d to the next one", ln-1, n-1)
+	}
+}
@@ -18,9 +18,6 @@ import (
 	"context"
 	"fmt"
 	"time"
-
-	clientv3 "go.etcd.io/etcd/client/v3"
-	"go.etcd.io/etcd/client/v3/concurrency"
 )
 
 var storeFactories = make(map[string]InitFunc)
@@ -42,29 +39,87 @@ func OpenStore(implementation string, serverAddress []string) (Store, error) {
 	return s(serverAddress)
 }
 
+// LeaseID is a lease of keys, they are deleted when it expires
+type LeaseID int64
+
+// LeaseKeepAliveResponse is sent each time a lease is kept alive
+type LeaseKeepAliveResponse struct {
+	ID  LeaseID
+	TTL int64
+}
+
+type EventType int32
+
+const (
+	PUT EventType = iota
+	DELETE
+)
+
+type KeyValue struct {
+	Key         []byte  `json:"key"`
+	Value       []byte  `json:"value,omitempty"`
+	ModRevision int64   `json:"mod_revision"`
+	Lease       LeaseID `json:"lease,omitempty"`
+}
+
+// Event is a change of a key, the value of a DELETE is empty
+type Event struct {
+	Type EventType `json:"type"`
+	Kv   *KeyValue `json:"kv"`
+}
+
+// WatchResponse has the events of one or more revisions, the watch is over
+// when it is Canceled
+type WatchResponse struct {
+	Revision int64
+	Events   []*Event
+	Canceled bool
+	Err      error
+}
+
+type WatchChan <-chan WatchResponse
+
+// STM is a software transactional memory, the keys it reads are checked
+// not to have changed when it commits what it puts and deletes
+type STM interface {
+	Get(key ...string) string
+	Put(key, val string)
+	Rev(key string) int64
+	Del(key string)
+}
+
+// Lock is a lock among the processes sharing a store, its key is bound to a
+// lease so a dead holder releases it
+type Lock interface {
+	Lock() error
+	TryLock() (bool, error)
+	Unlock() error
+	KeepAliveOnce()
+}
+
 type Store interface {
 	Put(ctx context.Context, key string, value []byte) error
 	Create(ctx context.Context, key string, value []byte) error
 	CreateWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error
-	KeepAlive(ctx context.Context, key string, value []byte, ttl time.Duration) (<-chan *clientv3.LeaseKeepAliveResponse, error)
-	PutWithLeaseId(ctx context.Context, key string, value []byte, ttl time.Duration, leaseId clientv3.LeaseID) error
+	KeepAlive(ctx context.Context, key string, value []byte, ttl time.Duration) (<-chan *LeaseKeepAliveResponse, error)
+	PutWithLeaseId(ctx context.Context, key string, value []byte, ttl time.Duration, leaseId LeaseID) error
 	Update(ctx context.Context, key string, value []byte) error
 	Get(ctx context.Context, key string) ([]byte, error)
+	// TTL returns the seconds left of the lease of key, -1 when it has none
+	TTL(ctx context.Context, key string) (int64, error)
 	PrefixScan(ctx context.Context, prefix string) ([][]byte, [][]byte, error)
 	Delete(ctx context.Context, key string) error
-	//Here we should not use the STM structure of etcd, but should define a data structure
-	//equivalent to STM in the store, and then convert it in etcdstorage.go, on the one hand, the
-	//decoupling of etcd and master logic, on the other hand Easy to extend other types of storage
-	STM(ctx context.Context, apply func(stm concurrency.STM) error) error
-	NewLock(ctx context.Context, key string, timeout time.Duration) *DistLock
+	// STM applies in one transaction, again when what it read has changed
+	STM(ctx context.Context, apply func(stm STM) error) error
+	NewLock(ctx context.Context, key string, timeout time.Duration) Lock
 	//it to generate increment unique id
 	NewIDGenerate(ctx context.Context, key string, base int64, timeout time.Duration) (int64, error)
-	WatchPrefix(ctx context.Context, key string) (clientv3.WatchChan, error)
+	WatchPrefix(ctx context.Context, key string) (WatchChan, error)
 }
 
 type WatcherJob interface {
-	Put(event *clientv3.Event)
-	Delete(event *clientv3.Event)
+	Put(event *Event)
+	Delete(event *Event)
 	Start()
 	Stop()
 }
@@ -0,0 +1,127 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package master
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util"
+	"github.com/vearch/vearch/util/ginutil"
+	"github.com/vearch/vearch/util/server/vearchhttp"
+)
+
+// storeApi serves the raft store of the metadata to ps, router and the other
+// masters when meta_store is raft
+type storeApi struct {
+	router *gin.Engine
+	store  *store.RaftStore
+	dh     *vearchhttp.BaseHandler
+}
+
+func ExportToStoreHandler(router *gin.Engine, raftStore *store.RaftStore) {
+	dh := vearchhttp.NewBaseHandler(30)
+
+	s := &storeApi{router: router, store: raftStore, dh: dh}
+
+	router.Handle(http.MethodPost, "/store/txn", dh.PaincHandler, dh.TimeOutHandler, s.auth, s.txn, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/store/get", dh.PaincHandler, dh.TimeOutHandler, s.auth, s.get, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/store/scan", dh.PaincHandler, dh.TimeOutHandler, s.auth, s.scan, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/store/lease/ttl", dh.PaincHandler, dh.TimeOutHandler, s.auth, s.leaseTTL, dh.TimeOutEndHandler)
+	router.Handle(http.MethodPost, "/store/lease/keepalive", dh.PaincHandler, dh.TimeOutHandler, s.auth, s.keepAlive, dh.TimeOutEndHandler)
+	router.Handle(http.MethodGet, "/store/watch", dh.PaincHandler, dh.TimeOutHandler, s.auth, s.watch, dh.TimeOutEndHandler)
+}
+
+// auth only lets in the calls made with the credential of the store api,
+// the store api is for the cluster members, not for the users of root
+func (s *storeApi) auth(c *gin.Context) {
+	if err := storeAuth(c); err != nil {
+		defer s.dh.TimeOutEndHandler(c)
+		c.Abort()
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+	}
+}
+
+func storeAuth(c *gin.Context) error {
+	if config.Conf().Global.SkipAuth {
+		return nil
+	}
+	username, password, err := util.AuthDecrypt(c.GetHeader(headerAuthKey))
+	if username != config.MetaStoreUser || subtle.ConstantTimeCompare([]byte(password), []byte(config.Conf().GetMetaStoreToken())) != 1 {
+		return vearchpb.NewError(vearchpb.ErrorEnum_AUTHENTICATION_FAILED, err)
+	}
+	return nil
+}
+
+// ctx of a request forwarded by another master is not forwarded again
+func (s *storeApi) ctx(c *gin.Context) context.Context {
+	ctx, _ := c.Get(vearchhttp.Ctx)
+	if cast.ToBool(c.Query("forwarded")) {
+		return store.WithForwarded(ctx.(context.Context))
+	}
+	return ctx.(context.Context)
+}
+
+func (s *storeApi) reply(c *gin.Context, data interface{}, err error) {
+	if err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	ginutil.NewAutoMehtodName(c).SendJsonHttpReplySuccess(data)
+}
+
+func (s *storeApi) txn(c *gin.Context) {
+	req := &store.TxnRequest{}
+	if err := c.Bind(req); err != nil {
+		ginutil.NewAutoMehtodName(c).SendJsonHttpReplyError(err)
+		return
+	}
+	resp, err := s.store.Txn(s.ctx(c), req)
+	s.reply(c, resp, err)
+}
+
+func (s *storeApi) get(c *gin.Context) {
+	kv, err := s.store.Get(s.ctx(c), c.Query("key"))
+	s.reply(c, kv, err)
+}
+
+func (s *storeApi) scan(c *gin.Context) {
+	kvs, err := s.store.Scan(s.ctx(c), c.Query("prefix"))
+	s.reply(c, kvs, err)
+}
+
+func (s *storeApi) leaseTTL(c *gin.Context) {
+	ttl, err := s.store.LeaseTTL(s.ctx(c), store.LeaseID(cast.ToInt64(c.Query("id"))))
+	s.reply(c, ttl, err)
+}
+
+func (s *storeApi) keepAlive(c *gin.Context) {
+	ttl, err := s.store.KeepAliveLease(s.ctx(c), store.LeaseID(cast.ToInt64(c.Query("id"))))
+	s.reply(c, ttl, err)
+}
+
+// watch waits up to wait milliseconds for the events after rev
+func (s *storeApi) watch(c *gin.Context) {
+	wait := time.Duration(cast.ToInt64(c.Query("wait"))) * time.Millisecond
+	resp, err := s.store.Events(s.ctx(c), c.Query("prefix"), cast.ToInt64(c.Query("rev")), wait)
+	s.reply(c, resp, err)
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+// ClusterMetaVersion is the version of the cluster metadata document, an
+// import refuses documents of a newer version
+const ClusterMetaVersion = 1
+
+// import modes of a cluster metadata document
+const (
+	// ImportRemap creates the dbs and spaces with new ids and empty partitions
+	ImportRemap = "remap"
+	// ImportAdopt keeps the ids and loads the partitions left in the data
+	// dirs of the ps
+	ImportAdopt = "adopt"
+)
+
+// ClusterMeta is the metadata of a cluster exported as one document
+type ClusterMeta struct {
+	Version int    `json:"version"`
+	Cluster string `json:"cluster"`
+	Time    int64  `json:"time"`
+	DBs     []*DB  `json:"dbs"`
+	// the spaces with their partitions and the nodes of their replicas
+	Spaces []*SpaceMeta `json:"spaces"`
+	Users  []*User      `json:"users,omitempty"`
+	// the ps the replicas are assigned to, alive or failed
+	Servers []*Server `json:"servers,omitempty"`
+}
+
+// SpaceMeta is a space of an exported cluster
+type SpaceMeta struct {
+	DBName    string     `json:"db_name"`
+	Space     *Space     `json:"space"`
+	EngineCfg *EngineCfg `json:"engine_cfg,omitempty"`
+}
+
+// ImportReport is what an import created, and the errors of what it could not
+type ImportReport struct {
+	Mode   string   `json:"mode"`
+	DBs    []string `json:"dbs"`
+	Spaces []string `json:"spaces"`
+	Users  []string `json:"users,omitempty"`
+	// the partitions loaded by each ps in adopt mode
+	Adopted map[NodeID][]PartitionID `json:"adopted,omitempty"`
+	Errors  []string                 `json:"errors,omitempty"`
+}
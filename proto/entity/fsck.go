@@ -0,0 +1,45 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package entity
+
+// kinds of the problems of the cluster metadata found by fsck
+const (
+	FsckOrphanSpace      = "orphan_space"       // a space of a missing db
+	FsckMissingPartition = "missing_partition"  // a partition of a space is not stored
+	FsckOrphanPartition  = "orphan_partition"   // a partition of a missing space
+	FsckUnknownReplica   = "unknown_replica"    // a replica on a server neither alive nor failed
+	FsckOrphanReplica    = "orphan_replica"     // a ps holds a partition which is not stored
+	FsckLeaderNotReplica = "leader_not_replica" // the leader of a partition is not one of its replicas
+	FsckStaleLock        = "stale_lock"         // a lock which never expires
+	FsckSequenceDrift    = "sequence_drift"     // an id sequence behind the ids in use
+)
+
+// FsckProblem is a problem of the cluster metadata and how to fix it
+type FsckProblem struct {
+	Kind   string `json:"kind"`
+	Key    string `json:"key,omitempty"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix"`
+	Fixed  bool   `json:"fixed,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// FsckReport is the result of a check of the cluster metadata, the problems
+// are fixed when Fix is set
+type FsckReport struct {
+	Time     int64          `json:"time"`
+	Fix      bool           `json:"fix"`
+	Problems []*FsckProblem `json:"problems"`
+}
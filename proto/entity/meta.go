@@ -56,6 +56,11 @@ func FailServerKey(nodeID uint64) string {
 	return fmt.Sprintf("%s%d", PrefixFailServer, nodeID)
 }
 
+// ConsistencyKey is the key of the consistency report of a partition
+func ConsistencyKey(partitionID PartitionID) string {
+	return fmt.Sprintf("%s%d", PrefixConsistency, partitionID)
+}
+
 // RouterKey Router key
 func RouterKey(key, value string) string {
 	return fmt.Sprintf("%s%s/%s", PrefixRouter, key, value)
@@ -82,6 +87,7 @@ func SetPrefixAndSequence(cluster_id string) {
     PrefixDataBaseBody = PrefixEtcdClusterID + PrefixDataBaseBody
     PrefixFailServer   = PrefixEtcdClusterID + PrefixFailServer
     PrefixRouter       = PrefixEtcdClusterID + PrefixRouter
+    PrefixConsistency  = PrefixEtcdClusterID + PrefixConsistency
 }
 
 // sids sequence key for etcd
@@ -104,6 +110,7 @@ var (
 	PrefixDataBaseBody = "/db/body/"
 	PrefixFailServer   = "/fail/server/"
 	PrefixRouter       = "/router/"
+	PrefixConsistency  = "/consistency/"
 	PrefixNodeId       = "/id/node"
 	PrefixSpaceId      = "/id/space"
 	PrefixDBId         = "/id/db"
@@ -119,6 +126,12 @@ const ClusterCleanJobKey = "/cluster/cleanjob"
 // ClusterWatchServerKey for server job lock
 const ClusterWatchServerKey = "watch/server"
 
+// ClusterDiskWatermarkKey for disk watermark job lock
+const ClusterDiskWatermarkKey = "job/disk_watermark"
+
+// ClusterConsistencyKey for replica consistency job lock
+const ClusterConsistencyKey = "job/consistency"
+
 // rpc time out, default 10 * 1000 ms
 type CTX_KEY string
 
@@ -55,6 +55,9 @@ type Partition struct {
 	status            PartitionStatus
 	lock              sync.RWMutex
 	ReStatusMap       map[uint64]uint32 `json:"status,omitempty"` // leader in replicas
+	// set by master when a replica is in a data dir above the flood stage
+	// disk watermark, documents can only be deleted then
+	ReadOnly bool `json:"read_only,omitempty"`
 }
 
 // this is safe method for set status
@@ -89,4 +92,72 @@ type PartitionInfo struct {
 	IndexNum    int               `json:"index_num"`
 	MaxDocid    int               `json:"max_docid"`
 	Error       string            `json:"error,omitempty"`
+	// the highest disk watermark of the ps of its replicas
+	DiskWatermark string `json:"disk_watermark,omitempty"`
+	// documents can only be deleted when a replica is above the flood stage
+	ReadOnly bool `json:"read_only,omitempty"`
+}
+
+// MovePartition moves a partition of a ps to another of its data dirs
+type MovePartition struct {
+	PartitionID PartitionID `json:"partition_id"`
+	NodeID      NodeID      `json:"node_id"`
+	DataDir     string      `json:"data_dir"`
+}
+
+// PartitionMeta is a partition found in a data dir of a ps, with the space
+// saved in its meta
+type PartitionMeta struct {
+	PartitionID PartitionID `json:"partition_id"`
+	DataDir     string      `json:"data_dir"`
+	Loaded      bool        `json:"loaded"`
+	Space       *Space      `json:"space"`
+	ModTime     int64       `json:"mod_time"` // unix seconds the meta was last saved
+}
+
+// ReplicaCheck asks a ps for the checksum of its replica of a partition at a
+// raft index, the leader proposes a new check when Index is 0
+type ReplicaCheck struct {
+	PartitionID PartitionID `json:"partition_id"`
+	Index       uint64      `json:"index"`
+	// the documents are only counted when it is 0
+	Buckets uint32 `json:"buckets"`
+}
+
+// ReplicaChecksum is the state of a replica when it applied a check, each
+// bucket sums the hashes of the documents whose primary key falls in it
+type ReplicaChecksum struct {
+	NodeID   NodeID   `json:"node_id"`
+	Index    uint64   `json:"index"`
+	DocCount uint64   `json:"doc_count"`
+	Buckets  []uint64 `json:"buckets,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// Equal reports whether two replicas hold the same documents
+func (rc *ReplicaChecksum) Equal(o *ReplicaChecksum) bool {
+	if rc.DocCount != o.DocCount || len(rc.Buckets) != len(o.Buckets) {
+		return false
+	}
+	for i := range rc.Buckets {
+		if rc.Buckets[i] != o.Buckets[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ConsistencyReport is the result of comparing the replicas of a partition
+type ConsistencyReport struct {
+	PartitionID PartitionID `json:"partition_id"`
+	DBId        DBID        `json:"db_id"`
+	SpaceId     SpaceID     `json:"space_id"`
+	Time        int64       `json:"time"`
+	// the replicas are compared by buckets when their document counts are equal
+	Buckets  uint32             `json:"buckets"`
+	Replicas []*ReplicaChecksum `json:"replicas"`
+	// the replicas which differ from the majority
+	Divergent []NodeID `json:"divergent,omitempty"`
+	Repaired  []NodeID `json:"repaired,omitempty"`
+	Error     string   `json:"error,omitempty"`
 }
@@ -38,6 +38,33 @@ type Server struct {
 	Size              uint64        `json:"size,omitempty"`
 	Private           bool          `json:"private"`
 	Version           *BuildVersion `json:"version"`
+	// the disk watermark the fullest data dir of the ps is above, "" for none
+	DiskWatermark   string  `json:"disk_watermark,omitempty"`
+	DiskUsedPercent float64 `json:"disk_used_percent,omitempty"`
+	// the partitions in the data dirs of the ps above the flood stage
+	FloodPartitionIds []PartitionID `json:"flood_partition_ids,omitempty"`
+}
+
+// disk watermarks of a ps. No new partition is placed on a ps above the low
+// one, replicas are moved away from a ps above the high one and the spaces
+// on a ps above the flood stage are read only.
+const (
+	DiskWatermarkLow   = "low"
+	DiskWatermarkHigh  = "high"
+	DiskWatermarkFlood = "flood_stage"
+)
+
+// DiskWatermarkLevel is 0 for no watermark and 3 for the flood stage
+func DiskWatermarkLevel(watermark string) int {
+	switch watermark {
+	case DiskWatermarkLow:
+		return 1
+	case DiskWatermarkHigh:
+		return 2
+	case DiskWatermarkFlood:
+		return 3
+	}
+	return 0
 }
 
 // FailServer /fail/server/id:[body] ttl 3m 3s
@@ -59,3 +86,35 @@ func (s *Server) Replica() *Replica {
 		RpcAddr:       util.BuildAddr(s.Ip, s.RpcPort),
 	}
 }
+
+// SnapshotLimit is the bytes per second of the raft snapshots a ps sends and
+// receives, 0 is unlimited
+type SnapshotLimit struct {
+	SendRate int64 `json:"send_rate"`
+	RecvRate int64 `json:"recv_rate"`
+}
+
+// SnapshotStats is reported in the stats of a ps
+type SnapshotStats struct {
+	SnapshotLimit
+	SentBytes int64 `json:"sent_bytes"`
+	RecvBytes int64 `json:"recv_bytes"`
+	// snapshots in progress
+	Sending   int64 `json:"sending"`
+	Receiving int64 `json:"receiving"`
+}
+
+// SnapshotFile is a file of a raft snapshot a follower has received completely
+type SnapshotFile struct {
+	Size     int64  `json:"size"`
+	ModTime  int64  `json:"mod_time"`
+	Checksum uint32 `json:"checksum"`
+}
+
+// SnapshotResume is sent by a follower to the leader sending it snapshot
+// SnapshotID, the leader skips the files the follower has received before
+type SnapshotResume struct {
+	PartitionID PartitionID              `json:"partition_id"`
+	SnapshotID  uint64                   `json:"snapshot_id"`
+	Files       map[string]*SnapshotFile `json:"files"`
+}
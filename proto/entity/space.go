@@ -89,6 +89,13 @@ type Space struct {
 	Engine          *Engine                     `json:"engine"`
 	Models          json.RawMessage             `json:"models,omitempty"` //json model config for python plugin
 	SpaceProperties map[string]*SpaceProperties `json:"space_properties"`
+	SlowLog         *SlowLogCfg                 `json:"slow_log,omitempty"`
+}
+
+// SlowLogCfg is set by /config/$db/$space/_slowlog, searches of the space
+// taking longer than ThresholdMs are kept in the slow log, 0 disables it
+type SlowLogCfg struct {
+	ThresholdMs int64 `json:"threshold_ms"`
 }
 
 // cache/[dbId]/[spaceId]:[cacheCfg]
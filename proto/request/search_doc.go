@@ -62,7 +62,33 @@ type SearchDocumentRequest struct {
 	DbName         string          `json:"db_name,omitempty"`
 	SpaceName      string          `json:"space_name,omitempty"`
 	LoadBalance    string          `json:"load_balance"`
-	sortOrder      sortorder.SortOrder
+	SearchAfter    json.RawMessage `json:"search_after,omitempty"`
+	RangeSearch    *RangeSearch    `json:"range_search,omitempty"`
+	Profile        bool            `json:"profile,omitempty"`
+	// AllowPartialResults returns the hits of the partitions that answered
+	// when others failed or did not answer in PartitionTimeout ms, it is the
+	// default when not set and only an explicit false fails such searches
+	AllowPartialResults *bool `json:"allow_partial_results,omitempty"`
+	PartitionTimeout    int64 `json:"partition_timeout,omitempty"`
+	sortOrder           sortorder.SortOrder
+}
+
+type RangeSearch struct {
+	MaxResults int    `json:"max_results,omitempty"`
+	Cursor     string `json:"cursor,omitempty"`
+	Stream     bool   `json:"stream,omitempty"`
+}
+
+// RecommendRequest searches the documents similar to the positive and not
+// to the negative example documents.
+type RecommendRequest struct {
+	SearchDocumentRequest
+	Positive json.RawMessage `json:"positive,omitempty"`
+	Negative json.RawMessage `json:"negative,omitempty"`
+	Field    string          `json:"field,omitempty"`
+	Strategy string          `json:"strategy,omitempty"`
+	Beta     *float64        `json:"beta,omitempty"`
+	Gamma    *float64        `json:"gamma,omitempty"`
 }
 
 type SearchRequestPo struct {
@@ -97,6 +97,8 @@ const (
 	ErrorEnum_DELETE_BY_QUERY_SERACH_ERR           ErrorEnum = 64
 	ErrorEnum_DELETE_BY_QUERY_SEARCH_ID_IS_0       ErrorEnum = 65
 	ErrorEnum_FLUSH_ERR                            ErrorEnum = 66
+	ErrorEnum_PARTIAL_RESULTS                      ErrorEnum = 67
+	ErrorEnum_DISK_FLOOD_STAGE                     ErrorEnum = 68
 	ErrorEnum_Create_RpcClient_Failed              ErrorEnum = 70
 	ErrorEnum_Call_RpcClient_Failed                ErrorEnum = 71
 	ErrorEnum_RECOVER                              ErrorEnum = 100
@@ -170,6 +172,8 @@ var ErrorEnum_name = map[int32]string{
 	64:  "DELETE_BY_QUERY_SERACH_ERR",
 	65:  "DELETE_BY_QUERY_SEARCH_ID_IS_0",
 	66:  "FLUSH_ERR",
+	67:  "PARTIAL_RESULTS",
+	68:  "DISK_FLOOD_STAGE",
 	70:  "Create_RpcClient_Failed",
 	71:  "Call_RpcClient_Failed",
 	100: "RECOVER",
@@ -243,6 +247,8 @@ var ErrorEnum_value = map[string]int32{
 	"DELETE_BY_QUERY_SERACH_ERR":           64,
 	"DELETE_BY_QUERY_SEARCH_ID_IS_0":       65,
 	"FLUSH_ERR":                            66,
+	"PARTIAL_RESULTS":                      67,
+	"DISK_FLOOD_STAGE":                     68,
 	"Create_RpcClient_Failed":              70,
 	"Call_RpcClient_Failed":                71,
 	"RECOVER":                              100,
@@ -333,78 +339,80 @@ func init() {
 func init() { proto.RegisterFile("errors.proto", fileDescriptor_24fe73c7f0ddb19c) }
 
 var fileDescriptor_24fe73c7f0ddb19c = []byte{
-	// 1131 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x55, 0xcb, 0x76, 0x13, 0x47,
-	0x13, 0xd6, 0x70, 0x77, 0x63, 0x4c, 0xd3, 0x60, 0x30, 0x06, 0x06, 0x73, 0xf9, 0x7f, 0x1c, 0x12,
-	0x0c, 0x81, 0xdc, 0xc8, 0x95, 0x56, 0x4f, 0x49, 0x9a, 0xc3, 0x4c, 0xf7, 0xd0, 0xdd, 0x63, 0x30,
-	0x9b, 0x3e, 0xb2, 0xad, 0x18, 0x9f, 0x23, 0x23, 0x1f, 0x49, 0xce, 0x89, 0x77, 0x79, 0x8c, 0x3c,
-	0x42, 0x1e, 0x21, 0x8b, 0x2c, 0xb2, 0x64, 0x99, 0x65, 0x96, 0x58, 0x79, 0x81, 0x2c, 0xb3, 0xcc,
-	0xa9, 0x9e, 0x19, 0x59, 0x8a, 0x77, 0x33, 0xf5, 0xd5, 0xe5, 0xab, 0xaf, 0xaa, 0x66, 0xc8, 0x6c,
-	0xa7, 0xdf, 0xef, 0xf5, 0x07, 0x2b, 0xbb, 0xfd, 0xde, 0xb0, 0xb7, 0xf8, 0x60, 0x6b, 0x7b, 0xf8,
-	0x66, 0x6f, 0x7d, 0x65, 0xa3, 0xb7, 0xf3, 0x70, 0xab, 0xb7, 0xd5, 0x7b, 0xe8, 0xcd, 0xeb, 0x7b,
-	0xdf, 0xfb, 0x37, 0xff, 0xe2, 0x9f, 0x0a, 0xf7, 0xdb, 0x4f, 0xc9, 0x49, 0xc0, 0x70, 0x16, 0x92,
-	0x13, 0x1b, 0xbd, 0xcd, 0xce, 0x42, 0xb0, 0x14, 0x2c, 0xcf, 0x3d, 0x26, 0x2b, 0xde, 0x0a, 0x6f,
-	0xf7, 0x76, 0xb4, 0xb7, 0x33, 0x4a, 0x8e, 0xef, 0x0c, 0xb6, 0x16, 0x8e, 0x2d, 0x05, 0xcb, 0x33,
-	0x1a, 0x1f, 0xef, 0xff, 0x76, 0x8e, 0xcc, 0x8c, 0xbd, 0xd8, 0x59, 0x72, 0xda, 0xe4, 0x42, 0x80,
-	0x31, 0xb4, 0xc6, 0x18, 0x99, 0x8b, 0xa5, 0x05, 0x2d, 0x79, 0xe2, 0x40, 0x6b, 0xa5, 0x69, 0xc0,
-	0x2e, 0x11, 0x2a, 0x79, 0x0a, 0x4e, 0x69, 0x97, 0x71, 0x63, 0x5e, 0x2a, 0x1d, 0xd1, 0x63, 0x3e,
-	0x6c, 0xcd, 0xd4, 0x73, 0xb3, 0x46, 0x8f, 0xb3, 0xf3, 0xe4, 0x6c, 0xc6, 0x35, 0x4f, 0xcb, 0x98,
-	0x13, 0x68, 0x88, 0xe5, 0x2a, 0x4f, 0xe2, 0xc8, 0x89, 0x46, 0x93, 0x9e, 0x44, 0x77, 0x1b, 0xa7,
-	0xa0, 0x72, 0x4b, 0x4f, 0xb1, 0x2b, 0xe4, 0xa2, 0x01, 0xbd, 0x1a, 0x0b, 0x70, 0xb9, 0xe4, 0xab,
-	0x3c, 0x4e, 0x78, 0x3d, 0x01, 0x7a, 0x9a, 0x5d, 0x24, 0xe7, 0x5f, 0x2b, 0x09, 0x4e, 0x2a, 0xeb,
-	0xe0, 0x55, 0x6c, 0xac, 0xa1, 0x67, 0xd8, 0x55, 0x32, 0x9f, 0x28, 0xc1, 0x13, 0xe7, 0x21, 0x95,
-	0x19, 0xd7, 0xe0, 0x71, 0x02, 0x11, 0x9d, 0x61, 0xb3, 0xe4, 0x4c, 0x94, 0x67, 0x1e, 0xa0, 0x84,
-	0x11, 0x72, 0x0a, 0xdf, 0xa2, 0x3a, 0x3d, 0x5b, 0x34, 0x52, 0x10, 0x00, 0xd9, 0x8c, 0x25, 0xd0,
-	0x59, 0x46, 0xc9, 0x6c, 0x54, 0xc7, 0xdc, 0x65, 0xea, 0x73, 0x95, 0xa5, 0x37, 0x74, 0xb0, 0xb3,
-	0x3b, 0xdc, 0xa7, 0x73, 0xec, 0x1c, 0x99, 0xc1, 0x1c, 0x26, 0xe3, 0x02, 0xe8, 0x79, 0x24, 0xe4,
-	0x1f, 0x27, 0xa2, 0x28, 0x5b, 0x24, 0x97, 0x33, 0xae, 0x6d, 0x6c, 0x63, 0x25, 0x5d, 0x8b, 0x1b,
-	0x67, 0xb9, 0x79, 0xee, 0xa4, 0x7a, 0x49, 0x2f, 0xb0, 0xcb, 0x84, 0x69, 0xc8, 0x92, 0x58, 0xf0,
-	0xc9, 0x26, 0x18, 0x0a, 0x82, 0x79, 0x4b, 0x8c, 0x5e, 0x64, 0xf7, 0xc8, 0x9d, 0xc3, 0x24, 0x55,
-	0x48, 0x02, 0x3c, 0x02, 0xed, 0x23, 0x23, 0x48, 0xc0, 0x02, 0xbd, 0x84, 0x1c, 0x33, 0x33, 0x51,
-	0x7f, 0x9e, 0xcd, 0x93, 0x0b, 0x99, 0x71, 0xbc, 0xdb, 0xef, 0xb4, 0x37, 0xf7, 0x1d, 0xfc, 0xb8,
-	0x3d, 0x18, 0x0e, 0xe8, 0x65, 0xa4, 0x55, 0xe8, 0x54, 0x30, 0x9e, 0x10, 0xea, 0x0a, 0x2a, 0x9e,
-	0xf4, 0x36, 0xda, 0x5d, 0x97, 0x19, 0xa7, 0x76, 0x07, 0xae, 0xd1, 0xde, 0xee, 0x76, 0x36, 0xe9,
-	0x02, 0x66, 0x6f, 0x82, 0x8c, 0xa3, 0xca, 0xf5, 0x2a, 0x66, 0xf7, 0x69, 0xa2, 0xba, 0x53, 0x99,
-	0x2d, 0xcd, 0x8b, 0x6c, 0x81, 0x5c, 0x2a, 0xf2, 0x1a, 0xd1, 0x82, 0x94, 0xbb, 0x52, 0x5d, 0x7a,
-	0x0d, 0xe7, 0xa3, 0x33, 0xe1, 0x9a, 0x60, 0x9d, 0x48, 0x62, 0x90, 0xb6, 0xca, 0x75, 0x1d, 0x57,
-	0x07, 0xa1, 0x6a, 0x12, 0x1a, 0x4c, 0x46, 0x6f, 0x60, 0x85, 0xd2, 0xaa, 0x9e, 0x43, 0xe5, 0x1c,
-	0xa2, 0xd6, 0x68, 0x9e, 0x5c, 0xa4, 0x9b, 0x58, 0x36, 0x05, 0xdb, 0x52, 0x91, 0x17, 0x25, 0x4e,
-	0xb3, 0x04, 0x52, 0x90, 0x96, 0x2e, 0xa1, 0x7b, 0x6e, 0x4a, 0xb1, 0x4a, 0x69, 0x6e, 0x55, 0x0b,
-	0x81, 0x00, 0xbd, 0x3d, 0x76, 0x99, 0x90, 0xe2, 0x0e, 0xd2, 0xe5, 0xb9, 0x6d, 0x81, 0xb4, 0xb1,
-	0xe0, 0x5e, 0xfd, 0x12, 0xba, 0xeb, 0x89, 0x41, 0x13, 0x4d, 0x13, 0x49, 0xff, 0xc7, 0xae, 0x93,
-	0x85, 0x94, 0x1b, 0x0b, 0x1a, 0xd5, 0x13, 0xbc, 0x40, 0x0d, 0x24, 0x20, 0x2c, 0xfd, 0x3f, 0xbb,
-	0x49, 0xae, 0x1d, 0xa2, 0x3e, 0x4e, 0xaa, 0xbc, 0xd9, 0xaa, 0x1c, 0xee, 0xa1, 0xf6, 0x87, 0x93,
-	0x8e, 0x72, 0x3f, 0x69, 0x0b, 0x74, 0x79, 0x1a, 0x18, 0x57, 0xa4, 0x1f, 0x60, 0xd3, 0xd3, 0x40,
-	0xb1, 0x17, 0xf4, 0xfe, 0x7f, 0x43, 0x2a, 0xe0, 0xc3, 0x69, 0x40, 0xc3, 0x8b, 0x42, 0x46, 0xfa,
-	0x11, 0xd2, 0x2b, 0x00, 0x25, 0xcb, 0x4b, 0xf0, 0xc7, 0x5c, 0x8d, 0xef, 0x01, 0xbb, 0x43, 0x6e,
-	0xe6, 0xf2, 0xb9, 0x54, 0x2f, 0xa5, 0x9b, 0xc8, 0xc0, 0x1b, 0xd6, 0x89, 0x34, 0x72, 0x76, 0x2d,
-	0x03, 0xba, 0xc2, 0x96, 0xc8, 0xf5, 0xb2, 0x49, 0x3c, 0x5c, 0xd0, 0x2e, 0x2e, 0x7a, 0xd5, 0xb9,
-	0x94, 0xb1, 0x6c, 0xd2, 0x87, 0xd3, 0x9c, 0x63, 0x33, 0x2e, 0xf0, 0x68, 0x9a, 0x5a, 0x6c, 0x9c,
-	0x48, 0x94, 0x81, 0x88, 0x7e, 0x8c, 0xb7, 0x12, 0x29, 0x91, 0xe3, 0x3c, 0x27, 0xda, 0x7f, 0x8c,
-	0xb7, 0x3b, 0xb6, 0x17, 0xb6, 0x27, 0x38, 0x83, 0xb1, 0x2d, 0xcd, 0x8d, 0xf5, 0x77, 0x67, 0x54,
-	0xae, 0x05, 0xd0, 0x4f, 0x58, 0x48, 0x16, 0xb3, 0x3c, 0x49, 0x9c, 0xca, 0xad, 0x5b, 0x05, 0x6d,
-	0x2a, 0xdd, 0x52, 0x6e, 0x45, 0x8b, 0x7e, 0xca, 0x96, 0xc9, 0xdd, 0x46, 0x2e, 0xc5, 0x78, 0x78,
-	0xe5, 0xea, 0xc5, 0xd2, 0x35, 0xb4, 0x7a, 0x0d, 0x95, 0x32, 0xf4, 0x33, 0x24, 0xab, 0x55, 0x6e,
-	0xfd, 0x5e, 0xf9, 0x71, 0xfb, 0x8d, 0xa6, 0x9f, 0xe3, 0x75, 0x95, 0x80, 0xe0, 0x49, 0x82, 0x10,
-	0x2e, 0x2b, 0x68, 0x4d, 0xbf, 0x60, 0xb7, 0xc8, 0x8d, 0x26, 0x4f, 0x53, 0xee, 0x0c, 0x70, 0x2d,
-	0x5a, 0xee, 0x45, 0x0e, 0x7a, 0xcd, 0xc9, 0x3c, 0x75, 0x09, 0x18, 0xe3, 0x1e, 0xd1, 0xa7, 0x28,
-	0xe0, 0x94, 0x8b, 0x54, 0x4e, 0x68, 0xe0, 0x16, 0x49, 0x44, 0xf0, 0x8a, 0x7e, 0x79, 0xc4, 0xc3,
-	0xdb, 0xcb, 0x54, 0x58, 0xe6, 0x2b, 0xa4, 0x30, 0xe5, 0xa1, 0x6c, 0x0b, 0xb4, 0xc7, 0xbe, 0x46,
-	0x2d, 0xb3, 0xfe, 0xf6, 0x4e, 0xbb, 0xbf, 0x3f, 0x29, 0xfe, 0x37, 0xe5, 0x58, 0x4c, 0x2c, 0x9b,
-	0x78, 0x7d, 0x79, 0x62, 0xcb, 0xcb, 0xfa, 0x16, 0x17, 0xa3, 0xa1, 0xb4, 0x00, 0x97, 0x82, 0x6e,
-	0x82, 0xab, 0xe7, 0x71, 0x12, 0x95, 0x45, 0x31, 0xe5, 0x77, 0x28, 0x6a, 0xf1, 0x11, 0x72, 0xf5,
-	0xb5, 0x92, 0x87, 0x01, 0xcd, 0x45, 0xcb, 0xe3, 0xcf, 0xd8, 0x6d, 0x12, 0x1e, 0xc5, 0x0b, 0xea,
-	0x11, 0x92, 0x78, 0x44, 0x39, 0x7e, 0x4e, 0x1b, 0x49, 0x6e, 0x8a, 0x90, 0x3a, 0xbb, 0x46, 0xae,
-	0x88, 0x7e, 0xa7, 0x3d, 0xec, 0x38, 0xbd, 0xbb, 0x21, 0xba, 0xdb, 0x9d, 0xb7, 0xc3, 0xea, 0x53,
-	0xd4, 0xc0, 0xc3, 0x14, 0xed, 0x6e, 0xf7, 0x28, 0xd4, 0xc4, 0xbf, 0x87, 0x06, 0xa1, 0x56, 0x41,
-	0xd3, 0xcd, 0xfb, 0x8a, 0x50, 0xd3, 0x69, 0xf7, 0x37, 0xde, 0xe8, 0xce, 0x60, 0xaf, 0x3b, 0x14,
-	0xf8, 0x93, 0x63, 0x64, 0xae, 0xac, 0x7d, 0xf8, 0x2f, 0x9b, 0x27, 0x17, 0x8a, 0x76, 0x70, 0xe2,
-	0xbe, 0x3d, 0x88, 0x68, 0x80, 0x5f, 0xbc, 0xd2, 0xb5, 0x50, 0xe2, 0x58, 0xfd, 0xd9, 0xbb, 0x83,
-	0xb0, 0xf6, 0xe7, 0x41, 0x58, 0x7b, 0x7f, 0x10, 0xd6, 0xfe, 0x3e, 0x08, 0x6b, 0xff, 0x1c, 0x84,
-	0xc1, 0x4f, 0xa3, 0x30, 0xf8, 0x65, 0x14, 0x06, 0xbf, 0x8e, 0xc2, 0xda, 0xef, 0xa3, 0xb0, 0xf6,
-	0x6e, 0x14, 0x06, 0x7f, 0x8c, 0xc2, 0xe0, 0xfd, 0x28, 0x0c, 0x7e, 0xfe, 0x2b, 0xac, 0xb5, 0x82,
-	0xd7, 0x67, 0x7e, 0xf0, 0x34, 0x76, 0xd7, 0xd7, 0x4f, 0xf9, 0x7f, 0xf2, 0x93, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xe3, 0x3e, 0x92, 0xce, 0xd2, 0x07, 0x00, 0x00,
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x55, 0x4b, 0x77, 0x13, 0xc7,
+	0x12, 0x96, 0x78, 0xbb, 0x31, 0xa6, 0x69, 0x30, 0x18, 0x03, 0x83, 0x79, 0xdc, 0x8b, 0x2f, 0xf7,
+	0x62, 0xb8, 0x90, 0x17, 0x79, 0xd2, 0xea, 0x29, 0x49, 0x73, 0xdc, 0xd3, 0x3d, 0x74, 0xf7, 0x18,
+	0xcc, 0xa6, 0x8f, 0x6c, 0x2b, 0xc6, 0xe7, 0xc8, 0xc8, 0x47, 0x96, 0x73, 0xc2, 0x2e, 0x3f, 0x23,
+	0x3f, 0x21, 0x3f, 0x21, 0xcb, 0x2c, 0x59, 0x66, 0x99, 0x25, 0x56, 0x16, 0xd9, 0x66, 0x99, 0x65,
+	0x4e, 0xf5, 0xcc, 0xc8, 0x52, 0xd8, 0xcd, 0xd4, 0x57, 0x55, 0xfd, 0xd5, 0x57, 0x55, 0xdd, 0x64,
+	0xb6, 0x3b, 0x18, 0xf4, 0x07, 0xfb, 0x2b, 0x7b, 0x83, 0xfe, 0xb0, 0xbf, 0xf8, 0x60, 0x7b, 0x67,
+	0xf8, 0xfa, 0x60, 0x63, 0x65, 0xb3, 0xbf, 0xfb, 0x70, 0xbb, 0xbf, 0xdd, 0x7f, 0x18, 0xcc, 0x1b,
+	0x07, 0xdf, 0x86, 0xbf, 0xf0, 0x13, 0xbe, 0x0a, 0xf7, 0xdb, 0x4f, 0xc9, 0x49, 0xc0, 0x70, 0x16,
+	0x91, 0x13, 0x9b, 0xfd, 0xad, 0xee, 0x42, 0x7d, 0xa9, 0xbe, 0x3c, 0xf7, 0x98, 0xac, 0x04, 0x2b,
+	0xbc, 0x39, 0xd8, 0x35, 0xc1, 0xce, 0x28, 0x39, 0xbe, 0xbb, 0xbf, 0xbd, 0x70, 0x6c, 0xa9, 0xbe,
+	0x3c, 0x63, 0xf0, 0xf3, 0xfe, 0x1f, 0xe7, 0xc8, 0xcc, 0xd8, 0x8b, 0x9d, 0x25, 0xa7, 0x6d, 0x2e,
+	0x04, 0x58, 0x4b, 0x6b, 0x8c, 0x91, 0xb9, 0x44, 0x39, 0x30, 0x8a, 0x4b, 0x0f, 0xc6, 0x68, 0x43,
+	0xeb, 0xec, 0x12, 0xa1, 0x8a, 0xa7, 0xe0, 0xb5, 0xf1, 0x19, 0xb7, 0xf6, 0x85, 0x36, 0x31, 0x3d,
+	0x16, 0xc2, 0xd6, 0x6d, 0x23, 0xb7, 0xeb, 0xf4, 0x38, 0x3b, 0x4f, 0xce, 0x66, 0xdc, 0xf0, 0xb4,
+	0x8c, 0x39, 0x81, 0x86, 0x44, 0xad, 0x71, 0x99, 0xc4, 0x5e, 0x34, 0x5b, 0xf4, 0x24, 0xba, 0xbb,
+	0x24, 0x05, 0x9d, 0x3b, 0x7a, 0x8a, 0x5d, 0x21, 0x17, 0x2d, 0x98, 0xb5, 0x44, 0x80, 0xcf, 0x15,
+	0x5f, 0xe3, 0x89, 0xe4, 0x0d, 0x09, 0xf4, 0x34, 0xbb, 0x48, 0xce, 0xbf, 0xd2, 0x0a, 0xbc, 0xd2,
+	0xce, 0xc3, 0xcb, 0xc4, 0x3a, 0x4b, 0xcf, 0xb0, 0xab, 0x64, 0x5e, 0x6a, 0xc1, 0xa5, 0x0f, 0x90,
+	0xce, 0xac, 0x6f, 0xf2, 0x44, 0x42, 0x4c, 0x67, 0xd8, 0x2c, 0x39, 0x13, 0xe7, 0x59, 0x00, 0x28,
+	0x61, 0x84, 0x9c, 0xc2, 0xbf, 0xb8, 0x41, 0xcf, 0x16, 0x85, 0x14, 0x04, 0x40, 0xb5, 0x12, 0x05,
+	0x74, 0x96, 0x51, 0x32, 0x1b, 0x37, 0x30, 0x77, 0x99, 0xfa, 0x5c, 0x65, 0xe9, 0x0f, 0x3d, 0xec,
+	0xee, 0x0d, 0xdf, 0xd2, 0x39, 0x76, 0x8e, 0xcc, 0x60, 0x0e, 0x9b, 0x71, 0x01, 0xf4, 0x3c, 0x12,
+	0x0a, 0x9f, 0x13, 0x51, 0x94, 0x2d, 0x92, 0xcb, 0x19, 0x37, 0x2e, 0x71, 0x89, 0x56, 0xbe, 0xcd,
+	0xad, 0x77, 0xdc, 0xae, 0x7a, 0xa5, 0x5f, 0xd0, 0x0b, 0xec, 0x32, 0x61, 0x06, 0x32, 0x99, 0x08,
+	0x3e, 0x59, 0x04, 0x43, 0x41, 0x30, 0x6f, 0x89, 0xd1, 0x8b, 0xec, 0x1e, 0xb9, 0x73, 0x94, 0xa4,
+	0x0a, 0x91, 0xc0, 0x63, 0x30, 0x21, 0x32, 0x06, 0x09, 0x0e, 0xe8, 0x25, 0xe4, 0x98, 0xd9, 0x89,
+	0xf3, 0xe7, 0xd9, 0x3c, 0xb9, 0x90, 0x59, 0xcf, 0x7b, 0x83, 0x6e, 0x67, 0xeb, 0xad, 0x87, 0xef,
+	0x77, 0xf6, 0x87, 0xfb, 0xf4, 0x32, 0xd2, 0x2a, 0x74, 0x2a, 0x18, 0x4f, 0x08, 0x75, 0x05, 0x15,
+	0x97, 0xfd, 0xcd, 0x4e, 0xcf, 0x67, 0xd6, 0xeb, 0xbd, 0x7d, 0xdf, 0xec, 0xec, 0xf4, 0xba, 0x5b,
+	0x74, 0x01, 0xb3, 0xb7, 0x40, 0x25, 0x71, 0xe5, 0x7a, 0x15, 0xb3, 0x87, 0x34, 0x71, 0xc3, 0xeb,
+	0xcc, 0x95, 0xe6, 0x45, 0xb6, 0x40, 0x2e, 0x15, 0x79, 0xad, 0x68, 0x43, 0xca, 0x7d, 0xa9, 0x2e,
+	0xbd, 0x86, 0xfd, 0x31, 0x99, 0xf0, 0x2d, 0x70, 0x5e, 0xc8, 0x04, 0x94, 0xab, 0x72, 0x5d, 0xc7,
+	0xd1, 0x41, 0xa8, 0xea, 0x84, 0x01, 0x9b, 0xd1, 0x1b, 0x78, 0x42, 0x69, 0xd5, 0xab, 0x50, 0x39,
+	0x47, 0xa8, 0x35, 0x9a, 0x27, 0x07, 0xe9, 0x26, 0x1e, 0x9b, 0x82, 0x6b, 0xeb, 0x38, 0x88, 0x92,
+	0xa4, 0x99, 0x84, 0x14, 0x94, 0xa3, 0x4b, 0xe8, 0x9e, 0xdb, 0x52, 0xac, 0x52, 0x9a, 0x5b, 0xd5,
+	0x40, 0x20, 0x40, 0x6f, 0x8f, 0x5d, 0x26, 0xa4, 0xb8, 0x83, 0x74, 0x79, 0xee, 0xda, 0xa0, 0x5c,
+	0x22, 0x78, 0x50, 0xbf, 0x84, 0xee, 0x06, 0x62, 0xd0, 0x42, 0xd3, 0x44, 0xd2, 0x7f, 0xb1, 0xeb,
+	0x64, 0x21, 0xe5, 0xd6, 0x81, 0x41, 0xf5, 0x04, 0x2f, 0x50, 0x0b, 0x12, 0x84, 0xa3, 0xff, 0x66,
+	0x37, 0xc9, 0xb5, 0x23, 0x34, 0xc4, 0x29, 0x9d, 0xb7, 0xda, 0x95, 0xc3, 0x3d, 0xd4, 0xfe, 0xa8,
+	0xd3, 0x71, 0x1e, 0x3a, 0xed, 0x80, 0x2e, 0x4f, 0x03, 0xe3, 0x13, 0xe9, 0x7f, 0xb0, 0xe8, 0x69,
+	0xa0, 0x98, 0x0b, 0x7a, 0xff, 0x9f, 0x21, 0x15, 0xf0, 0xdf, 0x69, 0xc0, 0xc0, 0xf3, 0x42, 0x46,
+	0xfa, 0x3f, 0xa4, 0x57, 0x00, 0x5a, 0x95, 0x9b, 0x10, 0x96, 0xb9, 0x6a, 0xdf, 0x03, 0x76, 0x87,
+	0xdc, 0xcc, 0xd5, 0xaa, 0xd2, 0x2f, 0x94, 0x9f, 0xc8, 0xc0, 0x9b, 0xce, 0x8b, 0x34, 0xf6, 0x6e,
+	0x3d, 0x03, 0xba, 0xc2, 0x96, 0xc8, 0xf5, 0xb2, 0x48, 0x5c, 0x5c, 0x30, 0x3e, 0x29, 0x6a, 0x35,
+	0xb9, 0x52, 0x89, 0x6a, 0xd1, 0x87, 0xd3, 0x9c, 0x13, 0x3b, 0x3e, 0xe0, 0xd1, 0x34, 0xb5, 0xc4,
+	0x7a, 0x21, 0xb5, 0x85, 0x98, 0xfe, 0x1f, 0x77, 0x25, 0xd6, 0x22, 0xc7, 0x7e, 0x4e, 0x94, 0xff,
+	0x18, 0x77, 0x77, 0x6c, 0x2f, 0x6c, 0x4f, 0xb0, 0x07, 0x63, 0x5b, 0x9a, 0x5b, 0x17, 0xf6, 0xce,
+	0xea, 0xdc, 0x08, 0xa0, 0x1f, 0xb1, 0x88, 0x2c, 0x66, 0xb9, 0x94, 0x5e, 0xe7, 0xce, 0xaf, 0x81,
+	0xb1, 0x95, 0x6e, 0x29, 0x77, 0xa2, 0x4d, 0x3f, 0x66, 0xcb, 0xe4, 0x6e, 0x33, 0x57, 0x62, 0xdc,
+	0xbc, 0x72, 0xf4, 0x12, 0xe5, 0x9b, 0x46, 0xbf, 0x82, 0x4a, 0x19, 0xfa, 0x09, 0x92, 0x35, 0x3a,
+	0x77, 0x61, 0xae, 0x42, 0xbb, 0xc3, 0x44, 0xd3, 0x4f, 0x71, 0xbb, 0x4a, 0x40, 0x70, 0x29, 0x11,
+	0xc2, 0x61, 0x05, 0x63, 0xe8, 0x67, 0xec, 0x16, 0xb9, 0xd1, 0xe2, 0x69, 0xca, 0xbd, 0x05, 0x6e,
+	0x44, 0xdb, 0x3f, 0xcf, 0xc1, 0xac, 0x7b, 0x95, 0xa7, 0x5e, 0x82, 0xb5, 0xfe, 0x11, 0x7d, 0x8a,
+	0x02, 0x4e, 0xb9, 0x28, 0xed, 0x85, 0x01, 0xee, 0x90, 0x44, 0x0c, 0x2f, 0xe9, 0xe7, 0x1f, 0x78,
+	0x04, 0x7b, 0x99, 0x0a, 0x8f, 0xf9, 0x02, 0x29, 0x4c, 0x79, 0x68, 0xd7, 0x06, 0x13, 0xb0, 0x2f,
+	0x51, 0xcb, 0x6c, 0xb0, 0xb3, 0xdb, 0x19, 0xbc, 0x9d, 0x14, 0xff, 0xab, 0xb2, 0x2d, 0x36, 0x51,
+	0x2d, 0xdc, 0xbe, 0x5c, 0xba, 0x72, 0xb3, 0xbe, 0xc6, 0xc1, 0x68, 0x6a, 0x23, 0xc0, 0xa7, 0x60,
+	0x5a, 0xe0, 0x1b, 0x79, 0x22, 0xe3, 0xf2, 0x50, 0x4c, 0xf9, 0x0d, 0x8a, 0x5a, 0x5c, 0x42, 0xbe,
+	0xb1, 0x5e, 0xf2, 0xb0, 0x60, 0xb8, 0x68, 0x07, 0xfc, 0x19, 0xbb, 0x4d, 0xa2, 0x0f, 0xf1, 0x82,
+	0x7a, 0x8c, 0x24, 0x1e, 0x51, 0x8e, 0xd7, 0x69, 0x53, 0xe6, 0xb6, 0x08, 0x69, 0xe0, 0x42, 0x86,
+	0x51, 0xe0, 0xb2, 0x64, 0x63, 0xa9, 0xc0, 0x4b, 0x22, 0x4e, 0xec, 0xaa, 0x6f, 0x4a, 0xad, 0x63,
+	0x6f, 0x1d, 0x6f, 0x01, 0x8d, 0xd9, 0x35, 0x72, 0x45, 0x0c, 0xba, 0x9d, 0x61, 0xd7, 0x9b, 0xbd,
+	0x4d, 0xd1, 0xdb, 0xe9, 0xbe, 0x19, 0x56, 0xb7, 0x56, 0x13, 0x77, 0x58, 0x74, 0x7a, 0xbd, 0x0f,
+	0xa1, 0x16, 0x3e, 0x34, 0x06, 0x84, 0x5e, 0x03, 0x43, 0xb7, 0xee, 0x6b, 0x42, 0x6d, 0xb7, 0x33,
+	0xd8, 0x7c, 0x6d, 0xba, 0xfb, 0x07, 0xbd, 0xa1, 0xc0, 0xf7, 0x90, 0x91, 0xb9, 0x92, 0xe6, 0xd1,
+	0xb3, 0x37, 0x4f, 0x2e, 0x14, 0x95, 0xe3, 0x70, 0x04, 0x25, 0x20, 0xa6, 0x75, 0xbc, 0x1c, 0x4b,
+	0xd7, 0x42, 0xb4, 0x63, 0x8d, 0x67, 0xef, 0x0e, 0xa3, 0xda, 0x6f, 0x87, 0x51, 0xed, 0xfd, 0x61,
+	0x54, 0xfb, 0xf3, 0x30, 0xaa, 0xfd, 0x75, 0x18, 0xd5, 0x7f, 0x18, 0x45, 0xf5, 0x9f, 0x46, 0x51,
+	0xfd, 0xe7, 0x51, 0x54, 0xfb, 0x65, 0x14, 0xd5, 0xde, 0x8d, 0xa2, 0xfa, 0xaf, 0xa3, 0xa8, 0xfe,
+	0x7e, 0x14, 0xd5, 0x7f, 0xfc, 0x3d, 0xaa, 0xb5, 0xeb, 0xaf, 0xce, 0x7c, 0x17, 0x68, 0xec, 0x6d,
+	0x6c, 0x9c, 0x0a, 0xcf, 0xf7, 0x93, 0xbf, 0x07, 0x00, 0x06, 0xeb, 0x67, 0x1b, 0xfd, 0x07, 0x00,
+	0x00,
 }
 
 func (this *Error) Equal(that interface{}) bool {
@@ -489,7 +497,7 @@ func encodeVarintErrors(dAtA []byte, offset int, v uint64) int {
 }
 func NewPopulatedError(r randyErrors, easy bool) *Error {
 	this := &Error{}
-	this.Code = ErrorEnum([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 70, 71, 100}[r.Intn(70)])
+	this.Code = ErrorEnum([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 70, 71, 100}[r.Intn(72)])
 	this.Msg = string(randStringErrors(r))
 	if !easy && r.Intn(10) != 0 {
 		this.XXX_unrecognized = randUnrecognizedErrors(r, 3)
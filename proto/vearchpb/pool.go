@@ -38,6 +38,7 @@ func CreateRaftCommand() *RaftCommand {
 	cmd.UpdateSpace = nil
 	cmd.WriteCommand = nil
 	cmd.Type = 0
+	cmd.Trace = nil
 	return cmd
 }
 
@@ -46,6 +47,7 @@ func (c *RaftCommand) Close() error {
 	c.Type = 0
 	c.WriteCommand = nil
 	c.UpdateSpace = nil
+	c.Trace = nil
 	raftCmdPool.Put(c)
 	return nil
 }
@@ -13,6 +13,7 @@ import (
 	strings "strings"
 
 	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	proto "github.com/golang/protobuf/proto"
 )
 
@@ -71,6 +72,7 @@ const (
 	CmdType_UPDATESPACE CmdType = 1
 	CmdType_FLUSH       CmdType = 2
 	CmdType_SEARCHDEL   CmdType = 3
+	CmdType_CHECKSUM    CmdType = 4
 )
 
 var CmdType_name = map[int32]string{
@@ -78,6 +80,7 @@ var CmdType_name = map[int32]string{
 	1: "UPDATESPACE",
 	2: "FLUSH",
 	3: "SEARCHDEL",
+	4: "CHECKSUM",
 }
 
 var CmdType_value = map[string]int32{
@@ -85,6 +88,7 @@ var CmdType_value = map[string]int32{
 	"UPDATESPACE": 1,
 	"FLUSH":       2,
 	"SEARCHDEL":   3,
+	"CHECKSUM":    4,
 }
 
 func (x CmdType) String() string {
@@ -110,6 +114,7 @@ type PartitionData struct {
 	DelByQueryResponse   *DelByQueryeResponse `protobuf:"bytes,12,opt,name=del_by_query_response,json=delByQueryResponse,proto3" json:"del_by_query_response,omitempty"`
 	IndexRequest         *IndexRequest        `protobuf:"bytes,13,opt,name=index_request,json=indexRequest,proto3" json:"index_request,omitempty"`
 	IndexResponse        *IndexResponse       `protobuf:"bytes,14,opt,name=index_response,json=indexResponse,proto3" json:"index_response,omitempty"`
+	CountResponse        *CountResponse       `protobuf:"bytes,15,opt,name=count_response,json=countResponse,proto3" json:"count_response,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -232,14 +237,22 @@ func (m *DocCmd) XXX_DiscardUnknown() {
 var xxx_messageInfo_DocCmd proto.InternalMessageInfo
 
 type RaftCommand struct {
-	Type                 CmdType         `protobuf:"varint,1,opt,name=type,proto3,enum=CmdType" json:"type,omitempty"`
-	WriteCommand         *DocCmd         `protobuf:"bytes,2,opt,name=write_command,json=writeCommand,proto3" json:"write_command,omitempty"`
-	UpdateSpace          *UpdateSpace    `protobuf:"bytes,3,opt,name=update_space,json=updateSpace,proto3" json:"update_space,omitempty"`
-	SearchDelReq         *SearchRequest  `protobuf:"bytes,4,opt,name=search_del_req,json=searchDelReq,proto3" json:"search_del_req,omitempty"`
-	SearchDelResp        *SearchResponse `protobuf:"bytes,5,opt,name=search_del_resp,json=searchDelResp,proto3" json:"search_del_resp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Type          CmdType         `protobuf:"varint,1,opt,name=type,proto3,enum=CmdType" json:"type,omitempty"`
+	WriteCommand  *DocCmd         `protobuf:"bytes,2,opt,name=write_command,json=writeCommand,proto3" json:"write_command,omitempty"`
+	UpdateSpace   *UpdateSpace    `protobuf:"bytes,3,opt,name=update_space,json=updateSpace,proto3" json:"update_space,omitempty"`
+	SearchDelReq  *SearchRequest  `protobuf:"bytes,4,opt,name=search_del_req,json=searchDelReq,proto3" json:"search_del_req,omitempty"`
+	SearchDelResp *SearchResponse `protobuf:"bytes,5,opt,name=search_del_resp,json=searchDelResp,proto3" json:"search_del_resp,omitempty"`
+	// trace context of the request, the apply span is its child
+	Trace map[string]string `protobuf:"bytes,6,rep,name=trace,proto3" json:"trace,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// buckets of a checksum command, 0 only counts the documents
+	ChecksumBuckets uint32 `protobuf:"varint,7,opt,name=checksum_buckets,json=checksumBuckets,proto3" json:"checksum_buckets,omitempty"`
+	// index of the first command of a bucket checksum, 0 for the first
+	ChecksumIndex uint64 `protobuf:"varint,8,opt,name=checksum_index,json=checksumIndex,proto3" json:"checksum_index,omitempty"`
+	// first docid hashed by a checksum command
+	ChecksumStart        int32    `protobuf:"varint,9,opt,name=checksum_start,json=checksumStart,proto3" json:"checksum_start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RaftCommand) Reset()      { *m = RaftCommand{} }
@@ -321,64 +334,73 @@ func init() {
 	proto.RegisterType((*UpdateSpace)(nil), "UpdateSpace")
 	proto.RegisterType((*DocCmd)(nil), "DocCmd")
 	proto.RegisterType((*RaftCommand)(nil), "RaftCommand")
+	proto.RegisterMapType((map[string]string)(nil), "RaftCommand.TraceEntry")
 	proto.RegisterType((*SnapData)(nil), "SnapData")
 }
 
 func init() { proto.RegisterFile("raftcmd.proto", fileDescriptor_f60a713a5f09c5ba) }
 
 var fileDescriptor_f60a713a5f09c5ba = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x8e, 0xe3, 0x44,
-	0x10, 0x4e, 0x8f, 0xf3, 0x5b, 0xb6, 0x33, 0xa6, 0xb5, 0x08, 0x8b, 0x5d, 0x59, 0x56, 0x4e, 0xd1,
-	0x0a, 0x3c, 0x52, 0x00, 0x81, 0x90, 0x10, 0xcc, 0xc4, 0x66, 0x27, 0x22, 0x82, 0xa1, 0x93, 0x11,
-	0x12, 0x97, 0xc8, 0xb1, 0x7b, 0xb2, 0x16, 0x71, 0xda, 0xd3, 0x6d, 0x0f, 0xe4, 0xc6, 0x63, 0xf0,
-	0x08, 0x3c, 0x02, 0x47, 0x8e, 0x7b, 0xe4, 0xc8, 0x71, 0x13, 0x6e, 0x9c, 0x38, 0xc2, 0x0d, 0x75,
-	0xdb, 0xc9, 0x7a, 0x76, 0x10, 0xb7, 0xfa, 0xbe, 0xae, 0xaa, 0xae, 0x9f, 0xaf, 0x1b, 0x4c, 0x1e,
-	0xde, 0xe4, 0x51, 0x1a, 0x7b, 0x19, 0x67, 0x39, 0x7b, 0xdb, 0xa0, 0x9c, 0x33, 0x2e, 0x2a, 0x64,
-	0xc5, 0x61, 0x1e, 0x2e, 0x52, 0x16, 0xd3, 0x75, 0xc5, 0xbc, 0xc1, 0x59, 0x91, 0x53, 0xbe, 0x58,
-	0xf1, 0x2c, 0xaa, 0xa8, 0x77, 0x57, 0x49, 0xfe, 0xbc, 0x58, 0x7a, 0x11, 0x4b, 0xcf, 0x56, 0x6c,
-	0xc5, 0xce, 0x14, 0xbd, 0x2c, 0x6e, 0x14, 0x52, 0x40, 0x59, 0xa5, 0xfb, 0xe0, 0xcf, 0x26, 0x98,
-	0x57, 0x21, 0xcf, 0x93, 0x3c, 0x61, 0x1b, 0x3f, 0xcc, 0x43, 0xfc, 0x18, 0x9a, 0xf9, 0x36, 0xa3,
-	0x36, 0x72, 0xd1, 0xb0, 0x3f, 0xea, 0x78, 0x5f, 0x65, 0xf3, 0x6d, 0x46, 0x89, 0x22, 0xb1, 0x0b,
-	0x7a, 0x76, 0xf0, 0x9e, 0xf8, 0xf6, 0x89, 0x8b, 0x86, 0x26, 0xa9, 0x53, 0xf8, 0x09, 0xf4, 0x52,
-	0x2a, 0x44, 0xb8, 0xa2, 0x13, 0xdf, 0xd6, 0x5c, 0x34, 0xec, 0x91, 0x57, 0x04, 0x7e, 0x0c, 0xad,
-	0x24, 0xa7, 0xa9, 0xb0, 0x9b, 0xae, 0x36, 0xd4, 0x47, 0x2d, 0x6f, 0x92, 0xd3, 0x94, 0x94, 0x1c,
-	0xfe, 0x00, 0xfa, 0x82, 0x86, 0x3c, 0x7a, 0xbe, 0xe0, 0xf4, 0xb6, 0xa0, 0x22, 0xb7, 0x5b, 0x2e,
-	0x1a, 0xea, 0xa3, 0xbe, 0x37, 0x53, 0x34, 0x29, 0x59, 0x62, 0x8a, 0x3a, 0xc4, 0x1f, 0xc1, 0xe9,
-	0x31, 0x4c, 0x64, 0x6c, 0x23, 0xa8, 0xdd, 0x56, 0x71, 0xa7, 0xc7, 0xb8, 0x92, 0x26, 0x7d, 0x71,
-	0x0f, 0x63, 0x0c, 0x4d, 0x39, 0x52, 0xbb, 0xe3, 0xa2, 0xa1, 0x41, 0x94, 0x8d, 0x6d, 0xd0, 0x28,
-	0xe7, 0x76, 0x57, 0x65, 0x68, 0x7b, 0x81, 0x5c, 0x00, 0x91, 0x14, 0xfe, 0xb0, 0x76, 0x8f, 0xba,
-	0x59, 0xd8, 0x3d, 0xd5, 0xc5, 0xeb, 0xf5, 0xf5, 0xef, 0xd5, 0x27, 0xf0, 0xc7, 0x60, 0xbd, 0x56,
-	0xa0, 0xb0, 0x41, 0x45, 0x3e, 0xa8, 0xf0, 0xf4, 0x7e, 0x85, 0x02, 0xbf, 0x05, 0x9d, 0x98, 0xae,
-	0x17, 0x9b, 0x22, 0xb5, 0x75, 0x17, 0x0d, 0x5b, 0xa4, 0x1d, 0xd3, 0xf5, 0x97, 0x45, 0x8a, 0x9f,
-	0xc1, 0x9b, 0xf2, 0x60, 0xb9, 0x5d, 0xdc, 0x16, 0x94, 0x6f, 0x5f, 0xf5, 0x6e, 0xa8, 0xca, 0x1f,
-	0x79, 0x3e, 0x5d, 0x5f, 0x6c, 0xbf, 0x96, 0x67, 0xf4, 0x98, 0x1e, 0xc7, 0x47, 0xf2, 0x38, 0x84,
-	0x11, 0x98, 0xc9, 0x26, 0xa6, 0x3f, 0x1c, 0x87, 0x6e, 0xaa, 0x04, 0xa6, 0x37, 0x91, 0xec, 0xa1,
-	0x27, 0x23, 0xa9, 0x21, 0xb9, 0xa9, 0x43, 0x4c, 0x75, 0x6b, 0xbf, 0xda, 0x54, 0x15, 0x54, 0xdd,
-	0x67, 0x26, 0x75, 0x38, 0xf8, 0x04, 0xf4, 0xeb, 0x2c, 0x0e, 0x73, 0x3a, 0xcb, 0xc2, 0x88, 0xe2,
-	0x47, 0xd0, 0x52, 0x86, 0x92, 0x9a, 0x41, 0x4a, 0x80, 0x6d, 0xe8, 0xdc, 0x51, 0x2e, 0x12, 0xb6,
-	0x51, 0xf2, 0x6a, 0x92, 0x03, 0x1c, 0x6c, 0xa1, 0xed, 0xb3, 0x68, 0x9c, 0xc6, 0xff, 0xaf, 0xd1,
-	0x5a, 0x02, 0xa9, 0x3f, 0xed, 0x98, 0x40, 0xee, 0x5b, 0xac, 0x59, 0x29, 0x2b, 0x93, 0x28, 0x1b,
-	0x5b, 0xa0, 0xc5, 0x2c, 0xaa, 0x24, 0x20, 0x4d, 0xa5, 0x0a, 0x16, 0x09, 0xbb, 0xeb, 0x6a, 0x4a,
-	0x15, 0x2c, 0x12, 0x83, 0x7f, 0x10, 0xe8, 0x24, 0xbc, 0xc9, 0xc7, 0x2c, 0x4d, 0xc3, 0x4d, 0x8c,
-	0x9f, 0xdc, 0x2b, 0xa0, 0xeb, 0x8d, 0xd3, 0xb8, 0x56, 0xc1, 0x3b, 0x60, 0x7e, 0xcf, 0x93, 0x9c,
-	0x2e, 0xa2, 0xd2, 0x5d, 0x35, 0xa2, 0x8f, 0x3a, 0x5e, 0x59, 0x3e, 0x31, 0xd4, 0xe9, 0x21, 0xd7,
-	0x19, 0x18, 0x85, 0x9a, 0xca, 0x42, 0xa8, 0x69, 0x68, 0xca, 0xd9, 0xf0, 0x6a, 0xa3, 0x22, 0x7a,
-	0x51, 0x9b, 0xdb, 0xfb, 0xc7, 0x77, 0x22, 0x15, 0xc0, 0xe9, 0xad, 0xdd, 0xfc, 0xcf, 0x77, 0x62,
-	0x94, 0x5e, 0x3e, 0x5d, 0x13, 0x7a, 0x5b, 0x93, 0x6f, 0x19, 0x25, 0xb2, 0xea, 0x79, 0x3d, 0x10,
-	0xa1, 0x59, 0x8b, 0x13, 0xd9, 0x60, 0x04, 0xdd, 0xd9, 0x26, 0xcc, 0xd4, 0xe7, 0x60, 0x81, 0xf6,
-	0x1d, 0xdd, 0x56, 0x0b, 0x93, 0xa6, 0x5c, 0xe2, 0x5d, 0xb8, 0x2e, 0xa8, 0xea, 0xd1, 0x20, 0x25,
-	0x78, 0x3a, 0x85, 0x76, 0xb9, 0x13, 0x0c, 0xd0, 0x1e, 0x93, 0xe0, 0x7c, 0x1e, 0x58, 0x0d, 0x69,
-	0xfb, 0xc1, 0x34, 0x98, 0x07, 0x16, 0xc2, 0x3a, 0x74, 0x48, 0x70, 0x35, 0x3d, 0x1f, 0x07, 0xd6,
-	0x09, 0xee, 0x42, 0xf3, 0xe2, 0x7a, 0xfa, 0x85, 0xa5, 0xe1, 0x0e, 0x68, 0xcf, 0x82, 0xb9, 0xd5,
-	0x94, 0xbe, 0xb3, 0xe0, 0x9c, 0x8c, 0x2f, 0xad, 0xd6, 0xd3, 0x4f, 0xa1, 0x53, 0x0d, 0x18, 0xf7,
-	0xa0, 0xf5, 0x0d, 0x99, 0xa8, 0x6c, 0xa7, 0xa0, 0x5f, 0x5f, 0xf9, 0xe7, 0xf3, 0x60, 0x76, 0x25,
-	0xb3, 0x20, 0x79, 0xf6, 0xf9, 0xf4, 0x7a, 0x76, 0x69, 0x9d, 0x60, 0x13, 0x7a, 0x65, 0xb4, 0x1f,
-	0x4c, 0x2d, 0xed, 0xe2, 0xb3, 0x17, 0x3b, 0xa7, 0xf1, 0xfb, 0xce, 0x69, 0xbc, 0xdc, 0x39, 0x8d,
-	0xbf, 0x76, 0x4e, 0xe3, 0xef, 0x9d, 0x83, 0x7e, 0xdc, 0x3b, 0xe8, 0xe7, 0xbd, 0x83, 0x7e, 0xd9,
-	0x3b, 0x8d, 0x5f, 0xf7, 0x4e, 0xe3, 0xc5, 0xde, 0x41, 0xbf, 0xed, 0x1d, 0xf4, 0x72, 0xef, 0xa0,
-	0x9f, 0xfe, 0x70, 0x1a, 0x97, 0xe8, 0xdb, 0xee, 0x9d, 0x9a, 0x44, 0xb6, 0x5c, 0xb6, 0xd5, 0x77,
-	0xf9, 0xde, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xed, 0xf7, 0x42, 0x08, 0xa1, 0x05, 0x00, 0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4f, 0x8f, 0xe3, 0xc4,
+	0x13, 0x8d, 0xc7, 0xf9, 0x5b, 0xb6, 0x13, 0xff, 0x5a, 0xfb, 0xd3, 0x5a, 0xbb, 0x2b, 0xcb, 0x1a,
+	0x09, 0x29, 0xac, 0x58, 0x8f, 0x14, 0x40, 0x8c, 0x56, 0x42, 0x62, 0x26, 0x31, 0x3b, 0xa3, 0xcd,
+	0xc2, 0xd0, 0x49, 0x84, 0xc4, 0x25, 0x72, 0xec, 0x9e, 0x4c, 0x34, 0x71, 0xec, 0xe9, 0x6e, 0x0f,
+	0xe4, 0xc6, 0xc7, 0xe0, 0xca, 0x8d, 0x8f, 0xc0, 0x91, 0xe3, 0x1e, 0x39, 0x22, 0x71, 0xd9, 0x84,
+	0x2f, 0xc0, 0x91, 0x23, 0xea, 0xb6, 0xe3, 0x38, 0x30, 0xe2, 0x56, 0xef, 0x75, 0xbd, 0xea, 0x72,
+	0xd5, 0xeb, 0x04, 0x0c, 0xea, 0x5f, 0xf3, 0x20, 0x0a, 0xdd, 0x84, 0xc6, 0x3c, 0x7e, 0xa2, 0x13,
+	0x4a, 0x63, 0xca, 0x72, 0x64, 0x86, 0x3e, 0xf7, 0xa7, 0x51, 0x1c, 0x92, 0x65, 0xce, 0xfc, 0x8f,
+	0xc6, 0x29, 0x27, 0x74, 0x3a, 0xa7, 0x49, 0x90, 0x53, 0x2f, 0xe6, 0x0b, 0x7e, 0x93, 0xce, 0xdc,
+	0x20, 0x8e, 0x4e, 0xe6, 0xf1, 0x3c, 0x3e, 0x91, 0xf4, 0x2c, 0xbd, 0x96, 0x48, 0x02, 0x19, 0x65,
+	0xe9, 0xc7, 0x3f, 0xd6, 0xc0, 0xb8, 0xf2, 0x29, 0x5f, 0xf0, 0x45, 0xbc, 0x1a, 0xf8, 0xdc, 0x47,
+	0x4f, 0xa1, 0xca, 0xd7, 0x09, 0xb1, 0x14, 0x47, 0xe9, 0xb6, 0x7b, 0x0d, 0xf7, 0xcb, 0x64, 0xbc,
+	0x4e, 0x08, 0x96, 0x24, 0x72, 0x40, 0x4b, 0x76, 0xd9, 0x97, 0x03, 0xeb, 0xc8, 0x51, 0xba, 0x06,
+	0x2e, 0x53, 0xe8, 0x19, 0xb4, 0x22, 0xc2, 0x98, 0x3f, 0x27, 0x97, 0x03, 0x4b, 0x75, 0x94, 0x6e,
+	0x0b, 0xef, 0x09, 0xf4, 0x14, 0x6a, 0x0b, 0x4e, 0x22, 0x66, 0x55, 0x1d, 0xb5, 0xab, 0xf5, 0x6a,
+	0xee, 0x25, 0x27, 0x11, 0xce, 0x38, 0xf4, 0x31, 0xb4, 0x19, 0xf1, 0x69, 0x70, 0x33, 0xa5, 0xe4,
+	0x2e, 0x25, 0x8c, 0x5b, 0x35, 0x47, 0xe9, 0x6a, 0xbd, 0xb6, 0x3b, 0x92, 0x34, 0xce, 0x58, 0x6c,
+	0xb0, 0x32, 0x44, 0xa7, 0xd0, 0x29, 0x64, 0x2c, 0x89, 0x57, 0x8c, 0x58, 0x75, 0xa9, 0xeb, 0x14,
+	0xba, 0x8c, 0xc6, 0x6d, 0x76, 0x80, 0x11, 0x82, 0xaa, 0x18, 0xa9, 0xd5, 0x70, 0x94, 0xae, 0x8e,
+	0x65, 0x8c, 0x2c, 0x50, 0x09, 0xa5, 0x56, 0x53, 0x56, 0xa8, 0xbb, 0x9e, 0x58, 0x00, 0x16, 0x14,
+	0xfa, 0xa4, 0x74, 0x8f, 0xbc, 0x99, 0x59, 0x2d, 0x47, 0x7d, 0xa0, 0xbf, 0xf6, 0x41, 0x7f, 0x0c,
+	0xbd, 0x04, 0xf3, 0x1f, 0x0d, 0x32, 0x0b, 0x1c, 0xf5, 0xa1, 0x0e, 0x3b, 0x87, 0x1d, 0x32, 0xf4,
+	0x18, 0x1a, 0x21, 0x59, 0x4e, 0x57, 0x69, 0x64, 0x69, 0x8e, 0xd2, 0xad, 0xe1, 0x7a, 0x48, 0x96,
+	0x5f, 0xa4, 0x11, 0x7a, 0x05, 0xff, 0x17, 0x07, 0xb3, 0xf5, 0xf4, 0x2e, 0x25, 0x74, 0xbd, 0xff,
+	0x76, 0x5d, 0x76, 0xfe, 0xc8, 0x1d, 0x90, 0xe5, 0xf9, 0xfa, 0x2b, 0x71, 0x46, 0x8a, 0xf2, 0x28,
+	0x2c, 0xc8, 0x62, 0x08, 0x3d, 0x30, 0x16, 0xab, 0x90, 0x7c, 0x57, 0x0c, 0xdd, 0x90, 0x05, 0x0c,
+	0xf7, 0x52, 0xb0, 0xbb, 0x6f, 0xd2, 0x17, 0x25, 0x24, 0x36, 0xb5, 0xd3, 0xe4, 0xb7, 0xb6, 0xf3,
+	0x4d, 0xe5, 0xa2, 0xfc, 0x3e, 0x63, 0x51, 0x86, 0x42, 0x16, 0xc4, 0xe9, 0x8a, 0xef, 0x65, 0x9d,
+	0x5c, 0xd6, 0x17, 0xf4, 0x5e, 0x16, 0x94, 0xe1, 0xf1, 0xa7, 0xa0, 0x4d, 0x92, 0xd0, 0xe7, 0x64,
+	0x94, 0xf8, 0x01, 0x41, 0x8f, 0xa0, 0x26, 0x03, 0xe9, 0x50, 0x1d, 0x67, 0x00, 0x59, 0xd0, 0xb8,
+	0x27, 0x94, 0x2d, 0xe2, 0x95, 0x74, 0x65, 0x15, 0xef, 0xe0, 0xf1, 0x1a, 0xea, 0x83, 0x38, 0xe8,
+	0x47, 0xe1, 0x7f, 0x5b, 0xbb, 0x54, 0x40, 0xd8, 0x56, 0x2d, 0x0a, 0x08, 0x9b, 0xb0, 0x65, 0x9c,
+	0xb9, 0xd1, 0xc0, 0x32, 0x46, 0x26, 0xa8, 0x61, 0x1c, 0xe4, 0xce, 0x11, 0xa1, 0x34, 0x53, 0x1c,
+	0x30, 0xab, 0xe9, 0xa8, 0xd2, 0x4c, 0x71, 0xc0, 0x8e, 0x7f, 0x57, 0x41, 0xc3, 0xfe, 0x35, 0xef,
+	0xc7, 0x51, 0xe4, 0xaf, 0x42, 0xf4, 0xec, 0xa0, 0x81, 0xa6, 0xdb, 0x8f, 0xc2, 0x52, 0x07, 0x1f,
+	0x80, 0xf1, 0x2d, 0x5d, 0x70, 0x32, 0x0d, 0xb2, 0x74, 0xf9, 0x21, 0x5a, 0xaf, 0xe1, 0x66, 0xed,
+	0x63, 0x5d, 0x9e, 0xee, 0x6a, 0x9d, 0x80, 0x9e, 0xca, 0xa9, 0x4c, 0x99, 0x9c, 0x86, 0x2a, 0x93,
+	0x75, 0xb7, 0x34, 0x2a, 0xac, 0xa5, 0x7b, 0x80, 0x3e, 0x2a, 0x9e, 0x97, 0x30, 0x0e, 0x25, 0x77,
+	0x56, 0xf5, 0xc1, 0xe7, 0xa5, 0x67, 0x59, 0x03, 0xb2, 0xc4, 0xe4, 0xae, 0xe4, 0xfa, 0x4c, 0xc5,
+	0x92, 0xfc, 0x55, 0xfe, 0xcb, 0xbb, 0x46, 0x49, 0xc7, 0x12, 0xf4, 0x02, 0x6a, 0x9c, 0x8a, 0xc6,
+	0xea, 0xd2, 0xea, 0x8f, 0xdd, 0xd2, 0x20, 0xdc, 0xb1, 0x38, 0xf1, 0x56, 0x9c, 0xae, 0x71, 0x96,
+	0x85, 0xde, 0x07, 0x33, 0xb8, 0x21, 0xc1, 0x2d, 0x4b, 0xa3, 0xe9, 0x2c, 0x0d, 0x6e, 0x09, 0x67,
+	0x72, 0xba, 0x06, 0xee, 0xec, 0xf8, 0xf3, 0x8c, 0x46, 0xef, 0x41, 0xbb, 0x48, 0x95, 0x06, 0x93,
+	0xaf, 0xb5, 0x8a, 0x8d, 0x1d, 0x2b, 0x4d, 0x78, 0x90, 0xc6, 0xb8, 0x4f, 0xb9, 0xd5, 0x92, 0x2f,
+	0xa8, 0x48, 0x1b, 0x09, 0xf2, 0xc9, 0x29, 0xc0, 0xbe, 0x1b, 0xb1, 0xd7, 0x5b, 0xb2, 0x96, 0x0b,
+	0x6a, 0x61, 0x11, 0x0a, 0xbb, 0xdd, 0xfb, 0xcb, 0x94, 0xc8, 0x6d, 0xb4, 0x70, 0x06, 0x5e, 0x1e,
+	0x9d, 0x2a, 0xc7, 0x3d, 0x68, 0x8e, 0x56, 0x7e, 0x22, 0x7f, 0x35, 0x4b, 0x3a, 0xfd, 0x01, 0x9d,
+	0x9e, 0xeb, 0x9e, 0x0f, 0xa1, 0x9e, 0xb9, 0x0e, 0x01, 0xd4, 0xfb, 0xd8, 0x3b, 0x1b, 0x7b, 0x66,
+	0x45, 0xc4, 0x03, 0x6f, 0xe8, 0x8d, 0x3d, 0x53, 0x41, 0x1a, 0x34, 0xb0, 0x77, 0x35, 0x3c, 0xeb,
+	0x7b, 0xe6, 0x11, 0x6a, 0x42, 0xf5, 0x7c, 0x32, 0x7c, 0x6d, 0xaa, 0xa8, 0x01, 0xea, 0x2b, 0x6f,
+	0x6c, 0x56, 0x45, 0xee, 0xc8, 0x3b, 0xc3, 0xfd, 0x0b, 0xb3, 0xf6, 0xfc, 0x0d, 0x34, 0x72, 0x0b,
+	0xa1, 0x16, 0xd4, 0xbe, 0xc6, 0x97, 0xb2, 0x5a, 0x07, 0xb4, 0xc9, 0xd5, 0xe0, 0x6c, 0xec, 0x8d,
+	0xae, 0x44, 0x15, 0x45, 0x9c, 0x7d, 0x3e, 0x9c, 0x8c, 0x2e, 0xcc, 0x23, 0x64, 0x40, 0x2b, 0x53,
+	0x0f, 0xbc, 0xa1, 0xa9, 0x22, 0x1d, 0x9a, 0xfd, 0x0b, 0xaf, 0xff, 0x7a, 0x34, 0x79, 0x63, 0x56,
+	0xcf, 0x3f, 0x7b, 0xbb, 0xb1, 0x2b, 0xbf, 0x6d, 0xec, 0xca, 0xbb, 0x8d, 0x5d, 0xf9, 0x73, 0x63,
+	0x57, 0xfe, 0xda, 0xd8, 0xca, 0xf7, 0x5b, 0x5b, 0xf9, 0x69, 0x6b, 0x2b, 0x3f, 0x6f, 0xed, 0xca,
+	0x2f, 0x5b, 0xbb, 0xf2, 0x76, 0x6b, 0x2b, 0xbf, 0x6e, 0x6d, 0xe5, 0xdd, 0xd6, 0x56, 0x7e, 0xf8,
+	0xc3, 0xae, 0x5c, 0x28, 0xdf, 0x34, 0xef, 0xe5, 0xe6, 0x93, 0xd9, 0xac, 0x2e, 0xff, 0x55, 0x3e,
+	0xfc, 0x7b, 0x00, 0x51, 0x95, 0x8e, 0x60, 0xc8, 0x06, 0x00, 0x00,
 }
 
 func (this *PartitionData) Equal(that interface{}) bool {
@@ -457,6 +479,9 @@ func (this *PartitionData) Equal(that interface{}) bool {
 	if !this.IndexResponse.Equal(that1.IndexResponse) {
 		return false
 	}
+	if !this.CountResponse.Equal(that1.CountResponse) {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -570,6 +595,23 @@ func (this *RaftCommand) Equal(that interface{}) bool {
 	if !this.SearchDelResp.Equal(that1.SearchDelResp) {
 		return false
 	}
+	if len(this.Trace) != len(that1.Trace) {
+		return false
+	}
+	for i := range this.Trace {
+		if this.Trace[i] != that1.Trace[i] {
+			return false
+		}
+	}
+	if this.ChecksumBuckets != that1.ChecksumBuckets {
+		return false
+	}
+	if this.ChecksumIndex != that1.ChecksumIndex {
+		return false
+	}
+	if this.ChecksumStart != that1.ChecksumStart {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -629,6 +671,18 @@ func (m *PartitionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.CountResponse != nil {
+		{
+			size, err := m.CountResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftcmd(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.IndexResponse != nil {
 		{
 			size, err := m.IndexResponse.MarshalToSizedBuffer(dAtA[:i])
@@ -896,6 +950,40 @@ func (m *RaftCommand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ChecksumStart != 0 {
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.ChecksumStart))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ChecksumIndex != 0 {
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.ChecksumIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ChecksumBuckets != 0 {
+		i = encodeVarintRaftcmd(dAtA, i, uint64(m.ChecksumBuckets))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Trace) > 0 {
+		for k := range m.Trace {
+			v := m.Trace[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRaftcmd(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRaftcmd(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRaftcmd(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SearchDelResp != nil {
 		{
 			size, err := m.SearchDelResp.MarshalToSizedBuffer(dAtA[:i])
@@ -1016,7 +1104,7 @@ func NewPopulatedPartitionData(r randyRaftcmd, easy bool) *PartitionData {
 			this.Items[i] = NewPopulatedItem(r, easy)
 		}
 	}
-	if r.Intn(5) != 0 {
+	if r.Intn(5) == 0 {
 		this.SearchRequest = NewPopulatedSearchRequest(r, easy)
 	}
 	if r.Intn(5) != 0 {
@@ -1030,7 +1118,7 @@ func NewPopulatedPartitionData(r randyRaftcmd, easy bool) *PartitionData {
 	if r.Intn(5) != 0 {
 		this.Err = NewPopulatedError(r, easy)
 	}
-	if r.Intn(5) != 0 {
+	if r.Intn(5) == 0 {
 		v3 := r.Intn(5)
 		this.SearchRequests = make([]*SearchRequest, v3)
 		for i := 0; i < v3; i++ {
@@ -1057,8 +1145,11 @@ func NewPopulatedPartitionData(r randyRaftcmd, easy bool) *PartitionData {
 	if r.Intn(5) != 0 {
 		this.IndexResponse = NewPopulatedIndexResponse(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.CountResponse = NewPopulatedCountResponse(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRaftcmd(r, 15)
+		this.XXX_unrecognized = randUnrecognizedRaftcmd(r, 16)
 	}
 	return this
 }
@@ -1107,35 +1198,48 @@ func NewPopulatedDocCmd(r randyRaftcmd, easy bool) *DocCmd {
 
 func NewPopulatedRaftCommand(r randyRaftcmd, easy bool) *RaftCommand {
 	this := &RaftCommand{}
-	this.Type = CmdType([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.Type = CmdType([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
 	if r.Intn(5) != 0 {
 		this.WriteCommand = NewPopulatedDocCmd(r, easy)
 	}
 	if r.Intn(5) != 0 {
 		this.UpdateSpace = NewPopulatedUpdateSpace(r, easy)
 	}
-	if r.Intn(5) != 0 {
+	if r.Intn(5) == 0 {
 		this.SearchDelReq = NewPopulatedSearchRequest(r, easy)
 	}
 	if r.Intn(5) != 0 {
 		this.SearchDelResp = NewPopulatedSearchResponse(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(10)
+		this.Trace = make(map[string]string)
+		for i := 0; i < v9; i++ {
+			this.Trace[randStringRaftcmd(r)] = randStringRaftcmd(r)
+		}
+	}
+	this.ChecksumBuckets = uint32(r.Uint32())
+	this.ChecksumIndex = uint64(uint64(r.Uint32()))
+	this.ChecksumStart = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ChecksumStart *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRaftcmd(r, 6)
+		this.XXX_unrecognized = randUnrecognizedRaftcmd(r, 10)
 	}
 	return this
 }
 
 func NewPopulatedSnapData(r randyRaftcmd, easy bool) *SnapData {
 	this := &SnapData{}
-	v9 := r.Intn(100)
-	this.Key = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.Key[i] = byte(r.Intn(256))
-	}
 	v10 := r.Intn(100)
-	this.Value = make([]byte, v10)
+	this.Key = make([]byte, v10)
 	for i := 0; i < v10; i++ {
+		this.Key[i] = byte(r.Intn(256))
+	}
+	v11 := r.Intn(100)
+	this.Value = make([]byte, v11)
+	for i := 0; i < v11; i++ {
 		this.Value[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1163,9 +1267,9 @@ func randUTF8RuneRaftcmd(r randyRaftcmd) rune {
 	return rune(ru + 61)
 }
 func randStringRaftcmd(r randyRaftcmd) string {
-	v11 := r.Intn(100)
-	tmps := make([]rune, v11)
-	for i := 0; i < v11; i++ {
+	v12 := r.Intn(100)
+	tmps := make([]rune, v12)
+	for i := 0; i < v12; i++ {
 		tmps[i] = randUTF8RuneRaftcmd(r)
 	}
 	return string(tmps)
@@ -1187,11 +1291,11 @@ func randFieldRaftcmd(dAtA []byte, r randyRaftcmd, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(key))
-		v12 := r.Int63()
+		v13 := r.Int63()
 		if r.Intn(2) == 0 {
-			v12 *= -1
+			v13 *= -1
 		}
-		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(v12))
+		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(v13))
 	case 1:
 		dAtA = encodeVarintPopulateRaftcmd(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1281,6 +1385,10 @@ func (m *PartitionData) Size() (n int) {
 		l = m.IndexResponse.Size()
 		n += 1 + l + sovRaftcmd(uint64(l))
 	}
+	if m.CountResponse != nil {
+		l = m.CountResponse.Size()
+		n += 1 + l + sovRaftcmd(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1362,6 +1470,23 @@ func (m *RaftCommand) Size() (n int) {
 		l = m.SearchDelResp.Size()
 		n += 1 + l + sovRaftcmd(uint64(l))
 	}
+	if len(m.Trace) > 0 {
+		for k, v := range m.Trace {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRaftcmd(uint64(len(k))) + 1 + len(v) + sovRaftcmd(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRaftcmd(uint64(mapEntrySize))
+		}
+	}
+	if m.ChecksumBuckets != 0 {
+		n += 1 + sovRaftcmd(uint64(m.ChecksumBuckets))
+	}
+	if m.ChecksumIndex != 0 {
+		n += 1 + sovRaftcmd(uint64(m.ChecksumIndex))
+	}
+	if m.ChecksumStart != 0 {
+		n += 1 + sovRaftcmd(uint64(m.ChecksumStart))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1428,6 +1553,7 @@ func (this *PartitionData) String() string {
 		`DelByQueryResponse:` + strings.Replace(fmt.Sprintf("%v", this.DelByQueryResponse), "DelByQueryeResponse", "DelByQueryeResponse", 1) + `,`,
 		`IndexRequest:` + strings.Replace(fmt.Sprintf("%v", this.IndexRequest), "IndexRequest", "IndexRequest", 1) + `,`,
 		`IndexResponse:` + strings.Replace(fmt.Sprintf("%v", this.IndexResponse), "IndexResponse", "IndexResponse", 1) + `,`,
+		`CountResponse:` + strings.Replace(fmt.Sprintf("%v", this.CountResponse), "CountResponse", "CountResponse", 1) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -1464,12 +1590,26 @@ func (this *RaftCommand) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTrace := make([]string, 0, len(this.Trace))
+	for k, _ := range this.Trace {
+		keysForTrace = append(keysForTrace, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTrace)
+	mapStringForTrace := "map[string]string{"
+	for _, k := range keysForTrace {
+		mapStringForTrace += fmt.Sprintf("%v: %v,", k, this.Trace[k])
+	}
+	mapStringForTrace += "}"
 	s := strings.Join([]string{`&RaftCommand{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`WriteCommand:` + strings.Replace(this.WriteCommand.String(), "DocCmd", "DocCmd", 1) + `,`,
 		`UpdateSpace:` + strings.Replace(this.UpdateSpace.String(), "UpdateSpace", "UpdateSpace", 1) + `,`,
 		`SearchDelReq:` + strings.Replace(fmt.Sprintf("%v", this.SearchDelReq), "SearchRequest", "SearchRequest", 1) + `,`,
 		`SearchDelResp:` + strings.Replace(fmt.Sprintf("%v", this.SearchDelResp), "SearchResponse", "SearchResponse", 1) + `,`,
+		`Trace:` + mapStringForTrace + `,`,
+		`ChecksumBuckets:` + fmt.Sprintf("%v", this.ChecksumBuckets) + `,`,
+		`ChecksumIndex:` + fmt.Sprintf("%v", this.ChecksumIndex) + `,`,
+		`ChecksumStart:` + fmt.Sprintf("%v", this.ChecksumStart) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -1965,6 +2105,42 @@ func (m *PartitionData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CountResponse == nil {
+				m.CountResponse = &CountResponse{}
+			}
+			if err := m.CountResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmd(dAtA[iNdEx:])
@@ -2457,6 +2633,190 @@ func (m *RaftCommand) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftcmd
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trace == nil {
+				m.Trace = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftcmd
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftcmd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRaftcmd
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRaftcmd
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftcmd
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRaftcmd
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRaftcmd
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftcmd(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftcmd
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Trace[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumBuckets", wireType)
+			}
+			m.ChecksumBuckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumBuckets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumIndex", wireType)
+			}
+			m.ChecksumIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumStart", wireType)
+			}
+			m.ChecksumStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftcmd
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksumStart |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftcmd(dAtA[iNdEx:])
@@ -33,6 +33,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type FilterClause_Type int32
+
+const (
+	FilterClause_TERM         FilterClause_Type = 0
+	FilterClause_RANGE        FilterClause_Type = 1
+	FilterClause_EXISTS       FilterClause_Type = 2
+	FilterClause_MISSING      FilterClause_Type = 3
+	FilterClause_PREFIX       FilterClause_Type = 4
+	FilterClause_IN           FilterClause_Type = 5
+	FilterClause_CONTAINS_ALL FilterClause_Type = 6
+	FilterClause_CONTAINS_ANY FilterClause_Type = 7
+)
+
+var FilterClause_Type_name = map[int32]string{
+	0: "TERM",
+	1: "RANGE",
+	2: "EXISTS",
+	3: "MISSING",
+	4: "PREFIX",
+	5: "IN",
+	6: "CONTAINS_ALL",
+	7: "CONTAINS_ANY",
+}
+
+var FilterClause_Type_value = map[string]int32{
+	"TERM":         0,
+	"RANGE":        1,
+	"EXISTS":       2,
+	"MISSING":      3,
+	"PREFIX":       4,
+	"IN":           5,
+	"CONTAINS_ALL": 6,
+	"CONTAINS_ANY": 7,
+}
+
+func (x FilterClause_Type) String() string {
+	return proto.EnumName(FilterClause_Type_name, int32(x))
+}
+
+func (FilterClause_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{35, 0}
+}
+
 type RetrievalParameters_DistanceMetricType int32
 
 const (
@@ -55,7 +98,7 @@ func (x RetrievalParameters_DistanceMetricType) String() string {
 }
 
 func (RetrievalParameters_DistanceMetricType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{23, 0}
+	return fileDescriptor_535779cc1a17303a, []int{39, 0}
 }
 
 type RequestHead struct {
@@ -576,25 +619,28 @@ func (m *IndexRequest) GetDescribe() int64 {
 	return 0
 }
 
-type GetResponse struct {
-	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Items                []*Item       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// QueryByIDsFeatureRequest searches with the vectors of the documents, the
+// vec_fields of the search request without value select the vector fields,
+// all vector fields are summed when it has none.
+type QueryByIDsFeatureRequest struct {
+	SearchRequest        *SearchRequest `protobuf:"bytes,1,opt,name=search_request,json=searchRequest,proto3" json:"search_request,omitempty"`
+	PrimaryKeys          []string       `protobuf:"bytes,2,rep,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetResponse) Reset()      { *m = GetResponse{} }
-func (*GetResponse) ProtoMessage() {}
-func (*GetResponse) Descriptor() ([]byte, []int) {
+func (m *QueryByIDsFeatureRequest) Reset()      { *m = QueryByIDsFeatureRequest{} }
+func (*QueryByIDsFeatureRequest) ProtoMessage() {}
+func (*QueryByIDsFeatureRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_535779cc1a17303a, []int{10}
 }
-func (m *GetResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryByIDsFeatureRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryByIDsFeatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryByIDsFeatureRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -604,51 +650,53 @@ func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetResponse.Merge(m, src)
+func (m *QueryByIDsFeatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryByIDsFeatureRequest.Merge(m, src)
 }
-func (m *GetResponse) XXX_Size() int {
+func (m *QueryByIDsFeatureRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetResponse.DiscardUnknown(m)
+func (m *QueryByIDsFeatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryByIDsFeatureRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryByIDsFeatureRequest proto.InternalMessageInfo
 
-func (m *GetResponse) GetHead() *ResponseHead {
+func (m *QueryByIDsFeatureRequest) GetSearchRequest() *SearchRequest {
 	if m != nil {
-		return m.Head
+		return m.SearchRequest
 	}
 	return nil
 }
 
-func (m *GetResponse) GetItems() []*Item {
+func (m *QueryByIDsFeatureRequest) GetPrimaryKeys() []string {
 	if m != nil {
-		return m.Items
+		return m.PrimaryKeys
 	}
 	return nil
 }
 
-type AddResponse struct {
-	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	PrimaryKey           string        `protobuf:"bytes,4,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type DbInfo struct {
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// ips of the ps the db is bound to
+	Ps                   []string `protobuf:"bytes,3,rep,name=ps,proto3" json:"ps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddResponse) Reset()      { *m = AddResponse{} }
-func (*AddResponse) ProtoMessage() {}
-func (*AddResponse) Descriptor() ([]byte, []int) {
+func (m *DbInfo) Reset()      { *m = DbInfo{} }
+func (*DbInfo) ProtoMessage() {}
+func (*DbInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_535779cc1a17303a, []int{11}
 }
-func (m *AddResponse) XXX_Unmarshal(b []byte) error {
+func (m *DbInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DbInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DbInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -658,50 +706,41 @@ func (m *AddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AddResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddResponse.Merge(m, src)
+func (m *DbInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DbInfo.Merge(m, src)
 }
-func (m *AddResponse) XXX_Size() int {
+func (m *DbInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AddResponse proto.InternalMessageInfo
-
-func (m *AddResponse) GetHead() *ResponseHead {
-	if m != nil {
-		return m.Head
-	}
-	return nil
+func (m *DbInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DbInfo.DiscardUnknown(m)
 }
 
-func (m *AddResponse) GetPrimaryKey() string {
-	if m != nil {
-		return m.PrimaryKey
-	}
-	return ""
-}
+var xxx_messageInfo_DbInfo proto.InternalMessageInfo
 
-type UpdateResponse struct {
-	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// DbRequest names the db by db.name or by the db_name of the head, create
+// takes the db and modify adds ps_ip to the ps of the db or removes it.
+type DbRequest struct {
+	Head                 *RequestHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Db                   *DbInfo      `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+	PsIp                 string       `protobuf:"bytes,3,opt,name=ps_ip,json=psIp,proto3" json:"ps_ip,omitempty"`
+	RemovePs             bool         `protobuf:"varint,4,opt,name=remove_ps,json=removePs,proto3" json:"remove_ps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *UpdateResponse) Reset()      { *m = UpdateResponse{} }
-func (*UpdateResponse) ProtoMessage() {}
-func (*UpdateResponse) Descriptor() ([]byte, []int) {
+func (m *DbRequest) Reset()      { *m = DbRequest{} }
+func (*DbRequest) ProtoMessage() {}
+func (*DbRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_535779cc1a17303a, []int{12}
 }
-func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *DbRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DbRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DbRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -711,44 +750,65 @@ func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *UpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateResponse.Merge(m, src)
+func (m *DbRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DbRequest.Merge(m, src)
 }
-func (m *UpdateResponse) XXX_Size() int {
+func (m *DbRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateResponse.DiscardUnknown(m)
+func (m *DbRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DbRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_DbRequest proto.InternalMessageInfo
 
-func (m *UpdateResponse) GetHead() *ResponseHead {
+func (m *DbRequest) GetHead() *RequestHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-type DeleteResponse struct {
+func (m *DbRequest) GetDb() *DbInfo {
+	if m != nil {
+		return m.Db
+	}
+	return nil
+}
+
+func (m *DbRequest) GetPsIp() string {
+	if m != nil {
+		return m.PsIp
+	}
+	return ""
+}
+
+func (m *DbRequest) GetRemovePs() bool {
+	if m != nil {
+		return m.RemovePs
+	}
+	return false
+}
+
+type DbResponse struct {
 	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Items                []*Item       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Dbs                  []*DbInfo     `protobuf:"bytes,2,rep,name=dbs,proto3" json:"dbs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *DeleteResponse) Reset()      { *m = DeleteResponse{} }
-func (*DeleteResponse) ProtoMessage() {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
+func (m *DbResponse) Reset()      { *m = DbResponse{} }
+func (*DbResponse) ProtoMessage() {}
+func (*DbResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_535779cc1a17303a, []int{13}
 }
-func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+func (m *DbResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DbResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DbResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,51 +818,63 @@ func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteResponse.Merge(m, src)
+func (m *DbResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DbResponse.Merge(m, src)
 }
-func (m *DeleteResponse) XXX_Size() int {
+func (m *DbResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+func (m *DbResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DbResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_DbResponse proto.InternalMessageInfo
 
-func (m *DeleteResponse) GetHead() *ResponseHead {
+func (m *DbResponse) GetHead() *ResponseHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-func (m *DeleteResponse) GetItems() []*Item {
+func (m *DbResponse) GetDbs() []*DbInfo {
 	if m != nil {
-		return m.Items
+		return m.Dbs
 	}
 	return nil
 }
 
-type BulkResponse struct {
-	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Items                []*Item       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// SpaceField is a field of the space schema, type is a type of the space
+// API: string, keyword, integer, long, float, double, date, bool or vector.
+// index is sent as is on create and a vector field is reported indexed
+// unless it was created with index false.
+type SpaceField struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Index     bool   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Array     bool   `protobuf:"varint,4,opt,name=array,proto3" json:"array,omitempty"`
+	Dimension int32  `protobuf:"varint,5,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	Format    string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	StoreType string `protobuf:"bytes,7,opt,name=store_type,json=storeType,proto3" json:"store_type,omitempty"`
+	// json
+	StoreParam           string   `protobuf:"bytes,8,opt,name=store_param,json=storeParam,proto3" json:"store_param,omitempty"`
+	ModelId              string   `protobuf:"bytes,9,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BulkResponse) Reset()      { *m = BulkResponse{} }
-func (*BulkResponse) ProtoMessage() {}
-func (*BulkResponse) Descriptor() ([]byte, []int) {
+func (m *SpaceField) Reset()      { *m = SpaceField{} }
+func (*SpaceField) ProtoMessage() {}
+func (*SpaceField) Descriptor() ([]byte, []int) {
 	return fileDescriptor_535779cc1a17303a, []int{14}
 }
-func (m *BulkResponse) XXX_Unmarshal(b []byte) error {
+func (m *SpaceField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpaceField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BulkResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpaceField.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -812,51 +884,85 @@ func (m *BulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *BulkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BulkResponse.Merge(m, src)
+func (m *SpaceField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceField.Merge(m, src)
 }
-func (m *BulkResponse) XXX_Size() int {
+func (m *SpaceField) XXX_Size() int {
 	return m.Size()
 }
-func (m *BulkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BulkResponse.DiscardUnknown(m)
+func (m *SpaceField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceField.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BulkResponse proto.InternalMessageInfo
+var xxx_messageInfo_SpaceField proto.InternalMessageInfo
 
-func (m *BulkResponse) GetHead() *ResponseHead {
-	if m != nil {
-		return m.Head
-	}
-	return nil
+type SpaceEngine struct {
+	IndexSize      int64    `protobuf:"varint,1,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
+	MetricType     string   `protobuf:"bytes,2,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	RetrievalType  string   `protobuf:"bytes,3,opt,name=retrieval_type,json=retrievalType,proto3" json:"retrieval_type,omitempty"`
+	RetrievalTypes []string `protobuf:"bytes,4,rep,name=retrieval_types,json=retrievalTypes,proto3" json:"retrieval_types,omitempty"`
+	// json of the retrieval_param and of the retrieval_params
+	RetrievalParam  string `protobuf:"bytes,5,opt,name=retrieval_param,json=retrievalParam,proto3" json:"retrieval_param,omitempty"`
+	RetrievalParams string `protobuf:"bytes,6,opt,name=retrieval_params,json=retrievalParams,proto3" json:"retrieval_params,omitempty"`
+	// string or long
+	IdType               string   `protobuf:"bytes,7,opt,name=id_type,json=idType,proto3" json:"id_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BulkResponse) GetItems() []*Item {
-	if m != nil {
-		return m.Items
+func (m *SpaceEngine) Reset()      { *m = SpaceEngine{} }
+func (*SpaceEngine) ProtoMessage() {}
+func (*SpaceEngine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{15}
+}
+func (m *SpaceEngine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpaceEngine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpaceEngine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SpaceEngine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceEngine.Merge(m, src)
+}
+func (m *SpaceEngine) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpaceEngine) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceEngine.DiscardUnknown(m)
 }
 
-type ForceMergeResponse struct {
-	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Shards               *SearchStatus `protobuf:"bytes,2,opt,name=shards,proto3" json:"shards,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+var xxx_messageInfo_SpaceEngine proto.InternalMessageInfo
+
+type SpacePartition struct {
+	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeaderId             uint64   `protobuf:"varint,2,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"`
+	Replicas             []uint64 `protobuf:"varint,3,rep,packed,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForceMergeResponse) Reset()      { *m = ForceMergeResponse{} }
-func (*ForceMergeResponse) ProtoMessage() {}
-func (*ForceMergeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{15}
+func (m *SpacePartition) Reset()      { *m = SpacePartition{} }
+func (*SpacePartition) ProtoMessage() {}
+func (*SpacePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{16}
 }
-func (m *ForceMergeResponse) XXX_Unmarshal(b []byte) error {
+func (m *SpacePartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ForceMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpacePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ForceMergeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpacePartition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -866,53 +972,88 @@ func (m *ForceMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ForceMergeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForceMergeResponse.Merge(m, src)
+func (m *SpacePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpacePartition.Merge(m, src)
 }
-func (m *ForceMergeResponse) XXX_Size() int {
+func (m *SpacePartition) XXX_Size() int {
 	return m.Size()
 }
-func (m *ForceMergeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForceMergeResponse.DiscardUnknown(m)
+func (m *SpacePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpacePartition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForceMergeResponse proto.InternalMessageInfo
+var xxx_messageInfo_SpacePartition proto.InternalMessageInfo
 
-func (m *ForceMergeResponse) GetHead() *ResponseHead {
-	if m != nil {
-		return m.Head
-	}
-	return nil
+// SpaceInfo is a space, id, db_id, enabled and partitions are only set by
+// the router.
+type SpaceInfo struct {
+	Id                   int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DbId                 int64             `protobuf:"varint,3,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	PartitionNum         int32             `protobuf:"varint,4,opt,name=partition_num,json=partitionNum,proto3" json:"partition_num,omitempty"`
+	ReplicaNum           int32             `protobuf:"varint,5,opt,name=replica_num,json=replicaNum,proto3" json:"replica_num,omitempty"`
+	Fields               []*SpaceField     `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Engine               *SpaceEngine      `protobuf:"bytes,7,opt,name=engine,proto3" json:"engine,omitempty"`
+	Enabled              bool              `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Partitions           []*SpacePartition `protobuf:"bytes,9,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ForceMergeResponse) GetShards() *SearchStatus {
-	if m != nil {
-		return m.Shards
+func (m *SpaceInfo) Reset()      { *m = SpaceInfo{} }
+func (*SpaceInfo) ProtoMessage() {}
+func (*SpaceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{17}
+}
+func (m *SpaceInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpaceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpaceInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *SpaceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceInfo.Merge(m, src)
+}
+func (m *SpaceInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpaceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceInfo.DiscardUnknown(m)
 }
 
-type DelByQueryeResponse struct {
-	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	DelNum               int32         `protobuf:"varint,2,opt,name=DelNum,proto3" json:"DelNum,omitempty"`
-	IdsStr               []string      `protobuf:"bytes,3,rep,name=ids_str,json=idsStr,proto3" json:"ids_str,omitempty"`
-	IdsLong              []int64       `protobuf:"varint,4,rep,packed,name=ids_long,json=idsLong,proto3" json:"ids_long,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+var xxx_messageInfo_SpaceInfo proto.InternalMessageInfo
+
+// SpaceRequest names the db and the space in the head, create and update
+// take the space, an update changes the fields and the name.
+type SpaceRequest struct {
+	Head                 *RequestHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Space                *SpaceInfo   `protobuf:"bytes,2,opt,name=space,proto3" json:"space,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *DelByQueryeResponse) Reset()      { *m = DelByQueryeResponse{} }
-func (*DelByQueryeResponse) ProtoMessage() {}
-func (*DelByQueryeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{16}
+func (m *SpaceRequest) Reset()      { *m = SpaceRequest{} }
+func (*SpaceRequest) ProtoMessage() {}
+func (*SpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{18}
 }
-func (m *DelByQueryeResponse) XXX_Unmarshal(b []byte) error {
+func (m *SpaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelByQueryeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelByQueryeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpaceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -922,65 +1063,51 @@ func (m *DelByQueryeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DelByQueryeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelByQueryeResponse.Merge(m, src)
+func (m *SpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceRequest.Merge(m, src)
 }
-func (m *DelByQueryeResponse) XXX_Size() int {
+func (m *SpaceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelByQueryeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelByQueryeResponse.DiscardUnknown(m)
+func (m *SpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelByQueryeResponse proto.InternalMessageInfo
+var xxx_messageInfo_SpaceRequest proto.InternalMessageInfo
 
-func (m *DelByQueryeResponse) GetHead() *ResponseHead {
+func (m *SpaceRequest) GetHead() *RequestHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-func (m *DelByQueryeResponse) GetDelNum() int32 {
+func (m *SpaceRequest) GetSpace() *SpaceInfo {
 	if m != nil {
-		return m.DelNum
-	}
-	return 0
-}
-
-func (m *DelByQueryeResponse) GetIdsStr() []string {
-	if m != nil {
-		return m.IdsStr
-	}
-	return nil
-}
-
-func (m *DelByQueryeResponse) GetIdsLong() []int64 {
-	if m != nil {
-		return m.IdsLong
+		return m.Space
 	}
 	return nil
 }
 
-type FlushResponse struct {
+type SpaceResponse struct {
 	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Shards               *SearchStatus `protobuf:"bytes,2,opt,name=shards,proto3" json:"shards,omitempty"`
+	Spaces               []*SpaceInfo  `protobuf:"bytes,2,rep,name=spaces,proto3" json:"spaces,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *FlushResponse) Reset()      { *m = FlushResponse{} }
-func (*FlushResponse) ProtoMessage() {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{17}
+func (m *SpaceResponse) Reset()      { *m = SpaceResponse{} }
+func (*SpaceResponse) ProtoMessage() {}
+func (*SpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{19}
 }
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+func (m *SpaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpaceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -990,51 +1117,52 @@ func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
+func (m *SpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceResponse.Merge(m, src)
 }
-func (m *FlushResponse) XXX_Size() int {
+func (m *SpaceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+func (m *SpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
+var xxx_messageInfo_SpaceResponse proto.InternalMessageInfo
 
-func (m *FlushResponse) GetHead() *ResponseHead {
+func (m *SpaceResponse) GetHead() *ResponseHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-func (m *FlushResponse) GetShards() *SearchStatus {
+func (m *SpaceResponse) GetSpaces() []*SpaceInfo {
 	if m != nil {
-		return m.Shards
+		return m.Spaces
 	}
 	return nil
 }
 
-type IndexResponse struct {
+// ClusterResponse is a cluster report of the master, body is its json.
+type ClusterResponse struct {
 	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Shards               *SearchStatus `protobuf:"bytes,2,opt,name=shards,proto3" json:"shards,omitempty"`
+	Body                 []byte        `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *IndexResponse) Reset()      { *m = IndexResponse{} }
-func (*IndexResponse) ProtoMessage() {}
-func (*IndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{18}
+func (m *ClusterResponse) Reset()      { *m = ClusterResponse{} }
+func (*ClusterResponse) ProtoMessage() {}
+func (*ClusterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{20}
 }
-func (m *IndexResponse) XXX_Unmarshal(b []byte) error {
+func (m *ClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IndexResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1044,52 +1172,51 @@ func (m *IndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *IndexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexResponse.Merge(m, src)
+func (m *ClusterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResponse.Merge(m, src)
 }
-func (m *IndexResponse) XXX_Size() int {
+func (m *ClusterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *IndexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexResponse.DiscardUnknown(m)
+func (m *ClusterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexResponse proto.InternalMessageInfo
+var xxx_messageInfo_ClusterResponse proto.InternalMessageInfo
 
-func (m *IndexResponse) GetHead() *ResponseHead {
+func (m *ClusterResponse) GetHead() *ResponseHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-func (m *IndexResponse) GetShards() *SearchStatus {
+func (m *ClusterResponse) GetBody() []byte {
 	if m != nil {
-		return m.Shards
+		return m.Body
 	}
 	return nil
 }
 
-type TermFilter struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	IsUnion              int32    `protobuf:"varint,3,opt,name=is_union,json=isUnion,proto3" json:"is_union,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Items                []*Item       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *TermFilter) Reset()      { *m = TermFilter{} }
-func (*TermFilter) ProtoMessage() {}
-func (*TermFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{19}
+func (m *GetResponse) Reset()      { *m = GetResponse{} }
+func (*GetResponse) ProtoMessage() {}
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{21}
 }
-func (m *TermFilter) XXX_Unmarshal(b []byte) error {
+func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TermFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TermFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1099,40 +1226,51 @@ func (m *TermFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TermFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TermFilter.Merge(m, src)
+func (m *GetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResponse.Merge(m, src)
 }
-func (m *TermFilter) XXX_Size() int {
+func (m *GetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TermFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_TermFilter.DiscardUnknown(m)
+func (m *GetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TermFilter proto.InternalMessageInfo
+var xxx_messageInfo_GetResponse proto.InternalMessageInfo
 
-type RangeFilter struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	LowerValue           []byte   `protobuf:"bytes,2,opt,name=lower_value,json=lowerValue,proto3" json:"lower_value,omitempty"`
-	UpperValue           []byte   `protobuf:"bytes,3,opt,name=upper_value,json=upperValue,proto3" json:"upper_value,omitempty"`
-	IncludeLower         bool     `protobuf:"varint,4,opt,name=include_lower,json=includeLower,proto3" json:"include_lower,omitempty"`
-	IncludeUpper         bool     `protobuf:"varint,5,opt,name=include_upper,json=includeUpper,proto3" json:"include_upper,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetResponse) GetHead() *ResponseHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
 }
 
-func (m *RangeFilter) Reset()      { *m = RangeFilter{} }
-func (*RangeFilter) ProtoMessage() {}
-func (*RangeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{20}
+func (m *GetResponse) GetItems() []*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
 }
-func (m *RangeFilter) XXX_Unmarshal(b []byte) error {
+
+type AddResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	PrimaryKey           string        `protobuf:"bytes,4,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AddResponse) Reset()      { *m = AddResponse{} }
+func (*AddResponse) ProtoMessage() {}
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{22}
+}
+func (m *AddResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RangeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RangeFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AddResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1142,37 +1280,50 @@ func (m *RangeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RangeFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RangeFilter.Merge(m, src)
+func (m *AddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddResponse.Merge(m, src)
 }
-func (m *RangeFilter) XXX_Size() int {
+func (m *AddResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RangeFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_RangeFilter.DiscardUnknown(m)
+func (m *AddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RangeFilter proto.InternalMessageInfo
+var xxx_messageInfo_AddResponse proto.InternalMessageInfo
 
-type SortField struct {
-	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Type                 bool     `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AddResponse) GetHead() *ResponseHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
 }
 
-func (m *SortField) Reset()      { *m = SortField{} }
-func (*SortField) ProtoMessage() {}
-func (*SortField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{21}
+func (m *AddResponse) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
 }
-func (m *SortField) XXX_Unmarshal(b []byte) error {
+
+type UpdateResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpdateResponse) Reset()      { *m = UpdateResponse{} }
+func (*UpdateResponse) ProtoMessage() {}
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{23}
+}
+func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SortField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SortField.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1182,43 +1333,44 @@ func (m *SortField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SortField) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SortField.Merge(m, src)
+func (m *UpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateResponse.Merge(m, src)
 }
-func (m *SortField) XXX_Size() int {
+func (m *UpdateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SortField) XXX_DiscardUnknown() {
-	xxx_messageInfo_SortField.DiscardUnknown(m)
+func (m *UpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SortField proto.InternalMessageInfo
+var xxx_messageInfo_UpdateResponse proto.InternalMessageInfo
 
-type VectorQuery struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	MinScore             float64  `protobuf:"fixed64,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
-	MaxScore             float64  `protobuf:"fixed64,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	Boost                float64  `protobuf:"fixed64,5,opt,name=boost,proto3" json:"boost,omitempty"`
-	HasBoost             int32    `protobuf:"varint,6,opt,name=has_boost,json=hasBoost,proto3" json:"has_boost,omitempty"`
-	Format               string   `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
-	RetrievalType        string   `protobuf:"bytes,8,opt,name=retrieval_type,json=retrievalType,proto3" json:"retrieval_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UpdateResponse) GetHead() *ResponseHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
 }
 
-func (m *VectorQuery) Reset()      { *m = VectorQuery{} }
-func (*VectorQuery) ProtoMessage() {}
-func (*VectorQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{22}
+type DeleteResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Items                []*Item       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *VectorQuery) XXX_Unmarshal(b []byte) error {
+
+func (m *DeleteResponse) Reset()      { *m = DeleteResponse{} }
+func (*DeleteResponse) ProtoMessage() {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{24}
+}
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VectorQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VectorQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1228,37 +1380,51 @@ func (m *VectorQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VectorQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorQuery.Merge(m, src)
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
 }
-func (m *VectorQuery) XXX_Size() int {
+func (m *DeleteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *VectorQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorQuery.DiscardUnknown(m)
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorQuery proto.InternalMessageInfo
-
-type RetrievalParameters struct {
-	MetricType           RetrievalParameters_DistanceMetricType `protobuf:"varint,1,opt,name=metric_type,json=metricType,proto3,enum=RetrievalParameters_DistanceMetricType" json:"metric_type,omitempty"`
-	Nprobe               int32                                  `protobuf:"varint,2,opt,name=nprobe,proto3" json:"nprobe,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
-	XXX_unrecognized     []byte                                 `json:"-"`
-	XXX_sizecache        int32                                  `json:"-"`
-}
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
-func (m *RetrievalParameters) Reset()      { *m = RetrievalParameters{} }
-func (*RetrievalParameters) ProtoMessage() {}
-func (*RetrievalParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{23}
+func (m *DeleteResponse) GetHead() *ResponseHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
 }
-func (m *RetrievalParameters) XXX_Unmarshal(b []byte) error {
+
+func (m *DeleteResponse) GetItems() []*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type BulkResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Items                []*Item       `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BulkResponse) Reset()      { *m = BulkResponse{} }
+func (*BulkResponse) ProtoMessage() {}
+func (*BulkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{25}
+}
+func (m *BulkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetrievalParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BulkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetrievalParameters.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BulkResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1268,53 +1434,55 @@ func (m *RetrievalParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *RetrievalParameters) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetrievalParameters.Merge(m, src)
+func (m *BulkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkResponse.Merge(m, src)
 }
-func (m *RetrievalParameters) XXX_Size() int {
+func (m *BulkResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetrievalParameters) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetrievalParameters.DiscardUnknown(m)
+func (m *BulkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetrievalParameters proto.InternalMessageInfo
+var xxx_messageInfo_BulkResponse proto.InternalMessageInfo
 
-type SearchRequest struct {
-	Head                 *RequestHead      `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	ReqNum               int32             `protobuf:"varint,2,opt,name=req_num,json=reqNum,proto3" json:"req_num,omitempty"`
-	TopN                 int32             `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
-	IsBruteSearch        int32             `protobuf:"varint,4,opt,name=is_brute_search,json=isBruteSearch,proto3" json:"is_brute_search,omitempty"`
-	VecFields            []*VectorQuery    `protobuf:"bytes,5,rep,name=vec_fields,json=vecFields,proto3" json:"vec_fields,omitempty"`
-	Fields               []string          `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
-	RangeFilters         []*RangeFilter    `protobuf:"bytes,7,rep,name=range_filters,json=rangeFilters,proto3" json:"range_filters,omitempty"`
-	TermFilters          []*TermFilter     `protobuf:"bytes,8,rep,name=term_filters,json=termFilters,proto3" json:"term_filters,omitempty"`
-	OnlineLogLevel       string            `protobuf:"bytes,9,opt,name=online_log_level,json=onlineLogLevel,proto3" json:"online_log_level,omitempty"`
-	RetrievalParams      string            `protobuf:"bytes,10,opt,name=retrieval_params,json=retrievalParams,proto3" json:"retrieval_params,omitempty"`
-	HasRank              bool              `protobuf:"varint,11,opt,name=has_rank,json=hasRank,proto3" json:"has_rank,omitempty"`
-	MultiVectorRank      int32             `protobuf:"varint,12,opt,name=multi_vector_rank,json=multiVectorRank,proto3" json:"multi_vector_rank,omitempty"`
-	ParallelBasedOnQuery bool              `protobuf:"varint,13,opt,name=parallel_based_on_query,json=parallelBasedOnQuery,proto3" json:"parallel_based_on_query,omitempty"`
-	L2Sqrt               bool              `protobuf:"varint,14,opt,name=l2_sqrt,json=l2Sqrt,proto3" json:"l2_sqrt,omitempty"`
-	IvfFlat              bool              `protobuf:"varint,15,opt,name=ivf_flat,json=ivfFlat,proto3" json:"ivf_flat,omitempty"`
-	IsVectorValue        bool              `protobuf:"varint,16,opt,name=is_vector_value,json=isVectorValue,proto3" json:"is_vector_value,omitempty"`
-	SortFieldMap         map[string]string `protobuf:"bytes,17,rep,name=sort_field_map,json=sortFieldMap,proto3" json:"sort_field_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	SortFields           []*SortField      `protobuf:"bytes,18,rep,name=sort_fields,json=sortFields,proto3" json:"sort_fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *BulkResponse) GetHead() *ResponseHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
 }
 
-func (m *SearchRequest) Reset()      { *m = SearchRequest{} }
-func (*SearchRequest) ProtoMessage() {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{24}
+func (m *BulkResponse) GetItems() []*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
 }
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+
+// BulkStreamResponse acknowledges one BulkRequest of a BulkStream, batches
+// are numbered from 0 in the order they were sent and may be acknowledged out
+// of order. items has the error of each document like BulkResponse.
+type BulkStreamResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Batch                int64         `protobuf:"varint,2,opt,name=batch,proto3" json:"batch,omitempty"`
+	Items                []*Item       `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BulkStreamResponse) Reset()      { *m = BulkStreamResponse{} }
+func (*BulkStreamResponse) ProtoMessage() {}
+func (*BulkStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{26}
+}
+func (m *BulkStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BulkStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BulkStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1324,166 +1492,189 @@ func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *BulkStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkStreamResponse.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
+func (m *BulkStreamResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *BulkStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_BulkStreamResponse proto.InternalMessageInfo
 
-func (m *SearchRequest) GetHead() *RequestHead {
+func (m *BulkStreamResponse) GetHead() *ResponseHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetReqNum() int32 {
+func (m *BulkStreamResponse) GetBatch() int64 {
 	if m != nil {
-		return m.ReqNum
+		return m.Batch
 	}
 	return 0
 }
 
-func (m *SearchRequest) GetTopN() int32 {
+func (m *BulkStreamResponse) GetItems() []*Item {
 	if m != nil {
-		return m.TopN
+		return m.Items
 	}
-	return 0
+	return nil
 }
 
-func (m *SearchRequest) GetIsBruteSearch() int32 {
-	if m != nil {
-		return m.IsBruteSearch
-	}
-	return 0
+type ForceMergeResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Shards               *SearchStatus `protobuf:"bytes,2,opt,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SearchRequest) GetVecFields() []*VectorQuery {
-	if m != nil {
-		return m.VecFields
-	}
-	return nil
+func (m *ForceMergeResponse) Reset()      { *m = ForceMergeResponse{} }
+func (*ForceMergeResponse) ProtoMessage() {}
+func (*ForceMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{27}
 }
-
-func (m *SearchRequest) GetFields() []string {
-	if m != nil {
-		return m.Fields
+func (m *ForceMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForceMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForceMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ForceMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForceMergeResponse.Merge(m, src)
+}
+func (m *ForceMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForceMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForceMergeResponse.DiscardUnknown(m)
 }
 
-func (m *SearchRequest) GetRangeFilters() []*RangeFilter {
+var xxx_messageInfo_ForceMergeResponse proto.InternalMessageInfo
+
+func (m *ForceMergeResponse) GetHead() *ResponseHead {
 	if m != nil {
-		return m.RangeFilters
+		return m.Head
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetTermFilters() []*TermFilter {
+func (m *ForceMergeResponse) GetShards() *SearchStatus {
 	if m != nil {
-		return m.TermFilters
+		return m.Shards
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetOnlineLogLevel() string {
-	if m != nil {
-		return m.OnlineLogLevel
-	}
-	return ""
+type DelByQueryeResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	DelNum               int32         `protobuf:"varint,2,opt,name=DelNum,proto3" json:"DelNum,omitempty"`
+	IdsStr               []string      `protobuf:"bytes,3,rep,name=ids_str,json=idsStr,proto3" json:"ids_str,omitempty"`
+	IdsLong              []int64       `protobuf:"varint,4,rep,packed,name=ids_long,json=idsLong,proto3" json:"ids_long,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SearchRequest) GetRetrievalParams() string {
-	if m != nil {
-		return m.RetrievalParams
-	}
-	return ""
+func (m *DelByQueryeResponse) Reset()      { *m = DelByQueryeResponse{} }
+func (*DelByQueryeResponse) ProtoMessage() {}
+func (*DelByQueryeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{28}
 }
-
-func (m *SearchRequest) GetHasRank() bool {
-	if m != nil {
-		return m.HasRank
-	}
-	return false
+func (m *DelByQueryeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *SearchRequest) GetMultiVectorRank() int32 {
-	if m != nil {
-		return m.MultiVectorRank
+func (m *DelByQueryeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelByQueryeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
 }
-
-func (m *SearchRequest) GetParallelBasedOnQuery() bool {
-	if m != nil {
-		return m.ParallelBasedOnQuery
-	}
-	return false
+func (m *DelByQueryeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelByQueryeResponse.Merge(m, src)
 }
-
-func (m *SearchRequest) GetL2Sqrt() bool {
-	if m != nil {
-		return m.L2Sqrt
-	}
-	return false
+func (m *DelByQueryeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelByQueryeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelByQueryeResponse.DiscardUnknown(m)
 }
 
-func (m *SearchRequest) GetIvfFlat() bool {
+var xxx_messageInfo_DelByQueryeResponse proto.InternalMessageInfo
+
+func (m *DelByQueryeResponse) GetHead() *ResponseHead {
 	if m != nil {
-		return m.IvfFlat
+		return m.Head
 	}
-	return false
+	return nil
 }
 
-func (m *SearchRequest) GetIsVectorValue() bool {
+func (m *DelByQueryeResponse) GetDelNum() int32 {
 	if m != nil {
-		return m.IsVectorValue
+		return m.DelNum
 	}
-	return false
+	return 0
 }
 
-func (m *SearchRequest) GetSortFieldMap() map[string]string {
+func (m *DelByQueryeResponse) GetIdsStr() []string {
 	if m != nil {
-		return m.SortFieldMap
+		return m.IdsStr
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetSortFields() []*SortField {
+func (m *DelByQueryeResponse) GetIdsLong() []int64 {
 	if m != nil {
-		return m.SortFields
+		return m.IdsLong
 	}
 	return nil
 }
 
-type ResultItem struct {
-	Score                float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
-	Fields               []*Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	Extra                string   `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
-	PKey                 string   `protobuf:"bytes,4,opt,name=p_key,json=pKey,proto3" json:"p_key,omitempty"`
-	Source               []byte   `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+// FieldStats are the statistics of a field over the documents matching a
+// filter. Distinct is estimated from sketch, the HyperLogLog registers of the
+// values, which router merges across partitions.
+type FieldStats struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Min                  string   `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  string   `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	Distinct             int64    `protobuf:"varint,5,opt,name=distinct,proto3" json:"distinct,omitempty"`
+	Sketch               []byte   `protobuf:"bytes,6,opt,name=sketch,proto3" json:"sketch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ResultItem) Reset()      { *m = ResultItem{} }
-func (*ResultItem) ProtoMessage() {}
-func (*ResultItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{25}
+func (m *FieldStats) Reset()      { *m = FieldStats{} }
+func (*FieldStats) ProtoMessage() {}
+func (*FieldStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{29}
 }
-func (m *ResultItem) XXX_Unmarshal(b []byte) error {
+func (m *FieldStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ResultItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FieldStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ResultItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FieldStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1493,46 +1684,38 @@ func (m *ResultItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ResultItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ResultItem.Merge(m, src)
+func (m *FieldStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStats.Merge(m, src)
 }
-func (m *ResultItem) XXX_Size() int {
+func (m *FieldStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *ResultItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_ResultItem.DiscardUnknown(m)
+func (m *FieldStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ResultItem proto.InternalMessageInfo
+var xxx_messageInfo_FieldStats proto.InternalMessageInfo
 
-type SearchResult struct {
-	TotalHits            int32             `protobuf:"varint,1,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
-	MaxScore             float64           `protobuf:"fixed64,2,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
-	MaxTook              int64             `protobuf:"varint,3,opt,name=max_took,json=maxTook,proto3" json:"max_took,omitempty"`
-	MaxTookId            uint32            `protobuf:"varint,4,opt,name=max_took_id,json=maxTookId,proto3" json:"max_took_id,omitempty"`
-	Status               *SearchStatus     `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Msg                  string            `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty"`
-	ResultItems          []*ResultItem     `protobuf:"bytes,7,rep,name=result_items,json=resultItems,proto3" json:"result_items,omitempty"`
-	PID                  uint32            `protobuf:"varint,8,opt,name=pID,proto3" json:"pID,omitempty"`
-	Explain              map[uint32]string `protobuf:"bytes,9,rep,name=explain,proto3" json:"explain,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Timeout              bool              `protobuf:"varint,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	TopN                 int32             `protobuf:"varint,11,opt,name=topN,proto3" json:"topN,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type CountResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Count                int64         `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	FieldStats           []*FieldStats `protobuf:"bytes,3,rep,name=field_stats,json=fieldStats,proto3" json:"field_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SearchResult) Reset()      { *m = SearchResult{} }
-func (*SearchResult) ProtoMessage() {}
-func (*SearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{26}
+func (m *CountResponse) Reset()      { *m = CountResponse{} }
+func (*CountResponse) ProtoMessage() {}
+func (*CountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{30}
 }
-func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+func (m *CountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1542,42 +1725,37 @@ func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SearchResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResult.Merge(m, src)
+func (m *CountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountResponse.Merge(m, src)
 }
-func (m *SearchResult) XXX_Size() int {
+func (m *CountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+func (m *CountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+var xxx_messageInfo_CountResponse proto.InternalMessageInfo
 
-type SearchResponse struct {
-	Head                 *ResponseHead     `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	Results              []*SearchResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
-	OnlineLogMessage     string            `protobuf:"bytes,3,opt,name=online_log_message,json=onlineLogMessage,proto3" json:"online_log_message,omitempty"`
-	Timeout              bool              `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	FlatBytes            []byte            `protobuf:"bytes,5,opt,name=FlatBytes,proto3" json:"FlatBytes,omitempty"`
-	SortFieldMap         map[string]string `protobuf:"bytes,6,rep,name=sort_field_map,json=sortFieldMap,proto3" json:"sort_field_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	TopSize              int32             `protobuf:"varint,7,opt,name=top_size,json=topSize,proto3" json:"top_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type FlushResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Shards               *SearchStatus `protobuf:"bytes,2,opt,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SearchResponse) Reset()      { *m = SearchResponse{} }
-func (*SearchResponse) ProtoMessage() {}
-func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{27}
+func (m *FlushResponse) Reset()      { *m = FlushResponse{} }
+func (*FlushResponse) ProtoMessage() {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{31}
 }
-func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1587,88 +1765,149 @@ func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *SearchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResponse.Merge(m, src)
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
 }
-func (m *SearchResponse) XXX_Size() int {
+func (m *FlushResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
 
-func (m *SearchResponse) GetHead() *ResponseHead {
+func (m *FlushResponse) GetHead() *ResponseHead {
 	if m != nil {
 		return m.Head
 	}
 	return nil
 }
 
-func (m *SearchResponse) GetResults() []*SearchResult {
+func (m *FlushResponse) GetShards() *SearchStatus {
 	if m != nil {
-		return m.Results
+		return m.Shards
 	}
 	return nil
 }
 
-func (m *SearchResponse) GetOnlineLogMessage() string {
-	if m != nil {
-		return m.OnlineLogMessage
-	}
-	return ""
+type IndexResponse struct {
+	Head                 *ResponseHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Shards               *SearchStatus `protobuf:"bytes,2,opt,name=shards,proto3" json:"shards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SearchResponse) GetTimeout() bool {
-	if m != nil {
-		return m.Timeout
+func (m *IndexResponse) Reset()      { *m = IndexResponse{} }
+func (*IndexResponse) ProtoMessage() {}
+func (*IndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{32}
+}
+func (m *IndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *IndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexResponse.Merge(m, src)
+}
+func (m *IndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexResponse.DiscardUnknown(m)
 }
 
-func (m *SearchResponse) GetFlatBytes() []byte {
+var xxx_messageInfo_IndexResponse proto.InternalMessageInfo
+
+func (m *IndexResponse) GetHead() *ResponseHead {
 	if m != nil {
-		return m.FlatBytes
+		return m.Head
 	}
 	return nil
 }
 
-func (m *SearchResponse) GetSortFieldMap() map[string]string {
+func (m *IndexResponse) GetShards() *SearchStatus {
 	if m != nil {
-		return m.SortFieldMap
+		return m.Shards
 	}
 	return nil
 }
 
-func (m *SearchResponse) GetTopSize() int32 {
-	if m != nil {
-		return m.TopSize
+type TermFilter struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	IsUnion              int32    `protobuf:"varint,3,opt,name=is_union,json=isUnion,proto3" json:"is_union,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TermFilter) Reset()      { *m = TermFilter{} }
+func (*TermFilter) ProtoMessage() {}
+func (*TermFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{33}
+}
+func (m *TermFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TermFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TermFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *TermFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TermFilter.Merge(m, src)
+}
+func (m *TermFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TermFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TermFilter.DiscardUnknown(m)
 }
 
-type SearchStatus struct {
-	Total                int32    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Failed               int32    `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
-	Successful           int32    `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
-	Msg                  string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+var xxx_messageInfo_TermFilter proto.InternalMessageInfo
+
+type RangeFilter struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	LowerValue           []byte   `protobuf:"bytes,2,opt,name=lower_value,json=lowerValue,proto3" json:"lower_value,omitempty"`
+	UpperValue           []byte   `protobuf:"bytes,3,opt,name=upper_value,json=upperValue,proto3" json:"upper_value,omitempty"`
+	IncludeLower         bool     `protobuf:"varint,4,opt,name=include_lower,json=includeLower,proto3" json:"include_lower,omitempty"`
+	IncludeUpper         bool     `protobuf:"varint,5,opt,name=include_upper,json=includeUpper,proto3" json:"include_upper,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SearchStatus) Reset()      { *m = SearchStatus{} }
-func (*SearchStatus) ProtoMessage() {}
-func (*SearchStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{28}
+func (m *RangeFilter) Reset()      { *m = RangeFilter{} }
+func (*RangeFilter) ProtoMessage() {}
+func (*RangeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{34}
 }
-func (m *SearchStatus) XXX_Unmarshal(b []byte) error {
+func (m *RangeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RangeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RangeFilter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1678,37 +1917,42 @@ func (m *SearchStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SearchStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchStatus.Merge(m, src)
+func (m *RangeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeFilter.Merge(m, src)
 }
-func (m *SearchStatus) XXX_Size() int {
+func (m *RangeFilter) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchStatus.DiscardUnknown(m)
+func (m *RangeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeFilter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchStatus proto.InternalMessageInfo
+var xxx_messageInfo_RangeFilter proto.InternalMessageInfo
 
-type MSearchRequest struct {
-	Head                 *RequestHead     `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
-	SearchRequests       []*SearchRequest `protobuf:"bytes,2,rep,name=search_requests,json=searchRequests,proto3" json:"search_requests,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// FilterClause is a leaf of the boolean filter tree. Range bounds are
+// values[0] and values[1], an empty bound is unbounded.
+type FilterClause struct {
+	Type                 FilterClause_Type `protobuf:"varint,1,opt,name=type,proto3,enum=FilterClause_Type" json:"type,omitempty"`
+	Field                string            `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Values               []string          `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	IncludeLower         bool              `protobuf:"varint,4,opt,name=include_lower,json=includeLower,proto3" json:"include_lower,omitempty"`
+	IncludeUpper         bool              `protobuf:"varint,5,opt,name=include_upper,json=includeUpper,proto3" json:"include_upper,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *MSearchRequest) Reset()      { *m = MSearchRequest{} }
-func (*MSearchRequest) ProtoMessage() {}
-func (*MSearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_535779cc1a17303a, []int{29}
+func (m *FilterClause) Reset()      { *m = FilterClause{} }
+func (*FilterClause) ProtoMessage() {}
+func (*FilterClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{35}
 }
-func (m *MSearchRequest) XXX_Unmarshal(b []byte) error {
+func (m *FilterClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FilterClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MSearchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FilterClause.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1718,791 +1962,1262 @@ func (m *MSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MSearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MSearchRequest.Merge(m, src)
+func (m *FilterClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterClause.Merge(m, src)
 }
-func (m *MSearchRequest) XXX_Size() int {
+func (m *FilterClause) XXX_Size() int {
 	return m.Size()
 }
-func (m *MSearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MSearchRequest.DiscardUnknown(m)
+func (m *FilterClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterClause.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MSearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_FilterClause proto.InternalMessageInfo
 
-func (m *MSearchRequest) GetHead() *RequestHead {
-	if m != nil {
-		return m.Head
-	}
-	return nil
+// BoolFilter is a node of the boolean filter tree, it holds either a clause
+// or the must/should/must_not children.
+type BoolFilter struct {
+	Clause               *FilterClause `protobuf:"bytes,1,opt,name=clause,proto3" json:"clause,omitempty"`
+	Must                 []*BoolFilter `protobuf:"bytes,2,rep,name=must,proto3" json:"must,omitempty"`
+	Should               []*BoolFilter `protobuf:"bytes,3,rep,name=should,proto3" json:"should,omitempty"`
+	MustNot              []*BoolFilter `protobuf:"bytes,4,rep,name=must_not,json=mustNot,proto3" json:"must_not,omitempty"`
+	MinimumShouldMatch   int32         `protobuf:"varint,5,opt,name=minimum_should_match,json=minimumShouldMatch,proto3" json:"minimum_should_match,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *MSearchRequest) GetSearchRequests() []*SearchRequest {
-	if m != nil {
-		return m.SearchRequests
-	}
-	return nil
+func (m *BoolFilter) Reset()      { *m = BoolFilter{} }
+func (*BoolFilter) ProtoMessage() {}
+func (*BoolFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{36}
 }
-
-func init() {
-	proto.RegisterEnum("RetrievalParameters_DistanceMetricType", RetrievalParameters_DistanceMetricType_name, RetrievalParameters_DistanceMetricType_value)
-	proto.RegisterType((*RequestHead)(nil), "RequestHead")
-	proto.RegisterMapType((map[string]string)(nil), "RequestHead.ParamsEntry")
-	proto.RegisterType((*ResponseHead)(nil), "ResponseHead")
-	proto.RegisterMapType((map[string]string)(nil), "ResponseHead.ParamsEntry")
-	proto.RegisterType((*GetRequest)(nil), "GetRequest")
-	proto.RegisterType((*DeleteRequest)(nil), "DeleteRequest")
-	proto.RegisterType((*AddRequest)(nil), "AddRequest")
-	proto.RegisterType((*UpdateRequest)(nil), "UpdateRequest")
-	proto.RegisterType((*BulkRequest)(nil), "BulkRequest")
-	proto.RegisterType((*ForceMergeRequest)(nil), "ForceMergeRequest")
-	proto.RegisterType((*FlushRequest)(nil), "FlushRequest")
-	proto.RegisterType((*IndexRequest)(nil), "IndexRequest")
-	proto.RegisterType((*GetResponse)(nil), "GetResponse")
-	proto.RegisterType((*AddResponse)(nil), "AddResponse")
-	proto.RegisterType((*UpdateResponse)(nil), "UpdateResponse")
-	proto.RegisterType((*DeleteResponse)(nil), "DeleteResponse")
-	proto.RegisterType((*BulkResponse)(nil), "BulkResponse")
-	proto.RegisterType((*ForceMergeResponse)(nil), "ForceMergeResponse")
-	proto.RegisterType((*DelByQueryeResponse)(nil), "DelByQueryeResponse")
-	proto.RegisterType((*FlushResponse)(nil), "FlushResponse")
-	proto.RegisterType((*IndexResponse)(nil), "IndexResponse")
-	proto.RegisterType((*TermFilter)(nil), "TermFilter")
-	proto.RegisterType((*RangeFilter)(nil), "RangeFilter")
-	proto.RegisterType((*SortField)(nil), "SortField")
-	proto.RegisterType((*VectorQuery)(nil), "VectorQuery")
-	proto.RegisterType((*RetrievalParameters)(nil), "RetrievalParameters")
-	proto.RegisterType((*SearchRequest)(nil), "SearchRequest")
-	proto.RegisterMapType((map[string]string)(nil), "SearchRequest.SortFieldMapEntry")
-	proto.RegisterType((*ResultItem)(nil), "ResultItem")
-	proto.RegisterType((*SearchResult)(nil), "SearchResult")
-	proto.RegisterMapType((map[uint32]string)(nil), "SearchResult.ExplainEntry")
-	proto.RegisterType((*SearchResponse)(nil), "SearchResponse")
-	proto.RegisterMapType((map[string]string)(nil), "SearchResponse.SortFieldMapEntry")
-	proto.RegisterType((*SearchStatus)(nil), "SearchStatus")
-	proto.RegisterType((*MSearchRequest)(nil), "MSearchRequest")
+func (m *BoolFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoolFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoolFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoolFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolFilter.Merge(m, src)
+}
+func (m *BoolFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoolFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolFilter.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("router_grpc.proto", fileDescriptor_535779cc1a17303a) }
+var xxx_messageInfo_BoolFilter proto.InternalMessageInfo
 
-var fileDescriptor_535779cc1a17303a = []byte{
-	// 2034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xe7, 0x8a, 0xe2, 0xd7, 0x5b, 0x92, 0x92, 0xc6, 0x46, 0x4c, 0x33, 0x0d, 0xa5, 0xb0, 0x48,
-	0xe3, 0xd6, 0xcd, 0xba, 0x51, 0x9b, 0xb6, 0x71, 0x0f, 0x6d, 0x14, 0x59, 0xb6, 0x11, 0xcb, 0x71,
-	0x46, 0xb2, 0x03, 0xe4, 0xb2, 0x58, 0xee, 0x8e, 0xa8, 0x85, 0x76, 0x77, 0x56, 0x33, 0xb3, 0x8a,
-	0x98, 0x53, 0x2f, 0x05, 0x8a, 0xfc, 0x01, 0x45, 0x7b, 0x6a, 0x6e, 0xed, 0xa1, 0x40, 0xaf, 0x3d,
-	0xf6, 0x98, 0x63, 0x8f, 0x3d, 0xc6, 0xca, 0xb9, 0x40, 0x8f, 0x3d, 0x16, 0xf3, 0x66, 0x48, 0x2e,
-	0x6d, 0xb7, 0xa6, 0x01, 0xfb, 0xc4, 0x79, 0x9f, 0xfb, 0x66, 0xe6, 0xf7, 0x3e, 0x86, 0xb0, 0x21,
-	0x78, 0xa1, 0x98, 0xf0, 0xc7, 0x22, 0x0f, 0xbd, 0x5c, 0x70, 0xc5, 0xfb, 0xeb, 0x51, 0xa0, 0x02,
-	0x3f, 0xe5, 0x11, 0x4b, 0x2c, 0xa7, 0xcd, 0x84, 0xe0, 0x42, 0x5a, 0xea, 0x9d, 0x71, 0xac, 0x8e,
-	0x8b, 0x91, 0x17, 0xf2, 0xf4, 0xc6, 0x98, 0x8f, 0xf9, 0x0d, 0x64, 0x8f, 0x8a, 0x23, 0xa4, 0x90,
-	0xc0, 0x95, 0x51, 0x1f, 0xfe, 0x65, 0x05, 0x5c, 0xca, 0x4e, 0x0b, 0x26, 0xd5, 0x1d, 0x16, 0x44,
-	0x64, 0x00, 0xae, 0x8a, 0x53, 0xe6, 0xf3, 0x42, 0xf9, 0xa9, 0xec, 0x39, 0x5b, 0xce, 0xb5, 0x2a,
-	0x6d, 0x69, 0xd6, 0xc7, 0x85, 0xda, 0x97, 0xe4, 0x75, 0x68, 0x15, 0x92, 0x09, 0x3f, 0x0b, 0x52,
-	0xd6, 0x5b, 0xd9, 0x72, 0xae, 0xb5, 0x68, 0x53, 0x33, 0xee, 0x07, 0x29, 0x23, 0x7d, 0x68, 0xe6,
-	0x81, 0x94, 0x9f, 0x73, 0x11, 0xf5, 0xaa, 0x46, 0x36, 0xa5, 0xc9, 0x15, 0x68, 0x44, 0x23, 0x63,
-	0xb6, 0x8a, 0xa2, 0x7a, 0x34, 0x42, 0xa3, 0x37, 0x00, 0x64, 0x1e, 0x84, 0xcc, 0xc8, 0x6a, 0x28,
-	0x6b, 0x21, 0x07, 0xc5, 0x9b, 0xe0, 0x86, 0x49, 0xcc, 0x32, 0xe5, 0xab, 0x49, 0xce, 0x7a, 0x75,
-	0x94, 0x83, 0x61, 0x1d, 0x4e, 0x72, 0x46, 0x7e, 0x04, 0xf5, 0x3c, 0x10, 0x41, 0x2a, 0x7b, 0x8d,
-	0xad, 0xea, 0x35, 0x77, 0xbb, 0xe7, 0x95, 0xf6, 0xe3, 0x3d, 0x40, 0xd1, 0xad, 0x4c, 0x89, 0x09,
-	0xb5, 0x7a, 0xfd, 0xf7, 0xc1, 0x2d, 0xb1, 0xc9, 0x3a, 0x54, 0x4f, 0xd8, 0x04, 0xb7, 0xda, 0xa2,
-	0x7a, 0x49, 0x2e, 0x43, 0xed, 0x2c, 0x48, 0x8a, 0xe9, 0x06, 0x0d, 0x71, 0x73, 0xe5, 0xe7, 0xce,
-	0xf0, 0x77, 0x0e, 0xb4, 0x29, 0x93, 0x39, 0xcf, 0x24, 0xc3, 0xf3, 0xea, 0x41, 0x95, 0x09, 0x81,
-	0xc6, 0xee, 0x76, 0xdd, 0xbb, 0xa5, 0xaf, 0x82, 0x6a, 0x16, 0x79, 0x77, 0x16, 0x57, 0x15, 0xe3,
-	0xba, 0xea, 0x95, 0x0d, 0x5f, 0x76, 0x60, 0x9f, 0x02, 0xdc, 0x66, 0xca, 0xee, 0x9c, 0x6c, 0xc1,
-	0xea, 0x31, 0x0b, 0x22, 0x1b, 0x56, 0xbb, 0x7c, 0x22, 0x14, 0x25, 0xe4, 0x4d, 0x68, 0xe7, 0x22,
-	0x4e, 0x03, 0x31, 0xf1, 0x4f, 0xd8, 0x44, 0xf6, 0x56, 0xb7, 0xaa, 0xd7, 0x5a, 0xd4, 0xb5, 0xbc,
-	0x8f, 0xd8, 0x44, 0xde, 0x5c, 0xfd, 0xed, 0x57, 0x9b, 0xce, 0xf0, 0x33, 0xe8, 0xec, 0xb2, 0x84,
-	0x29, 0xf6, 0x0a, 0x7c, 0x7f, 0x02, 0xf0, 0x41, 0x14, 0x2d, 0xef, 0xf8, 0x75, 0xa8, 0x46, 0x3c,
-	0x44, 0xfc, 0xb8, 0xdb, 0x2d, 0x6f, 0x97, 0x87, 0x45, 0xca, 0x32, 0x45, 0x35, 0xd7, 0xba, 0x3c,
-	0x84, 0xce, 0xc3, 0x3c, 0x0a, 0x5e, 0x24, 0xdc, 0xa5, 0xbc, 0xba, 0x3b, 0x45, 0x72, 0xb2, 0xbc,
-	0xcf, 0x37, 0x60, 0x35, 0xe2, 0xa1, 0xd9, 0xfa, 0x82, 0x53, 0x64, 0x5b, 0xaf, 0xbf, 0x80, 0x8d,
-	0x3d, 0x2e, 0x42, 0xb6, 0xcf, 0xc4, 0x78, 0xf9, 0x78, 0xad, 0xf1, 0x4f, 0xa1, 0xbd, 0x97, 0x14,
-	0xf2, 0xf8, 0x45, 0xed, 0xfe, 0xe8, 0x40, 0xfb, 0x6e, 0x16, 0xb1, 0xf3, 0xe5, 0x37, 0xe3, 0xc1,
-	0xa5, 0x48, 0xf0, 0xdc, 0x1f, 0xb1, 0x23, 0x2e, 0x98, 0x2f, 0xd8, 0xa8, 0x88, 0x93, 0x08, 0x31,
-	0x58, 0xa5, 0x1b, 0x5a, 0xb4, 0x83, 0x12, 0x6a, 0x04, 0xba, 0x46, 0x24, 0x71, 0x1a, 0x2b, 0x3f,
-	0xcc, 0x0b, 0xac, 0x03, 0x55, 0xda, 0x44, 0xc6, 0x87, 0x79, 0xa1, 0x6b, 0x44, 0xc4, 0x64, 0x28,
-	0xe2, 0x91, 0x29, 0x04, 0x55, 0x3a, 0xa3, 0x6d, 0x84, 0x07, 0xe0, 0x22, 0x94, 0x4d, 0xb2, 0x90,
-	0x37, 0x17, 0xe2, 0xeb, 0x2c, 0x64, 0xd1, 0xec, 0x06, 0x6b, 0xb1, 0x62, 0xa9, 0xec, 0xad, 0xe0,
-	0x71, 0xd7, 0xbc, 0xbb, 0x8a, 0xa5, 0xd4, 0xf0, 0xac, 0xd3, 0x4f, 0xc1, 0x45, 0xa8, 0x2d, 0xef,
-	0x74, 0x13, 0xdc, 0x12, 0x8a, 0x6d, 0xd1, 0x82, 0x39, 0x88, 0xad, 0xe3, 0xf7, 0xa1, 0x3b, 0x05,
-	0xdc, 0xd2, 0xbe, 0xad, 0xe9, 0x23, 0xe8, 0x4e, 0x53, 0xeb, 0xa5, 0xee, 0xf5, 0x10, 0xda, 0x06,
-	0xad, 0x2f, 0xd5, 0x6b, 0x04, 0xa4, 0x8c, 0xd6, 0xe5, 0x7d, 0xbf, 0x05, 0x75, 0x79, 0x1c, 0x88,
-	0x48, 0x22, 0x62, 0xb4, 0xd2, 0x01, 0x0b, 0x44, 0x78, 0x7c, 0xa0, 0x02, 0x55, 0x48, 0x6a, 0x85,
-	0xf6, 0x2b, 0x5f, 0x3a, 0x70, 0x69, 0x97, 0x25, 0x3b, 0x93, 0x4f, 0x0a, 0x26, 0x26, 0x2f, 0xf4,
-	0x9d, 0xd7, 0xa0, 0xbe, 0xcb, 0x92, 0xfb, 0x45, 0x8a, 0xdf, 0xa9, 0x51, 0x4b, 0xe9, 0xce, 0x13,
-	0x47, 0xd2, 0x97, 0x4a, 0x60, 0x25, 0x6e, 0xd1, 0x7a, 0x1c, 0xc9, 0x03, 0x25, 0xc8, 0x55, 0x68,
-	0x6a, 0x41, 0xc2, 0xb3, 0x31, 0x26, 0x6a, 0x95, 0x6a, 0xc5, 0x7b, 0x3c, 0x1b, 0xdb, 0x60, 0x7c,
-	0xe8, 0xd8, 0x1c, 0x7b, 0x45, 0xbb, 0xf5, 0xa1, 0x63, 0x73, 0xf1, 0x15, 0x7d, 0xe0, 0x00, 0xe0,
-	0x90, 0x89, 0x74, 0x2f, 0x4e, 0x14, 0x13, 0xba, 0x7d, 0x1c, 0xc5, 0x2c, 0x89, 0x6c, 0x4b, 0x31,
-	0xc4, 0x62, 0x53, 0x69, 0xdb, 0xa6, 0x82, 0x87, 0x23, 0xfd, 0x22, 0x8b, 0x79, 0x86, 0x39, 0x5c,
-	0xa3, 0x8d, 0x58, 0x3e, 0xd4, 0xe4, 0xf0, 0xaf, 0x0e, 0xb8, 0x34, 0xc8, 0xc6, 0xec, 0xff, 0xba,
-	0xdd, 0x04, 0x37, 0xe1, 0x9f, 0x33, 0xe1, 0x97, 0x9d, 0x03, 0xb2, 0x1e, 0xe1, 0x17, 0x36, 0xc1,
-	0x2d, 0xf2, 0x7c, 0xa6, 0x50, 0x35, 0x0a, 0xc8, 0x32, 0x0a, 0xdf, 0x85, 0x4e, 0x9c, 0x85, 0x49,
-	0x11, 0x31, 0x1f, 0xcd, 0x30, 0x07, 0x9b, 0xb4, 0x6d, 0x99, 0xf7, 0x34, 0xaf, 0xac, 0x84, 0xa6,
-	0x38, 0x41, 0xcc, 0x95, 0x1e, 0x6a, 0xde, 0xf0, 0x3d, 0x68, 0x1d, 0x70, 0xa1, 0xf6, 0xa6, 0xfb,
-	0x7d, 0x46, 0xb8, 0x04, 0x56, 0x71, 0xc0, 0x58, 0x41, 0x73, 0x5c, 0x0f, 0xbf, 0x75, 0xc0, 0x7d,
-	0xc4, 0x42, 0xc5, 0x05, 0xa2, 0x51, 0xeb, 0xe0, 0x90, 0x62, 0x0c, 0x71, 0xfd, 0x3f, 0x4e, 0xef,
-	0x75, 0x68, 0xa5, 0x71, 0xe6, 0xcb, 0x90, 0x0b, 0xb3, 0x33, 0x87, 0x36, 0xd3, 0x38, 0x3b, 0xd0,
-	0x34, 0x0a, 0x83, 0x73, 0x2b, 0x5c, 0xb5, 0xc2, 0xe0, 0xdc, 0x08, 0x2f, 0x43, 0x6d, 0xc4, 0xb9,
-	0x54, 0xb8, 0x0f, 0x87, 0x1a, 0x42, 0x9b, 0x1c, 0x07, 0xd2, 0x37, 0x92, 0x3a, 0x5e, 0x47, 0xf3,
-	0x38, 0x90, 0x3b, 0x28, 0x7c, 0x0d, 0xea, 0x47, 0x5c, 0xa4, 0x81, 0xea, 0x35, 0xcc, 0x64, 0x65,
-	0x28, 0xf2, 0x16, 0x74, 0x05, 0x53, 0x22, 0x66, 0x67, 0x41, 0x62, 0xa6, 0xa7, 0x26, 0xca, 0x3b,
-	0x33, 0xae, 0x1e, 0xa0, 0x86, 0x7f, 0x72, 0xe0, 0x12, 0x9d, 0x72, 0x70, 0xfe, 0x60, 0x8a, 0x09,
-	0x49, 0xee, 0x80, 0x9b, 0x6a, 0x76, 0x68, 0x6c, 0xf5, 0xa6, 0xbb, 0xdb, 0x6f, 0x7b, 0xcf, 0x50,
-	0xf5, 0x76, 0x63, 0xa9, 0x82, 0x4c, 0xd7, 0x06, 0xad, 0xaf, 0xbd, 0x52, 0x48, 0x67, 0x6b, 0x1d,
-	0x60, 0x96, 0x0b, 0x3e, 0x62, 0xd3, 0xcc, 0x34, 0xd4, 0xd0, 0x03, 0xf2, 0xb4, 0x25, 0x59, 0xd7,
-	0x0d, 0x2a, 0x63, 0xe2, 0x81, 0xe0, 0x51, 0x11, 0xaa, 0xf5, 0x0a, 0xa9, 0xc3, 0xca, 0xbd, 0xed,
-	0x75, 0x67, 0xf8, 0x87, 0x3a, 0x74, 0x0c, 0xd8, 0x97, 0x6f, 0x5e, 0x57, 0xa0, 0x21, 0xd8, 0xa9,
-	0x9f, 0xcd, 0xcb, 0x82, 0x60, 0xa7, 0xba, 0x2c, 0xe8, 0x0b, 0xe7, 0xf9, 0x7d, 0x0b, 0x6e, 0x5c,
-	0x93, 0xef, 0xc1, 0x5a, 0x2c, 0xfd, 0x91, 0x28, 0x14, 0xf3, 0x25, 0x7e, 0x08, 0xef, 0xa7, 0x46,
-	0x3b, 0xb1, 0xdc, 0xd1, 0x5c, 0xf3, 0x75, 0x72, 0x1d, 0xe0, 0x8c, 0x85, 0x3e, 0x22, 0x47, 0xf6,
-	0x6a, 0x58, 0x33, 0xdb, 0x5e, 0x09, 0x2a, 0xb4, 0x75, 0xc6, 0x42, 0x84, 0x9b, 0xc4, 0xeb, 0x31,
-	0x8a, 0x75, 0x53, 0x7e, 0x0c, 0x45, 0xde, 0x85, 0x8e, 0xd0, 0x59, 0xe4, 0x1f, 0x61, 0x1a, 0x4d,
-	0xe7, 0xd7, 0xb6, 0x57, 0xca, 0x2d, 0xda, 0x16, 0x73, 0x42, 0x12, 0x0f, 0xda, 0x8a, 0x89, 0x74,
-	0x66, 0xd1, 0x44, 0x0b, 0xd7, 0x9b, 0xe7, 0x38, 0x75, 0xd5, 0x6c, 0x2d, 0xc9, 0x35, 0x58, 0xe7,
-	0x59, 0x12, 0x67, 0x3a, 0x81, 0xc6, 0x7e, 0xc2, 0xce, 0x58, 0xd2, 0x6b, 0x21, 0x06, 0xba, 0x86,
-	0x7f, 0x8f, 0x8f, 0xef, 0x69, 0x2e, 0xf9, 0x3e, 0xac, 0xcf, 0xb1, 0x62, 0xe7, 0x56, 0x40, 0xcd,
-	0x35, 0xb1, 0x70, 0xe1, 0x52, 0x57, 0x06, 0x8d, 0x45, 0x11, 0x64, 0x27, 0x3d, 0x17, 0xb3, 0xa5,
-	0x71, 0x1c, 0x48, 0x1a, 0x64, 0x27, 0xe4, 0x07, 0xb0, 0x91, 0x16, 0x89, 0x8a, 0xfd, 0x33, 0x3c,
-	0x0a, 0xa3, 0xd3, 0xc6, 0x13, 0x5c, 0x43, 0x81, 0x39, 0x22, 0xd4, 0x7d, 0x0f, 0xae, 0xe8, 0xef,
-	0x24, 0x09, 0x4b, 0xfc, 0x51, 0x20, 0x59, 0xe4, 0xf3, 0xcc, 0x3f, 0xd5, 0x87, 0xd7, 0xeb, 0xa0,
-	0xd7, 0xcb, 0x53, 0xf1, 0x8e, 0x96, 0x7e, 0x9c, 0x99, 0x1c, 0xbc, 0x02, 0x8d, 0x64, 0xdb, 0x97,
-	0xa7, 0x42, 0xf5, 0xba, 0xa8, 0x56, 0x4f, 0xb6, 0x0f, 0x4e, 0x85, 0xc2, 0x82, 0x75, 0x76, 0xe4,
-	0x1f, 0x25, 0x81, 0xea, 0xad, 0x99, 0xb0, 0xe2, 0xb3, 0xa3, 0xbd, 0x24, 0x50, 0xf6, 0x5a, 0x6d,
-	0x4c, 0x26, 0x5b, 0xd7, 0x51, 0xa3, 0x13, 0x4b, 0x13, 0x91, 0x29, 0x38, 0x7b, 0xd0, 0x95, 0x5c,
-	0x28, 0x73, 0xaf, 0x7e, 0x1a, 0xe4, 0xbd, 0x0d, 0x3c, 0xe0, 0x2d, 0x6f, 0x01, 0x75, 0xde, 0xac,
-	0x96, 0xec, 0x07, 0xb9, 0x99, 0xe0, 0xdb, 0xb2, 0xc4, 0x22, 0xd7, 0xc1, 0x9d, 0xfb, 0x91, 0x3d,
-	0x82, 0x4e, 0x60, 0x6e, 0x46, 0x61, 0xa6, 0x2e, 0xfb, 0xbf, 0x84, 0x8d, 0xa7, 0xfc, 0xbd, 0xc8,
-	0xe8, 0x6f, 0x2b, 0xfd, 0x6f, 0x1c, 0x00, 0xca, 0x64, 0x91, 0x28, 0xdd, 0xba, 0xb5, 0xba, 0xa9,
-	0x2f, 0x8e, 0x29, 0x23, 0x48, 0x90, 0xc1, 0x0c, 0x8a, 0xa6, 0xcf, 0xd7, 0x3d, 0x13, 0xcf, 0x14,
-	0x92, 0x97, 0xa1, 0xc6, 0xce, 0x95, 0x08, 0xec, 0xeb, 0xcd, 0x10, 0xe4, 0x12, 0xd4, 0xf2, 0xd2,
-	0x0c, 0xb4, 0x9a, 0x7f, 0xc4, 0x26, 0x1a, 0xd5, 0x92, 0x17, 0x22, 0x34, 0x4f, 0xb6, 0x36, 0xb5,
-	0xd4, 0xf0, 0xab, 0x2a, 0xb4, 0xa7, 0xa7, 0xa5, 0xa3, 0xd1, 0xef, 0x3b, 0xc5, 0x55, 0x90, 0xf8,
-	0xc7, 0xb1, 0x32, 0x0f, 0xca, 0x1a, 0x6d, 0x21, 0xe7, 0x4e, 0xac, 0xe4, 0x62, 0x31, 0x5c, 0x79,
-	0xa2, 0x18, 0x5e, 0x05, 0xbd, 0xf6, 0x15, 0xe7, 0x27, 0x76, 0x90, 0x6c, 0xa4, 0xc1, 0xf9, 0x21,
-	0xe7, 0x27, 0xfa, 0xa1, 0x3a, 0x15, 0xf9, 0x71, 0x84, 0xa1, 0x75, 0x68, 0xcb, 0x4a, 0xef, 0x9a,
-	0x36, 0x89, 0x1d, 0x11, 0xe3, 0x7b, 0x46, 0x9b, 0xc4, 0x5f, 0x7d, 0xd0, 0xa9, 0x1c, 0xdb, 0x67,
-	0xa5, 0x5e, 0xea, 0x1c, 0x13, 0x18, 0xb9, 0x6f, 0x26, 0xa2, 0x86, 0xcd, 0xb1, 0xf9, 0xe1, 0x52,
-	0x57, 0xcc, 0xd6, 0xe8, 0x21, 0xbf, 0xbb, 0x8b, 0xa5, 0xb5, 0x43, 0xf5, 0x92, 0xfc, 0x04, 0x1a,
-	0xec, 0x3c, 0x4f, 0x82, 0x38, 0xeb, 0xb5, 0xd0, 0xb8, 0xef, 0x95, 0x4f, 0xc4, 0xbb, 0x65, 0x84,
-	0x06, 0x39, 0x53, 0x55, 0xd2, 0x83, 0x86, 0x7e, 0x66, 0xf3, 0x42, 0x61, 0xe2, 0x35, 0xe9, 0x94,
-	0x9c, 0x55, 0x2a, 0x77, 0x5e, 0xa9, 0xfa, 0x37, 0xa1, 0x5d, 0x76, 0x53, 0x06, 0x4c, 0xe7, 0x79,
-	0x6f, 0xc5, 0x7f, 0xad, 0x40, 0x77, 0x16, 0xd0, 0xd2, 0x73, 0xc7, 0xdb, 0xba, 0x90, 0xea, 0xf8,
-	0xa7, 0xe0, 0xe9, 0x2c, 0xec, 0x8a, 0x4e, 0xa5, 0xe4, 0x87, 0x40, 0x4a, 0x45, 0x27, 0x65, 0x52,
-	0x06, 0x63, 0x66, 0x11, 0xb5, 0x3e, 0x2b, 0x3b, 0xfb, 0x86, 0x5f, 0xde, 0xf6, 0xea, 0xe2, 0xb6,
-	0xbf, 0x03, 0x2d, 0x9d, 0xbd, 0x3b, 0x13, 0xc5, 0xa4, 0x05, 0xd9, 0x9c, 0x41, 0x6e, 0x3f, 0x95,
-	0xab, 0x75, 0x8c, 0xea, 0x4d, 0x6f, 0x71, 0x6b, 0xcf, 0x4d, 0xd6, 0xab, 0xd0, 0x54, 0x3c, 0xf7,
-	0x65, 0xfc, 0x05, 0xc3, 0xfe, 0x59, 0xa3, 0x0d, 0xc5, 0xf3, 0x83, 0xf8, 0x0b, 0xf6, 0xb2, 0x52,
-	0x33, 0x9b, 0x66, 0x84, 0xc1, 0x9e, 0xd6, 0x47, 0xfc, 0xdb, 0x64, 0x30, 0x04, 0xb6, 0x89, 0x20,
-	0x4e, 0x58, 0x34, 0xed, 0x53, 0x86, 0x22, 0x03, 0x00, 0x59, 0x84, 0x21, 0x93, 0xf2, 0xa8, 0x48,
-	0x6c, 0xb7, 0x2a, 0x71, 0xa6, 0x08, 0x5e, 0x9d, 0x21, 0x78, 0x78, 0x0a, 0xdd, 0xfd, 0x17, 0x6d,
-	0x93, 0x3f, 0x83, 0x35, 0xd3, 0xf0, 0x7c, 0x61, 0x64, 0xd3, 0x5b, 0xee, 0x2e, 0xd6, 0x3e, 0xda,
-	0x95, 0x65, 0xd2, 0xce, 0x99, 0xdb, 0x5f, 0x56, 0x61, 0x83, 0xe2, 0x7f, 0x55, 0xb7, 0xe9, 0x83,
-	0x0f, 0x0f, 0x98, 0x38, 0x8b, 0x43, 0x46, 0x86, 0x50, 0xbd, 0xcd, 0x14, 0x71, 0xbd, 0xf9, 0x5f,
-	0x13, 0xfd, 0xb6, 0x57, 0x7a, 0xdc, 0x0d, 0x2b, 0x5a, 0xe7, 0x83, 0x28, 0x22, 0xae, 0x37, 0xff,
-	0x27, 0xa0, 0xdf, 0xf6, 0x4a, 0x6f, 0xb5, 0x61, 0x85, 0x5c, 0xc7, 0xc9, 0x9e, 0x29, 0x46, 0xba,
-	0xde, 0xc2, 0x9f, 0x11, 0xfd, 0x35, 0x6f, 0xf1, 0x05, 0x65, 0x94, 0xcd, 0x83, 0x8c, 0x74, 0xbd,
-	0x85, 0xbf, 0x02, 0xfa, 0x6b, 0xde, 0xe2, 0x4b, 0xcd, 0x28, 0xdb, 0x96, 0xfe, 0xc4, 0x3e, 0xfb,
-	0x6b, 0x4f, 0xe0, 0x68, 0x58, 0x21, 0x6f, 0xc1, 0xaa, 0x7e, 0x57, 0x91, 0xb6, 0x57, 0xfa, 0x33,
-	0xa0, 0xdf, 0xf1, 0xca, 0x8f, 0xad, 0x61, 0x85, 0xbc, 0x03, 0x0d, 0x7b, 0xfc, 0x64, 0xcd, 0xdb,
-	0x7f, 0xae, 0xd7, 0x4d, 0xa8, 0x1d, 0xe4, 0x41, 0xc8, 0xc8, 0xc2, 0xb5, 0xf4, 0xeb, 0xde, 0x61,
-	0x30, 0x4a, 0xb4, 0xc2, 0x0d, 0x00, 0x63, 0xb4, 0x33, 0xb9, 0xbb, 0xbb, 0x44, 0x9c, 0x3b, 0xbf,
-	0xfa, 0xfa, 0xf1, 0xa0, 0xf2, 0xcf, 0xc7, 0x83, 0xca, 0x37, 0x8f, 0x07, 0x95, 0x7f, 0x3f, 0x1e,
-	0x54, 0xfe, 0xf3, 0x78, 0xe0, 0xfc, 0xfa, 0x62, 0xe0, 0xfc, 0xf9, 0x62, 0xe0, 0xfc, 0xed, 0x62,
-	0x50, 0xf9, 0xfb, 0xc5, 0xa0, 0xf2, 0xf5, 0xc5, 0xc0, 0xf9, 0xc7, 0xc5, 0xc0, 0xf9, 0xe6, 0x62,
-	0xe0, 0xfc, 0xfe, 0xdb, 0x41, 0xe5, 0x8e, 0xf3, 0x59, 0xf3, 0x0c, 0x3d, 0xe5, 0xa3, 0x51, 0x1d,
-	0xff, 0x1c, 0xfc, 0xf1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x30, 0xbb, 0xbb, 0xa5, 0x80, 0x14,
-	0x00, 0x00,
+type SortField struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Type                 bool     `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (this *RequestHead) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*RequestHead)
-	if !ok {
-		that2, ok := that.(RequestHead)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.TimeOutMs != that1.TimeOutMs {
-		return false
-	}
-	if this.UserName != that1.UserName {
-		return false
-	}
-	if this.Password != that1.Password {
-		return false
-	}
-	if this.DbName != that1.DbName {
-		return false
-	}
-	if this.SpaceName != that1.SpaceName {
-		return false
-	}
-	if this.ClientType != that1.ClientType {
-		return false
-	}
-	if len(this.Params) != len(that1.Params) {
-		return false
-	}
-	for i := range this.Params {
-		if this.Params[i] != that1.Params[i] {
-			return false
+func (m *SortField) Reset()      { *m = SortField{} }
+func (*SortField) ProtoMessage() {}
+func (*SortField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{37}
+}
+func (m *SortField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SortField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SortField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *ResponseHead) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SortField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortField.Merge(m, src)
+}
+func (m *SortField) XXX_Size() int {
+	return m.Size()
+}
+func (m *SortField) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortField.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ResponseHead)
-	if !ok {
-		that2, ok := that.(ResponseHead)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Err.Equal(that1.Err) {
-		return false
-	}
-	if len(this.Params) != len(that1.Params) {
-		return false
-	}
-	for i := range this.Params {
-		if this.Params[i] != that1.Params[i] {
-			return false
-		}
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
+var xxx_messageInfo_SortField proto.InternalMessageInfo
+
+type VectorQuery struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	MinScore             float64  `protobuf:"fixed64,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore             float64  `protobuf:"fixed64,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Boost                float64  `protobuf:"fixed64,5,opt,name=boost,proto3" json:"boost,omitempty"`
+	HasBoost             int32    `protobuf:"varint,6,opt,name=has_boost,json=hasBoost,proto3" json:"has_boost,omitempty"`
+	Format               string   `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	RetrievalType        string   `protobuf:"bytes,8,opt,name=retrieval_type,json=retrievalType,proto3" json:"retrieval_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (this *GetRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetRequest)
-	if !ok {
-		that2, ok := that.(GetRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if len(this.PrimaryKeys) != len(that1.PrimaryKeys) {
-		return false
-	}
-	for i := range this.PrimaryKeys {
-		if this.PrimaryKeys[i] != that1.PrimaryKeys[i] {
-			return false
+func (m *VectorQuery) Reset()      { *m = VectorQuery{} }
+func (*VectorQuery) ProtoMessage() {}
+func (*VectorQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{38}
+}
+func (m *VectorQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VectorQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VectorQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *DeleteRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *VectorQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorQuery.Merge(m, src)
+}
+func (m *VectorQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *VectorQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorQuery.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DeleteRequest)
-	if !ok {
-		that2, ok := that.(DeleteRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if len(this.PrimaryKeys) != len(that1.PrimaryKeys) {
-		return false
-	}
-	for i := range this.PrimaryKeys {
-		if this.PrimaryKeys[i] != that1.PrimaryKeys[i] {
-			return false
+var xxx_messageInfo_VectorQuery proto.InternalMessageInfo
+
+type RetrievalParameters struct {
+	MetricType           RetrievalParameters_DistanceMetricType `protobuf:"varint,1,opt,name=metric_type,json=metricType,proto3,enum=RetrievalParameters_DistanceMetricType" json:"metric_type,omitempty"`
+	Nprobe               int32                                  `protobuf:"varint,2,opt,name=nprobe,proto3" json:"nprobe,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *RetrievalParameters) Reset()      { *m = RetrievalParameters{} }
+func (*RetrievalParameters) ProtoMessage() {}
+func (*RetrievalParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{39}
+}
+func (m *RetrievalParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetrievalParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetrievalParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *AddRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RetrievalParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetrievalParameters.Merge(m, src)
+}
+func (m *RetrievalParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetrievalParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetrievalParameters.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AddRequest)
-	if !ok {
-		that2, ok := that.(AddRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_RetrievalParameters proto.InternalMessageInfo
+
+type SearchRequest struct {
+	Head                 *RequestHead      `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	ReqNum               int32             `protobuf:"varint,2,opt,name=req_num,json=reqNum,proto3" json:"req_num,omitempty"`
+	TopN                 int32             `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
+	IsBruteSearch        int32             `protobuf:"varint,4,opt,name=is_brute_search,json=isBruteSearch,proto3" json:"is_brute_search,omitempty"`
+	VecFields            []*VectorQuery    `protobuf:"bytes,5,rep,name=vec_fields,json=vecFields,proto3" json:"vec_fields,omitempty"`
+	Fields               []string          `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	RangeFilters         []*RangeFilter    `protobuf:"bytes,7,rep,name=range_filters,json=rangeFilters,proto3" json:"range_filters,omitempty"`
+	TermFilters          []*TermFilter     `protobuf:"bytes,8,rep,name=term_filters,json=termFilters,proto3" json:"term_filters,omitempty"`
+	OnlineLogLevel       string            `protobuf:"bytes,9,opt,name=online_log_level,json=onlineLogLevel,proto3" json:"online_log_level,omitempty"`
+	RetrievalParams      string            `protobuf:"bytes,10,opt,name=retrieval_params,json=retrievalParams,proto3" json:"retrieval_params,omitempty"`
+	HasRank              bool              `protobuf:"varint,11,opt,name=has_rank,json=hasRank,proto3" json:"has_rank,omitempty"`
+	MultiVectorRank      int32             `protobuf:"varint,12,opt,name=multi_vector_rank,json=multiVectorRank,proto3" json:"multi_vector_rank,omitempty"`
+	ParallelBasedOnQuery bool              `protobuf:"varint,13,opt,name=parallel_based_on_query,json=parallelBasedOnQuery,proto3" json:"parallel_based_on_query,omitempty"`
+	L2Sqrt               bool              `protobuf:"varint,14,opt,name=l2_sqrt,json=l2Sqrt,proto3" json:"l2_sqrt,omitempty"`
+	IvfFlat              bool              `protobuf:"varint,15,opt,name=ivf_flat,json=ivfFlat,proto3" json:"ivf_flat,omitempty"`
+	IsVectorValue        bool              `protobuf:"varint,16,opt,name=is_vector_value,json=isVectorValue,proto3" json:"is_vector_value,omitempty"`
+	SortFieldMap         map[string]string `protobuf:"bytes,17,rep,name=sort_field_map,json=sortFieldMap,proto3" json:"sort_field_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SortFields           []*SortField      `protobuf:"bytes,18,rep,name=sort_fields,json=sortFields,proto3" json:"sort_fields,omitempty"`
+	SearchAfter          *SearchAfter      `protobuf:"bytes,19,opt,name=search_after,json=searchAfter,proto3" json:"search_after,omitempty"`
+	BoolFilter           *BoolFilter       `protobuf:"bytes,20,opt,name=bool_filter,json=boolFilter,proto3" json:"bool_filter,omitempty"`
+	RangeSearch          *RangeSearch      `protobuf:"bytes,21,opt,name=range_search,json=rangeSearch,proto3" json:"range_search,omitempty"`
+	Profile              bool              `protobuf:"varint,22,opt,name=profile,proto3" json:"profile,omitempty"`
+	// fail the search when some partitions failed, by default the hits of the
+	// partitions that answered are returned
+	DenyPartialResults bool `protobuf:"varint,23,opt,name=deny_partial_results,json=denyPartialResults,proto3" json:"deny_partial_results,omitempty"`
+	// a partition not answering in time is failed, 0 uses the request timeout
+	PartitionTimeoutMs   int64    `protobuf:"varint,24,opt,name=partition_timeout_ms,json=partitionTimeoutMs,proto3" json:"partition_timeout_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()      { *m = SearchRequest{} }
+func (*SearchRequest) ProtoMessage() {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{40}
+}
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if !this.Doc.Equal(that1.Doc) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *UpdateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*UpdateRequest)
-	if !ok {
-		that2, ok := that.(UpdateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetHead() *RequestHead {
+	if m != nil {
+		return m.Head
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *SearchRequest) GetReqNum() int32 {
+	if m != nil {
+		return m.ReqNum
 	}
-	if !this.Head.Equal(that1.Head) {
-		return false
+	return 0
+}
+
+func (m *SearchRequest) GetTopN() int32 {
+	if m != nil {
+		return m.TopN
 	}
-	if !this.Doc.Equal(that1.Doc) {
-		return false
+	return 0
+}
+
+func (m *SearchRequest) GetIsBruteSearch() int32 {
+	if m != nil {
+		return m.IsBruteSearch
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return 0
+}
+
+func (m *SearchRequest) GetVecFields() []*VectorQuery {
+	if m != nil {
+		return m.VecFields
 	}
-	return true
+	return nil
 }
-func (this *BulkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SearchRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
 	}
+	return nil
+}
 
-	that1, ok := that.(*BulkRequest)
-	if !ok {
-		that2, ok := that.(BulkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SearchRequest) GetRangeFilters() []*RangeFilter {
+	if m != nil {
+		return m.RangeFilters
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *SearchRequest) GetTermFilters() []*TermFilter {
+	if m != nil {
+		return m.TermFilters
 	}
-	if !this.Head.Equal(that1.Head) {
-		return false
+	return nil
+}
+
+func (m *SearchRequest) GetOnlineLogLevel() string {
+	if m != nil {
+		return m.OnlineLogLevel
 	}
-	if len(this.Docs) != len(that1.Docs) {
-		return false
+	return ""
+}
+
+func (m *SearchRequest) GetRetrievalParams() string {
+	if m != nil {
+		return m.RetrievalParams
 	}
-	for i := range this.Docs {
-		if !this.Docs[i].Equal(that1.Docs[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *SearchRequest) GetHasRank() bool {
+	if m != nil {
+		return m.HasRank
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return false
+}
+
+func (m *SearchRequest) GetMultiVectorRank() int32 {
+	if m != nil {
+		return m.MultiVectorRank
 	}
-	return true
+	return 0
 }
-func (this *ForceMergeRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SearchRequest) GetParallelBasedOnQuery() bool {
+	if m != nil {
+		return m.ParallelBasedOnQuery
 	}
+	return false
+}
 
-	that1, ok := that.(*ForceMergeRequest)
-	if !ok {
-		that2, ok := that.(ForceMergeRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SearchRequest) GetL2Sqrt() bool {
+	if m != nil {
+		return m.L2Sqrt
 	}
-	return true
+	return false
 }
-func (this *FlushRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*FlushRequest)
-	if !ok {
-		that2, ok := that.(FlushRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SearchRequest) GetIvfFlat() bool {
+	if m != nil {
+		return m.IvfFlat
 	}
-	return true
+	return false
 }
-func (this *IndexRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*IndexRequest)
-	if !ok {
-		that2, ok := that.(IndexRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if this.DropBeforeRebuild != that1.DropBeforeRebuild {
-		return false
-	}
-	if this.LimitCpu != that1.LimitCpu {
-		return false
-	}
-	if this.Describe != that1.Describe {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SearchRequest) GetIsVectorValue() bool {
+	if m != nil {
+		return m.IsVectorValue
 	}
-	return true
+	return false
 }
-func (this *GetResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetResponse)
-	if !ok {
-		that2, ok := that.(GetResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if len(this.Items) != len(that1.Items) {
-		return false
-	}
-	for i := range this.Items {
-		if !this.Items[i].Equal(that1.Items[i]) {
-			return false
-		}
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SearchRequest) GetSortFieldMap() map[string]string {
+	if m != nil {
+		return m.SortFieldMap
 	}
-	return true
+	return nil
 }
-func (this *AddResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*AddResponse)
-	if !ok {
-		that2, ok := that.(AddResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if this.PrimaryKey != that1.PrimaryKey {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SearchRequest) GetSortFields() []*SortField {
+	if m != nil {
+		return m.SortFields
 	}
-	return true
+	return nil
 }
-func (this *UpdateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*UpdateResponse)
-	if !ok {
-		that2, ok := that.(UpdateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+func (m *SearchRequest) GetSearchAfter() *SearchAfter {
+	if m != nil {
+		return m.SearchAfter
 	}
-	return true
+	return nil
 }
-func (this *DeleteResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DeleteResponse)
-	if !ok {
-		that2, ok := that.(DeleteResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
+func (m *SearchRequest) GetBoolFilter() *BoolFilter {
+	if m != nil {
+		return m.BoolFilter
 	}
-	if len(this.Items) != len(that1.Items) {
-		return false
+	return nil
+}
+
+func (m *SearchRequest) GetRangeSearch() *RangeSearch {
+	if m != nil {
+		return m.RangeSearch
 	}
-	for i := range this.Items {
-		if !this.Items[i].Equal(that1.Items[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *SearchRequest) GetProfile() bool {
+	if m != nil {
+		return m.Profile
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return false
+}
+
+func (m *SearchRequest) GetDenyPartialResults() bool {
+	if m != nil {
+		return m.DenyPartialResults
 	}
-	return true
+	return false
 }
-func (this *BulkResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SearchRequest) GetPartitionTimeoutMs() int64 {
+	if m != nil {
+		return m.PartitionTimeoutMs
 	}
+	return 0
+}
 
-	that1, ok := that.(*BulkResponse)
-	if !ok {
-		that2, ok := that.(BulkResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SearchAfter is the position of the last hit of the previous page: one value
+// per sort field followed by the primary key used to break ties.
+type SearchAfter struct {
+	SortValues           []string `protobuf:"bytes,1,rep,name=sort_values,json=sortValues,proto3" json:"sort_values,omitempty"`
+	PKey                 string   `protobuf:"bytes,2,opt,name=p_key,json=pKey,proto3" json:"p_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchAfter) Reset()      { *m = SearchAfter{} }
+func (*SearchAfter) ProtoMessage() {}
+func (*SearchAfter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{41}
+}
+func (m *SearchAfter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchAfter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchAfter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Head.Equal(that1.Head) {
-		return false
-	}
-	if len(this.Items) != len(that1.Items) {
-		return false
-	}
-	for i := range this.Items {
-		if !this.Items[i].Equal(that1.Items[i]) {
-			return false
+}
+func (m *SearchAfter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchAfter.Merge(m, src)
+}
+func (m *SearchAfter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchAfter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchAfter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchAfter proto.InternalMessageInfo
+
+// RangeSearch returns every hit within the score bounds of the vector query,
+// page by page in score order. returned counts the hits of previous pages.
+type RangeSearch struct {
+	MaxResults           int32    `protobuf:"varint,1,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	Returned             int32    `protobuf:"varint,2,opt,name=returned,proto3" json:"returned,omitempty"`
+	Stream               bool     `protobuf:"varint,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RangeSearch) Reset()      { *m = RangeSearch{} }
+func (*RangeSearch) ProtoMessage() {}
+func (*RangeSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{42}
+}
+func (m *RangeSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
-	}
-	return true
 }
-func (this *ForceMergeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RangeSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeSearch.Merge(m, src)
+}
+func (m *RangeSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeSearch proto.InternalMessageInfo
+
+type ResultItem struct {
+	Score                float64  `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Fields               []*Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Extra                string   `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+	PKey                 string   `protobuf:"bytes,4,opt,name=p_key,json=pKey,proto3" json:"p_key,omitempty"`
+	Source               []byte   `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultItem) Reset()      { *m = ResultItem{} }
+func (*ResultItem) ProtoMessage() {}
+func (*ResultItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{43}
+}
+func (m *ResultItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResultItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResultItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *ResultItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultItem.Merge(m, src)
+}
+func (m *ResultItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResultItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultItem.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ForceMergeResponse)
-	if !ok {
-		that2, ok := that.(ForceMergeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ResultItem proto.InternalMessageInfo
+
+type SearchResult struct {
+	TotalHits            int32             `protobuf:"varint,1,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	MaxScore             float64           `protobuf:"fixed64,2,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	MaxTook              int64             `protobuf:"varint,3,opt,name=max_took,json=maxTook,proto3" json:"max_took,omitempty"`
+	MaxTookId            uint32            `protobuf:"varint,4,opt,name=max_took_id,json=maxTookId,proto3" json:"max_took_id,omitempty"`
+	Status               *SearchStatus     `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string            `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty"`
+	ResultItems          []*ResultItem     `protobuf:"bytes,7,rep,name=result_items,json=resultItems,proto3" json:"result_items,omitempty"`
+	PID                  uint32            `protobuf:"varint,8,opt,name=pID,proto3" json:"pID,omitempty"`
+	Explain              map[uint32]string `protobuf:"bytes,9,rep,name=explain,proto3" json:"explain,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timeout              bool              `protobuf:"varint,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	TopN                 int32             `protobuf:"varint,11,opt,name=topN,proto3" json:"topN,omitempty"`
+	Cursor               string            `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Truncated            bool              `protobuf:"varint,13,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SearchResult) Reset()      { *m = SearchResult{} }
+func (*SearchResult) ProtoMessage() {}
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{44}
+}
+func (m *SearchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResult.Merge(m, src)
+}
+func (m *SearchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResult proto.InternalMessageInfo
+
+type SearchResponse struct {
+	Head                 *ResponseHead     `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Results              []*SearchResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	OnlineLogMessage     string            `protobuf:"bytes,3,opt,name=online_log_message,json=onlineLogMessage,proto3" json:"online_log_message,omitempty"`
+	Timeout              bool              `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	FlatBytes            []byte            `protobuf:"bytes,5,opt,name=FlatBytes,proto3" json:"FlatBytes,omitempty"`
+	SortFieldMap         map[string]string `protobuf:"bytes,6,rep,name=sort_field_map,json=sortFieldMap,proto3" json:"sort_field_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TopSize              int32             `protobuf:"varint,7,opt,name=top_size,json=topSize,proto3" json:"top_size,omitempty"`
+	Profiles             []*SearchProfile  `protobuf:"bytes,8,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	RouterProfile        *RouterProfile    `protobuf:"bytes,9,opt,name=router_profile,json=routerProfile,proto3" json:"router_profile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SearchResponse) Reset()      { *m = SearchResponse{} }
+func (*SearchResponse) ProtoMessage() {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{45}
+}
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Head.Equal(that1.Head) {
-		return false
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetHead() *ResponseHead {
+	if m != nil {
+		return m.Head
 	}
-	if !this.Shards.Equal(that1.Shards) {
-		return false
+	return nil
+}
+
+func (m *SearchResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return nil
+}
+
+func (m *SearchResponse) GetOnlineLogMessage() string {
+	if m != nil {
+		return m.OnlineLogMessage
 	}
-	return true
+	return ""
 }
-func (this *DelByQueryeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SearchResponse) GetTimeout() bool {
+	if m != nil {
+		return m.Timeout
 	}
+	return false
+}
 
-	that1, ok := that.(*DelByQueryeResponse)
-	if !ok {
-		that2, ok := that.(DelByQueryeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SearchResponse) GetFlatBytes() []byte {
+	if m != nil {
+		return m.FlatBytes
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *SearchResponse) GetSortFieldMap() map[string]string {
+	if m != nil {
+		return m.SortFieldMap
 	}
-	if !this.Head.Equal(that1.Head) {
-		return false
+	return nil
+}
+
+func (m *SearchResponse) GetTopSize() int32 {
+	if m != nil {
+		return m.TopSize
 	}
-	if this.DelNum != that1.DelNum {
-		return false
+	return 0
+}
+
+func (m *SearchResponse) GetProfiles() []*SearchProfile {
+	if m != nil {
+		return m.Profiles
 	}
-	if len(this.IdsStr) != len(that1.IdsStr) {
-		return false
+	return nil
+}
+
+func (m *SearchResponse) GetRouterProfile() *RouterProfile {
+	if m != nil {
+		return m.RouterProfile
 	}
-	for i := range this.IdsStr {
-		if this.IdsStr[i] != that1.IdsStr[i] {
-			return false
+	return nil
+}
+
+// SearchProfile is where a profiled search spent its time on one replica of
+// a partition, times are in milliseconds
+type SearchProfile struct {
+	PartitionId uint32 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	NodeId      uint64 `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// waiting for a search slot of the ps
+	QueueMs float64 `protobuf:"fixed64,3,opt,name=queue_ms,json=queueMs,proto3" json:"queue_ms,omitempty"`
+	// term and range filters of the engine
+	FilterMs   float64 `protobuf:"fixed64,4,opt,name=filter_ms,json=filterMs,proto3" json:"filter_ms,omitempty"`
+	FilterDocs int64   `protobuf:"varint,5,opt,name=filter_docs,json=filterDocs,proto3" json:"filter_docs,omitempty"`
+	AnnMs      float64 `protobuf:"fixed64,6,opt,name=ann_ms,json=annMs,proto3" json:"ann_ms,omitempty"`
+	// vectors compared with the queries, 0 if the index does not count them
+	Candidates int64 `protobuf:"varint,7,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	// index parameters the engine searched with, as nprobe or efSearch
+	IndexParams map[string]int64 `protobuf:"bytes,8,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// reading the fields of the hits
+	FetchMs  float64 `protobuf:"fixed64,9,opt,name=fetch_ms,json=fetchMs,proto3" json:"fetch_ms,omitempty"`
+	EngineMs float64 `protobuf:"fixed64,10,opt,name=engine_ms,json=engineMs,proto3" json:"engine_ms,omitempty"`
+	// search_after applied by the ps
+	PostFilterMs float64 `protobuf:"fixed64,11,opt,name=post_filter_ms,json=postFilterMs,proto3" json:"post_filter_ms,omitempty"`
+	// measured by the router
+	RpcMs                float64  `protobuf:"fixed64,12,opt,name=rpc_ms,json=rpcMs,proto3" json:"rpc_ms,omitempty"`
+	DeserializeMs        float64  `protobuf:"fixed64,13,opt,name=deserialize_ms,json=deserializeMs,proto3" json:"deserialize_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchProfile) Reset()      { *m = SearchProfile{} }
+func (*SearchProfile) ProtoMessage() {}
+func (*SearchProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{46}
+}
+func (m *SearchProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.IdsLong) != len(that1.IdsLong) {
-		return false
+}
+func (m *SearchProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProfile.Merge(m, src)
+}
+func (m *SearchProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProfile proto.InternalMessageInfo
+
+// RouterProfile is the time a profiled search spent on the router
+type RouterProfile struct {
+	MergeMs              float64  `protobuf:"fixed64,1,opt,name=merge_ms,json=mergeMs,proto3" json:"merge_ms,omitempty"`
+	SerializeMs          float64  `protobuf:"fixed64,2,opt,name=serialize_ms,json=serializeMs,proto3" json:"serialize_ms,omitempty"`
+	TookMs               float64  `protobuf:"fixed64,3,opt,name=took_ms,json=tookMs,proto3" json:"took_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouterProfile) Reset()      { *m = RouterProfile{} }
+func (*RouterProfile) ProtoMessage() {}
+func (*RouterProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{47}
+}
+func (m *RouterProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouterProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouterProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	for i := range this.IdsLong {
-		if this.IdsLong[i] != that1.IdsLong[i] {
-			return false
+}
+func (m *RouterProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouterProfile.Merge(m, src)
+}
+func (m *RouterProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouterProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouterProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouterProfile proto.InternalMessageInfo
+
+type SearchStatus struct {
+	Total      int32  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Failed     int32  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Successful int32  `protobuf:"varint,3,opt,name=successful,proto3" json:"successful,omitempty"`
+	Msg        string `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// the partitions that failed, set by the router
+	Failures []*PartitionFailure `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	// ratio of the partitions that answered
+	Coverage             float64  `protobuf:"fixed64,6,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchStatus) Reset()      { *m = SearchStatus{} }
+func (*SearchStatus) ProtoMessage() {}
+func (*SearchStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{48}
+}
+func (m *SearchStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *SearchStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchStatus.Merge(m, src)
+}
+func (m *SearchStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchStatus proto.InternalMessageInfo
+
+type PartitionFailure struct {
+	PartitionId          uint32    `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Code                 ErrorEnum `protobuf:"varint,2,opt,name=code,proto3,enum=ErrorEnum" json:"code,omitempty"`
+	Reason               string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timeout              bool      `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PartitionFailure) Reset()      { *m = PartitionFailure{} }
+func (*PartitionFailure) ProtoMessage() {}
+func (*PartitionFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{49}
+}
+func (m *PartitionFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *FlushResponse) Equal(that interface{}) bool {
+func (m *PartitionFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionFailure.Merge(m, src)
+}
+func (m *PartitionFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionFailure proto.InternalMessageInfo
+
+type MSearchRequest struct {
+	Head                 *RequestHead     `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	SearchRequests       []*SearchRequest `protobuf:"bytes,2,rep,name=search_requests,json=searchRequests,proto3" json:"search_requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MSearchRequest) Reset()      { *m = MSearchRequest{} }
+func (*MSearchRequest) ProtoMessage() {}
+func (*MSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_535779cc1a17303a, []int{50}
+}
+func (m *MSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MSearchRequest.Merge(m, src)
+}
+func (m *MSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MSearchRequest proto.InternalMessageInfo
+
+func (m *MSearchRequest) GetHead() *RequestHead {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *MSearchRequest) GetSearchRequests() []*SearchRequest {
+	if m != nil {
+		return m.SearchRequests
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("FilterClause_Type", FilterClause_Type_name, FilterClause_Type_value)
+	proto.RegisterEnum("RetrievalParameters_DistanceMetricType", RetrievalParameters_DistanceMetricType_name, RetrievalParameters_DistanceMetricType_value)
+	proto.RegisterType((*RequestHead)(nil), "RequestHead")
+	proto.RegisterMapType((map[string]string)(nil), "RequestHead.ParamsEntry")
+	proto.RegisterType((*ResponseHead)(nil), "ResponseHead")
+	proto.RegisterMapType((map[string]string)(nil), "ResponseHead.ParamsEntry")
+	proto.RegisterType((*GetRequest)(nil), "GetRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "DeleteRequest")
+	proto.RegisterType((*AddRequest)(nil), "AddRequest")
+	proto.RegisterType((*UpdateRequest)(nil), "UpdateRequest")
+	proto.RegisterType((*BulkRequest)(nil), "BulkRequest")
+	proto.RegisterType((*ForceMergeRequest)(nil), "ForceMergeRequest")
+	proto.RegisterType((*FlushRequest)(nil), "FlushRequest")
+	proto.RegisterType((*IndexRequest)(nil), "IndexRequest")
+	proto.RegisterType((*QueryByIDsFeatureRequest)(nil), "QueryByIDsFeatureRequest")
+	proto.RegisterType((*DbInfo)(nil), "DbInfo")
+	proto.RegisterType((*DbRequest)(nil), "DbRequest")
+	proto.RegisterType((*DbResponse)(nil), "DbResponse")
+	proto.RegisterType((*SpaceField)(nil), "SpaceField")
+	proto.RegisterType((*SpaceEngine)(nil), "SpaceEngine")
+	proto.RegisterType((*SpacePartition)(nil), "SpacePartition")
+	proto.RegisterType((*SpaceInfo)(nil), "SpaceInfo")
+	proto.RegisterType((*SpaceRequest)(nil), "SpaceRequest")
+	proto.RegisterType((*SpaceResponse)(nil), "SpaceResponse")
+	proto.RegisterType((*ClusterResponse)(nil), "ClusterResponse")
+	proto.RegisterType((*GetResponse)(nil), "GetResponse")
+	proto.RegisterType((*AddResponse)(nil), "AddResponse")
+	proto.RegisterType((*UpdateResponse)(nil), "UpdateResponse")
+	proto.RegisterType((*DeleteResponse)(nil), "DeleteResponse")
+	proto.RegisterType((*BulkResponse)(nil), "BulkResponse")
+	proto.RegisterType((*BulkStreamResponse)(nil), "BulkStreamResponse")
+	proto.RegisterType((*ForceMergeResponse)(nil), "ForceMergeResponse")
+	proto.RegisterType((*DelByQueryeResponse)(nil), "DelByQueryeResponse")
+	proto.RegisterType((*FieldStats)(nil), "FieldStats")
+	proto.RegisterType((*CountResponse)(nil), "CountResponse")
+	proto.RegisterType((*FlushResponse)(nil), "FlushResponse")
+	proto.RegisterType((*IndexResponse)(nil), "IndexResponse")
+	proto.RegisterType((*TermFilter)(nil), "TermFilter")
+	proto.RegisterType((*RangeFilter)(nil), "RangeFilter")
+	proto.RegisterType((*FilterClause)(nil), "FilterClause")
+	proto.RegisterType((*BoolFilter)(nil), "BoolFilter")
+	proto.RegisterType((*SortField)(nil), "SortField")
+	proto.RegisterType((*VectorQuery)(nil), "VectorQuery")
+	proto.RegisterType((*RetrievalParameters)(nil), "RetrievalParameters")
+	proto.RegisterType((*SearchRequest)(nil), "SearchRequest")
+	proto.RegisterMapType((map[string]string)(nil), "SearchRequest.SortFieldMapEntry")
+	proto.RegisterType((*SearchAfter)(nil), "SearchAfter")
+	proto.RegisterType((*RangeSearch)(nil), "RangeSearch")
+	proto.RegisterType((*ResultItem)(nil), "ResultItem")
+	proto.RegisterType((*SearchResult)(nil), "SearchResult")
+	proto.RegisterMapType((map[uint32]string)(nil), "SearchResult.ExplainEntry")
+	proto.RegisterType((*SearchResponse)(nil), "SearchResponse")
+	proto.RegisterMapType((map[string]string)(nil), "SearchResponse.SortFieldMapEntry")
+	proto.RegisterType((*SearchProfile)(nil), "SearchProfile")
+	proto.RegisterMapType((map[string]int64)(nil), "SearchProfile.IndexParamsEntry")
+	proto.RegisterType((*RouterProfile)(nil), "RouterProfile")
+	proto.RegisterType((*SearchStatus)(nil), "SearchStatus")
+	proto.RegisterType((*PartitionFailure)(nil), "PartitionFailure")
+	proto.RegisterType((*MSearchRequest)(nil), "MSearchRequest")
+}
+
+func init() { proto.RegisterFile("router_grpc.proto", fileDescriptor_535779cc1a17303a) }
+
+var fileDescriptor_535779cc1a17303a = []byte{
+	// 3682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0x1c, 0x47,
+	0x76, 0xd3, 0x9c, 0xef, 0xd7, 0x33, 0xc3, 0x51, 0x49, 0x96, 0x46, 0xe3, 0x5d, 0x52, 0x6e, 0x47,
+	0xb6, 0xe2, 0x8f, 0xb6, 0x97, 0x89, 0xb3, 0x59, 0x27, 0x48, 0x22, 0x8a, 0xa2, 0x34, 0x58, 0x0d,
+	0xcd, 0xed, 0xa1, 0xbd, 0xde, 0x45, 0x80, 0x46, 0xcf, 0x74, 0x91, 0x6c, 0xa8, 0xbf, 0x54, 0x55,
+	0xcd, 0x25, 0x7d, 0x08, 0x72, 0x09, 0x12, 0xec, 0x29, 0xc8, 0x21, 0xc8, 0x21, 0x40, 0xf6, 0xb6,
+	0x39, 0x04, 0xc8, 0x35, 0xb9, 0xe5, 0xb8, 0xc7, 0x05, 0x02, 0x04, 0x39, 0xae, 0xe4, 0x3f, 0x90,
+	0xdc, 0x02, 0xe4, 0xb2, 0xa8, 0x57, 0xd5, 0x1f, 0x43, 0x52, 0xe6, 0x08, 0x90, 0x4f, 0xd3, 0xef,
+	0xa3, 0xaa, 0xde, 0x7b, 0xf5, 0xea, 0xd5, 0xab, 0xf7, 0x06, 0xae, 0xb1, 0x24, 0x13, 0x94, 0xb9,
+	0x47, 0x2c, 0x5d, 0xd8, 0x29, 0x4b, 0x44, 0x32, 0x1e, 0xfa, 0x9e, 0xf0, 0xdc, 0x28, 0xf1, 0x69,
+	0xa8, 0x31, 0x3d, 0xca, 0x58, 0xc2, 0xb8, 0x86, 0x3e, 0x3c, 0x0a, 0xc4, 0x71, 0x36, 0xb7, 0x17,
+	0x49, 0xf4, 0xd1, 0x51, 0x72, 0x94, 0x7c, 0x84, 0xe8, 0x79, 0x76, 0x88, 0x10, 0x02, 0xf8, 0xa5,
+	0xd8, 0xad, 0x7f, 0x59, 0x03, 0xd3, 0xa1, 0xcf, 0x32, 0xca, 0xc5, 0x63, 0xea, 0xf9, 0x64, 0x03,
+	0x4c, 0x11, 0x44, 0xd4, 0x4d, 0x32, 0xe1, 0x46, 0x7c, 0x64, 0xdc, 0x31, 0xee, 0xd5, 0x9d, 0xae,
+	0x44, 0x7d, 0x96, 0x89, 0x29, 0x27, 0x6f, 0x42, 0x37, 0xe3, 0x94, 0xb9, 0xb1, 0x17, 0xd1, 0xd1,
+	0xda, 0x1d, 0xe3, 0x5e, 0xd7, 0xe9, 0x48, 0xc4, 0x9e, 0x17, 0x51, 0x32, 0x86, 0x4e, 0xea, 0x71,
+	0xfe, 0xb3, 0x84, 0xf9, 0xa3, 0xba, 0xa2, 0xe5, 0x30, 0xb9, 0x05, 0x6d, 0x7f, 0xae, 0x86, 0x35,
+	0x90, 0xd4, 0xf2, 0xe7, 0x38, 0xe8, 0xbb, 0x00, 0x3c, 0xf5, 0x16, 0x54, 0xd1, 0x9a, 0x48, 0xeb,
+	0x22, 0x06, 0xc9, 0x9b, 0x60, 0x2e, 0xc2, 0x80, 0xc6, 0xc2, 0x15, 0x67, 0x29, 0x1d, 0xb5, 0x90,
+	0x0e, 0x0a, 0x75, 0x70, 0x96, 0x52, 0xf2, 0x31, 0xb4, 0x52, 0x8f, 0x79, 0x11, 0x1f, 0xb5, 0xef,
+	0xd4, 0xef, 0x99, 0x5b, 0x23, 0xbb, 0xa2, 0x8f, 0xbd, 0x8f, 0xa4, 0x87, 0xb1, 0x60, 0x67, 0x8e,
+	0xe6, 0x1b, 0xff, 0x00, 0xcc, 0x0a, 0x9a, 0x0c, 0xa1, 0xfe, 0x94, 0x9e, 0xa1, 0xaa, 0x5d, 0x47,
+	0x7e, 0x92, 0x1b, 0xd0, 0x3c, 0xf1, 0xc2, 0x2c, 0x57, 0x50, 0x01, 0x9f, 0xae, 0xfd, 0xa1, 0x61,
+	0xfd, 0xbd, 0x01, 0x3d, 0x87, 0xf2, 0x34, 0x89, 0x39, 0x45, 0x7b, 0x8d, 0xa0, 0x4e, 0x19, 0xc3,
+	0xc1, 0xe6, 0x56, 0xcb, 0x7e, 0x28, 0xb7, 0xc2, 0x91, 0x28, 0xf2, 0xbd, 0x42, 0xae, 0x3a, 0xca,
+	0x75, 0xdb, 0xae, 0x0e, 0x7c, 0xdd, 0x82, 0xfd, 0x18, 0xe0, 0x11, 0x15, 0x5a, 0x73, 0x72, 0x07,
+	0x1a, 0xc7, 0xd4, 0xf3, 0xb5, 0x58, 0xbd, 0xaa, 0x45, 0x1c, 0xa4, 0x90, 0xb7, 0xa0, 0x97, 0xb2,
+	0x20, 0xf2, 0xd8, 0x99, 0xfb, 0x94, 0x9e, 0xf1, 0x51, 0xe3, 0x4e, 0xfd, 0x5e, 0xd7, 0x31, 0x35,
+	0xee, 0x87, 0xf4, 0x8c, 0x7f, 0xda, 0xf8, 0x9b, 0x5f, 0x6c, 0x1a, 0xd6, 0x4f, 0xa1, 0xbf, 0x43,
+	0x43, 0x2a, 0xe8, 0xb7, 0x30, 0xf7, 0x8f, 0x00, 0xee, 0xfb, 0xfe, 0xea, 0x13, 0xbf, 0x09, 0x75,
+	0x3f, 0x59, 0xa0, 0xff, 0x98, 0x5b, 0x5d, 0x7b, 0x27, 0x59, 0x64, 0x11, 0x8d, 0x85, 0x23, 0xb1,
+	0x7a, 0xca, 0x03, 0xe8, 0x7f, 0x9e, 0xfa, 0x9e, 0xa0, 0xaf, 0x79, 0x56, 0x73, 0x3b, 0x0b, 0x9f,
+	0xae, 0x3e, 0xe7, 0x77, 0xa1, 0xe1, 0x27, 0x0b, 0xa5, 0xfa, 0xd2, 0xa4, 0x88, 0xd6, 0xb3, 0xfe,
+	0x11, 0x5c, 0xdb, 0x4d, 0xd8, 0x82, 0x4e, 0x29, 0x3b, 0x5a, 0x5d, 0x5e, 0x3d, 0xf8, 0x0f, 0xa0,
+	0xb7, 0x1b, 0x66, 0xfc, 0xf8, 0x55, 0xc7, 0xfd, 0x93, 0x01, 0xbd, 0x49, 0xec, 0xd3, 0xd3, 0xd5,
+	0x95, 0xb1, 0xe1, 0xba, 0xcf, 0x92, 0xd4, 0x9d, 0xd3, 0xc3, 0x84, 0x51, 0x97, 0xd1, 0x79, 0x16,
+	0x84, 0x3e, 0xfa, 0x60, 0xdd, 0xb9, 0x26, 0x49, 0xdb, 0x48, 0x71, 0x14, 0x41, 0xc6, 0x88, 0x30,
+	0x88, 0x02, 0xe1, 0x2e, 0xd2, 0x0c, 0xe3, 0x40, 0xdd, 0xe9, 0x20, 0xe2, 0x41, 0x9a, 0xc9, 0x18,
+	0xe1, 0x53, 0xbe, 0x60, 0xc1, 0x5c, 0x05, 0x82, 0xba, 0x53, 0xc0, 0x5a, 0xc2, 0xaf, 0x60, 0xf4,
+	0xa3, 0x8c, 0xb2, 0xb3, 0xed, 0xb3, 0xc9, 0x0e, 0xdf, 0xa5, 0x9e, 0xc8, 0x58, 0x61, 0x9d, 0x4f,
+	0x60, 0xc0, 0xa9, 0xc7, 0x16, 0xc7, 0x2e, 0x53, 0x18, 0x2d, 0xf6, 0xc0, 0x9e, 0x21, 0x5a, 0xf3,
+	0x39, 0x7d, 0x5e, 0x05, 0x2f, 0x78, 0xe4, 0xda, 0xcb, 0x3c, 0xf2, 0x8f, 0xa1, 0xb5, 0x33, 0x9f,
+	0xc4, 0x87, 0x09, 0x19, 0xc0, 0x5a, 0xe0, 0xeb, 0xf8, 0xb7, 0x16, 0xf8, 0x84, 0x40, 0xa3, 0x12,
+	0xf3, 0xf0, 0x5b, 0xf2, 0xa4, 0xea, 0x78, 0x77, 0x9d, 0xb5, 0x94, 0x5b, 0x7f, 0x01, 0xdd, 0x9d,
+	0xf9, 0xea, 0x76, 0xbd, 0x05, 0x6b, 0xfe, 0x1c, 0x27, 0x34, 0xb7, 0xda, 0xb6, 0x5a, 0xd7, 0x59,
+	0xf3, 0xe7, 0xe4, 0x3a, 0x34, 0x53, 0xee, 0x06, 0xa9, 0x0e, 0xa2, 0x8d, 0x94, 0x4f, 0x52, 0x69,
+	0x55, 0x46, 0xa3, 0xe4, 0x84, 0xba, 0x29, 0x47, 0xcb, 0x75, 0x9c, 0x8e, 0x42, 0xec, 0xe7, 0xd2,
+	0xef, 0x03, 0xec, 0xcc, 0xf3, 0x28, 0x43, 0xde, 0x5a, 0x12, 0xa0, 0xbf, 0x14, 0x7e, 0xb4, 0x04,
+	0xb7, 0xa1, 0xee, 0xcf, 0x95, 0x39, 0x2a, 0x22, 0x48, 0x9c, 0x9e, 0xf1, 0x7f, 0x0d, 0x80, 0x99,
+	0x8c, 0xc5, 0xbb, 0x01, 0x0d, 0x4b, 0x23, 0x18, 0x15, 0x23, 0x10, 0x68, 0x60, 0x64, 0xd6, 0x86,
+	0x91, 0xdf, 0x32, 0x4e, 0x05, 0xd2, 0xc7, 0x50, 0x81, 0x8e, 0xa3, 0x00, 0x89, 0xf5, 0x18, 0xf3,
+	0xce, 0xb4, 0xf4, 0x0a, 0x20, 0xdf, 0x81, 0xae, 0x1f, 0x44, 0x34, 0xe6, 0x41, 0x12, 0x63, 0xf8,
+	0x6f, 0x3a, 0x25, 0x82, 0xdc, 0x84, 0xd6, 0x61, 0xc2, 0x22, 0x4f, 0xe8, 0xc8, 0xaf, 0x21, 0xbc,
+	0x35, 0x84, 0xf4, 0x46, 0x5c, 0xbb, 0xad, 0x6f, 0x0d, 0x89, 0xc1, 0x4b, 0x61, 0x13, 0x4c, 0x45,
+	0xc6, 0xc8, 0x3a, 0xea, 0x20, 0x5d, 0x8d, 0xc0, 0x08, 0x4b, 0x6e, 0x43, 0x07, 0xef, 0x50, 0x37,
+	0xf0, 0x47, 0x5d, 0xa4, 0xb6, 0x11, 0x9e, 0xf8, 0xd6, 0xcf, 0xd7, 0xc0, 0x44, 0x9d, 0x1f, 0xc6,
+	0x47, 0x41, 0x8c, 0x17, 0x14, 0xca, 0xef, 0xf2, 0xe0, 0x2b, 0x9a, 0xdf, 0x88, 0x88, 0x99, 0x05,
+	0x5f, 0xe1, 0x52, 0x11, 0x15, 0x2c, 0x58, 0xb8, 0x15, 0x33, 0x80, 0x42, 0xa1, 0x2c, 0x77, 0x61,
+	0xc0, 0x24, 0x44, 0x4f, 0xbc, 0x50, 0xf1, 0xa8, 0x6d, 0xed, 0x17, 0x58, 0x64, 0x7b, 0x17, 0xd6,
+	0x97, 0xd9, 0xf2, 0xc0, 0x39, 0x58, 0xe2, 0xe3, 0xcb, 0x8c, 0x4a, 0x3f, 0x75, 0x6b, 0x96, 0x8c,
+	0x4a, 0xc7, 0xdf, 0x85, 0xe1, 0x39, 0x46, 0xae, 0xad, 0xb8, 0xbe, 0xcc, 0xc9, 0xe5, 0xed, 0x1c,
+	0xf8, 0x55, 0x5b, 0xb6, 0x02, 0x5f, 0xae, 0x66, 0xfd, 0x04, 0x06, 0x68, 0x8b, 0x7d, 0x8f, 0x89,
+	0x40, 0xc8, 0x1d, 0x29, 0x0f, 0x46, 0x1f, 0x0f, 0x86, 0x3c, 0xed, 0xd4, 0xf3, 0x29, 0x93, 0xa6,
+	0x94, 0xda, 0x37, 0x9c, 0x8e, 0x42, 0x4c, 0x7c, 0x79, 0xda, 0x19, 0x4d, 0xc3, 0x60, 0xe1, 0xa9,
+	0x73, 0xd2, 0x70, 0x0a, 0xd8, 0xfa, 0xc5, 0x1a, 0x74, 0x71, 0xee, 0x95, 0xcf, 0xdb, 0x75, 0x68,
+	0xfa, 0x73, 0xb9, 0x8c, 0x0a, 0x2a, 0x0d, 0x7f, 0x3e, 0xf1, 0xc9, 0xdb, 0xd0, 0x4f, 0x73, 0xe1,
+	0xdc, 0x38, 0x8b, 0xd0, 0xbb, 0x9a, 0x4e, 0xaf, 0x40, 0xee, 0x65, 0x91, 0xdc, 0x24, 0xbd, 0x2e,
+	0xb2, 0x28, 0x37, 0x03, 0x8d, 0x92, 0x0c, 0x6f, 0x43, 0xeb, 0x50, 0xba, 0xb8, 0xb4, 0x90, 0x3c,
+	0x0c, 0xa6, 0x5d, 0xba, 0xbd, 0xa3, 0x49, 0xe4, 0x77, 0xa0, 0x45, 0xd1, 0x27, 0x46, 0x6d, 0x7d,
+	0xa8, 0x2b, 0x7e, 0xe2, 0x68, 0x1a, 0x19, 0x41, 0x9b, 0xc6, 0xde, 0x3c, 0xa4, 0x3e, 0xfa, 0x5d,
+	0xc7, 0xc9, 0x41, 0xf2, 0x11, 0x40, 0x21, 0x15, 0x1f, 0x75, 0x71, 0xa1, 0x75, 0x7b, 0xd9, 0xbe,
+	0x4e, 0x85, 0xc5, 0xfa, 0x12, 0x7a, 0x48, 0x5d, 0x3d, 0xa6, 0xdc, 0x81, 0x26, 0xe6, 0x4e, 0x3a,
+	0xac, 0x80, 0x5d, 0x58, 0xd8, 0x51, 0x04, 0x7d, 0xb0, 0xff, 0x1c, 0xfa, 0x7a, 0xe6, 0xd5, 0xa3,
+	0x85, 0x05, 0x2d, 0x9c, 0x22, 0x0f, 0x18, 0xd5, 0xc9, 0x35, 0x45, 0xcf, 0xbe, 0x07, 0xeb, 0x0f,
+	0xc2, 0x8c, 0x0b, 0xca, 0x5e, 0x65, 0x7e, 0x02, 0x8d, 0x79, 0xe2, 0x9f, 0xa1, 0xe8, 0x3d, 0x07,
+	0xbf, 0xf5, 0x7c, 0x33, 0x30, 0x31, 0xbb, 0x59, 0x7d, 0xae, 0x37, 0xa1, 0x19, 0x08, 0x1a, 0xe5,
+	0xa2, 0x36, 0xed, 0x89, 0xa0, 0x91, 0xa3, 0x70, 0x7a, 0xd2, 0x1f, 0x83, 0x89, 0xd9, 0xc7, 0xea,
+	0x93, 0x6e, 0x82, 0x59, 0xb9, 0x46, 0x74, 0x1e, 0x0b, 0xe5, 0x2d, 0xa2, 0x27, 0xfe, 0x01, 0x0c,
+	0xf2, 0x1c, 0x64, 0xe5, 0xb9, 0xf5, 0xd0, 0x2f, 0x60, 0x90, 0x67, 0x5b, 0xaf, 0x55, 0xd7, 0x03,
+	0xe8, 0xa9, 0x04, 0xe6, 0xb5, 0xce, 0x9a, 0x02, 0x91, 0xb3, 0xce, 0x04, 0xa3, 0x5e, 0xf4, 0x2a,
+	0x73, 0xdf, 0x80, 0xe6, 0xdc, 0x13, 0x8b, 0x63, 0x9d, 0x43, 0x28, 0xa0, 0x5c, 0xb1, 0xfe, 0xd2,
+	0x15, 0x7d, 0x20, 0xd5, 0x94, 0x69, 0xf5, 0x15, 0xef, 0x42, 0x8b, 0x1f, 0x7b, 0xcc, 0xe7, 0xfa,
+	0x60, 0xf4, 0x75, 0xc2, 0x30, 0x13, 0x9e, 0xc8, 0xb8, 0xa3, 0x89, 0x7a, 0x95, 0x9f, 0x1b, 0x70,
+	0x7d, 0x87, 0x86, 0xdb, 0x67, 0x98, 0x87, 0xbc, 0xd2, 0x3a, 0x37, 0xa1, 0xb5, 0x43, 0xc3, 0xbd,
+	0x2c, 0xc2, 0x75, 0x9a, 0x8e, 0x86, 0x54, 0x80, 0xe5, 0x2e, 0x17, 0x4c, 0xe7, 0x0b, 0xad, 0xc0,
+	0xe7, 0x33, 0xc1, 0xe4, 0x45, 0x24, 0x09, 0x61, 0x12, 0x1f, 0x61, 0xbc, 0xaf, 0x3b, 0x92, 0xf1,
+	0x49, 0x12, 0x1f, 0x69, 0x61, 0xfe, 0xd6, 0x00, 0xc0, 0x30, 0x24, 0x45, 0xe5, 0xd2, 0x74, 0x18,
+	0x8d, 0xf4, 0x1d, 0xac, 0x00, 0x89, 0x5d, 0x24, 0x59, 0x2c, 0x72, 0x83, 0x22, 0x20, 0x1f, 0x10,
+	0x51, 0x10, 0xeb, 0xeb, 0x46, 0x7e, 0x22, 0xc6, 0x3b, 0xd5, 0x9e, 0x2b, 0x3f, 0x31, 0x1f, 0x0b,
+	0xb8, 0x08, 0xe2, 0x85, 0x18, 0x35, 0x75, 0x3e, 0xa6, 0x61, 0xa9, 0x0c, 0x7f, 0x4a, 0xe5, 0x3e,
+	0xb5, 0xf0, 0x48, 0x6a, 0xc8, 0x3a, 0x81, 0xfe, 0x03, 0xb9, 0xc0, 0x2b, 0x6e, 0xf9, 0x25, 0x12,
+	0x7e, 0x00, 0x26, 0x2a, 0xe0, 0x72, 0xa9, 0x9c, 0xde, 0x78, 0xd3, 0x2e, 0xf5, 0x75, 0xe0, 0xb0,
+	0xf8, 0xb6, 0x5c, 0xe8, 0xeb, 0x9c, 0xf7, 0x5b, 0xda, 0x78, 0x17, 0xfa, 0x3a, 0x37, 0xfe, 0x96,
+	0x16, 0x98, 0x01, 0x1c, 0x50, 0x16, 0xed, 0x06, 0xa1, 0xa0, 0xec, 0xe5, 0x7b, 0x59, 0x3e, 0xf2,
+	0x7a, 0xfa, 0x91, 0x87, 0x7e, 0xc2, 0xdd, 0x2c, 0x0e, 0x12, 0xb5, 0xa1, 0x4d, 0xa7, 0x1d, 0xf0,
+	0xcf, 0x25, 0x68, 0xfd, 0xab, 0x01, 0xa6, 0xe3, 0xc5, 0x47, 0xf4, 0x1b, 0xa7, 0xdd, 0x04, 0x33,
+	0x4c, 0x7e, 0x46, 0x99, 0x5b, 0x9d, 0x1c, 0x10, 0xf5, 0x05, 0xae, 0xb0, 0x09, 0x66, 0x96, 0xa6,
+	0x05, 0x43, 0x5d, 0x31, 0x20, 0x4a, 0x31, 0xbc, 0x0d, 0xfd, 0x20, 0x5e, 0x84, 0x99, 0x4f, 0x5d,
+	0x1c, 0xa6, 0xf3, 0xb8, 0x9e, 0x46, 0x3e, 0x91, 0xb8, 0x2a, 0x13, 0x0e, 0x1d, 0x35, 0x97, 0x98,
+	0x3e, 0x97, 0x38, 0xeb, 0x1f, 0xd7, 0xa0, 0xa7, 0x84, 0x7d, 0x10, 0x7a, 0x19, 0xa7, 0xe4, 0x1d,
+	0x9d, 0x44, 0x4a, 0x89, 0x07, 0x5b, 0xc4, 0xae, 0x12, 0x6d, 0x99, 0x88, 0x94, 0x89, 0xa5, 0x52,
+	0x6d, 0xad, 0xaa, 0xda, 0x4d, 0x68, 0xa1, 0xcc, 0x79, 0x2e, 0xae, 0xa1, 0xd7, 0x28, 0x70, 0x08,
+	0x0d, 0x4c, 0xd2, 0x3a, 0xd0, 0x38, 0x78, 0xe8, 0x4c, 0x87, 0x35, 0xd2, 0x85, 0xa6, 0x73, 0x7f,
+	0xef, 0xd1, 0xc3, 0xa1, 0x41, 0x00, 0x5a, 0x0f, 0xbf, 0x9c, 0xcc, 0x0e, 0x66, 0xc3, 0x35, 0x62,
+	0x42, 0x7b, 0x3a, 0x99, 0xcd, 0x26, 0x7b, 0x8f, 0x86, 0x75, 0x49, 0xd8, 0x77, 0x1e, 0xee, 0x4e,
+	0xbe, 0x1c, 0x36, 0x48, 0x0b, 0xd6, 0x26, 0x7b, 0xc3, 0x26, 0x19, 0x42, 0xef, 0xc1, 0x67, 0x7b,
+	0x07, 0xf7, 0x27, 0x7b, 0x33, 0xf7, 0xfe, 0x93, 0x27, 0xc3, 0xd6, 0x32, 0x66, 0xef, 0x27, 0xc3,
+	0xb6, 0xf5, 0x9f, 0x06, 0xc0, 0x76, 0x92, 0x84, 0x7a, 0x3f, 0xef, 0x42, 0x6b, 0x81, 0x96, 0x28,
+	0xdc, 0xb0, 0x6a, 0x1e, 0x47, 0x13, 0xc9, 0x26, 0x34, 0xa2, 0x8c, 0x0b, 0x1d, 0xaf, 0x4d, 0xbb,
+	0x9c, 0xc1, 0x41, 0x82, 0xcc, 0x71, 0xf8, 0x71, 0x92, 0x85, 0xfe, 0xa8, 0x7e, 0x91, 0x45, 0x93,
+	0xc8, 0x3b, 0xd0, 0x91, 0xcc, 0x6e, 0x9c, 0x88, 0x51, 0xe3, 0x22, 0x5b, 0x5b, 0x12, 0xf7, 0x12,
+	0x41, 0x3e, 0x86, 0x1b, 0x51, 0x10, 0x07, 0x51, 0x16, 0xb9, 0x6a, 0xa4, 0x1b, 0x61, 0x44, 0x57,
+	0xa9, 0x15, 0xd1, 0xb4, 0x19, 0x92, 0xa6, 0x92, 0x62, 0x7d, 0x02, 0xdd, 0x59, 0xc2, 0xc4, 0x6e,
+	0xee, 0xe4, 0x97, 0xf8, 0x68, 0xf5, 0x2d, 0xd1, 0x51, 0x5b, 0x6e, 0x7d, 0x6d, 0x80, 0xf9, 0x05,
+	0x5d, 0x88, 0x84, 0x61, 0x34, 0xbe, 0xf4, 0x0d, 0x72, 0xf9, 0x91, 0x79, 0x13, 0xba, 0x51, 0x10,
+	0xbb, 0x7c, 0x91, 0x30, 0xe5, 0xce, 0x86, 0xd3, 0x89, 0x82, 0x78, 0x26, 0x61, 0x24, 0x7a, 0xa7,
+	0x9a, 0xd8, 0xd0, 0x44, 0xef, 0x54, 0x11, 0xe5, 0xfd, 0x94, 0x24, 0x5c, 0x45, 0x44, 0xc3, 0x51,
+	0x80, 0x1c, 0x72, 0xec, 0x71, 0x57, 0x51, 0x5a, 0xa8, 0x67, 0xe7, 0xd8, 0xe3, 0xdb, 0x48, 0x2c,
+	0x1f, 0x2a, 0xed, 0xa5, 0x87, 0xca, 0xc5, 0xec, 0xbf, 0x73, 0x49, 0xf6, 0x6f, 0xfd, 0xd2, 0x80,
+	0xeb, 0xce, 0x52, 0x52, 0x4e, 0x05, 0x65, 0x9c, 0x3c, 0x5e, 0x7e, 0x5d, 0xa8, 0xf3, 0xf1, 0xae,
+	0x7d, 0x09, 0xab, 0xbd, 0x13, 0x70, 0xe1, 0xc5, 0xf2, 0x6e, 0xcc, 0x9f, 0x1e, 0x4b, 0xcf, 0x90,
+	0x9b, 0xd0, 0x8a, 0x53, 0x96, 0xcc, 0x69, 0x7e, 0x33, 0x29, 0xc8, 0xb2, 0x81, 0x5c, 0x1c, 0x29,
+	0x9d, 0x72, 0x12, 0xc7, 0x94, 0xed, 0xb3, 0xc4, 0xcf, 0x16, 0x62, 0x58, 0x93, 0x0e, 0xfc, 0x64,
+	0x6b, 0x68, 0x58, 0xbf, 0xec, 0x40, 0x7f, 0xe9, 0xb1, 0xbd, 0xd2, 0x4b, 0xb7, 0xcd, 0xe8, 0x33,
+	0x4c, 0xbd, 0xf5, 0xe2, 0x8c, 0x3e, 0x93, 0xd7, 0xa2, 0xdc, 0xf0, 0x24, 0xdd, 0xd3, 0x11, 0x0d,
+	0xbf, 0xc9, 0x3b, 0xb0, 0x1e, 0x70, 0x77, 0xce, 0x32, 0x41, 0x5d, 0xf5, 0x8c, 0xd7, 0x29, 0x7d,
+	0x3f, 0xe0, 0xdb, 0x12, 0xab, 0x56, 0x27, 0xef, 0x03, 0x9c, 0xd0, 0x85, 0xab, 0xd3, 0xf6, 0x26,
+	0xfa, 0x6a, 0xcf, 0xae, 0xb8, 0x8a, 0xd3, 0x3d, 0xa1, 0x0b, 0x74, 0x37, 0x8e, 0xdb, 0x53, 0xe6,
+	0xf7, 0xdd, 0x22, 0xa5, 0xff, 0x1e, 0xf4, 0x99, 0x0c, 0x9d, 0xee, 0x21, 0xfa, 0x77, 0x5e, 0x44,
+	0xec, 0xd9, 0x95, 0x80, 0xea, 0xf4, 0x58, 0x09, 0x70, 0x62, 0x43, 0x4f, 0x50, 0x16, 0x15, 0x23,
+	0x3a, 0xfa, 0x94, 0x94, 0x81, 0xdd, 0x31, 0x45, 0xf1, 0xcd, 0xc9, 0x3d, 0x18, 0x26, 0x71, 0x18,
+	0xc4, 0x32, 0x08, 0x1d, 0xb9, 0x21, 0x3d, 0xa1, 0xa1, 0x7e, 0x72, 0x0e, 0x14, 0xfe, 0x49, 0x72,
+	0xf4, 0x44, 0x62, 0x2f, 0x7d, 0xb0, 0xc1, 0xe5, 0x0f, 0xb6, 0xdb, 0x20, 0x5d, 0xcf, 0x65, 0x5e,
+	0xfc, 0x74, 0x64, 0xaa, 0x57, 0xc6, 0xb1, 0xc7, 0x1d, 0x2f, 0x7e, 0x4a, 0xde, 0x83, 0x6b, 0x51,
+	0x16, 0x8a, 0xc0, 0x3d, 0x41, 0x53, 0x28, 0x9e, 0x1e, 0x5a, 0x70, 0x1d, 0x09, 0xca, 0x44, 0xc8,
+	0xfb, 0x09, 0xdc, 0x92, 0xeb, 0x84, 0x21, 0x0d, 0xdd, 0xb9, 0xc7, 0xa9, 0xef, 0x26, 0xb1, 0xfb,
+	0x4c, 0x1a, 0x6f, 0xd4, 0xc7, 0x59, 0x6f, 0xe4, 0xe4, 0x6d, 0x49, 0xfd, 0x2c, 0x56, 0x67, 0xf0,
+	0x16, 0xb4, 0xc3, 0x2d, 0x97, 0x3f, 0x63, 0x62, 0x34, 0x40, 0xb6, 0x56, 0xb8, 0x35, 0x7b, 0xc6,
+	0x04, 0xde, 0x52, 0x27, 0x87, 0xee, 0x61, 0xe8, 0x89, 0xd1, 0xba, 0x12, 0x2b, 0x38, 0x39, 0xdc,
+	0x0d, 0x3d, 0xa1, 0xb7, 0x55, 0xcb, 0xa4, 0x4e, 0xeb, 0x10, 0x39, 0xfa, 0x01, 0x57, 0x12, 0xa9,
+	0x5b, 0x66, 0x17, 0x06, 0x3c, 0x61, 0x42, 0xed, 0xab, 0x1b, 0x79, 0xe9, 0xe8, 0x1a, 0x1a, 0xf8,
+	0xce, 0x72, 0x89, 0xc7, 0x2e, 0x62, 0xc9, 0xd4, 0x4b, 0x55, 0x19, 0xb5, 0xc7, 0x2b, 0x28, 0xf2,
+	0x3e, 0x98, 0xe5, 0x3c, 0x7c, 0x44, 0xf2, 0x27, 0x4b, 0xce, 0xe3, 0x40, 0xc1, 0xce, 0xc9, 0x47,
+	0xd0, 0xd3, 0x75, 0x25, 0xef, 0x50, 0x50, 0x36, 0xba, 0x9e, 0xbf, 0xef, 0x10, 0x79, 0xff, 0x10,
+	0x37, 0x95, 0x97, 0x80, 0x4c, 0x5c, 0xe6, 0x49, 0x12, 0x6a, 0x27, 0x18, 0xdd, 0xb8, 0x63, 0x9c,
+	0x8f, 0x94, 0x30, 0x2f, 0xbe, 0xe5, 0xf4, 0xca, 0xcb, 0xb4, 0x3f, 0xbf, 0x91, 0x9f, 0x14, 0x89,
+	0xd4, 0x6a, 0x99, 0xac, 0x04, 0xe4, 0x1b, 0x32, 0x65, 0xc9, 0x61, 0x10, 0xd2, 0xd1, 0x4d, 0x65,
+	0x46, 0x0d, 0xca, 0xb8, 0xeb, 0xd3, 0xf8, 0xcc, 0xc5, 0x57, 0xa2, 0x17, 0xba, 0x8c, 0xf2, 0x2c,
+	0x14, 0x7c, 0x74, 0x0b, 0xd9, 0x88, 0xa4, 0xed, 0x2b, 0x92, 0xa3, 0x28, 0x72, 0x44, 0xf9, 0x40,
+	0x96, 0x95, 0x7c, 0x5d, 0xdb, 0x1f, 0x61, 0x22, 0x46, 0x0a, 0xda, 0x81, 0x22, 0x4d, 0xf9, 0xf8,
+	0x4f, 0xe1, 0xda, 0x05, 0xeb, 0xbe, 0x4a, 0x35, 0x5a, 0x27, 0x3b, 0x0f, 0xc0, 0xac, 0xd8, 0x8f,
+	0x6c, 0xea, 0x0d, 0xd1, 0x57, 0xb5, 0x81, 0xe7, 0x10, 0x37, 0x01, 0x37, 0x9e, 0x63, 0xd9, 0x0b,
+	0x1f, 0x56, 0xfa, 0xcd, 0x9f, 0xfe, 0x90, 0x9e, 0x59, 0x73, 0x9d, 0xdb, 0x68, 0xc3, 0xc8, 0x6a,
+	0x8b, 0x77, 0x5a, 0x68, 0x6d, 0xa8, 0x87, 0x7c, 0xe4, 0x9d, 0xe6, 0xda, 0x62, 0xc5, 0x41, 0x64,
+	0x2c, 0xa6, 0xbe, 0x8e, 0x35, 0x05, 0x8c, 0xf9, 0x2c, 0xbe, 0x55, 0x74, 0x5d, 0x4a, 0x43, 0xd6,
+	0x5f, 0x19, 0x00, 0x6a, 0xbc, 0x7c, 0x71, 0x48, 0xbd, 0xd4, 0xb5, 0x60, 0xa8, 0xe8, 0x8f, 0x00,
+	0xd9, 0x28, 0x22, 0x88, 0xba, 0x60, 0x5b, 0xf6, 0x72, 0x71, 0xe0, 0x06, 0x34, 0xe9, 0xa9, 0x60,
+	0x9e, 0x4e, 0xb7, 0x15, 0x50, 0xea, 0xd4, 0x28, 0x75, 0x42, 0x39, 0x92, 0x8c, 0x2d, 0x54, 0xbb,
+	0xa3, 0xe7, 0x68, 0xc8, 0xfa, 0xaf, 0x3a, 0xf4, 0x72, 0x27, 0x97, 0xd2, 0xc8, 0xd2, 0x93, 0x48,
+	0x84, 0x17, 0xba, 0xc7, 0x41, 0xa1, 0x6c, 0x17, 0x31, 0x8f, 0x03, 0xc1, 0x97, 0xef, 0xb0, 0xb5,
+	0x73, 0x77, 0x98, 0xac, 0x70, 0x79, 0xa7, 0xae, 0x48, 0x92, 0xa7, 0xba, 0x5e, 0xd2, 0x8e, 0xbc,
+	0xd3, 0x83, 0x24, 0x79, 0x2a, 0x9b, 0x3c, 0x39, 0x49, 0x56, 0x53, 0x1a, 0x58, 0xcb, 0xe9, 0x6a,
+	0xea, 0x44, 0xa5, 0xb4, 0x98, 0xbd, 0x8e, 0x9a, 0x3a, 0xe1, 0x38, 0x97, 0xd2, 0xe2, 0x2f, 0x3e,
+	0x26, 0xf8, 0x91, 0x2e, 0x29, 0xc9, 0x4f, 0x19, 0x1a, 0xd5, 0xce, 0xb8, 0xea, 0x21, 0xd7, 0xd6,
+	0xa1, 0xb1, 0x34, 0xae, 0x63, 0xb2, 0xe2, 0x1b, 0x67, 0x48, 0x27, 0x3b, 0x78, 0x23, 0xf6, 0x1d,
+	0xf9, 0x49, 0x7e, 0x1f, 0xda, 0xf4, 0x34, 0x0d, 0xbd, 0x20, 0xd6, 0xf5, 0x91, 0xb1, 0x5d, 0xb5,
+	0x88, 0xfd, 0x50, 0x11, 0xd5, 0x81, 0xcf, 0x59, 0xe5, 0x71, 0xd1, 0x8e, 0x8d, 0xf1, 0xb2, 0xe3,
+	0xe4, 0x60, 0x71, 0xc1, 0x98, 0x95, 0x0b, 0xe6, 0x26, 0xb4, 0x16, 0x19, 0xe3, 0x09, 0xc3, 0xa8,
+	0xd8, 0x75, 0x34, 0x24, 0x2b, 0x91, 0x82, 0x65, 0xf1, 0xc2, 0x13, 0xd4, 0xd7, 0xe1, 0xaf, 0x44,
+	0x8c, 0x3f, 0x85, 0x5e, 0x75, 0xf1, 0xea, 0x79, 0xe8, 0x5f, 0xd5, 0x9d, 0xf9, 0x75, 0x1d, 0x06,
+	0x85, 0x1a, 0x2b, 0xbf, 0x2c, 0xde, 0x95, 0xb7, 0xa6, 0xf2, 0x73, 0xe5, 0x72, 0xfd, 0x25, 0x5b,
+	0x38, 0x39, 0x95, 0x7c, 0x00, 0xa4, 0x72, 0xc3, 0x44, 0x94, 0x73, 0xef, 0x28, 0xaf, 0x32, 0x0e,
+	0x8b, 0x3b, 0x66, 0xaa, 0xf0, 0x55, 0x63, 0x35, 0x96, 0x8d, 0xf5, 0x1d, 0xe8, 0xca, 0x50, 0xbd,
+	0x7d, 0x26, 0x28, 0xd7, 0xae, 0x59, 0x22, 0xc8, 0xa3, 0x0b, 0x81, 0x59, 0x95, 0xca, 0xde, 0xb2,
+	0x97, 0x55, 0xbb, 0x32, 0x32, 0xdf, 0x86, 0x8e, 0x48, 0x52, 0x55, 0x4e, 0x6d, 0xab, 0xa7, 0x8c,
+	0x48, 0x52, 0x2c, 0xa6, 0xbe, 0x07, 0x1d, 0x1d, 0xe8, 0xf2, 0x7b, 0x35, 0xaf, 0xec, 0xef, 0x2b,
+	0xb4, 0x53, 0xd0, 0x65, 0x2f, 0x40, 0xb7, 0x47, 0x35, 0x0a, 0x6f, 0x55, 0x39, 0xc2, 0x41, 0x74,
+	0x3e, 0xa2, 0xcf, 0xaa, 0xe0, 0xeb, 0x0a, 0x6e, 0xff, 0x5f, 0xcf, 0xd3, 0x20, 0x3d, 0x31, 0x36,
+	0x19, 0x8a, 0x38, 0x5b, 0x94, 0x48, 0xcd, 0x02, 0x37, 0xc1, 0x3c, 0x28, 0x4e, 0x7c, 0x5a, 0x56,
+	0x4a, 0x5b, 0x12, 0x9c, 0xc8, 0x42, 0x7c, 0xe7, 0x59, 0x46, 0x33, 0xea, 0x62, 0xf5, 0x43, 0x1e,
+	0xe4, 0x36, 0xc2, 0xaa, 0xe3, 0xaa, 0x2e, 0x19, 0x49, 0xd3, 0x89, 0xaa, 0x42, 0x4c, 0xb9, 0x0c,
+	0x87, 0x9a, 0x88, 0xed, 0x26, 0xf5, 0x80, 0x07, 0x85, 0xda, 0x49, 0x16, 0x9c, 0xbc, 0x01, 0x2d,
+	0x2f, 0x8e, 0x5d, 0x5d, 0xf9, 0x35, 0x9c, 0xa6, 0x17, 0xc7, 0x53, 0x4e, 0x36, 0x00, 0x16, 0x5e,
+	0xec, 0x07, 0xbe, 0x27, 0xb7, 0xba, 0xad, 0x86, 0x95, 0x18, 0xb2, 0x0d, 0x3d, 0x55, 0xf3, 0xd6,
+	0x59, 0x88, 0xda, 0x8b, 0xcd, 0xe5, 0xbd, 0xb0, 0xf1, 0xad, 0x5c, 0x6d, 0x64, 0x9a, 0x41, 0x89,
+	0x91, 0x3a, 0x1d, 0xca, 0x72, 0x81, 0x5c, 0xbc, 0xab, 0x74, 0x42, 0x58, 0xe9, 0xa4, 0x8a, 0xa5,
+	0xae, 0xce, 0x70, 0x0c, 0xa7, 0xa3, 0x10, 0x53, 0x59, 0x65, 0x1d, 0xa4, 0x09, 0x17, 0x6e, 0xa9,
+	0xb5, 0x89, 0x1c, 0x3d, 0x89, 0xdd, 0xcd, 0x35, 0x7f, 0x03, 0x5a, 0x2c, 0x5d, 0x48, 0x6a, 0x4f,
+	0x29, 0xc6, 0xd2, 0xc5, 0x94, 0xcb, 0x74, 0xdb, 0xa7, 0x9c, 0xb2, 0xc0, 0x0b, 0x83, 0xaf, 0x70,
+	0xfa, 0x3e, 0x92, 0xfb, 0x15, 0xec, 0x94, 0x8f, 0xff, 0x04, 0x86, 0xe7, 0x85, 0xbf, 0xca, 0x07,
+	0xea, 0xd5, 0x03, 0x7d, 0x08, 0xfd, 0x25, 0x27, 0xc3, 0x68, 0x2b, 0x6b, 0x52, 0x79, 0xd3, 0xdc,
+	0x70, 0xda, 0x08, 0x4f, 0xb9, 0xf4, 0x8b, 0x25, 0x81, 0x54, 0xa0, 0x36, 0x2b, 0xe2, 0x48, 0xbf,
+	0xc0, 0x60, 0x5c, 0xec, 0x7e, 0x4b, 0x82, 0x53, 0x6e, 0xfd, 0xbb, 0x01, 0xbd, 0x6a, 0xec, 0x95,
+	0x22, 0x61, 0xfc, 0xd7, 0x97, 0x81, 0x02, 0x30, 0xbb, 0xf5, 0x82, 0xb0, 0xb8, 0xf2, 0x34, 0x24,
+	0xb7, 0x99, 0x67, 0x8b, 0x05, 0xe5, 0xfc, 0x30, 0x0b, 0x75, 0x92, 0x5d, 0xc1, 0xe4, 0x11, 0xbc,
+	0x51, 0x46, 0xf0, 0x0f, 0xa1, 0x23, 0xc7, 0x66, 0x8c, 0xe6, 0x29, 0xf5, 0x35, 0xbb, 0xa8, 0x4d,
+	0xef, 0x2a, 0x8a, 0x53, 0xb0, 0xc8, 0xdb, 0x76, 0x91, 0x9c, 0x50, 0x26, 0xe3, 0x8d, 0x72, 0xb0,
+	0x02, 0xb6, 0xfe, 0xda, 0x80, 0xe1, 0xf9, 0xa1, 0xab, 0x1c, 0x92, 0x0d, 0x68, 0x2c, 0x12, 0x5f,
+	0x19, 0x7d, 0xb0, 0x05, 0xaa, 0xa7, 0xfe, 0x30, 0xce, 0x22, 0x07, 0xf1, 0x52, 0x59, 0x46, 0x3d,
+	0x9e, 0xe4, 0x85, 0x2d, 0x0d, 0xbd, 0x3c, 0xae, 0x59, 0xcf, 0x60, 0x30, 0x7d, 0xd5, 0x27, 0xcb,
+	0xf7, 0x61, 0x7d, 0xb9, 0xd3, 0x98, 0x07, 0xe1, 0xf3, 0xad, 0xc6, 0xc1, 0x52, 0xab, 0x51, 0x17,
+	0x7a, 0xb6, 0xfe, 0xce, 0x84, 0x6b, 0xca, 0x43, 0x1e, 0x39, 0xfb, 0x0f, 0x66, 0x94, 0x9d, 0x04,
+	0x0b, 0x4a, 0x2c, 0xa8, 0x3f, 0xa2, 0x82, 0x98, 0x76, 0xd9, 0xab, 0x1f, 0xf7, 0xec, 0x4a, 0x69,
+	0xdb, 0xaa, 0x49, 0x9e, 0xfb, 0xbe, 0x4f, 0x4c, 0xbb, 0x6c, 0x8d, 0x8f, 0x7b, 0x76, 0xa5, 0x52,
+	0x6d, 0xd5, 0xc8, 0xfb, 0x58, 0x65, 0xa4, 0x82, 0x92, 0x81, 0xbd, 0xd4, 0x9d, 0x1f, 0xaf, 0xdb,
+	0xcb, 0xf5, 0x63, 0xc5, 0xac, 0xca, 0xd1, 0x64, 0x60, 0x2f, 0xf5, 0xc6, 0xc7, 0xeb, 0xf6, 0x72,
+	0x9d, 0x5a, 0x31, 0xeb, 0x4c, 0xeb, 0x9c, 0x9e, 0xe3, 0xf5, 0x73, 0x61, 0xde, 0xaa, 0x91, 0xbb,
+	0xd0, 0x90, 0xf5, 0x5f, 0xd2, 0xb3, 0x2b, 0xdd, 0xf1, 0x71, 0xdf, 0xae, 0x96, 0x9a, 0xad, 0x1a,
+	0xf9, 0x10, 0xda, 0xda, 0xfc, 0x64, 0xdd, 0x9e, 0x5e, 0x39, 0xeb, 0x26, 0x34, 0xb1, 0xaf, 0x40,
+	0x96, 0xb6, 0x65, 0xdc, 0xb2, 0x0f, 0x64, 0x17, 0xc5, 0xaa, 0xc9, 0x36, 0x8a, 0x1a, 0x24, 0x3b,
+	0xc4, 0xab, 0xc8, 0xf9, 0xae, 0xb4, 0x00, 0xa7, 0x4c, 0x5c, 0x25, 0xe9, 0x7b, 0xd0, 0x54, 0x0f,
+	0x9c, 0x15, 0x26, 0xfd, 0x7e, 0xfe, 0xc7, 0x88, 0xed, 0xb3, 0xcb, 0xc7, 0xdc, 0xb0, 0x2f, 0xa9,
+	0x21, 0x5b, 0x35, 0x72, 0x0f, 0x9a, 0x58, 0xc5, 0x24, 0x7d, 0xbb, 0xda, 0xc1, 0x1f, 0x0f, 0xec,
+	0xa5, 0xe2, 0x26, 0x2e, 0x01, 0x65, 0xb5, 0x9b, 0x10, 0xfb, 0xc2, 0xbf, 0x05, 0xc6, 0xd7, 0xed,
+	0x8b, 0xe5, 0x70, 0xd4, 0xa3, 0x9d, 0x37, 0xe3, 0xfb, 0x76, 0xb5, 0xdb, 0x3f, 0x1e, 0xd8, 0x4b,
+	0x05, 0x4e, 0xab, 0x46, 0xee, 0xc3, 0xb5, 0x0b, 0xed, 0x76, 0x72, 0xdb, 0x7e, 0x59, 0x0b, 0xfe,
+	0x32, 0x53, 0x7c, 0x02, 0x50, 0xf6, 0x01, 0xce, 0xd9, 0xf8, 0xba, 0x7d, 0xb1, 0x45, 0x60, 0xd5,
+	0xee, 0x19, 0x1f, 0x1b, 0xc4, 0x06, 0x78, 0x12, 0x70, 0x21, 0x0f, 0x07, 0x65, 0xe7, 0x76, 0x7b,
+	0x68, 0x9f, 0x6b, 0x20, 0x59, 0x35, 0x59, 0xbf, 0x92, 0xfc, 0x3b, 0xdb, 0x04, 0xec, 0xa2, 0xcf,
+	0x3e, 0x36, 0xed, 0xb2, 0xe7, 0x6d, 0xd5, 0xc8, 0x07, 0xd0, 0xc5, 0x49, 0xd1, 0x83, 0xfa, 0x76,
+	0xb5, 0x7d, 0x36, 0x1e, 0xd8, 0x4b, 0x3d, 0x2f, 0xab, 0x26, 0x9f, 0xff, 0x92, 0xbb, 0xec, 0x6e,
+	0x5e, 0x2d, 0xc5, 0x5d, 0xe8, 0x3c, 0x60, 0xd4, 0x13, 0xf4, 0x9b, 0xe5, 0xb0, 0xa0, 0xf9, 0x88,
+	0x5e, 0x21, 0xeb, 0x5d, 0xe8, 0x28, 0x17, 0xba, 0x92, 0x6d, 0x9a, 0xf8, 0xc1, 0xe1, 0xd9, 0x37,
+	0xb3, 0xd9, 0x60, 0x2a, 0xc1, 0x56, 0xd4, 0xfd, 0x7d, 0xe8, 0x3c, 0xa2, 0xab, 0x1a, 0xca, 0x06,
+	0x53, 0xc5, 0x8a, 0xd5, 0xf9, 0x95, 0x6a, 0xab, 0x6f, 0x84, 0x36, 0xf5, 0x63, 0xea, 0x85, 0xe2,
+	0x78, 0x85, 0x8d, 0xf8, 0x18, 0x7a, 0x1a, 0xa9, 0x3a, 0x23, 0x57, 0x8e, 0xd8, 0xfe, 0xb3, 0x5f,
+	0x3d, 0xdf, 0xa8, 0xfd, 0xf7, 0xf3, 0x8d, 0xda, 0x6f, 0x9e, 0x6f, 0xd4, 0xfe, 0xe7, 0xf9, 0x46,
+	0xed, 0xff, 0x9e, 0x6f, 0x18, 0x7f, 0xf9, 0x62, 0xc3, 0xf8, 0xe7, 0x17, 0x1b, 0xc6, 0xbf, 0xbd,
+	0xd8, 0xa8, 0xfd, 0xc7, 0x8b, 0x8d, 0xda, 0xaf, 0x5e, 0x6c, 0x18, 0xbf, 0x7e, 0xb1, 0x61, 0xfc,
+	0xe6, 0xc5, 0x86, 0xf1, 0x0f, 0x5f, 0x6f, 0xd4, 0x1e, 0x1b, 0x3f, 0xed, 0x9c, 0xa0, 0xc7, 0xa7,
+	0xf3, 0x79, 0x0b, 0xff, 0x35, 0xf7, 0x7b, 0xbf, 0x1d, 0x00, 0x58, 0x77, 0xf8, 0x3d, 0x99, 0x27,
+	0x00, 0x00,
+}
+
+func (this *RequestHead) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FlushResponse)
+	that1, ok := that.(*RequestHead)
 	if !ok {
-		that2, ok := that.(FlushResponse)
+		that2, ok := that.(RequestHead)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2514,25 +3229,45 @@ func (this *FlushResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Head.Equal(that1.Head) {
+	if this.TimeOutMs != that1.TimeOutMs {
 		return false
 	}
-	if !this.Shards.Equal(that1.Shards) {
+	if this.UserName != that1.UserName {
+		return false
+	}
+	if this.Password != that1.Password {
 		return false
 	}
+	if this.DbName != that1.DbName {
+		return false
+	}
+	if this.SpaceName != that1.SpaceName {
+		return false
+	}
+	if this.ClientType != that1.ClientType {
+		return false
+	}
+	if len(this.Params) != len(that1.Params) {
+		return false
+	}
+	for i := range this.Params {
+		if this.Params[i] != that1.Params[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *IndexResponse) Equal(that interface{}) bool {
+func (this *ResponseHead) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IndexResponse)
+	that1, ok := that.(*ResponseHead)
 	if !ok {
-		that2, ok := that.(IndexResponse)
+		that2, ok := that.(ResponseHead)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2544,25 +3279,30 @@ func (this *IndexResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Head.Equal(that1.Head) {
+	if !this.Err.Equal(that1.Err) {
 		return false
 	}
-	if !this.Shards.Equal(that1.Shards) {
+	if len(this.Params) != len(that1.Params) {
 		return false
 	}
+	for i := range this.Params {
+		if this.Params[i] != that1.Params[i] {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *TermFilter) Equal(that interface{}) bool {
+func (this *GetRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TermFilter)
+	that1, ok := that.(*GetRequest)
 	if !ok {
-		that2, ok := that.(TermFilter)
+		that2, ok := that.(GetRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2574,28 +3314,30 @@ func (this *TermFilter) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Field != that1.Field {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if !bytes.Equal(this.Value, that1.Value) {
+	if len(this.PrimaryKeys) != len(that1.PrimaryKeys) {
 		return false
 	}
-	if this.IsUnion != that1.IsUnion {
-		return false
+	for i := range this.PrimaryKeys {
+		if this.PrimaryKeys[i] != that1.PrimaryKeys[i] {
+			return false
+		}
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *RangeFilter) Equal(that interface{}) bool {
+func (this *DeleteRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RangeFilter)
+	that1, ok := that.(*DeleteRequest)
 	if !ok {
-		that2, ok := that.(RangeFilter)
+		that2, ok := that.(DeleteRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2607,34 +3349,30 @@ func (this *RangeFilter) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Field != that1.Field {
-		return false
-	}
-	if !bytes.Equal(this.LowerValue, that1.LowerValue) {
-		return false
-	}
-	if !bytes.Equal(this.UpperValue, that1.UpperValue) {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if this.IncludeLower != that1.IncludeLower {
+	if len(this.PrimaryKeys) != len(that1.PrimaryKeys) {
 		return false
 	}
-	if this.IncludeUpper != that1.IncludeUpper {
-		return false
+	for i := range this.PrimaryKeys {
+		if this.PrimaryKeys[i] != that1.PrimaryKeys[i] {
+			return false
+		}
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *SortField) Equal(that interface{}) bool {
+func (this *AddRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SortField)
+	that1, ok := that.(*AddRequest)
 	if !ok {
-		that2, ok := that.(SortField)
+		that2, ok := that.(AddRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2646,10 +3384,10 @@ func (this *SortField) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Field != that1.Field {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if this.Type != that1.Type {
+	if !this.Doc.Equal(that1.Doc) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2657,14 +3395,14 @@ func (this *SortField) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *VectorQuery) Equal(that interface{}) bool {
+func (this *UpdateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*VectorQuery)
+	that1, ok := that.(*UpdateRequest)
 	if !ok {
-		that2, ok := that.(VectorQuery)
+		that2, ok := that.(UpdateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2676,28 +3414,10 @@ func (this *VectorQuery) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if !bytes.Equal(this.Value, that1.Value) {
-		return false
-	}
-	if this.MinScore != that1.MinScore {
-		return false
-	}
-	if this.MaxScore != that1.MaxScore {
-		return false
-	}
-	if this.Boost != that1.Boost {
-		return false
-	}
-	if this.HasBoost != that1.HasBoost {
-		return false
-	}
-	if this.Format != that1.Format {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if this.RetrievalType != that1.RetrievalType {
+	if !this.Doc.Equal(that1.Doc) {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2705,14 +3425,14 @@ func (this *VectorQuery) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RetrievalParameters) Equal(that interface{}) bool {
+func (this *BulkRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RetrievalParameters)
+	that1, ok := that.(*BulkRequest)
 	if !ok {
-		that2, ok := that.(RetrievalParameters)
+		that2, ok := that.(BulkRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2724,25 +3444,30 @@ func (this *RetrievalParameters) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MetricType != that1.MetricType {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if this.Nprobe != that1.Nprobe {
+	if len(this.Docs) != len(that1.Docs) {
 		return false
 	}
+	for i := range this.Docs {
+		if !this.Docs[i].Equal(that1.Docs[i]) {
+			return false
+		}
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *SearchRequest) Equal(that interface{}) bool {
+func (this *ForceMergeRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SearchRequest)
+	that1, ok := that.(*ForceMergeRequest)
 	if !ok {
-		that2, ok := that.(SearchRequest)
+		that2, ok := that.(ForceMergeRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2757,100 +3482,82 @@ func (this *SearchRequest) Equal(that interface{}) bool {
 	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if this.ReqNum != that1.ReqNum {
-		return false
-	}
-	if this.TopN != that1.TopN {
-		return false
-	}
-	if this.IsBruteSearch != that1.IsBruteSearch {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
-	if len(this.VecFields) != len(that1.VecFields) {
-		return false
+	return true
+}
+func (this *FlushRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.VecFields {
-		if !this.VecFields[i].Equal(that1.VecFields[i]) {
+
+	that1, ok := that.(*FlushRequest)
+	if !ok {
+		that2, ok := that.(FlushRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if len(this.Fields) != len(that1.Fields) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	for i := range this.Fields {
-		if this.Fields[i] != that1.Fields[i] {
-			return false
-		}
-	}
-	if len(this.RangeFilters) != len(that1.RangeFilters) {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	for i := range this.RangeFilters {
-		if !this.RangeFilters[i].Equal(that1.RangeFilters[i]) {
-			return false
-		}
-	}
-	if len(this.TermFilters) != len(that1.TermFilters) {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
-	for i := range this.TermFilters {
-		if !this.TermFilters[i].Equal(that1.TermFilters[i]) {
+	return true
+}
+func (this *IndexRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexRequest)
+	if !ok {
+		that2, ok := that.(IndexRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.OnlineLogLevel != that1.OnlineLogLevel {
-		return false
-	}
-	if this.RetrievalParams != that1.RetrievalParams {
-		return false
-	}
-	if this.HasRank != that1.HasRank {
-		return false
-	}
-	if this.MultiVectorRank != that1.MultiVectorRank {
-		return false
-	}
-	if this.ParallelBasedOnQuery != that1.ParallelBasedOnQuery {
-		return false
-	}
-	if this.L2Sqrt != that1.L2Sqrt {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.IvfFlat != that1.IvfFlat {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if this.IsVectorValue != that1.IsVectorValue {
+	if this.DropBeforeRebuild != that1.DropBeforeRebuild {
 		return false
 	}
-	if len(this.SortFieldMap) != len(that1.SortFieldMap) {
+	if this.LimitCpu != that1.LimitCpu {
 		return false
 	}
-	for i := range this.SortFieldMap {
-		if this.SortFieldMap[i] != that1.SortFieldMap[i] {
-			return false
-		}
-	}
-	if len(this.SortFields) != len(that1.SortFields) {
+	if this.Describe != that1.Describe {
 		return false
 	}
-	for i := range this.SortFields {
-		if !this.SortFields[i].Equal(that1.SortFields[i]) {
-			return false
-		}
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *ResultItem) Equal(that interface{}) bool {
+func (this *QueryByIDsFeatureRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResultItem)
+	that1, ok := that.(*QueryByIDsFeatureRequest)
 	if !ok {
-		that2, ok := that.(ResultItem)
+		that2, ok := that.(QueryByIDsFeatureRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2862,39 +3569,30 @@ func (this *ResultItem) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Score != that1.Score {
+	if !this.SearchRequest.Equal(that1.SearchRequest) {
 		return false
 	}
-	if len(this.Fields) != len(that1.Fields) {
+	if len(this.PrimaryKeys) != len(that1.PrimaryKeys) {
 		return false
 	}
-	for i := range this.Fields {
-		if !this.Fields[i].Equal(that1.Fields[i]) {
+	for i := range this.PrimaryKeys {
+		if this.PrimaryKeys[i] != that1.PrimaryKeys[i] {
 			return false
 		}
 	}
-	if this.Extra != that1.Extra {
-		return false
-	}
-	if this.PKey != that1.PKey {
-		return false
-	}
-	if !bytes.Equal(this.Source, that1.Source) {
-		return false
-	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
 	return true
 }
-func (this *SearchResult) Equal(that interface{}) bool {
+func (this *DbInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SearchResult)
+	that1, ok := that.(*DbInfo)
 	if !ok {
-		that2, ok := that.(SearchResult)
+		that2, ok := that.(DbInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2906,47 +3604,54 @@ func (this *SearchResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TotalHits != that1.TotalHits {
-		return false
-	}
-	if this.MaxScore != that1.MaxScore {
+	if this.Id != that1.Id {
 		return false
 	}
-	if this.MaxTook != that1.MaxTook {
+	if this.Name != that1.Name {
 		return false
 	}
-	if this.MaxTookId != that1.MaxTookId {
+	if len(this.Ps) != len(that1.Ps) {
 		return false
 	}
-	if !this.Status.Equal(that1.Status) {
-		return false
+	for i := range this.Ps {
+		if this.Ps[i] != that1.Ps[i] {
+			return false
+		}
 	}
-	if this.Msg != that1.Msg {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
-	if len(this.ResultItems) != len(that1.ResultItems) {
-		return false
+	return true
+}
+func (this *DbRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.ResultItems {
-		if !this.ResultItems[i].Equal(that1.ResultItems[i]) {
+
+	that1, ok := that.(*DbRequest)
+	if !ok {
+		that2, ok := that.(DbRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.PID != that1.PID {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if len(this.Explain) != len(that1.Explain) {
+	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	for i := range this.Explain {
-		if this.Explain[i] != that1.Explain[i] {
-			return false
-		}
+	if !this.Db.Equal(that1.Db) {
+		return false
 	}
-	if this.Timeout != that1.Timeout {
+	if this.PsIp != that1.PsIp {
 		return false
 	}
-	if this.TopN != that1.TopN {
+	if this.RemovePs != that1.RemovePs {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -2954,14 +3659,14 @@ func (this *SearchResult) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SearchResponse) Equal(that interface{}) bool {
+func (this *DbResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SearchResponse)
+	that1, ok := that.(*DbResponse)
 	if !ok {
-		that2, ok := that.(SearchResponse)
+		that2, ok := that.(DbResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2976,32 +3681,63 @@ func (this *SearchResponse) Equal(that interface{}) bool {
 	if !this.Head.Equal(that1.Head) {
 		return false
 	}
-	if len(this.Results) != len(that1.Results) {
+	if len(this.Dbs) != len(that1.Dbs) {
 		return false
 	}
-	for i := range this.Results {
-		if !this.Results[i].Equal(that1.Results[i]) {
+	for i := range this.Dbs {
+		if !this.Dbs[i].Equal(that1.Dbs[i]) {
 			return false
 		}
 	}
-	if this.OnlineLogMessage != that1.OnlineLogMessage {
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
-	if this.Timeout != that1.Timeout {
+	return true
+}
+func (this *SpaceField) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SpaceField)
+	if !ok {
+		that2, ok := that.(SpaceField)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.FlatBytes, that1.FlatBytes) {
+	if this.Name != that1.Name {
 		return false
 	}
-	if len(this.SortFieldMap) != len(that1.SortFieldMap) {
+	if this.Type != that1.Type {
 		return false
 	}
-	for i := range this.SortFieldMap {
-		if this.SortFieldMap[i] != that1.SortFieldMap[i] {
-			return false
-		}
+	if this.Index != that1.Index {
+		return false
 	}
-	if this.TopSize != that1.TopSize {
+	if this.Array != that1.Array {
+		return false
+	}
+	if this.Dimension != that1.Dimension {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if this.StoreType != that1.StoreType {
+		return false
+	}
+	if this.StoreParam != that1.StoreParam {
+		return false
+	}
+	if this.ModelId != that1.ModelId {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3009,14 +3745,14 @@ func (this *SearchResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SearchStatus) Equal(that interface{}) bool {
+func (this *SpaceEngine) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SearchStatus)
+	that1, ok := that.(*SpaceEngine)
 	if !ok {
-		that2, ok := that.(SearchStatus)
+		that2, ok := that.(SpaceEngine)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3028,16 +3764,30 @@ func (this *SearchStatus) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Total != that1.Total {
+	if this.IndexSize != that1.IndexSize {
 		return false
 	}
-	if this.Failed != that1.Failed {
+	if this.MetricType != that1.MetricType {
 		return false
 	}
-	if this.Successful != that1.Successful {
+	if this.RetrievalType != that1.RetrievalType {
 		return false
 	}
-	if this.Msg != that1.Msg {
+	if len(this.RetrievalTypes) != len(that1.RetrievalTypes) {
+		return false
+	}
+	for i := range this.RetrievalTypes {
+		if this.RetrievalTypes[i] != that1.RetrievalTypes[i] {
+			return false
+		}
+	}
+	if this.RetrievalParam != that1.RetrievalParam {
+		return false
+	}
+	if this.RetrievalParams != that1.RetrievalParams {
+		return false
+	}
+	if this.IdType != that1.IdType {
 		return false
 	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
@@ -3045,14 +3795,14 @@ func (this *SearchStatus) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MSearchRequest) Equal(that interface{}) bool {
+func (this *SpacePartition) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MSearchRequest)
+	that1, ok := that.(*SpacePartition)
 	if !ok {
-		that2, ok := that.(MSearchRequest)
+		that2, ok := that.(SpacePartition)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3064,14 +3814,17 @@ func (this *MSearchRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Head.Equal(that1.Head) {
+	if this.Id != that1.Id {
 		return false
 	}
-	if len(this.SearchRequests) != len(that1.SearchRequests) {
+	if this.LeaderId != that1.LeaderId {
 		return false
 	}
-	for i := range this.SearchRequests {
-		if !this.SearchRequests[i].Equal(that1.SearchRequests[i]) {
+	if len(this.Replicas) != len(that1.Replicas) {
+		return false
+	}
+	for i := range this.Replicas {
+		if this.Replicas[i] != that1.Replicas[i] {
 			return false
 		}
 	}
@@ -3080,4313 +3833,13460 @@ func (this *MSearchRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// RouterGRPCServiceClient is the client API for RouterGRPCService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RouterGRPCServiceClient interface {
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-	Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
-	MSearch(ctx context.Context, in *MSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-	Space(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*Table, error)
-	SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
-}
-
-type routerGRPCServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewRouterGRPCServiceClient(cc *grpc.ClientConn) RouterGRPCServiceClient {
-	return &routerGRPCServiceClient{cc}
-}
-
-func (c *routerGRPCServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *SpaceInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *routerGRPCServiceClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
-	out := new(AddResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Add", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SpaceInfo)
+	if !ok {
+		that2, ok := that.(SpaceInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *routerGRPCServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *routerGRPCServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
-	out := new(UpdateResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.Id != that1.Id {
+		return false
 	}
-	return out, nil
-}
-
-func (c *routerGRPCServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Search", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.Name != that1.Name {
+		return false
 	}
-	return out, nil
-}
-
-func (c *routerGRPCServiceClient) Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
-	out := new(BulkResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Bulk", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.DbId != that1.DbId {
+		return false
 	}
-	return out, nil
-}
-
-func (c *routerGRPCServiceClient) MSearch(ctx context.Context, in *MSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/MSearch", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.PartitionNum != that1.PartitionNum {
+		return false
 	}
-	return out, nil
+	if this.ReplicaNum != that1.ReplicaNum {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
+	}
+	if !this.Engine.Equal(that1.Engine) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
+	}
+	for i := range this.Partitions {
+		if !this.Partitions[i].Equal(that1.Partitions[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SpaceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *routerGRPCServiceClient) Space(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*Table, error) {
-	out := new(Table)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/Space", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SpaceRequest)
+	if !ok {
+		that2, ok := that.(SpaceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Head.Equal(that1.Head) {
+		return false
+	}
+	if !this.Space.Equal(that1.Space) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SpaceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *routerGRPCServiceClient) SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
-	out := new(SearchResponse)
-	err := c.cc.Invoke(ctx, "/RouterGRPCService/SearchByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*SpaceResponse)
+	if !ok {
+		that2, ok := that.(SpaceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-// RouterGRPCServiceServer is the server API for RouterGRPCService service.
-type RouterGRPCServiceServer interface {
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	Add(context.Context, *AddRequest) (*AddResponse, error)
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
-	Search(context.Context, *SearchRequest) (*SearchResponse, error)
-	Bulk(context.Context, *BulkRequest) (*BulkResponse, error)
-	MSearch(context.Context, *MSearchRequest) (*SearchResponse, error)
-	Space(context.Context, *RequestHead) (*Table, error)
-	SearchByID(context.Context, *SearchRequest) (*SearchResponse, error)
-}
-
-// UnimplementedRouterGRPCServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedRouterGRPCServiceServer struct {
-}
-
-func (*UnimplementedRouterGRPCServiceServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) Bulk(ctx context.Context, req *BulkRequest) (*BulkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bulk not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) MSearch(ctx context.Context, req *MSearchRequest) (*SearchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MSearch not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) Space(ctx context.Context, req *RequestHead) (*Table, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Space not implemented")
-}
-func (*UnimplementedRouterGRPCServiceServer) SearchByID(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchByID not implemented")
-}
-
-func RegisterRouterGRPCServiceServer(s *grpc.Server, srv RouterGRPCServiceServer) {
-	s.RegisterService(&_RouterGRPCService_serviceDesc, srv)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Head.Equal(that1.Head) {
+		return false
+	}
+	if len(this.Spaces) != len(that1.Spaces) {
+		return false
+	}
+	for i := range this.Spaces {
+		if !this.Spaces[i].Equal(that1.Spaces[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _RouterGRPCService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ClusterResponse)
+	if !ok {
+		that2, ok := that.(ClusterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Get(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Get",
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Get(ctx, req.(*GetRequest))
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RouterGRPCService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Add(ctx, in)
+	return true
+}
+func (this *GetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Add",
+
+	that1, ok := that.(*GetResponse)
+	if !ok {
+		that2, ok := that.(GetResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Add(ctx, req.(*AddRequest))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RouterGRPCService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Delete(ctx, in)
+	if len(this.Items) != len(that1.Items) {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Delete",
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Delete(ctx, req.(*DeleteRequest))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *AddResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _RouterGRPCService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*AddResponse)
+	if !ok {
+		that2, ok := that.(AddResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Update(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Update",
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Update(ctx, req.(*UpdateRequest))
+	if this.PrimaryKey != that1.PrimaryKey {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *UpdateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _RouterGRPCService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*UpdateResponse)
+	if !ok {
+		that2, ok := that.(UpdateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Search(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Search",
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Search(ctx, req.(*SearchRequest))
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _RouterGRPCService_Bulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *DeleteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Bulk(ctx, in)
+
+	that1, ok := that.(*DeleteResponse)
+	if !ok {
+		that2, ok := that.(DeleteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Bulk",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Bulk(ctx, req.(*BulkRequest))
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _RouterGRPCService_MSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MSearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	if len(this.Items) != len(that1.Items) {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).MSearch(ctx, in)
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/MSearch",
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).MSearch(ctx, req.(*MSearchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *BulkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _RouterGRPCService_Space_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RequestHead)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*BulkResponse)
+	if !ok {
+		that2, ok := that.(BulkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).Space(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/Space",
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).Space(ctx, req.(*RequestHead))
+	if len(this.Items) != len(that1.Items) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *BulkStreamResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _RouterGRPCService_SearchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*BulkStreamResponse)
+	if !ok {
+		that2, ok := that.(BulkStreamResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(RouterGRPCServiceServer).SearchByID(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/RouterGRPCService/SearchByID",
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterGRPCServiceServer).SearchByID(ctx, req.(*SearchRequest))
+	if this.Batch != that1.Batch {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _RouterGRPCService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "RouterGRPCService",
-	HandlerType: (*RouterGRPCServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _RouterGRPCService_Get_Handler,
-		},
-		{
-			MethodName: "Add",
-			Handler:    _RouterGRPCService_Add_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _RouterGRPCService_Delete_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _RouterGRPCService_Update_Handler,
-		},
-		{
-			MethodName: "Search",
-			Handler:    _RouterGRPCService_Search_Handler,
-		},
-		{
-			MethodName: "Bulk",
-			Handler:    _RouterGRPCService_Bulk_Handler,
-		},
-		{
-			MethodName: "MSearch",
-			Handler:    _RouterGRPCService_MSearch_Handler,
-		},
-		{
-			MethodName: "Space",
-			Handler:    _RouterGRPCService_Space_Handler,
-		},
-		{
-			MethodName: "SearchByID",
-			Handler:    _RouterGRPCService_SearchByID_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "router_grpc.proto",
-}
-
-func (m *RequestHead) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Items) != len(that1.Items) {
+		return false
 	}
-	return dAtA[:n], nil
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ForceMergeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RequestHead) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ForceMergeResponse)
+	if !ok {
+		that2, ok := that.(ForceMergeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Head.Equal(that1.Head) {
+		return false
+	}
+	if !this.Shards.Equal(that1.Shards) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func (m *RequestHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *DelByQueryeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Params) > 0 {
-		for k := range m.Params {
-			v := m.Params[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
+
+	that1, ok := that.(*DelByQueryeResponse)
+	if !ok {
+		that2, ok := that.(DelByQueryeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ClientType) > 0 {
-		i -= len(m.ClientType)
-		copy(dAtA[i:], m.ClientType)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.ClientType)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SpaceName) > 0 {
-		i -= len(m.SpaceName)
-		copy(dAtA[i:], m.SpaceName)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.SpaceName)))
-		i--
-		dAtA[i] = 0x2a
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	if len(m.DbName) > 0 {
-		i -= len(m.DbName)
-		copy(dAtA[i:], m.DbName)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.DbName)))
-		i--
-		dAtA[i] = 0x22
+	if this.DelNum != that1.DelNum {
+		return false
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.IdsStr) != len(that1.IdsStr) {
+		return false
 	}
-	if len(m.UserName) > 0 {
-		i -= len(m.UserName)
-		copy(dAtA[i:], m.UserName)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.UserName)))
-		i--
-		dAtA[i] = 0x12
+	for i := range this.IdsStr {
+		if this.IdsStr[i] != that1.IdsStr[i] {
+			return false
+		}
 	}
-	if m.TimeOutMs != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TimeOutMs))
-		i--
-		dAtA[i] = 0x8
+	if len(this.IdsLong) != len(that1.IdsLong) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ResponseHead) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.IdsLong {
+		if this.IdsLong[i] != that1.IdsLong[i] {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResponseHead) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func (m *ResponseHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *FieldStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Params) > 0 {
-		for k := range m.Params {
-			v := m.Params[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+
+	that1, ok := that.(*FieldStats)
+	if !ok {
+		that2, ok := that.(FieldStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Err != nil {
-		{
-			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Field != that1.Field {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.Count != that1.Count {
+		return false
 	}
-	if len(m.PrimaryKeys) > 0 {
-		for iNdEx := len(m.PrimaryKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PrimaryKeys[iNdEx])
-			copy(dAtA[i:], m.PrimaryKeys[iNdEx])
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if this.Min != that1.Min {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.Max != that1.Max {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Distinct != that1.Distinct {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.Sketch, that1.Sketch) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *CountResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.PrimaryKeys) > 0 {
-		for iNdEx := len(m.PrimaryKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PrimaryKeys[iNdEx])
-			copy(dAtA[i:], m.PrimaryKeys[iNdEx])
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+
+	that1, ok := that.(*CountResponse)
+	if !ok {
+		that2, ok := that.(CountResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.Count != that1.Count {
+		return false
 	}
-	if m.Doc != nil {
-		{
-			size, err := m.Doc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if len(this.FieldStats) != len(that1.FieldStats) {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	for i := range this.FieldStats {
+		if !this.FieldStats[i].Equal(that1.FieldStats[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *FlushResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Doc != nil {
-		{
-			size, err := m.Doc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	that1, ok := that.(*FlushResponse)
+	if !ok {
+		that2, ok := that.(FlushResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BulkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BulkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	if len(m.Docs) > 0 {
-		for iNdEx := len(m.Docs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Docs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if !this.Shards.Equal(that1.Shards) {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ForceMergeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *IndexResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ForceMergeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ForceMergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	that1, ok := that.(*IndexResponse)
+	if !ok {
+		that2, ok := that.(IndexResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FlushRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FlushRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FlushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !this.Shards.Equal(that1.Shards) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *IndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *TermFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *IndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*TermFilter)
+	if !ok {
+		that2, ok := that.(TermFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Describe != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Describe))
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LimitCpu != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.LimitCpu))
-		i--
-		dAtA[i] = 0x18
+	if this.Field != that1.Field {
+		return false
 	}
-	if m.DropBeforeRebuild != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.DropBeforeRebuild))
-		i--
-		dAtA[i] = 0x10
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.IsUnion != that1.IsUnion {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *RangeFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+
+	that1, ok := that.(*RangeFilter)
+	if !ok {
+		that2, ok := that.(RangeFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Field != that1.Field {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.LowerValue, that1.LowerValue) {
+		return false
 	}
-	if len(m.PrimaryKey) > 0 {
-		i -= len(m.PrimaryKey)
-		copy(dAtA[i:], m.PrimaryKey)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKey)))
-		i--
-		dAtA[i] = 0x22
+	if !bytes.Equal(this.UpperValue, that1.UpperValue) {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.IncludeLower != that1.IncludeLower {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.IncludeUpper != that1.IncludeUpper {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *FilterClause) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*FilterClause)
+	if !ok {
+		that2, ok := that.(FilterClause)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.IncludeLower != that1.IncludeLower {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.IncludeUpper != that1.IncludeUpper {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *BoolFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*BoolFilter)
+	if !ok {
+		that2, ok := that.(BoolFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Clause.Equal(that1.Clause) {
+		return false
+	}
+	if len(this.Must) != len(that1.Must) {
+		return false
+	}
+	for i := range this.Must {
+		if !this.Must[i].Equal(that1.Must[i]) {
+			return false
 		}
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	if len(this.Should) != len(that1.Should) {
+		return false
+	}
+	for i := range this.Should {
+		if !this.Should[i].Equal(that1.Should[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BulkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *BulkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BulkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(this.MustNot) != len(that1.MustNot) {
+		return false
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	for i := range this.MustNot {
+		if !this.MustNot[i].Equal(that1.MustNot[i]) {
+			return false
 		}
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.MinimumShouldMatch != that1.MinimumShouldMatch {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ForceMergeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ForceMergeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *ForceMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *SortField) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Shards != nil {
-		{
-			size, err := m.Shards.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+
+	that1, ok := that.(*SortField)
+	if !ok {
+		that2, ok := that.(SortField)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DelByQueryeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Field != that1.Field {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelByQueryeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func (m *DelByQueryeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *VectorQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.IdsLong) > 0 {
-		dAtA20 := make([]byte, len(m.IdsLong)*10)
-		var j19 int
-		for _, num1 := range m.IdsLong {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA20[j19] = uint8(num)
-			j19++
+
+	that1, ok := that.(*VectorQuery)
+	if !ok {
+		that2, ok := that.(VectorQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(j19))
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.IdsStr) > 0 {
-		for iNdEx := len(m.IdsStr) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.IdsStr[iNdEx])
-			copy(dAtA[i:], m.IdsStr[iNdEx])
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.IdsStr[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DelNum != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.DelNum))
-		i--
-		dAtA[i] = 0x10
+	if this.Name != that1.Name {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.MinScore != that1.MinScore {
+		return false
+	}
+	if this.MaxScore != that1.MaxScore {
+		return false
+	}
+	if this.Boost != that1.Boost {
+		return false
+	}
+	if this.HasBoost != that1.HasBoost {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if this.RetrievalType != that1.RetrievalType {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *RetrievalParameters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FlushResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RetrievalParameters)
+	if !ok {
+		that2, ok := that.(RetrievalParameters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MetricType != that1.MetricType {
+		return false
+	}
+	if this.Nprobe != that1.Nprobe {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *SearchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FlushResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FlushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Shards != nil {
-		{
-			size, err := m.Shards.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	that1, ok := that.(*SearchRequest)
+	if !ok {
+		that2, ok := that.(SearchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Head.Equal(that1.Head) {
+		return false
+	}
+	if this.ReqNum != that1.ReqNum {
+		return false
+	}
+	if this.TopN != that1.TopN {
+		return false
+	}
+	if this.IsBruteSearch != that1.IsBruteSearch {
+		return false
+	}
+	if len(this.VecFields) != len(that1.VecFields) {
+		return false
+	}
+	for i := range this.VecFields {
+		if !this.VecFields[i].Equal(that1.VecFields[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *IndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *IndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *IndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
 	}
-	if m.Shards != nil {
-		{
-			size, err := m.Shards.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	if len(this.RangeFilters) != len(that1.RangeFilters) {
+		return false
+	}
+	for i := range this.RangeFilters {
+		if !this.RangeFilters[i].Equal(that1.RangeFilters[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	if len(this.TermFilters) != len(that1.TermFilters) {
+		return false
+	}
+	for i := range this.TermFilters {
+		if !this.TermFilters[i].Equal(that1.TermFilters[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TermFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.OnlineLogLevel != that1.OnlineLogLevel {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TermFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TermFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.RetrievalParams != that1.RetrievalParams {
+		return false
 	}
-	if m.IsUnion != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.IsUnion))
-		i--
-		dAtA[i] = 0x18
+	if this.HasRank != that1.HasRank {
+		return false
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if this.MultiVectorRank != that1.MultiVectorRank {
+		return false
 	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+	if this.ParallelBasedOnQuery != that1.ParallelBasedOnQuery {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RangeFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.L2Sqrt != that1.L2Sqrt {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RangeFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RangeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.IvfFlat != that1.IvfFlat {
+		return false
 	}
-	if m.IncludeUpper {
-		i--
-		if m.IncludeUpper {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	if this.IsVectorValue != that1.IsVectorValue {
+		return false
 	}
-	if m.IncludeLower {
-		i--
-		if m.IncludeLower {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(this.SortFieldMap) != len(that1.SortFieldMap) {
+		return false
+	}
+	for i := range this.SortFieldMap {
+		if this.SortFieldMap[i] != that1.SortFieldMap[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.UpperValue) > 0 {
-		i -= len(m.UpperValue)
-		copy(dAtA[i:], m.UpperValue)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.UpperValue)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.SortFields) != len(that1.SortFields) {
+		return false
 	}
-	if len(m.LowerValue) > 0 {
-		i -= len(m.LowerValue)
-		copy(dAtA[i:], m.LowerValue)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.LowerValue)))
-		i--
-		dAtA[i] = 0x12
+	for i := range this.SortFields {
+		if !this.SortFields[i].Equal(that1.SortFields[i]) {
+			return false
+		}
 	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+	if !this.SearchAfter.Equal(that1.SearchAfter) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SortField) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.BoolFilter.Equal(that1.BoolFilter) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SortField) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.RangeSearch.Equal(that1.RangeSearch) {
+		return false
+	}
+	if this.Profile != that1.Profile {
+		return false
+	}
+	if this.DenyPartialResults != that1.DenyPartialResults {
+		return false
+	}
+	if this.PartitionTimeoutMs != that1.PartitionTimeoutMs {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func (m *SortField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (this *SearchAfter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Type {
-		i--
-		if m.Type {
-			dAtA[i] = 1
+
+	that1, ok := that.(*SearchAfter)
+	if !ok {
+		that2, ok := that.(SearchAfter)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *VectorQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.SortValues) != len(that1.SortValues) {
+		return false
 	}
-	return dAtA[:n], nil
+	for i := range this.SortValues {
+		if this.SortValues[i] != that1.SortValues[i] {
+			return false
+		}
+	}
+	if this.PKey != that1.PKey {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *RangeSearch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *VectorQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RangeSearch)
+	if !ok {
+		that2, ok := that.(RangeSearch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxResults != that1.MaxResults {
+		return false
+	}
+	if this.Returned != that1.Returned {
+		return false
+	}
+	if this.Stream != that1.Stream {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *ResultItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *VectorQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*ResultItem)
+	if !ok {
+		that2, ok := that.(ResultItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.RetrievalType) > 0 {
-		i -= len(m.RetrievalType)
-		copy(dAtA[i:], m.RetrievalType)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalType)))
-		i--
-		dAtA[i] = 0x42
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Format) > 0 {
-		i -= len(m.Format)
-		copy(dAtA[i:], m.Format)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Format)))
-		i--
-		dAtA[i] = 0x3a
+	if this.Score != that1.Score {
+		return false
 	}
-	if m.HasBoost != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.HasBoost))
-		i--
-		dAtA[i] = 0x30
+	if len(this.Fields) != len(that1.Fields) {
+		return false
 	}
-	if m.Boost != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Boost))))
-		i--
-		dAtA[i] = 0x29
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
 	}
-	if m.MaxScore != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxScore))))
-		i--
-		dAtA[i] = 0x21
+	if this.Extra != that1.Extra {
+		return false
 	}
-	if m.MinScore != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinScore))))
-		i--
-		dAtA[i] = 0x19
+	if this.PKey != that1.PKey {
+		return false
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if !bytes.Equal(this.Source, that1.Source) {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RetrievalParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SearchResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RetrievalParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *RetrievalParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*SearchResult)
+	if !ok {
+		that2, ok := that.(SearchResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Nprobe != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Nprobe))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MetricType != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MetricType))
-		i--
-		dAtA[i] = 0x8
+	if this.TotalHits != that1.TotalHits {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxScore != that1.MaxScore {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.MaxTook != that1.MaxTook {
+		return false
 	}
-	if len(m.SortFields) > 0 {
-		for iNdEx := len(m.SortFields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SortFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	if this.MaxTookId != that1.MaxTookId {
+		return false
 	}
-	if len(m.SortFieldMap) > 0 {
-		for k := range m.SortFieldMap {
-			v := m.SortFieldMap[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	if !this.Status.Equal(that1.Status) {
+		return false
 	}
-	if m.IsVectorValue {
-		i--
-		if m.IsVectorValue {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	if this.Msg != that1.Msg {
+		return false
 	}
-	if m.IvfFlat {
-		i--
-		if m.IvfFlat {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
+	if len(this.ResultItems) != len(that1.ResultItems) {
+		return false
 	}
-	if m.L2Sqrt {
-		i--
-		if m.L2Sqrt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.ResultItems {
+		if !this.ResultItems[i].Equal(that1.ResultItems[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x70
 	}
-	if m.ParallelBasedOnQuery {
-		i--
-		if m.ParallelBasedOnQuery {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	if this.PID != that1.PID {
+		return false
 	}
-	if m.MultiVectorRank != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MultiVectorRank))
-		i--
-		dAtA[i] = 0x60
+	if len(this.Explain) != len(that1.Explain) {
+		return false
 	}
-	if m.HasRank {
-		i--
-		if m.HasRank {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i := range this.Explain {
+		if this.Explain[i] != that1.Explain[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x58
 	}
-	if len(m.RetrievalParams) > 0 {
-		i -= len(m.RetrievalParams)
-		copy(dAtA[i:], m.RetrievalParams)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalParams)))
-		i--
-		dAtA[i] = 0x52
+	if this.Timeout != that1.Timeout {
+		return false
 	}
-	if len(m.OnlineLogLevel) > 0 {
-		i -= len(m.OnlineLogLevel)
-		copy(dAtA[i:], m.OnlineLogLevel)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.OnlineLogLevel)))
-		i--
-		dAtA[i] = 0x4a
+	if this.TopN != that1.TopN {
+		return false
 	}
-	if len(m.TermFilters) > 0 {
-		for iNdEx := len(m.TermFilters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TermFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	if this.Cursor != that1.Cursor {
+		return false
 	}
-	if len(m.RangeFilters) > 0 {
-		for iNdEx := len(m.RangeFilters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RangeFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if this.Truncated != that1.Truncated {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fields[iNdEx])
-			copy(dAtA[i:], m.Fields[iNdEx])
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Fields[iNdEx])))
-			i--
-			dAtA[i] = 0x32
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *SearchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchResponse)
+	if !ok {
+		that2, ok := that.(SearchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.VecFields) > 0 {
-		for iNdEx := len(m.VecFields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VecFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Head.Equal(that1.Head) {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
 		}
 	}
-	if m.IsBruteSearch != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.IsBruteSearch))
-		i--
-		dAtA[i] = 0x20
+	if this.OnlineLogMessage != that1.OnlineLogMessage {
+		return false
 	}
-	if m.TopN != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TopN))
-		i--
-		dAtA[i] = 0x18
+	if this.Timeout != that1.Timeout {
+		return false
 	}
-	if m.ReqNum != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.ReqNum))
-		i--
-		dAtA[i] = 0x10
+	if !bytes.Equal(this.FlatBytes, that1.FlatBytes) {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	if len(this.SortFieldMap) != len(that1.SortFieldMap) {
+		return false
+	}
+	for i := range this.SortFieldMap {
+		if this.SortFieldMap[i] != that1.SortFieldMap[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ResultItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TopSize != that1.TopSize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResultItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResultItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(this.Profiles) != len(that1.Profiles) {
+		return false
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x2a
+	for i := range this.Profiles {
+		if !this.Profiles[i].Equal(that1.Profiles[i]) {
+			return false
+		}
 	}
-	if len(m.PKey) > 0 {
-		i -= len(m.PKey)
-		copy(dAtA[i:], m.PKey)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PKey)))
-		i--
-		dAtA[i] = 0x22
+	if !this.RouterProfile.Equal(that1.RouterProfile) {
+		return false
 	}
-	if len(m.Extra) > 0 {
-		i -= len(m.Extra)
-		copy(dAtA[i:], m.Extra)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Extra)))
-		i--
-		dAtA[i] = 0x1a
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return true
+}
+func (this *SearchProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchProfile)
+	if !ok {
+		that2, ok := that.(SearchProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Score != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
-		i--
-		dAtA[i] = 0x9
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SearchResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.PartitionId != that1.PartitionId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SearchResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if this.NodeId != that1.NodeId {
+		return false
 	}
-	if m.TopN != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TopN))
-		i--
-		dAtA[i] = 0x58
+	if this.QueueMs != that1.QueueMs {
+		return false
 	}
-	if m.Timeout {
-		i--
-		if m.Timeout {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	if this.FilterMs != that1.FilterMs {
+		return false
 	}
-	if len(m.Explain) > 0 {
-		for k := range m.Explain {
-			v := m.Explain[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
+	if this.FilterDocs != that1.FilterDocs {
+		return false
 	}
-	if m.PID != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.PID))
-		i--
-		dAtA[i] = 0x40
+	if this.AnnMs != that1.AnnMs {
+		return false
 	}
-	if len(m.ResultItems) > 0 {
-		for iNdEx := len(m.ResultItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResultItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if this.Candidates != that1.Candidates {
+		return false
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x32
+	if len(this.IndexParams) != len(that1.IndexParams) {
+		return false
 	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+	for i := range this.IndexParams {
+		if this.IndexParams[i] != that1.IndexParams[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.MaxTookId != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MaxTookId))
-		i--
-		dAtA[i] = 0x20
+	if this.FetchMs != that1.FetchMs {
+		return false
 	}
-	if m.MaxTook != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MaxTook))
-		i--
-		dAtA[i] = 0x18
+	if this.EngineMs != that1.EngineMs {
+		return false
 	}
-	if m.MaxScore != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxScore))))
-		i--
-		dAtA[i] = 0x11
+	if this.PostFilterMs != that1.PostFilterMs {
+		return false
 	}
-	if m.TotalHits != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TotalHits))
-		i--
-		dAtA[i] = 0x8
+	if this.RpcMs != that1.RpcMs {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.DeserializeMs != that1.DeserializeMs {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *RouterProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*RouterProfile)
+	if !ok {
+		that2, ok := that.(RouterProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.TopSize != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TopSize))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SortFieldMap) > 0 {
-		for k := range m.SortFieldMap {
-			v := m.SortFieldMap[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+	if this.MergeMs != that1.MergeMs {
+		return false
 	}
-	if len(m.FlatBytes) > 0 {
-		i -= len(m.FlatBytes)
-		copy(dAtA[i:], m.FlatBytes)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.FlatBytes)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Timeout {
-		i--
-		if m.Timeout {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.OnlineLogMessage) > 0 {
-		i -= len(m.OnlineLogMessage)
-		copy(dAtA[i:], m.OnlineLogMessage)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.OnlineLogMessage)))
-		i--
-		dAtA[i] = 0x1a
+	if this.SerializeMs != that1.SerializeMs {
+		return false
 	}
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if this.TookMs != that1.TookMs {
+		return false
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *SearchStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SearchStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SearchStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *SearchStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*SearchStatus)
+	if !ok {
+		that2, ok := that.(SearchStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Successful != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Successful))
-		i--
-		dAtA[i] = 0x18
+	if this.Total != that1.Total {
+		return false
 	}
-	if m.Failed != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Failed))
-		i--
-		dAtA[i] = 0x10
+	if this.Failed != that1.Failed {
+		return false
 	}
-	if m.Total != 0 {
-		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Total))
-		i--
-		dAtA[i] = 0x8
+	if this.Successful != that1.Successful {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MSearchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Msg != that1.Msg {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MSearchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(this.Failures) != len(that1.Failures) {
+		return false
 	}
-	if len(m.SearchRequests) > 0 {
-		for iNdEx := len(m.SearchRequests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SearchRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	for i := range this.Failures {
+		if !this.Failures[i].Equal(that1.Failures[i]) {
+			return false
 		}
 	}
-	if m.Head != nil {
-		{
-			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.Coverage != that1.Coverage {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintRouterGrpc(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRouterGrpc(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return true
 }
-func NewPopulatedRequestHead(r randyRouterGrpc, easy bool) *RequestHead {
-	this := &RequestHead{}
-	this.TimeOutMs = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.TimeOutMs *= -1
+func (this *PartitionFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	this.UserName = string(randStringRouterGrpc(r))
-	this.Password = string(randStringRouterGrpc(r))
-	this.DbName = string(randStringRouterGrpc(r))
-	this.SpaceName = string(randStringRouterGrpc(r))
-	this.ClientType = string(randStringRouterGrpc(r))
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(10)
-		this.Params = make(map[string]string)
-		for i := 0; i < v1; i++ {
-			this.Params[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
+
+	that1, ok := that.(*PartitionFailure)
+	if !ok {
+		that2, ok := that.(PartitionFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 8)
-	}
-	return this
-}
-
-func NewPopulatedResponseHead(r randyRouterGrpc, easy bool) *ResponseHead {
-	this := &ResponseHead{}
-	if r.Intn(5) != 0 {
-		this.Err = NewPopulatedError(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		v2 := r.Intn(10)
-		this.Params = make(map[string]string)
-		for i := 0; i < v2; i++ {
-			this.Params[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
-		}
+	if this.PartitionId != that1.PartitionId {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	if this.Code != that1.Code {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedGetRequest(r randyRouterGrpc, easy bool) *GetRequest {
-	this := &GetRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
+	if this.Reason != that1.Reason {
+		return false
 	}
-	v3 := r.Intn(10)
-	this.PrimaryKeys = make([]string, v3)
-	for i := 0; i < v3; i++ {
-		this.PrimaryKeys[i] = string(randStringRouterGrpc(r))
+	if this.Timeout != that1.Timeout {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedDeleteRequest(r randyRouterGrpc, easy bool) *DeleteRequest {
-	this := &DeleteRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
+func (this *MSearchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	v4 := r.Intn(10)
-	this.PrimaryKeys = make([]string, v4)
-	for i := 0; i < v4; i++ {
-		this.PrimaryKeys[i] = string(randStringRouterGrpc(r))
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
-	}
-	return this
-}
 
-func NewPopulatedAddRequest(r randyRouterGrpc, easy bool) *AddRequest {
-	this := &AddRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
+	that1, ok := that.(*MSearchRequest)
+	if !ok {
+		that2, ok := that.(MSearchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.Doc = NewPopulatedDocument(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	if !this.Head.Equal(that1.Head) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedUpdateRequest(r randyRouterGrpc, easy bool) *UpdateRequest {
-	this := &UpdateRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
+	if len(this.SearchRequests) != len(that1.SearchRequests) {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.Doc = NewPopulatedDocument(r, easy)
+	for i := range this.SearchRequests {
+		if !this.SearchRequests[i].Equal(that1.SearchRequests[i]) {
+			return false
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
 
-func NewPopulatedBulkRequest(r randyRouterGrpc, easy bool) *BulkRequest {
-	this := &BulkRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		v5 := r.Intn(5)
-		this.Docs = make([]*Document, v5)
-		for i := 0; i < v5; i++ {
-			this.Docs[i] = NewPopulatedDocument(r, easy)
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
-	}
-	return this
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RouterGRPCServiceClient is the client API for RouterGRPCService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RouterGRPCServiceClient interface {
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	MSearch(ctx context.Context, in *MSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Space(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*Table, error)
+	SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Upsert(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	Query(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	DeleteByQuery(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*DelByQueryeResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	ForceMerge(ctx context.Context, in *ForceMergeRequest, opts ...grpc.CallOption) (*ForceMergeResponse, error)
+	Rebuild(ctx context.Context, in *IndexRequest, opts ...grpc.CallOption) (*IndexResponse, error)
+	QueryByIDsFeature(ctx context.Context, in *QueryByIDsFeatureRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	BulkStream(ctx context.Context, opts ...grpc.CallOption) (RouterGRPCService_BulkStreamClient, error)
+	// db and space admin, proxied to master
+	ListServer(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error)
+	ListDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error)
+	ListSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error)
+	ListPartition(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error)
+	CreateDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error)
+	GetDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error)
+	DeleteDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error)
+	ModifyDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error)
+	CreateSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error)
+	GetSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error)
+	UpdateSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error)
+	DeleteSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error)
+	ClusterHealth(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error)
+	ClusterStats(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error)
 }
 
-func NewPopulatedForceMergeRequest(r randyRouterGrpc, easy bool) *ForceMergeRequest {
-	this := &ForceMergeRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 2)
-	}
-	return this
+type routerGRPCServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func NewPopulatedFlushRequest(r randyRouterGrpc, easy bool) *FlushRequest {
-	this := &FlushRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 2)
-	}
-	return this
+func NewRouterGRPCServiceClient(cc *grpc.ClientConn) RouterGRPCServiceClient {
+	return &routerGRPCServiceClient{cc}
 }
 
-func NewPopulatedIndexRequest(r randyRouterGrpc, easy bool) *IndexRequest {
-	this := &IndexRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
+func (c *routerGRPCServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	this.DropBeforeRebuild = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.DropBeforeRebuild *= -1
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error) {
+	out := new(AddResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	this.LimitCpu = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.LimitCpu *= -1
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	this.Describe = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Describe *= -1
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedGetResponse(r randyRouterGrpc, easy bool) *GetResponse {
-	this := &GetResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
+func (c *routerGRPCServiceClient) Bulk(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Bulk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Items = make([]*Item, v6)
-		for i := 0; i < v6; i++ {
-			this.Items[i] = NewPopulatedItem(r, easy)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) MSearch(ctx context.Context, in *MSearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/MSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Space(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*Table, error) {
+	out := new(Table)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Space", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedAddResponse(r randyRouterGrpc, easy bool) *AddResponse {
-	this := &AddResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
+func (c *routerGRPCServiceClient) SearchByID(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/SearchByID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	this.PrimaryKey = string(randStringRouterGrpc(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Upsert(ctx context.Context, in *BulkRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	out := new(BulkResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedUpdateResponse(r randyRouterGrpc, easy bool) *UpdateResponse {
-	this := &UpdateResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
+func (c *routerGRPCServiceClient) Query(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 2)
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) DeleteByQuery(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*DelByQueryeResponse, error) {
+	out := new(DelByQueryeResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/DeleteByQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedDeleteResponse(r randyRouterGrpc, easy bool) *DeleteResponse {
-	this := &DeleteResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
+func (c *routerGRPCServiceClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v7 := r.Intn(5)
-		this.Items = make([]*Item, v7)
-		for i := 0; i < v7; i++ {
-			this.Items[i] = NewPopulatedItem(r, easy)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) ForceMerge(ctx context.Context, in *ForceMergeRequest, opts ...grpc.CallOption) (*ForceMergeResponse, error) {
+	out := new(ForceMergeResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ForceMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) Rebuild(ctx context.Context, in *IndexRequest, opts ...grpc.CallOption) (*IndexResponse, error) {
+	out := new(IndexResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/Rebuild", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedBulkResponse(r randyRouterGrpc, easy bool) *BulkResponse {
-	this := &BulkResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Items = make([]*Item, v8)
-		for i := 0; i < v8; i++ {
-			this.Items[i] = NewPopulatedItem(r, easy)
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+func (c *routerGRPCServiceClient) QueryByIDsFeature(ctx context.Context, in *QueryByIDsFeatureRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/QueryByIDsFeature", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedForceMergeResponse(r randyRouterGrpc, easy bool) *ForceMergeResponse {
-	this := &ForceMergeResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Shards = NewPopulatedSearchStatus(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+func (c *routerGRPCServiceClient) BulkStream(ctx context.Context, opts ...grpc.CallOption) (RouterGRPCService_BulkStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RouterGRPCService_serviceDesc.Streams[0], "/RouterGRPCService/BulkStream", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	x := &routerGRPCServiceBulkStreamClient{stream}
+	return x, nil
 }
 
-func NewPopulatedDelByQueryeResponse(r randyRouterGrpc, easy bool) *DelByQueryeResponse {
-	this := &DelByQueryeResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
-	}
-	this.DelNum = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.DelNum *= -1
-	}
-	v9 := r.Intn(10)
-	this.IdsStr = make([]string, v9)
-	for i := 0; i < v9; i++ {
-		this.IdsStr[i] = string(randStringRouterGrpc(r))
-	}
-	v10 := r.Intn(10)
-	this.IdsLong = make([]int64, v10)
-	for i := 0; i < v10; i++ {
-		this.IdsLong[i] = int64(r.Int63())
-		if r.Intn(2) == 0 {
-			this.IdsLong[i] *= -1
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
-	}
-	return this
+type RouterGRPCService_BulkStreamClient interface {
+	Send(*BulkRequest) error
+	Recv() (*BulkStreamResponse, error)
+	grpc.ClientStream
 }
 
-func NewPopulatedFlushResponse(r randyRouterGrpc, easy bool) *FlushResponse {
-	this := &FlushResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Shards = NewPopulatedSearchStatus(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
-	}
-	return this
+type routerGRPCServiceBulkStreamClient struct {
+	grpc.ClientStream
 }
 
-func NewPopulatedIndexResponse(r randyRouterGrpc, easy bool) *IndexResponse {
-	this := &IndexResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Shards = NewPopulatedSearchStatus(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
-	}
-	return this
+func (x *routerGRPCServiceBulkStreamClient) Send(m *BulkRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func NewPopulatedTermFilter(r randyRouterGrpc, easy bool) *TermFilter {
-	this := &TermFilter{}
-	this.Field = string(randStringRouterGrpc(r))
-	v11 := r.Intn(100)
-	this.Value = make([]byte, v11)
-	for i := 0; i < v11; i++ {
-		this.Value[i] = byte(r.Intn(256))
-	}
-	this.IsUnion = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.IsUnion *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+func (x *routerGRPCServiceBulkStreamClient) Recv() (*BulkStreamResponse, error) {
+	m := new(BulkStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return this
+	return m, nil
 }
 
-func NewPopulatedRangeFilter(r randyRouterGrpc, easy bool) *RangeFilter {
-	this := &RangeFilter{}
-	this.Field = string(randStringRouterGrpc(r))
-	v12 := r.Intn(100)
-	this.LowerValue = make([]byte, v12)
-	for i := 0; i < v12; i++ {
-		this.LowerValue[i] = byte(r.Intn(256))
-	}
-	v13 := r.Intn(100)
-	this.UpperValue = make([]byte, v13)
-	for i := 0; i < v13; i++ {
-		this.UpperValue[i] = byte(r.Intn(256))
-	}
-	this.IncludeLower = bool(bool(r.Intn(2) == 0))
-	this.IncludeUpper = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 6)
+func (c *routerGRPCServiceClient) ListServer(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ListServer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedSortField(r randyRouterGrpc, easy bool) *SortField {
-	this := &SortField{}
-	this.Field = string(randStringRouterGrpc(r))
-	this.Type = bool(bool(r.Intn(2) == 0))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+func (c *routerGRPCServiceClient) ListDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error) {
+	out := new(DbResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ListDB", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedVectorQuery(r randyRouterGrpc, easy bool) *VectorQuery {
-	this := &VectorQuery{}
-	this.Name = string(randStringRouterGrpc(r))
-	v14 := r.Intn(100)
-	this.Value = make([]byte, v14)
-	for i := 0; i < v14; i++ {
-		this.Value[i] = byte(r.Intn(256))
-	}
-	this.MinScore = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.MinScore *= -1
-	}
-	this.MaxScore = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.MaxScore *= -1
-	}
-	this.Boost = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.Boost *= -1
-	}
-	this.HasBoost = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.HasBoost *= -1
-	}
-	this.Format = string(randStringRouterGrpc(r))
-	this.RetrievalType = string(randStringRouterGrpc(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 9)
+func (c *routerGRPCServiceClient) ListSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error) {
+	out := new(SpaceResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ListSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedRetrievalParameters(r randyRouterGrpc, easy bool) *RetrievalParameters {
-	this := &RetrievalParameters{}
-	this.MetricType = RetrievalParameters_DistanceMetricType([]int32{0, 1}[r.Intn(2)])
-	this.Nprobe = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Nprobe *= -1
-	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+func (c *routerGRPCServiceClient) ListPartition(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ListPartition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedSearchRequest(r randyRouterGrpc, easy bool) *SearchRequest {
-	this := &SearchRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
-	}
-	this.ReqNum = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.ReqNum *= -1
-	}
-	this.TopN = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.TopN *= -1
-	}
-	this.IsBruteSearch = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.IsBruteSearch *= -1
+func (c *routerGRPCServiceClient) CreateDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error) {
+	out := new(DbResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/CreateDB", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v15 := r.Intn(5)
-		this.VecFields = make([]*VectorQuery, v15)
-		for i := 0; i < v15; i++ {
-			this.VecFields[i] = NewPopulatedVectorQuery(r, easy)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) GetDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error) {
+	out := new(DbResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/GetDB", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	v16 := r.Intn(10)
-	this.Fields = make([]string, v16)
-	for i := 0; i < v16; i++ {
-		this.Fields[i] = string(randStringRouterGrpc(r))
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) DeleteDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error) {
+	out := new(DbResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/DeleteDB", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v17 := r.Intn(5)
-		this.RangeFilters = make([]*RangeFilter, v17)
-		for i := 0; i < v17; i++ {
-			this.RangeFilters[i] = NewPopulatedRangeFilter(r, easy)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) ModifyDB(ctx context.Context, in *DbRequest, opts ...grpc.CallOption) (*DbResponse, error) {
+	out := new(DbResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ModifyDB", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v18 := r.Intn(5)
-		this.TermFilters = make([]*TermFilter, v18)
-		for i := 0; i < v18; i++ {
-			this.TermFilters[i] = NewPopulatedTermFilter(r, easy)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) CreateSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error) {
+	out := new(SpaceResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/CreateSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	this.OnlineLogLevel = string(randStringRouterGrpc(r))
-	this.RetrievalParams = string(randStringRouterGrpc(r))
-	this.HasRank = bool(bool(r.Intn(2) == 0))
-	this.MultiVectorRank = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.MultiVectorRank *= -1
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) GetSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error) {
+	out := new(SpaceResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/GetSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	this.ParallelBasedOnQuery = bool(bool(r.Intn(2) == 0))
-	this.L2Sqrt = bool(bool(r.Intn(2) == 0))
-	this.IvfFlat = bool(bool(r.Intn(2) == 0))
-	this.IsVectorValue = bool(bool(r.Intn(2) == 0))
-	if r.Intn(5) != 0 {
-		v19 := r.Intn(10)
-		this.SortFieldMap = make(map[string]string)
-		for i := 0; i < v19; i++ {
-			this.SortFieldMap[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) UpdateSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error) {
+	out := new(SpaceResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/UpdateSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v20 := r.Intn(5)
-		this.SortFields = make([]*SortField, v20)
-		for i := 0; i < v20; i++ {
-			this.SortFields[i] = NewPopulatedSortField(r, easy)
-		}
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) DeleteSpace(ctx context.Context, in *SpaceRequest, opts ...grpc.CallOption) (*SpaceResponse, error) {
+	out := new(SpaceResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/DeleteSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 19)
+	return out, nil
+}
+
+func (c *routerGRPCServiceClient) ClusterHealth(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ClusterHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedResultItem(r randyRouterGrpc, easy bool) *ResultItem {
-	this := &ResultItem{}
-	this.Score = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.Score *= -1
+func (c *routerGRPCServiceClient) ClusterStats(ctx context.Context, in *RequestHead, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := c.cc.Invoke(ctx, "/RouterGRPCService/ClusterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v21 := r.Intn(5)
-		this.Fields = make([]*Field, v21)
-		for i := 0; i < v21; i++ {
-			this.Fields[i] = NewPopulatedField(r, easy)
-		}
+	return out, nil
+}
+
+// RouterGRPCServiceServer is the server API for RouterGRPCService service.
+type RouterGRPCServiceServer interface {
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	Add(context.Context, *AddRequest) (*AddResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Bulk(context.Context, *BulkRequest) (*BulkResponse, error)
+	MSearch(context.Context, *MSearchRequest) (*SearchResponse, error)
+	Space(context.Context, *RequestHead) (*Table, error)
+	SearchByID(context.Context, *SearchRequest) (*SearchResponse, error)
+	Upsert(context.Context, *BulkRequest) (*BulkResponse, error)
+	Query(context.Context, *SearchRequest) (*SearchResponse, error)
+	DeleteByQuery(context.Context, *SearchRequest) (*DelByQueryeResponse, error)
+	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
+	ForceMerge(context.Context, *ForceMergeRequest) (*ForceMergeResponse, error)
+	Rebuild(context.Context, *IndexRequest) (*IndexResponse, error)
+	QueryByIDsFeature(context.Context, *QueryByIDsFeatureRequest) (*SearchResponse, error)
+	BulkStream(RouterGRPCService_BulkStreamServer) error
+	// db and space admin, proxied to master
+	ListServer(context.Context, *RequestHead) (*ClusterResponse, error)
+	ListDB(context.Context, *DbRequest) (*DbResponse, error)
+	ListSpace(context.Context, *SpaceRequest) (*SpaceResponse, error)
+	ListPartition(context.Context, *RequestHead) (*ClusterResponse, error)
+	CreateDB(context.Context, *DbRequest) (*DbResponse, error)
+	GetDB(context.Context, *DbRequest) (*DbResponse, error)
+	DeleteDB(context.Context, *DbRequest) (*DbResponse, error)
+	ModifyDB(context.Context, *DbRequest) (*DbResponse, error)
+	CreateSpace(context.Context, *SpaceRequest) (*SpaceResponse, error)
+	GetSpace(context.Context, *SpaceRequest) (*SpaceResponse, error)
+	UpdateSpace(context.Context, *SpaceRequest) (*SpaceResponse, error)
+	DeleteSpace(context.Context, *SpaceRequest) (*SpaceResponse, error)
+	ClusterHealth(context.Context, *RequestHead) (*ClusterResponse, error)
+	ClusterStats(context.Context, *RequestHead) (*ClusterResponse, error)
+}
+
+// UnimplementedRouterGRPCServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRouterGRPCServiceServer struct {
+}
+
+func (*UnimplementedRouterGRPCServiceServer) Get(ctx context.Context, req *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Update(ctx context.Context, req *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Bulk(ctx context.Context, req *BulkRequest) (*BulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bulk not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) MSearch(ctx context.Context, req *MSearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSearch not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Space(ctx context.Context, req *RequestHead) (*Table, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Space not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) SearchByID(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchByID not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Upsert(ctx context.Context, req *BulkRequest) (*BulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Query(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) DeleteByQuery(ctx context.Context, req *SearchRequest) (*DelByQueryeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByQuery not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Flush(ctx context.Context, req *FlushRequest) (*FlushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flush not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ForceMerge(ctx context.Context, req *ForceMergeRequest) (*ForceMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceMerge not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) Rebuild(ctx context.Context, req *IndexRequest) (*IndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebuild not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) QueryByIDsFeature(ctx context.Context, req *QueryByIDsFeatureRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryByIDsFeature not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) BulkStream(srv RouterGRPCService_BulkStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BulkStream not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ListServer(ctx context.Context, req *RequestHead) (*ClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServer not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ListDB(ctx context.Context, req *DbRequest) (*DbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDB not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ListSpace(ctx context.Context, req *SpaceRequest) (*SpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpace not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ListPartition(ctx context.Context, req *RequestHead) (*ClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPartition not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) CreateDB(ctx context.Context, req *DbRequest) (*DbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDB not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) GetDB(ctx context.Context, req *DbRequest) (*DbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDB not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) DeleteDB(ctx context.Context, req *DbRequest) (*DbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDB not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ModifyDB(ctx context.Context, req *DbRequest) (*DbResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyDB not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) CreateSpace(ctx context.Context, req *SpaceRequest) (*SpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpace not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) GetSpace(ctx context.Context, req *SpaceRequest) (*SpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpace not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) UpdateSpace(ctx context.Context, req *SpaceRequest) (*SpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpace not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) DeleteSpace(ctx context.Context, req *SpaceRequest) (*SpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpace not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ClusterHealth(ctx context.Context, req *RequestHead) (*ClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterHealth not implemented")
+}
+func (*UnimplementedRouterGRPCServiceServer) ClusterStats(ctx context.Context, req *RequestHead) (*ClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterStats not implemented")
+}
+
+func RegisterRouterGRPCServiceServer(s *grpc.Server, srv RouterGRPCServiceServer) {
+	s.RegisterService(&_RouterGRPCService_serviceDesc, srv)
+}
+
+func _RouterGRPCService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	this.Extra = string(randStringRouterGrpc(r))
-	this.PKey = string(randStringRouterGrpc(r))
-	v22 := r.Intn(100)
-	this.Source = make([]byte, v22)
-	for i := 0; i < v22; i++ {
-		this.Source[i] = byte(r.Intn(256))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Get(ctx, in)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 6)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Get",
 	}
-	return this
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedSearchResult(r randyRouterGrpc, easy bool) *SearchResult {
-	this := &SearchResult{}
-	this.TotalHits = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.TotalHits *= -1
+func _RouterGRPCService_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	this.MaxScore = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.MaxScore *= -1
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Add(ctx, in)
 	}
-	this.MaxTook = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.MaxTook *= -1
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Add",
 	}
-	this.MaxTookId = uint32(r.Uint32())
-	if r.Intn(5) != 0 {
-		this.Status = NewPopulatedSearchStatus(r, easy)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Add(ctx, req.(*AddRequest))
 	}
-	this.Msg = string(randStringRouterGrpc(r))
-	if r.Intn(5) != 0 {
-		v23 := r.Intn(5)
-		this.ResultItems = make([]*ResultItem, v23)
-		for i := 0; i < v23; i++ {
-			this.ResultItems[i] = NewPopulatedResultItem(r, easy)
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouterGRPCService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	this.PID = uint32(r.Uint32())
-	if r.Intn(5) != 0 {
-		v24 := r.Intn(10)
-		this.Explain = make(map[uint32]string)
-		for i := 0; i < v24; i++ {
-			this.Explain[uint32(r.Uint32())] = randStringRouterGrpc(r)
-		}
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Delete(ctx, in)
 	}
-	this.Timeout = bool(bool(r.Intn(2) == 0))
-	this.TopN = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.TopN *= -1
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Delete",
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 12)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Delete(ctx, req.(*DeleteRequest))
 	}
-	return this
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedSearchResponse(r randyRouterGrpc, easy bool) *SearchResponse {
-	this := &SearchResponse{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedResponseHead(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		v25 := r.Intn(5)
-		this.Results = make([]*SearchResult, v25)
-		for i := 0; i < v25; i++ {
-			this.Results[i] = NewPopulatedSearchResult(r, easy)
-		}
-	}
-	this.OnlineLogMessage = string(randStringRouterGrpc(r))
-	this.Timeout = bool(bool(r.Intn(2) == 0))
-	v26 := r.Intn(100)
-	this.FlatBytes = make([]byte, v26)
-	for i := 0; i < v26; i++ {
-		this.FlatBytes[i] = byte(r.Intn(256))
+func _RouterGRPCService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v27 := r.Intn(10)
-		this.SortFieldMap = make(map[string]string)
-		for i := 0; i < v27; i++ {
-			this.SortFieldMap[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
-		}
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Update(ctx, in)
 	}
-	this.TopSize = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.TopSize *= -1
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Update",
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 8)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Update(ctx, req.(*UpdateRequest))
 	}
-	return this
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedSearchStatus(r randyRouterGrpc, easy bool) *SearchStatus {
-	this := &SearchStatus{}
-	this.Total = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Total *= -1
+func _RouterGRPCService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	this.Failed = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Failed *= -1
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Search(ctx, in)
 	}
-	this.Successful = int32(r.Int31())
-	if r.Intn(2) == 0 {
-		this.Successful *= -1
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Search",
 	}
-	this.Msg = string(randStringRouterGrpc(r))
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Search(ctx, req.(*SearchRequest))
 	}
-	return this
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedMSearchRequest(r randyRouterGrpc, easy bool) *MSearchRequest {
-	this := &MSearchRequest{}
-	if r.Intn(5) != 0 {
-		this.Head = NewPopulatedRequestHead(r, easy)
+func _RouterGRPCService_Bulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if r.Intn(5) != 0 {
-		v28 := r.Intn(5)
-		this.SearchRequests = make([]*SearchRequest, v28)
-		for i := 0; i < v28; i++ {
-			this.SearchRequests[i] = NewPopulatedSearchRequest(r, easy)
-		}
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Bulk(ctx, in)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Bulk",
 	}
-	return this
-}
-
-type randyRouterGrpc interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Bulk(ctx, req.(*BulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func randUTF8RuneRouterGrpc(r randyRouterGrpc) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
-}
-func randStringRouterGrpc(r randyRouterGrpc) string {
-	v29 := r.Intn(100)
-	tmps := make([]rune, v29)
-	for i := 0; i < v29; i++ {
-		tmps[i] = randUTF8RuneRouterGrpc(r)
+func _RouterGRPCService_MSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return string(tmps)
-}
-func randUnrecognizedRouterGrpc(r randyRouterGrpc, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldRouterGrpc(dAtA, r, fieldNumber, wire)
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).MSearch(ctx, in)
 	}
-	return dAtA
-}
-func randFieldRouterGrpc(dAtA []byte, r randyRouterGrpc, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
-		v30 := r.Int63()
-		if r.Intn(2) == 0 {
-			v30 *= -1
-		}
-		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(v30))
-	case 1:
-		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/MSearch",
 	}
-	return dAtA
-}
-func encodeVarintPopulateRouterGrpc(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).MSearch(ctx, req.(*MSearchRequest))
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return interceptor(ctx, in, info, handler)
 }
-func (m *RequestHead) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TimeOutMs != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.TimeOutMs))
+
+func _RouterGRPCService_Space_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestHead)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.UserName)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Space(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Space",
 	}
-	l = len(m.DbName)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Space(ctx, req.(*RequestHead))
 	}
-	l = len(m.SpaceName)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouterGRPCService_SearchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ClientType)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).SearchByID(ctx, in)
 	}
-	if len(m.Params) > 0 {
-		for k, v := range m.Params {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/SearchByID",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).SearchByID(ctx, req.(*SearchRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ResponseHead) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Err != nil {
-		l = m.Err.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Upsert(ctx, in)
 	}
-	if len(m.Params) > 0 {
-		for k, v := range m.Params {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Upsert",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Upsert(ctx, req.(*BulkRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Query(ctx, in)
 	}
-	if len(m.PrimaryKeys) > 0 {
-		for _, s := range m.PrimaryKeys {
-			l = len(s)
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Query",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Query(ctx, req.(*SearchRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_DeleteByQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).DeleteByQuery(ctx, in)
 	}
-	if len(m.PrimaryKeys) > 0 {
-		for _, s := range m.PrimaryKeys {
-			l = len(s)
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/DeleteByQuery",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).DeleteByQuery(ctx, req.(*SearchRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_Flush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Flush(ctx, in)
 	}
-	if m.Doc != nil {
-		l = m.Doc.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Flush",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Flush(ctx, req.(*FlushRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_ForceMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ForceMerge(ctx, in)
 	}
-	if m.Doc != nil {
-		l = m.Doc.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ForceMerge",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ForceMerge(ctx, req.(*ForceMergeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BulkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_Rebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).Rebuild(ctx, in)
 	}
-	if len(m.Docs) > 0 {
-		for _, e := range m.Docs {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/Rebuild",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).Rebuild(ctx, req.(*IndexRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ForceMergeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_QueryByIDsFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByIDsFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).QueryByIDsFeature(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/QueryByIDsFeature",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).QueryByIDsFeature(ctx, req.(*QueryByIDsFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FlushRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func _RouterGRPCService_BulkStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouterGRPCServiceServer).BulkStream(&routerGRPCServiceBulkStreamServer{stream})
 }
 
-func (m *IndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+type RouterGRPCService_BulkStreamServer interface {
+	Send(*BulkStreamResponse) error
+	Recv() (*BulkRequest, error)
+	grpc.ServerStream
+}
+
+type routerGRPCServiceBulkStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerGRPCServiceBulkStreamServer) Send(m *BulkStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *routerGRPCServiceBulkStreamServer) Recv() (*BulkRequest, error) {
+	m := new(BulkRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.DropBeforeRebuild != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.DropBeforeRebuild))
+	return m, nil
+}
+
+func _RouterGRPCService_ListServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestHead)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.LimitCpu != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.LimitCpu))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ListServer(ctx, in)
 	}
-	if m.Describe != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.Describe))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ListServer",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ListServer(ctx, req.(*RequestHead))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_ListDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ListDB(ctx, in)
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ListDB",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ListDB(ctx, req.(*DbRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_ListSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ListSpace(ctx, in)
 	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ListSpace",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ListSpace(ctx, req.(*SpaceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_ListPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestHead)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ListPartition(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ListPartition",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ListPartition(ctx, req.(*RequestHead))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_CreateDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).CreateDB(ctx, in)
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/CreateDB",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).CreateDB(ctx, req.(*DbRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BulkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_GetDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).GetDB(ctx, in)
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/GetDB",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).GetDB(ctx, req.(*DbRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ForceMergeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_DeleteDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).DeleteDB(ctx, in)
 	}
-	if m.Shards != nil {
-		l = m.Shards.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/DeleteDB",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).DeleteDB(ctx, req.(*DbRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelByQueryeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if m.DelNum != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.DelNum))
+func _RouterGRPCService_ModifyDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.IdsStr) > 0 {
-		for _, s := range m.IdsStr {
-			l = len(s)
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ModifyDB(ctx, in)
 	}
-	if len(m.IdsLong) > 0 {
-		l = 0
-		for _, e := range m.IdsLong {
-			l += sovRouterGrpc(uint64(e))
-		}
-		n += 1 + sovRouterGrpc(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ModifyDB",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ModifyDB(ctx, req.(*DbRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FlushResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_CreateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).CreateSpace(ctx, in)
 	}
-	if m.Shards != nil {
-		l = m.Shards.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/CreateSpace",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).CreateSpace(ctx, req.(*SpaceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_GetSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).GetSpace(ctx, in)
 	}
-	if m.Shards != nil {
-		l = m.Shards.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/GetSpace",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).GetSpace(ctx, req.(*SpaceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TermFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+func _RouterGRPCService_UpdateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).UpdateSpace(ctx, in)
 	}
-	if m.IsUnion != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.IsUnion))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/UpdateSpace",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).UpdateSpace(ctx, req.(*SpaceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RangeFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	l = len(m.LowerValue)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	l = len(m.UpperValue)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+func _RouterGRPCService_DeleteSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.IncludeLower {
-		n += 2
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).DeleteSpace(ctx, in)
 	}
-	if m.IncludeUpper {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/DeleteSpace",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).DeleteSpace(ctx, req.(*SpaceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortField) Size() (n int) {
-	if m == nil {
-		return 0
+func _RouterGRPCService_ClusterHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestHead)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ClusterHealth(ctx, in)
 	}
-	if m.Type {
-		n += 2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ClusterHealth",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ClusterHealth(ctx, req.(*RequestHead))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VectorQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if m.MinScore != 0 {
-		n += 9
-	}
-	if m.MaxScore != 0 {
-		n += 9
-	}
-	if m.Boost != 0 {
-		n += 9
-	}
-	if m.HasBoost != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.HasBoost))
+func _RouterGRPCService_ClusterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestHead)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Format)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if interceptor == nil {
+		return srv.(RouterGRPCServiceServer).ClusterStats(ctx, in)
 	}
-	l = len(m.RetrievalType)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RouterGRPCService/ClusterStats",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterGRPCServiceServer).ClusterStats(ctx, req.(*RequestHead))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RetrievalParameters) Size() (n int) {
-	if m == nil {
-		return 0
+var _RouterGRPCService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "RouterGRPCService",
+	HandlerType: (*RouterGRPCServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _RouterGRPCService_Get_Handler,
+		},
+		{
+			MethodName: "Add",
+			Handler:    _RouterGRPCService_Add_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _RouterGRPCService_Delete_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _RouterGRPCService_Update_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _RouterGRPCService_Search_Handler,
+		},
+		{
+			MethodName: "Bulk",
+			Handler:    _RouterGRPCService_Bulk_Handler,
+		},
+		{
+			MethodName: "MSearch",
+			Handler:    _RouterGRPCService_MSearch_Handler,
+		},
+		{
+			MethodName: "Space",
+			Handler:    _RouterGRPCService_Space_Handler,
+		},
+		{
+			MethodName: "SearchByID",
+			Handler:    _RouterGRPCService_SearchByID_Handler,
+		},
+		{
+			MethodName: "Upsert",
+			Handler:    _RouterGRPCService_Upsert_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _RouterGRPCService_Query_Handler,
+		},
+		{
+			MethodName: "DeleteByQuery",
+			Handler:    _RouterGRPCService_DeleteByQuery_Handler,
+		},
+		{
+			MethodName: "Flush",
+			Handler:    _RouterGRPCService_Flush_Handler,
+		},
+		{
+			MethodName: "ForceMerge",
+			Handler:    _RouterGRPCService_ForceMerge_Handler,
+		},
+		{
+			MethodName: "Rebuild",
+			Handler:    _RouterGRPCService_Rebuild_Handler,
+		},
+		{
+			MethodName: "QueryByIDsFeature",
+			Handler:    _RouterGRPCService_QueryByIDsFeature_Handler,
+		},
+		{
+			MethodName: "ListServer",
+			Handler:    _RouterGRPCService_ListServer_Handler,
+		},
+		{
+			MethodName: "ListDB",
+			Handler:    _RouterGRPCService_ListDB_Handler,
+		},
+		{
+			MethodName: "ListSpace",
+			Handler:    _RouterGRPCService_ListSpace_Handler,
+		},
+		{
+			MethodName: "ListPartition",
+			Handler:    _RouterGRPCService_ListPartition_Handler,
+		},
+		{
+			MethodName: "CreateDB",
+			Handler:    _RouterGRPCService_CreateDB_Handler,
+		},
+		{
+			MethodName: "GetDB",
+			Handler:    _RouterGRPCService_GetDB_Handler,
+		},
+		{
+			MethodName: "DeleteDB",
+			Handler:    _RouterGRPCService_DeleteDB_Handler,
+		},
+		{
+			MethodName: "ModifyDB",
+			Handler:    _RouterGRPCService_ModifyDB_Handler,
+		},
+		{
+			MethodName: "CreateSpace",
+			Handler:    _RouterGRPCService_CreateSpace_Handler,
+		},
+		{
+			MethodName: "GetSpace",
+			Handler:    _RouterGRPCService_GetSpace_Handler,
+		},
+		{
+			MethodName: "UpdateSpace",
+			Handler:    _RouterGRPCService_UpdateSpace_Handler,
+		},
+		{
+			MethodName: "DeleteSpace",
+			Handler:    _RouterGRPCService_DeleteSpace_Handler,
+		},
+		{
+			MethodName: "ClusterHealth",
+			Handler:    _RouterGRPCService_ClusterHealth_Handler,
+		},
+		{
+			MethodName: "ClusterStats",
+			Handler:    _RouterGRPCService_ClusterStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BulkStream",
+			Handler:       _RouterGRPCService_BulkStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "router_grpc.proto",
+}
+
+func (m *RequestHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MetricType != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.MetricType))
-	}
-	if m.Nprobe != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.Nprobe))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *SearchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if m.ReqNum != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.ReqNum))
-	}
-	if m.TopN != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.TopN))
-	}
-	if m.IsBruteSearch != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.IsBruteSearch))
-	}
-	if len(m.VecFields) > 0 {
-		for _, e := range m.VecFields {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
-	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
-	}
-	if len(m.RangeFilters) > 0 {
-		for _, e := range m.RangeFilters {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TermFilters) > 0 {
-		for _, e := range m.TermFilters {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
+	if len(m.Params) > 0 {
+		for k := range m.Params {
+			v := m.Params[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	l = len(m.OnlineLogLevel)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.RetrievalParams)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.HasRank {
-		n += 2
+	if len(m.DbName) > 0 {
+		i -= len(m.DbName)
+		copy(dAtA[i:], m.DbName)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.DbName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.MultiVectorRank != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.MultiVectorRank))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ParallelBasedOnQuery {
-		n += 2
+	if len(m.UserName) > 0 {
+		i -= len(m.UserName)
+		copy(dAtA[i:], m.UserName)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.UserName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.L2Sqrt {
-		n += 2
+	if m.TimeOutMs != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TimeOutMs))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.IvfFlat {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.IsVectorValue {
-		n += 3
+	return dAtA[:n], nil
+}
+
+func (m *ResponseHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.SortFieldMap) > 0 {
-		for k, v := range m.SortFieldMap {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
-			n += mapEntrySize + 2 + sovRouterGrpc(uint64(mapEntrySize))
+	if len(m.Params) > 0 {
+		for k := range m.Params {
+			v := m.Params[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.SortFields) > 0 {
-		for _, e := range m.SortFields {
-			l = e.Size()
-			n += 2 + l + sovRouterGrpc(uint64(l))
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResultItem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Score != 0 {
-		n += 9
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
+	if len(m.PrimaryKeys) > 0 {
+		for iNdEx := len(m.PrimaryKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrimaryKeys[iNdEx])
+			copy(dAtA[i:], m.PrimaryKeys[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	l = len(m.Extra)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	l = len(m.PKey)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SearchResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TotalHits != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.TotalHits))
-	}
-	if m.MaxScore != 0 {
-		n += 9
-	}
-	if m.MaxTook != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.MaxTook))
-	}
-	if m.MaxTookId != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.MaxTookId))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if len(m.ResultItems) > 0 {
-		for _, e := range m.ResultItems {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
-		}
-	}
-	if m.PID != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.PID))
-	}
-	if len(m.Explain) > 0 {
-		for k, v := range m.Explain {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovRouterGrpc(uint64(k)) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
-		}
-	}
-	if m.Timeout {
-		n += 2
-	}
-	if m.TopN != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.TopN))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SearchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
+	if len(m.PrimaryKeys) > 0 {
+		for iNdEx := len(m.PrimaryKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrimaryKeys[iNdEx])
+			copy(dAtA[i:], m.PrimaryKeys[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	l = len(m.OnlineLogMessage)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if m.Timeout {
-		n += 2
-	}
-	l = len(m.FlatBytes)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
-	}
-	if len(m.SortFieldMap) > 0 {
-		for k, v := range m.SortFieldMap {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.TopSize != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.TopSize))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SearchStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Total != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.Total))
-	}
-	if m.Failed != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.Failed))
-	}
-	if m.Successful != 0 {
-		n += 1 + sovRouterGrpc(uint64(m.Successful))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if m.Doc != nil {
+		{
+			size, err := m.Doc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MSearchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Head != nil {
-		l = m.Head.Size()
-		n += 1 + l + sovRouterGrpc(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.SearchRequests) > 0 {
-		for _, e := range m.SearchRequests {
-			l = e.Size()
-			n += 1 + l + sovRouterGrpc(uint64(l))
+	if m.Doc != nil {
+		{
+			size, err := m.Doc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovRouterGrpc(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozRouterGrpc(x uint64) (n int) {
-	return sovRouterGrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *RequestHead) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	keysForParams := make([]string, 0, len(this.Params))
-	for k, _ := range this.Params {
-		keysForParams = append(keysForParams, k)
+	if len(m.Docs) > 0 {
+		for iNdEx := len(m.Docs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Docs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
-	mapStringForParams := "map[string]string{"
-	for _, k := range keysForParams {
-		mapStringForParams += fmt.Sprintf("%v: %v,", k, this.Params[k])
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	mapStringForParams += "}"
-	s := strings.Join([]string{`&RequestHead{`,
-		`TimeOutMs:` + fmt.Sprintf("%v", this.TimeOutMs) + `,`,
-		`UserName:` + fmt.Sprintf("%v", this.UserName) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`DbName:` + fmt.Sprintf("%v", this.DbName) + `,`,
-		`SpaceName:` + fmt.Sprintf("%v", this.SpaceName) + `,`,
-		`ClientType:` + fmt.Sprintf("%v", this.ClientType) + `,`,
-		`Params:` + mapStringForParams + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ResponseHead) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForParams := make([]string, 0, len(this.Params))
-	for k, _ := range this.Params {
-		keysForParams = append(keysForParams, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
-	mapStringForParams := "map[string]string{"
-	for _, k := range keysForParams {
-		mapStringForParams += fmt.Sprintf("%v: %v,", k, this.Params[k])
+
+func (m *ForceMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForParams += "}"
-	s := strings.Join([]string{`&ResponseHead{`,
-		`Err:` + strings.Replace(fmt.Sprintf("%v", this.Err), "Error", "Error", 1) + `,`,
-		`Params:` + mapStringForParams + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`PrimaryKeys:` + fmt.Sprintf("%v", this.PrimaryKeys) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ForceMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *DeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ForceMergeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&DeleteRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`PrimaryKeys:` + fmt.Sprintf("%v", this.PrimaryKeys) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&AddRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`Doc:` + strings.Replace(fmt.Sprintf("%v", this.Doc), "Document", "Document", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *UpdateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FlushRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&UpdateRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`Doc:` + strings.Replace(fmt.Sprintf("%v", this.Doc), "Document", "Document", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *BulkRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FlushRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	repeatedStringForDocs := "[]*Document{"
-	for _, f := range this.Docs {
-		repeatedStringForDocs += strings.Replace(fmt.Sprintf("%v", f), "Document", "Document", 1) + ","
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	repeatedStringForDocs += "}"
-	s := strings.Join([]string{`&BulkRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`Docs:` + repeatedStringForDocs + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ForceMergeRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *IndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ForceMergeRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *FlushRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&FlushRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *IndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *IndexRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *IndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&IndexRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`DropBeforeRebuild:` + fmt.Sprintf("%v", this.DropBeforeRebuild) + `,`,
-		`LimitCpu:` + fmt.Sprintf("%v", this.LimitCpu) + `,`,
-		`Describe:` + fmt.Sprintf("%v", this.Describe) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Describe != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Describe))
+		i--
+		dAtA[i] = 0x20
 	}
-	repeatedStringForItems := "[]*Item{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
+	if m.LimitCpu != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.LimitCpu))
+		i--
+		dAtA[i] = 0x18
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&GetResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.DropBeforeRebuild != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.DropBeforeRebuild))
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&AddResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`PrimaryKey:` + fmt.Sprintf("%v", this.PrimaryKey) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&UpdateResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DeleteResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]*Item{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
+
+func (m *QueryByIDsFeatureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&DeleteResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *BulkResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]*Item{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&BulkResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *QueryByIDsFeatureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ForceMergeResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *QueryByIDsFeatureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&ForceMergeResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Shards:` + strings.Replace(this.Shards.String(), "SearchStatus", "SearchStatus", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DelByQueryeResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.PrimaryKeys) > 0 {
+		for iNdEx := len(m.PrimaryKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrimaryKeys[iNdEx])
+			copy(dAtA[i:], m.PrimaryKeys[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	s := strings.Join([]string{`&DelByQueryeResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`DelNum:` + fmt.Sprintf("%v", this.DelNum) + `,`,
-		`IdsStr:` + fmt.Sprintf("%v", this.IdsStr) + `,`,
-		`IdsLong:` + fmt.Sprintf("%v", this.IdsLong) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FlushResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.SearchRequest != nil {
+		{
+			size, err := m.SearchRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&FlushResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Shards:` + strings.Replace(this.Shards.String(), "SearchStatus", "SearchStatus", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *IndexResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DbInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&IndexResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Shards:` + strings.Replace(this.Shards.String(), "SearchStatus", "SearchStatus", 1) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *TermFilter) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&TermFilter{`,
-		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`IsUnion:` + fmt.Sprintf("%v", this.IsUnion) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *DbInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *RangeFilter) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DbInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&RangeFilter{`,
-		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
-		`LowerValue:` + fmt.Sprintf("%v", this.LowerValue) + `,`,
-		`UpperValue:` + fmt.Sprintf("%v", this.UpperValue) + `,`,
-		`IncludeLower:` + fmt.Sprintf("%v", this.IncludeLower) + `,`,
-		`IncludeUpper:` + fmt.Sprintf("%v", this.IncludeUpper) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SortField) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Ps) > 0 {
+		for iNdEx := len(m.Ps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ps[iNdEx])
+			copy(dAtA[i:], m.Ps[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Ps[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	s := strings.Join([]string{`&SortField{`,
-		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *VectorQuery) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&VectorQuery{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`MinScore:` + fmt.Sprintf("%v", this.MinScore) + `,`,
-		`MaxScore:` + fmt.Sprintf("%v", this.MaxScore) + `,`,
-		`Boost:` + fmt.Sprintf("%v", this.Boost) + `,`,
-		`HasBoost:` + fmt.Sprintf("%v", this.HasBoost) + `,`,
-		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
-		`RetrievalType:` + fmt.Sprintf("%v", this.RetrievalType) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Id != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
-func (this *RetrievalParameters) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DbRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&RetrievalParameters{`,
-		`MetricType:` + fmt.Sprintf("%v", this.MetricType) + `,`,
-		`Nprobe:` + fmt.Sprintf("%v", this.Nprobe) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SearchRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForVecFields := "[]*VectorQuery{"
-	for _, f := range this.VecFields {
-		repeatedStringForVecFields += strings.Replace(f.String(), "VectorQuery", "VectorQuery", 1) + ","
-	}
-	repeatedStringForVecFields += "}"
-	repeatedStringForRangeFilters := "[]*RangeFilter{"
-	for _, f := range this.RangeFilters {
-		repeatedStringForRangeFilters += strings.Replace(f.String(), "RangeFilter", "RangeFilter", 1) + ","
+
+func (m *DbRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DbRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	repeatedStringForRangeFilters += "}"
-	repeatedStringForTermFilters := "[]*TermFilter{"
-	for _, f := range this.TermFilters {
-		repeatedStringForTermFilters += strings.Replace(f.String(), "TermFilter", "TermFilter", 1) + ","
+	if m.RemovePs {
+		i--
+		if m.RemovePs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	repeatedStringForTermFilters += "}"
-	repeatedStringForSortFields := "[]*SortField{"
-	for _, f := range this.SortFields {
-		repeatedStringForSortFields += strings.Replace(f.String(), "SortField", "SortField", 1) + ","
+	if len(m.PsIp) > 0 {
+		i -= len(m.PsIp)
+		copy(dAtA[i:], m.PsIp)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PsIp)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForSortFields += "}"
-	keysForSortFieldMap := make([]string, 0, len(this.SortFieldMap))
-	for k, _ := range this.SortFieldMap {
-		keysForSortFieldMap = append(keysForSortFieldMap, k)
+	if m.Db != nil {
+		{
+			size, err := m.Db.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSortFieldMap)
-	mapStringForSortFieldMap := "map[string]string{"
-	for _, k := range keysForSortFieldMap {
-		mapStringForSortFieldMap += fmt.Sprintf("%v: %v,", k, this.SortFieldMap[k])
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	mapStringForSortFieldMap += "}"
-	s := strings.Join([]string{`&SearchRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`ReqNum:` + fmt.Sprintf("%v", this.ReqNum) + `,`,
-		`TopN:` + fmt.Sprintf("%v", this.TopN) + `,`,
-		`IsBruteSearch:` + fmt.Sprintf("%v", this.IsBruteSearch) + `,`,
-		`VecFields:` + repeatedStringForVecFields + `,`,
-		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
-		`RangeFilters:` + repeatedStringForRangeFilters + `,`,
-		`TermFilters:` + repeatedStringForTermFilters + `,`,
-		`OnlineLogLevel:` + fmt.Sprintf("%v", this.OnlineLogLevel) + `,`,
-		`RetrievalParams:` + fmt.Sprintf("%v", this.RetrievalParams) + `,`,
-		`HasRank:` + fmt.Sprintf("%v", this.HasRank) + `,`,
-		`MultiVectorRank:` + fmt.Sprintf("%v", this.MultiVectorRank) + `,`,
-		`ParallelBasedOnQuery:` + fmt.Sprintf("%v", this.ParallelBasedOnQuery) + `,`,
-		`L2Sqrt:` + fmt.Sprintf("%v", this.L2Sqrt) + `,`,
-		`IvfFlat:` + fmt.Sprintf("%v", this.IvfFlat) + `,`,
-		`IsVectorValue:` + fmt.Sprintf("%v", this.IsVectorValue) + `,`,
-		`SortFieldMap:` + mapStringForSortFieldMap + `,`,
-		`SortFields:` + repeatedStringForSortFields + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ResultItem) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForFields := "[]*Field{"
-	for _, f := range this.Fields {
-		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+
+func (m *DbResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForFields += "}"
-	s := strings.Join([]string{`&ResultItem{`,
-		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
-		`Fields:` + repeatedStringForFields + `,`,
-		`Extra:` + fmt.Sprintf("%v", this.Extra) + `,`,
-		`PKey:` + fmt.Sprintf("%v", this.PKey) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SearchResult) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DbResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DbResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	repeatedStringForResultItems := "[]*ResultItem{"
-	for _, f := range this.ResultItems {
-		repeatedStringForResultItems += strings.Replace(f.String(), "ResultItem", "ResultItem", 1) + ","
+	if len(m.Dbs) > 0 {
+		for iNdEx := len(m.Dbs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dbs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	repeatedStringForResultItems += "}"
-	keysForExplain := make([]uint32, 0, len(this.Explain))
-	for k, _ := range this.Explain {
-		keysForExplain = append(keysForExplain, k)
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	github_com_gogo_protobuf_sortkeys.Uint32s(keysForExplain)
-	mapStringForExplain := "map[uint32]string{"
-	for _, k := range keysForExplain {
-		mapStringForExplain += fmt.Sprintf("%v: %v,", k, this.Explain[k])
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForExplain += "}"
-	s := strings.Join([]string{`&SearchResult{`,
-		`TotalHits:` + fmt.Sprintf("%v", this.TotalHits) + `,`,
-		`MaxScore:` + fmt.Sprintf("%v", this.MaxScore) + `,`,
-		`MaxTook:` + fmt.Sprintf("%v", this.MaxTook) + `,`,
-		`MaxTookId:` + fmt.Sprintf("%v", this.MaxTookId) + `,`,
-		`Status:` + strings.Replace(this.Status.String(), "SearchStatus", "SearchStatus", 1) + `,`,
-		`Msg:` + fmt.Sprintf("%v", this.Msg) + `,`,
-		`ResultItems:` + repeatedStringForResultItems + `,`,
-		`PID:` + fmt.Sprintf("%v", this.PID) + `,`,
-		`Explain:` + mapStringForExplain + `,`,
-		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
-		`TopN:` + fmt.Sprintf("%v", this.TopN) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SearchResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SpaceField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	repeatedStringForResults := "[]*SearchResult{"
-	for _, f := range this.Results {
-		repeatedStringForResults += strings.Replace(f.String(), "SearchResult", "SearchResult", 1) + ","
+	if len(m.ModelId) > 0 {
+		i -= len(m.ModelId)
+		copy(dAtA[i:], m.ModelId)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.ModelId)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	repeatedStringForResults += "}"
-	keysForSortFieldMap := make([]string, 0, len(this.SortFieldMap))
-	for k, _ := range this.SortFieldMap {
-		keysForSortFieldMap = append(keysForSortFieldMap, k)
+	if len(m.StoreParam) > 0 {
+		i -= len(m.StoreParam)
+		copy(dAtA[i:], m.StoreParam)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.StoreParam)))
+		i--
+		dAtA[i] = 0x42
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSortFieldMap)
-	mapStringForSortFieldMap := "map[string]string{"
-	for _, k := range keysForSortFieldMap {
-		mapStringForSortFieldMap += fmt.Sprintf("%v: %v,", k, this.SortFieldMap[k])
+	if len(m.StoreType) > 0 {
+		i -= len(m.StoreType)
+		copy(dAtA[i:], m.StoreType)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.StoreType)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	mapStringForSortFieldMap += "}"
-	s := strings.Join([]string{`&SearchResponse{`,
-		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
-		`Results:` + repeatedStringForResults + `,`,
-		`OnlineLogMessage:` + fmt.Sprintf("%v", this.OnlineLogMessage) + `,`,
-		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
-		`FlatBytes:` + fmt.Sprintf("%v", this.FlatBytes) + `,`,
-		`SortFieldMap:` + mapStringForSortFieldMap + `,`,
-		`TopSize:` + fmt.Sprintf("%v", this.TopSize) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SearchStatus) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x32
 	}
-	s := strings.Join([]string{`&SearchStatus{`,
-		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
-		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
-		`Successful:` + fmt.Sprintf("%v", this.Successful) + `,`,
-		`Msg:` + fmt.Sprintf("%v", this.Msg) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
+	if m.Dimension != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Dimension))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Array {
+		i--
+		if m.Array {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Index {
+		i--
+		if m.Index {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceEngine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpaceEngine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceEngine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IdType) > 0 {
+		i -= len(m.IdType)
+		copy(dAtA[i:], m.IdType)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.IdType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RetrievalParams) > 0 {
+		i -= len(m.RetrievalParams)
+		copy(dAtA[i:], m.RetrievalParams)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalParams)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RetrievalParam) > 0 {
+		i -= len(m.RetrievalParam)
+		copy(dAtA[i:], m.RetrievalParam)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalParam)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RetrievalTypes) > 0 {
+		for iNdEx := len(m.RetrievalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetrievalTypes[iNdEx])
+			copy(dAtA[i:], m.RetrievalTypes[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RetrievalType) > 0 {
+		i -= len(m.RetrievalType)
+		copy(dAtA[i:], m.RetrievalType)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetricType) > 0 {
+		i -= len(m.MetricType)
+		copy(dAtA[i:], m.MetricType)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.MetricType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IndexSize != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.IndexSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpacePartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpacePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpacePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Replicas) > 0 {
+		dAtA17 := make([]byte, len(m.Replicas)*10)
+		var j16 int
+		for _, num := range m.Replicas {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeaderId != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.LeaderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpaceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Engine != nil {
+		{
+			size, err := m.Engine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ReplicaNum != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.ReplicaNum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PartitionNum != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.PartitionNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DbId != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.DbId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Space != nil {
+		{
+			size, err := m.Space.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Spaces) > 0 {
+		for iNdEx := len(m.Spaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PrimaryKey) > 0 {
+		i -= len(m.PrimaryKey)
+		copy(dAtA[i:], m.PrimaryKey)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PrimaryKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BulkStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Batch != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Batch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceMergeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Shards != nil {
+		{
+			size, err := m.Shards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelByQueryeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelByQueryeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelByQueryeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IdsLong) > 0 {
+		dAtA32 := make([]byte, len(m.IdsLong)*10)
+		var j31 int
+		for _, num1 := range m.IdsLong {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdsStr) > 0 {
+		for iNdEx := len(m.IdsStr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IdsStr[iNdEx])
+			copy(dAtA[i:], m.IdsStr[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.IdsStr[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DelNum != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.DelNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Sketch) > 0 {
+		i -= len(m.Sketch)
+		copy(dAtA[i:], m.Sketch)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Sketch)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Distinct != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Distinct))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Count != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FieldStats) > 0 {
+		for iNdEx := len(m.FieldStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Shards != nil {
+		{
+			size, err := m.Shards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Shards != nil {
+		{
+			size, err := m.Shards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TermFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TermFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TermFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsUnion != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.IsUnion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeUpper {
+		i--
+		if m.IncludeUpper {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IncludeLower {
+		i--
+		if m.IncludeLower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UpperValue) > 0 {
+		i -= len(m.UpperValue)
+		copy(dAtA[i:], m.UpperValue)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.UpperValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LowerValue) > 0 {
+		i -= len(m.LowerValue)
+		copy(dAtA[i:], m.LowerValue)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.LowerValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FilterClause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilterClause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilterClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IncludeUpper {
+		i--
+		if m.IncludeUpper {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IncludeLower {
+		i--
+		if m.IncludeLower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BoolFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoolFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoolFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MinimumShouldMatch != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MinimumShouldMatch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MustNot) > 0 {
+		for iNdEx := len(m.MustNot) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MustNot[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Should) > 0 {
+		for iNdEx := len(m.Should) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Should[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Must) > 0 {
+		for iNdEx := len(m.Must) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Must[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Clause != nil {
+		{
+			size, err := m.Clause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SortField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SortField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SortField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Type {
+		i--
+		if m.Type {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VectorQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VectorQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VectorQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RetrievalType) > 0 {
+		i -= len(m.RetrievalType)
+		copy(dAtA[i:], m.RetrievalType)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasBoost != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.HasBoost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Boost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Boost))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.MaxScore != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxScore))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.MinScore != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MinScore))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetrievalParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetrievalParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetrievalParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Nprobe != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Nprobe))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MetricType != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MetricType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartitionTimeoutMs != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.PartitionTimeoutMs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.DenyPartialResults {
+		i--
+		if m.DenyPartialResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Profile {
+		i--
+		if m.Profile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RangeSearch != nil {
+		{
+			size, err := m.RangeSearch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.BoolFilter != nil {
+		{
+			size, err := m.BoolFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.SearchAfter != nil {
+		{
+			size, err := m.SearchAfter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SortFields) > 0 {
+		for iNdEx := len(m.SortFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SortFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.SortFieldMap) > 0 {
+		for k := range m.SortFieldMap {
+			v := m.SortFieldMap[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.IsVectorValue {
+		i--
+		if m.IsVectorValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.IvfFlat {
+		i--
+		if m.IvfFlat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.L2Sqrt {
+		i--
+		if m.L2Sqrt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ParallelBasedOnQuery {
+		i--
+		if m.ParallelBasedOnQuery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MultiVectorRank != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MultiVectorRank))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.HasRank {
+		i--
+		if m.HasRank {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RetrievalParams) > 0 {
+		i -= len(m.RetrievalParams)
+		copy(dAtA[i:], m.RetrievalParams)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.RetrievalParams)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.OnlineLogLevel) > 0 {
+		i -= len(m.OnlineLogLevel)
+		copy(dAtA[i:], m.OnlineLogLevel)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.OnlineLogLevel)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TermFilters) > 0 {
+		for iNdEx := len(m.TermFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TermFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RangeFilters) > 0 {
+		for iNdEx := len(m.RangeFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangeFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VecFields) > 0 {
+		for iNdEx := len(m.VecFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VecFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.IsBruteSearch != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.IsBruteSearch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopN != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReqNum != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.ReqNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchAfter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchAfter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchAfter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PKey) > 0 {
+		i -= len(m.PKey)
+		copy(dAtA[i:], m.PKey)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SortValues) > 0 {
+		for iNdEx := len(m.SortValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SortValues[iNdEx])
+			copy(dAtA[i:], m.SortValues[iNdEx])
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.SortValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangeSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangeSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stream {
+		i--
+		if m.Stream {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Returned != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Returned))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxResults != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MaxResults))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResultItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResultItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResultItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PKey) > 0 {
+		i -= len(m.PKey)
+		copy(dAtA[i:], m.PKey)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.PKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Extra) > 0 {
+		i -= len(m.Extra)
+		copy(dAtA[i:], m.Extra)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Extra)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.TopN != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Timeout {
+		i--
+		if m.Timeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Explain) > 0 {
+		for k := range m.Explain {
+			v := m.Explain[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PID != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.PID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ResultItems) > 0 {
+		for iNdEx := len(m.ResultItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResultItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxTookId != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MaxTookId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTook != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.MaxTook))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxScore != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxScore))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.TotalHits != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TotalHits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RouterProfile != nil {
+		{
+			size, err := m.RouterProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TopSize != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.TopSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SortFieldMap) > 0 {
+		for k := range m.SortFieldMap {
+			v := m.SortFieldMap[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FlatBytes) > 0 {
+		i -= len(m.FlatBytes)
+		copy(dAtA[i:], m.FlatBytes)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.FlatBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timeout {
+		i--
+		if m.Timeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OnlineLogMessage) > 0 {
+		i -= len(m.OnlineLogMessage)
+		copy(dAtA[i:], m.OnlineLogMessage)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.OnlineLogMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeserializeMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DeserializeMs))))
+		i--
+		dAtA[i] = 0x69
+	}
+	if m.RpcMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RpcMs))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.PostFilterMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PostFilterMs))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.EngineMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EngineMs))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.FetchMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FetchMs))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if len(m.IndexParams) > 0 {
+		for k := range m.IndexParams {
+			v := m.IndexParams[k]
+			baseI := i
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Candidates != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Candidates))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AnnMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AnnMs))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.FilterDocs != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.FilterDocs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FilterMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FilterMs))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.QueueMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.QueueMs))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.NodeId != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.NodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartitionId != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.PartitionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouterProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouterProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TookMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TookMs))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.SerializeMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SerializeMs))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.MergeMs != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MergeMs))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Coverage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Coverage))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Successful != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Successful))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Failed != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Timeout {
+		i--
+		if m.Timeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PartitionId != 0 {
+		i = encodeVarintRouterGrpc(dAtA, i, uint64(m.PartitionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SearchRequests) > 0 {
+		for iNdEx := len(m.SearchRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SearchRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRouterGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRouterGrpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRouterGrpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedRequestHead(r randyRouterGrpc, easy bool) *RequestHead {
+	this := &RequestHead{}
+	this.TimeOutMs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.TimeOutMs *= -1
+	}
+	this.UserName = string(randStringRouterGrpc(r))
+	this.Password = string(randStringRouterGrpc(r))
+	this.DbName = string(randStringRouterGrpc(r))
+	this.SpaceName = string(randStringRouterGrpc(r))
+	this.ClientType = string(randStringRouterGrpc(r))
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(10)
+		this.Params = make(map[string]string)
+		for i := 0; i < v1; i++ {
+			this.Params[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 8)
+	}
+	return this
+}
+
+func NewPopulatedResponseHead(r randyRouterGrpc, easy bool) *ResponseHead {
+	this := &ResponseHead{}
+	if r.Intn(5) != 0 {
+		this.Err = NewPopulatedError(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(10)
+		this.Params = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.Params[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedGetRequest(r randyRouterGrpc, easy bool) *GetRequest {
+	this := &GetRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	v3 := r.Intn(10)
+	this.PrimaryKeys = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.PrimaryKeys[i] = string(randStringRouterGrpc(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedDeleteRequest(r randyRouterGrpc, easy bool) *DeleteRequest {
+	this := &DeleteRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	v4 := r.Intn(10)
+	this.PrimaryKeys = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.PrimaryKeys[i] = string(randStringRouterGrpc(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedAddRequest(r randyRouterGrpc, easy bool) *AddRequest {
+	this := &AddRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Doc = NewPopulatedDocument(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedUpdateRequest(r randyRouterGrpc, easy bool) *UpdateRequest {
+	this := &UpdateRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Doc = NewPopulatedDocument(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedBulkRequest(r randyRouterGrpc, easy bool) *BulkRequest {
+	this := &BulkRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.Docs = make([]*Document, v5)
+		for i := 0; i < v5; i++ {
+			this.Docs[i] = NewPopulatedDocument(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedForceMergeRequest(r randyRouterGrpc, easy bool) *ForceMergeRequest {
+	this := &ForceMergeRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedFlushRequest(r randyRouterGrpc, easy bool) *FlushRequest {
+	this := &FlushRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedIndexRequest(r randyRouterGrpc, easy bool) *IndexRequest {
+	this := &IndexRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	this.DropBeforeRebuild = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DropBeforeRebuild *= -1
+	}
+	this.LimitCpu = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.LimitCpu *= -1
+	}
+	this.Describe = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Describe *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedQueryByIDsFeatureRequest(r randyRouterGrpc, easy bool) *QueryByIDsFeatureRequest {
+	this := &QueryByIDsFeatureRequest{}
+	if r.Intn(5) == 0 {
+		this.SearchRequest = NewPopulatedSearchRequest(r, easy)
+	}
+	v6 := r.Intn(10)
+	this.PrimaryKeys = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.PrimaryKeys[i] = string(randStringRouterGrpc(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedDbInfo(r randyRouterGrpc, easy bool) *DbInfo {
+	this := &DbInfo{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.Name = string(randStringRouterGrpc(r))
+	v7 := r.Intn(10)
+	this.Ps = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.Ps[i] = string(randStringRouterGrpc(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedDbRequest(r randyRouterGrpc, easy bool) *DbRequest {
+	this := &DbRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Db = NewPopulatedDbInfo(r, easy)
+	}
+	this.PsIp = string(randStringRouterGrpc(r))
+	this.RemovePs = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedDbResponse(r randyRouterGrpc, easy bool) *DbResponse {
+	this := &DbResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.Dbs = make([]*DbInfo, v8)
+		for i := 0; i < v8; i++ {
+			this.Dbs[i] = NewPopulatedDbInfo(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedSpaceField(r randyRouterGrpc, easy bool) *SpaceField {
+	this := &SpaceField{}
+	this.Name = string(randStringRouterGrpc(r))
+	this.Type = string(randStringRouterGrpc(r))
+	this.Index = bool(bool(r.Intn(2) == 0))
+	this.Array = bool(bool(r.Intn(2) == 0))
+	this.Dimension = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Dimension *= -1
+	}
+	this.Format = string(randStringRouterGrpc(r))
+	this.StoreType = string(randStringRouterGrpc(r))
+	this.StoreParam = string(randStringRouterGrpc(r))
+	this.ModelId = string(randStringRouterGrpc(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 10)
+	}
+	return this
+}
+
+func NewPopulatedSpaceEngine(r randyRouterGrpc, easy bool) *SpaceEngine {
+	this := &SpaceEngine{}
+	this.IndexSize = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.IndexSize *= -1
+	}
+	this.MetricType = string(randStringRouterGrpc(r))
+	this.RetrievalType = string(randStringRouterGrpc(r))
+	v9 := r.Intn(10)
+	this.RetrievalTypes = make([]string, v9)
+	for i := 0; i < v9; i++ {
+		this.RetrievalTypes[i] = string(randStringRouterGrpc(r))
+	}
+	this.RetrievalParam = string(randStringRouterGrpc(r))
+	this.RetrievalParams = string(randStringRouterGrpc(r))
+	this.IdType = string(randStringRouterGrpc(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 8)
+	}
+	return this
+}
+
+func NewPopulatedSpacePartition(r randyRouterGrpc, easy bool) *SpacePartition {
+	this := &SpacePartition{}
+	this.Id = uint32(r.Uint32())
+	this.LeaderId = uint64(uint64(r.Uint32()))
+	v10 := r.Intn(10)
+	this.Replicas = make([]uint64, v10)
+	for i := 0; i < v10; i++ {
+		this.Replicas[i] = uint64(uint64(r.Uint32()))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSpaceInfo(r randyRouterGrpc, easy bool) *SpaceInfo {
+	this := &SpaceInfo{}
+	this.Id = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Id *= -1
+	}
+	this.Name = string(randStringRouterGrpc(r))
+	this.DbId = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.DbId *= -1
+	}
+	this.PartitionNum = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.PartitionNum *= -1
+	}
+	this.ReplicaNum = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ReplicaNum *= -1
+	}
+	if r.Intn(5) != 0 {
+		v11 := r.Intn(5)
+		this.Fields = make([]*SpaceField, v11)
+		for i := 0; i < v11; i++ {
+			this.Fields[i] = NewPopulatedSpaceField(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.Engine = NewPopulatedSpaceEngine(r, easy)
+	}
+	this.Enabled = bool(bool(r.Intn(2) == 0))
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(5)
+		this.Partitions = make([]*SpacePartition, v12)
+		for i := 0; i < v12; i++ {
+			this.Partitions[i] = NewPopulatedSpacePartition(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 10)
+	}
+	return this
+}
+
+func NewPopulatedSpaceRequest(r randyRouterGrpc, easy bool) *SpaceRequest {
+	this := &SpaceRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Space = NewPopulatedSpaceInfo(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedSpaceResponse(r randyRouterGrpc, easy bool) *SpaceResponse {
+	this := &SpaceResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v13 := r.Intn(5)
+		this.Spaces = make([]*SpaceInfo, v13)
+		for i := 0; i < v13; i++ {
+			this.Spaces[i] = NewPopulatedSpaceInfo(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedClusterResponse(r randyRouterGrpc, easy bool) *ClusterResponse {
+	this := &ClusterResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	v14 := r.Intn(100)
+	this.Body = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.Body[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedGetResponse(r randyRouterGrpc, easy bool) *GetResponse {
+	this := &GetResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v15 := r.Intn(5)
+		this.Items = make([]*Item, v15)
+		for i := 0; i < v15; i++ {
+			this.Items[i] = NewPopulatedItem(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedAddResponse(r randyRouterGrpc, easy bool) *AddResponse {
+	this := &AddResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	this.PrimaryKey = string(randStringRouterGrpc(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedUpdateResponse(r randyRouterGrpc, easy bool) *UpdateResponse {
+	this := &UpdateResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedDeleteResponse(r randyRouterGrpc, easy bool) *DeleteResponse {
+	this := &DeleteResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v16 := r.Intn(5)
+		this.Items = make([]*Item, v16)
+		for i := 0; i < v16; i++ {
+			this.Items[i] = NewPopulatedItem(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedBulkResponse(r randyRouterGrpc, easy bool) *BulkResponse {
+	this := &BulkResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v17 := r.Intn(5)
+		this.Items = make([]*Item, v17)
+		for i := 0; i < v17; i++ {
+			this.Items[i] = NewPopulatedItem(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedBulkStreamResponse(r randyRouterGrpc, easy bool) *BulkStreamResponse {
+	this := &BulkStreamResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	this.Batch = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Batch *= -1
+	}
+	if r.Intn(5) != 0 {
+		v18 := r.Intn(5)
+		this.Items = make([]*Item, v18)
+		for i := 0; i < v18; i++ {
+			this.Items[i] = NewPopulatedItem(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedForceMergeResponse(r randyRouterGrpc, easy bool) *ForceMergeResponse {
+	this := &ForceMergeResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Shards = NewPopulatedSearchStatus(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedDelByQueryeResponse(r randyRouterGrpc, easy bool) *DelByQueryeResponse {
+	this := &DelByQueryeResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	this.DelNum = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.DelNum *= -1
+	}
+	v19 := r.Intn(10)
+	this.IdsStr = make([]string, v19)
+	for i := 0; i < v19; i++ {
+		this.IdsStr[i] = string(randStringRouterGrpc(r))
+	}
+	v20 := r.Intn(10)
+	this.IdsLong = make([]int64, v20)
+	for i := 0; i < v20; i++ {
+		this.IdsLong[i] = int64(r.Int63())
+		if r.Intn(2) == 0 {
+			this.IdsLong[i] *= -1
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedFieldStats(r randyRouterGrpc, easy bool) *FieldStats {
+	this := &FieldStats{}
+	this.Field = string(randStringRouterGrpc(r))
+	this.Count = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Count *= -1
+	}
+	this.Min = string(randStringRouterGrpc(r))
+	this.Max = string(randStringRouterGrpc(r))
+	this.Distinct = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Distinct *= -1
+	}
+	v21 := r.Intn(100)
+	this.Sketch = make([]byte, v21)
+	for i := 0; i < v21; i++ {
+		this.Sketch[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedCountResponse(r randyRouterGrpc, easy bool) *CountResponse {
+	this := &CountResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	this.Count = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Count *= -1
+	}
+	if r.Intn(5) != 0 {
+		v22 := r.Intn(5)
+		this.FieldStats = make([]*FieldStats, v22)
+		for i := 0; i < v22; i++ {
+			this.FieldStats[i] = NewPopulatedFieldStats(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedFlushResponse(r randyRouterGrpc, easy bool) *FlushResponse {
+	this := &FlushResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Shards = NewPopulatedSearchStatus(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedIndexResponse(r randyRouterGrpc, easy bool) *IndexResponse {
+	this := &IndexResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Shards = NewPopulatedSearchStatus(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedTermFilter(r randyRouterGrpc, easy bool) *TermFilter {
+	this := &TermFilter{}
+	this.Field = string(randStringRouterGrpc(r))
+	v23 := r.Intn(100)
+	this.Value = make([]byte, v23)
+	for i := 0; i < v23; i++ {
+		this.Value[i] = byte(r.Intn(256))
+	}
+	this.IsUnion = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.IsUnion *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedRangeFilter(r randyRouterGrpc, easy bool) *RangeFilter {
+	this := &RangeFilter{}
+	this.Field = string(randStringRouterGrpc(r))
+	v24 := r.Intn(100)
+	this.LowerValue = make([]byte, v24)
+	for i := 0; i < v24; i++ {
+		this.LowerValue[i] = byte(r.Intn(256))
+	}
+	v25 := r.Intn(100)
+	this.UpperValue = make([]byte, v25)
+	for i := 0; i < v25; i++ {
+		this.UpperValue[i] = byte(r.Intn(256))
+	}
+	this.IncludeLower = bool(bool(r.Intn(2) == 0))
+	this.IncludeUpper = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedFilterClause(r randyRouterGrpc, easy bool) *FilterClause {
+	this := &FilterClause{}
+	this.Type = FilterClause_Type([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	this.Field = string(randStringRouterGrpc(r))
+	v26 := r.Intn(10)
+	this.Values = make([]string, v26)
+	for i := 0; i < v26; i++ {
+		this.Values[i] = string(randStringRouterGrpc(r))
+	}
+	this.IncludeLower = bool(bool(r.Intn(2) == 0))
+	this.IncludeUpper = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedBoolFilter(r randyRouterGrpc, easy bool) *BoolFilter {
+	this := &BoolFilter{}
+	if r.Intn(5) != 0 {
+		this.Clause = NewPopulatedFilterClause(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		v27 := r.Intn(5)
+		this.Must = make([]*BoolFilter, v27)
+		for i := 0; i < v27; i++ {
+			this.Must[i] = NewPopulatedBoolFilter(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		v28 := r.Intn(5)
+		this.Should = make([]*BoolFilter, v28)
+		for i := 0; i < v28; i++ {
+			this.Should[i] = NewPopulatedBoolFilter(r, easy)
+		}
+	}
+	if r.Intn(5) == 0 {
+		v29 := r.Intn(5)
+		this.MustNot = make([]*BoolFilter, v29)
+		for i := 0; i < v29; i++ {
+			this.MustNot[i] = NewPopulatedBoolFilter(r, easy)
+		}
+	}
+	this.MinimumShouldMatch = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MinimumShouldMatch *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedSortField(r randyRouterGrpc, easy bool) *SortField {
+	this := &SortField{}
+	this.Field = string(randStringRouterGrpc(r))
+	this.Type = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedVectorQuery(r randyRouterGrpc, easy bool) *VectorQuery {
+	this := &VectorQuery{}
+	this.Name = string(randStringRouterGrpc(r))
+	v30 := r.Intn(100)
+	this.Value = make([]byte, v30)
+	for i := 0; i < v30; i++ {
+		this.Value[i] = byte(r.Intn(256))
+	}
+	this.MinScore = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MinScore *= -1
+	}
+	this.MaxScore = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MaxScore *= -1
+	}
+	this.Boost = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Boost *= -1
+	}
+	this.HasBoost = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.HasBoost *= -1
+	}
+	this.Format = string(randStringRouterGrpc(r))
+	this.RetrievalType = string(randStringRouterGrpc(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 9)
+	}
+	return this
+}
+
+func NewPopulatedRetrievalParameters(r randyRouterGrpc, easy bool) *RetrievalParameters {
+	this := &RetrievalParameters{}
+	this.MetricType = RetrievalParameters_DistanceMetricType([]int32{0, 1}[r.Intn(2)])
+	this.Nprobe = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Nprobe *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedSearchRequest(r randyRouterGrpc, easy bool) *SearchRequest {
+	this := &SearchRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	this.ReqNum = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.ReqNum *= -1
+	}
+	this.TopN = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TopN *= -1
+	}
+	this.IsBruteSearch = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.IsBruteSearch *= -1
+	}
+	if r.Intn(5) != 0 {
+		v31 := r.Intn(5)
+		this.VecFields = make([]*VectorQuery, v31)
+		for i := 0; i < v31; i++ {
+			this.VecFields[i] = NewPopulatedVectorQuery(r, easy)
+		}
+	}
+	v32 := r.Intn(10)
+	this.Fields = make([]string, v32)
+	for i := 0; i < v32; i++ {
+		this.Fields[i] = string(randStringRouterGrpc(r))
+	}
+	if r.Intn(5) != 0 {
+		v33 := r.Intn(5)
+		this.RangeFilters = make([]*RangeFilter, v33)
+		for i := 0; i < v33; i++ {
+			this.RangeFilters[i] = NewPopulatedRangeFilter(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v34 := r.Intn(5)
+		this.TermFilters = make([]*TermFilter, v34)
+		for i := 0; i < v34; i++ {
+			this.TermFilters[i] = NewPopulatedTermFilter(r, easy)
+		}
+	}
+	this.OnlineLogLevel = string(randStringRouterGrpc(r))
+	this.RetrievalParams = string(randStringRouterGrpc(r))
+	this.HasRank = bool(bool(r.Intn(2) == 0))
+	this.MultiVectorRank = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MultiVectorRank *= -1
+	}
+	this.ParallelBasedOnQuery = bool(bool(r.Intn(2) == 0))
+	this.L2Sqrt = bool(bool(r.Intn(2) == 0))
+	this.IvfFlat = bool(bool(r.Intn(2) == 0))
+	this.IsVectorValue = bool(bool(r.Intn(2) == 0))
+	if r.Intn(5) != 0 {
+		v35 := r.Intn(10)
+		this.SortFieldMap = make(map[string]string)
+		for i := 0; i < v35; i++ {
+			this.SortFieldMap[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v36 := r.Intn(5)
+		this.SortFields = make([]*SortField, v36)
+		for i := 0; i < v36; i++ {
+			this.SortFields[i] = NewPopulatedSortField(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.SearchAfter = NewPopulatedSearchAfter(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.BoolFilter = NewPopulatedBoolFilter(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.RangeSearch = NewPopulatedRangeSearch(r, easy)
+	}
+	this.Profile = bool(bool(r.Intn(2) == 0))
+	this.DenyPartialResults = bool(bool(r.Intn(2) == 0))
+	this.PartitionTimeoutMs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.PartitionTimeoutMs *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 25)
+	}
+	return this
+}
+
+func NewPopulatedSearchAfter(r randyRouterGrpc, easy bool) *SearchAfter {
+	this := &SearchAfter{}
+	v37 := r.Intn(10)
+	this.SortValues = make([]string, v37)
+	for i := 0; i < v37; i++ {
+		this.SortValues[i] = string(randStringRouterGrpc(r))
+	}
+	this.PKey = string(randStringRouterGrpc(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedRangeSearch(r randyRouterGrpc, easy bool) *RangeSearch {
+	this := &RangeSearch{}
+	this.MaxResults = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.MaxResults *= -1
+	}
+	this.Returned = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Returned *= -1
+	}
+	this.Stream = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedResultItem(r randyRouterGrpc, easy bool) *ResultItem {
+	this := &ResultItem{}
+	this.Score = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Score *= -1
+	}
+	if r.Intn(5) != 0 {
+		v38 := r.Intn(5)
+		this.Fields = make([]*Field, v38)
+		for i := 0; i < v38; i++ {
+			this.Fields[i] = NewPopulatedField(r, easy)
+		}
+	}
+	this.Extra = string(randStringRouterGrpc(r))
+	this.PKey = string(randStringRouterGrpc(r))
+	v39 := r.Intn(100)
+	this.Source = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.Source[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedSearchResult(r randyRouterGrpc, easy bool) *SearchResult {
+	this := &SearchResult{}
+	this.TotalHits = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TotalHits *= -1
+	}
+	this.MaxScore = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MaxScore *= -1
+	}
+	this.MaxTook = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.MaxTook *= -1
+	}
+	this.MaxTookId = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.Status = NewPopulatedSearchStatus(r, easy)
+	}
+	this.Msg = string(randStringRouterGrpc(r))
+	if r.Intn(5) != 0 {
+		v40 := r.Intn(5)
+		this.ResultItems = make([]*ResultItem, v40)
+		for i := 0; i < v40; i++ {
+			this.ResultItems[i] = NewPopulatedResultItem(r, easy)
+		}
+	}
+	this.PID = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		v41 := r.Intn(10)
+		this.Explain = make(map[uint32]string)
+		for i := 0; i < v41; i++ {
+			this.Explain[uint32(r.Uint32())] = randStringRouterGrpc(r)
+		}
+	}
+	this.Timeout = bool(bool(r.Intn(2) == 0))
+	this.TopN = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TopN *= -1
+	}
+	this.Cursor = string(randStringRouterGrpc(r))
+	this.Truncated = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 14)
+	}
+	return this
+}
+
+func NewPopulatedSearchResponse(r randyRouterGrpc, easy bool) *SearchResponse {
+	this := &SearchResponse{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedResponseHead(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v42 := r.Intn(5)
+		this.Results = make([]*SearchResult, v42)
+		for i := 0; i < v42; i++ {
+			this.Results[i] = NewPopulatedSearchResult(r, easy)
+		}
+	}
+	this.OnlineLogMessage = string(randStringRouterGrpc(r))
+	this.Timeout = bool(bool(r.Intn(2) == 0))
+	v43 := r.Intn(100)
+	this.FlatBytes = make([]byte, v43)
+	for i := 0; i < v43; i++ {
+		this.FlatBytes[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		v44 := r.Intn(10)
+		this.SortFieldMap = make(map[string]string)
+		for i := 0; i < v44; i++ {
+			this.SortFieldMap[randStringRouterGrpc(r)] = randStringRouterGrpc(r)
+		}
+	}
+	this.TopSize = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.TopSize *= -1
+	}
+	if r.Intn(5) != 0 {
+		v45 := r.Intn(5)
+		this.Profiles = make([]*SearchProfile, v45)
+		for i := 0; i < v45; i++ {
+			this.Profiles[i] = NewPopulatedSearchProfile(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.RouterProfile = NewPopulatedRouterProfile(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 10)
+	}
+	return this
+}
+
+func NewPopulatedSearchProfile(r randyRouterGrpc, easy bool) *SearchProfile {
+	this := &SearchProfile{}
+	this.PartitionId = uint32(r.Uint32())
+	this.NodeId = uint64(uint64(r.Uint32()))
+	this.QueueMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.QueueMs *= -1
+	}
+	this.FilterMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.FilterMs *= -1
+	}
+	this.FilterDocs = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.FilterDocs *= -1
+	}
+	this.AnnMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.AnnMs *= -1
+	}
+	this.Candidates = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Candidates *= -1
+	}
+	if r.Intn(5) != 0 {
+		v46 := r.Intn(10)
+		this.IndexParams = make(map[string]int64)
+		for i := 0; i < v46; i++ {
+			v47 := randStringRouterGrpc(r)
+			this.IndexParams[v47] = int64(r.Int63())
+			if r.Intn(2) == 0 {
+				this.IndexParams[v47] *= -1
+			}
+		}
+	}
+	this.FetchMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.FetchMs *= -1
+	}
+	this.EngineMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.EngineMs *= -1
+	}
+	this.PostFilterMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.PostFilterMs *= -1
+	}
+	this.RpcMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.RpcMs *= -1
+	}
+	this.DeserializeMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.DeserializeMs *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 14)
+	}
+	return this
+}
+
+func NewPopulatedRouterProfile(r randyRouterGrpc, easy bool) *RouterProfile {
+	this := &RouterProfile{}
+	this.MergeMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MergeMs *= -1
+	}
+	this.SerializeMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.SerializeMs *= -1
+	}
+	this.TookMs = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.TookMs *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedSearchStatus(r randyRouterGrpc, easy bool) *SearchStatus {
+	this := &SearchStatus{}
+	this.Total = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Total *= -1
+	}
+	this.Failed = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Failed *= -1
+	}
+	this.Successful = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.Successful *= -1
+	}
+	this.Msg = string(randStringRouterGrpc(r))
+	if r.Intn(5) != 0 {
+		v48 := r.Intn(5)
+		this.Failures = make([]*PartitionFailure, v48)
+		for i := 0; i < v48; i++ {
+			this.Failures[i] = NewPopulatedPartitionFailure(r, easy)
+		}
+	}
+	this.Coverage = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Coverage *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedPartitionFailure(r randyRouterGrpc, easy bool) *PartitionFailure {
+	this := &PartitionFailure{}
+	this.PartitionId = uint32(r.Uint32())
+	this.Code = ErrorEnum([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 70, 71, 100}[r.Intn(72)])
+	this.Reason = string(randStringRouterGrpc(r))
+	this.Timeout = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedMSearchRequest(r randyRouterGrpc, easy bool) *MSearchRequest {
+	this := &MSearchRequest{}
+	if r.Intn(5) != 0 {
+		this.Head = NewPopulatedRequestHead(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		v49 := r.Intn(5)
+		this.SearchRequests = make([]*SearchRequest, v49)
+		for i := 0; i < v49; i++ {
+			this.SearchRequests[i] = NewPopulatedSearchRequest(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedRouterGrpc(r, 3)
+	}
+	return this
+}
+
+type randyRouterGrpc interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneRouterGrpc(r randyRouterGrpc) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringRouterGrpc(r randyRouterGrpc) string {
+	v50 := r.Intn(100)
+	tmps := make([]rune, v50)
+	for i := 0; i < v50; i++ {
+		tmps[i] = randUTF8RuneRouterGrpc(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedRouterGrpc(r randyRouterGrpc, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldRouterGrpc(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldRouterGrpc(dAtA []byte, r randyRouterGrpc, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
+		v51 := r.Int63()
+		if r.Intn(2) == 0 {
+			v51 *= -1
+		}
+		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(v51))
+	case 1:
+		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateRouterGrpc(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateRouterGrpc(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *RequestHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeOutMs != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.TimeOutMs))
+	}
+	l = len(m.UserName)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.DbName)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for k, v := range m.Params {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResponseHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for k, v := range m.Params {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.PrimaryKeys) > 0 {
+		for _, s := range m.PrimaryKeys {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.PrimaryKeys) > 0 {
+		for _, s := range m.PrimaryKeys {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Doc != nil {
+		l = m.Doc.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Doc != nil {
+		l = m.Doc.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Docs) > 0 {
+		for _, e := range m.Docs {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForceMergeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.DropBeforeRebuild != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.DropBeforeRebuild))
+	}
+	if m.LimitCpu != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.LimitCpu))
+	}
+	if m.Describe != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Describe))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QueryByIDsFeatureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SearchRequest != nil {
+		l = m.SearchRequest.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.PrimaryKeys) > 0 {
+		for _, s := range m.PrimaryKeys {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DbInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Ps) > 0 {
+		for _, s := range m.Ps {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DbRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Db != nil {
+		l = m.Db.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.PsIp)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.RemovePs {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DbResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Dbs) > 0 {
+		for _, e := range m.Dbs {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpaceField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Index {
+		n += 2
+	}
+	if m.Array {
+		n += 2
+	}
+	if m.Dimension != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Dimension))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.StoreType)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.StoreParam)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.ModelId)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpaceEngine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexSize != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.IndexSize))
+	}
+	l = len(m.MetricType)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.RetrievalType)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.RetrievalTypes) > 0 {
+		for _, s := range m.RetrievalTypes {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	l = len(m.RetrievalParam)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.RetrievalParams)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.IdType)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpacePartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Id))
+	}
+	if m.LeaderId != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.LeaderId))
+	}
+	if len(m.Replicas) > 0 {
+		l = 0
+		for _, e := range m.Replicas {
+			l += sovRouterGrpc(uint64(e))
+		}
+		n += 1 + sovRouterGrpc(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpaceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.DbId != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.DbId))
+	}
+	if m.PartitionNum != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.PartitionNum))
+	}
+	if m.ReplicaNum != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.ReplicaNum))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.Engine != nil {
+		l = m.Engine.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Space != nil {
+		l = m.Space.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Spaces) > 0 {
+		for _, e := range m.Spaces {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Batch != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Batch))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForceMergeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Shards != nil {
+		l = m.Shards.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DelByQueryeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.DelNum != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.DelNum))
+	}
+	if len(m.IdsStr) > 0 {
+		for _, s := range m.IdsStr {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if len(m.IdsLong) > 0 {
+		l = 0
+		for _, e := range m.IdsLong {
+			l += sovRouterGrpc(uint64(e))
+		}
+		n += 1 + sovRouterGrpc(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FieldStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Count))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Distinct != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Distinct))
+	}
+	l = len(m.Sketch)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Count))
+	}
+	if len(m.FieldStats) > 0 {
+		for _, e := range m.FieldStats {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Shards != nil {
+		l = m.Shards.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Shards != nil {
+		l = m.Shards.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TermFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.IsUnion != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.IsUnion))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RangeFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.LowerValue)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.UpperValue)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.IncludeLower {
+		n += 2
+	}
+	if m.IncludeUpper {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FilterClause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Type))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.IncludeLower {
+		n += 2
+	}
+	if m.IncludeUpper {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BoolFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clause != nil {
+		l = m.Clause.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Must) > 0 {
+		for _, e := range m.Must {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if len(m.Should) > 0 {
+		for _, e := range m.Should {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if len(m.MustNot) > 0 {
+		for _, e := range m.MustNot {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.MinimumShouldMatch != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.MinimumShouldMatch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SortField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Type {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VectorQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.MinScore != 0 {
+		n += 9
+	}
+	if m.MaxScore != 0 {
+		n += 9
+	}
+	if m.Boost != 0 {
+		n += 9
+	}
+	if m.HasBoost != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.HasBoost))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.RetrievalType)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RetrievalParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MetricType != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.MetricType))
+	}
+	if m.Nprobe != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Nprobe))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.ReqNum != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.ReqNum))
+	}
+	if m.TopN != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.TopN))
+	}
+	if m.IsBruteSearch != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.IsBruteSearch))
+	}
+	if len(m.VecFields) > 0 {
+		for _, e := range m.VecFields {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if len(m.RangeFilters) > 0 {
+		for _, e := range m.RangeFilters {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if len(m.TermFilters) > 0 {
+		for _, e := range m.TermFilters {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	l = len(m.OnlineLogLevel)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.RetrievalParams)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.HasRank {
+		n += 2
+	}
+	if m.MultiVectorRank != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.MultiVectorRank))
+	}
+	if m.ParallelBasedOnQuery {
+		n += 2
+	}
+	if m.L2Sqrt {
+		n += 2
+	}
+	if m.IvfFlat {
+		n += 2
+	}
+	if m.IsVectorValue {
+		n += 3
+	}
+	if len(m.SortFieldMap) > 0 {
+		for k, v := range m.SortFieldMap {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
+			n += mapEntrySize + 2 + sovRouterGrpc(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SortFields) > 0 {
+		for _, e := range m.SortFields {
+			l = e.Size()
+			n += 2 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.SearchAfter != nil {
+		l = m.SearchAfter.Size()
+		n += 2 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.BoolFilter != nil {
+		l = m.BoolFilter.Size()
+		n += 2 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.RangeSearch != nil {
+		l = m.RangeSearch.Size()
+		n += 2 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Profile {
+		n += 3
+	}
+	if m.DenyPartialResults {
+		n += 3
+	}
+	if m.PartitionTimeoutMs != 0 {
+		n += 2 + sovRouterGrpc(uint64(m.PartitionTimeoutMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchAfter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SortValues) > 0 {
+		for _, s := range m.SortValues {
+			l = len(s)
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	l = len(m.PKey)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RangeSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxResults != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.MaxResults))
+	}
+	if m.Returned != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Returned))
+	}
+	if m.Stream {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResultItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Score != 0 {
+		n += 9
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	l = len(m.Extra)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.PKey)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalHits != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.TotalHits))
+	}
+	if m.MaxScore != 0 {
+		n += 9
+	}
+	if m.MaxTook != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.MaxTook))
+	}
+	if m.MaxTookId != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.MaxTookId))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.ResultItems) > 0 {
+		for _, e := range m.ResultItems {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.PID != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.PID))
+	}
+	if len(m.Explain) > 0 {
+		for k, v := range m.Explain {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovRouterGrpc(uint64(k)) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.Timeout {
+		n += 2
+	}
+	if m.TopN != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.TopN))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	l = len(m.OnlineLogMessage)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Timeout {
+		n += 2
+	}
+	l = len(m.FlatBytes)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.SortFieldMap) > 0 {
+		for k, v := range m.SortFieldMap {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + len(v) + sovRouterGrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.TopSize != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.TopSize))
+	}
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.RouterProfile != nil {
+		l = m.RouterProfile.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.PartitionId))
+	}
+	if m.NodeId != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.NodeId))
+	}
+	if m.QueueMs != 0 {
+		n += 9
+	}
+	if m.FilterMs != 0 {
+		n += 9
+	}
+	if m.FilterDocs != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.FilterDocs))
+	}
+	if m.AnnMs != 0 {
+		n += 9
+	}
+	if m.Candidates != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Candidates))
+	}
+	if len(m.IndexParams) > 0 {
+		for k, v := range m.IndexParams {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRouterGrpc(uint64(len(k))) + 1 + sovRouterGrpc(uint64(v))
+			n += mapEntrySize + 1 + sovRouterGrpc(uint64(mapEntrySize))
+		}
+	}
+	if m.FetchMs != 0 {
+		n += 9
+	}
+	if m.EngineMs != 0 {
+		n += 9
+	}
+	if m.PostFilterMs != 0 {
+		n += 9
+	}
+	if m.RpcMs != 0 {
+		n += 9
+	}
+	if m.DeserializeMs != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RouterProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergeMs != 0 {
+		n += 9
+	}
+	if m.SerializeMs != 0 {
+		n += 9
+	}
+	if m.TookMs != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Total))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Failed))
+	}
+	if m.Successful != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Successful))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.Coverage != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.PartitionId))
+	}
+	if m.Code != 0 {
+		n += 1 + sovRouterGrpc(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if m.Timeout {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovRouterGrpc(uint64(l))
+	}
+	if len(m.SearchRequests) > 0 {
+		for _, e := range m.SearchRequests {
+			l = e.Size()
+			n += 1 + l + sovRouterGrpc(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRouterGrpc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRouterGrpc(x uint64) (n int) {
+	return sovRouterGrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RequestHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForParams := make([]string, 0, len(this.Params))
+	for k, _ := range this.Params {
+		keysForParams = append(keysForParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
+	mapStringForParams := "map[string]string{"
+	for _, k := range keysForParams {
+		mapStringForParams += fmt.Sprintf("%v: %v,", k, this.Params[k])
+	}
+	mapStringForParams += "}"
+	s := strings.Join([]string{`&RequestHead{`,
+		`TimeOutMs:` + fmt.Sprintf("%v", this.TimeOutMs) + `,`,
+		`UserName:` + fmt.Sprintf("%v", this.UserName) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`DbName:` + fmt.Sprintf("%v", this.DbName) + `,`,
+		`SpaceName:` + fmt.Sprintf("%v", this.SpaceName) + `,`,
+		`ClientType:` + fmt.Sprintf("%v", this.ClientType) + `,`,
+		`Params:` + mapStringForParams + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResponseHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForParams := make([]string, 0, len(this.Params))
+	for k, _ := range this.Params {
+		keysForParams = append(keysForParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
+	mapStringForParams := "map[string]string{"
+	for _, k := range keysForParams {
+		mapStringForParams += fmt.Sprintf("%v: %v,", k, this.Params[k])
+	}
+	mapStringForParams += "}"
+	s := strings.Join([]string{`&ResponseHead{`,
+		`Err:` + strings.Replace(fmt.Sprintf("%v", this.Err), "Error", "Error", 1) + `,`,
+		`Params:` + mapStringForParams + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`PrimaryKeys:` + fmt.Sprintf("%v", this.PrimaryKeys) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`PrimaryKeys:` + fmt.Sprintf("%v", this.PrimaryKeys) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`Doc:` + strings.Replace(fmt.Sprintf("%v", this.Doc), "Document", "Document", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`Doc:` + strings.Replace(fmt.Sprintf("%v", this.Doc), "Document", "Document", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDocs := "[]*Document{"
+	for _, f := range this.Docs {
+		repeatedStringForDocs += strings.Replace(fmt.Sprintf("%v", f), "Document", "Document", 1) + ","
+	}
+	repeatedStringForDocs += "}"
+	s := strings.Join([]string{`&BulkRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`Docs:` + repeatedStringForDocs + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ForceMergeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ForceMergeRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FlushRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FlushRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IndexRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`DropBeforeRebuild:` + fmt.Sprintf("%v", this.DropBeforeRebuild) + `,`,
+		`LimitCpu:` + fmt.Sprintf("%v", this.LimitCpu) + `,`,
+		`Describe:` + fmt.Sprintf("%v", this.Describe) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryByIDsFeatureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryByIDsFeatureRequest{`,
+		`SearchRequest:` + strings.Replace(this.SearchRequest.String(), "SearchRequest", "SearchRequest", 1) + `,`,
+		`PrimaryKeys:` + fmt.Sprintf("%v", this.PrimaryKeys) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DbInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DbInfo{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Ps:` + fmt.Sprintf("%v", this.Ps) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DbRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DbRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`Db:` + strings.Replace(this.Db.String(), "DbInfo", "DbInfo", 1) + `,`,
+		`PsIp:` + fmt.Sprintf("%v", this.PsIp) + `,`,
+		`RemovePs:` + fmt.Sprintf("%v", this.RemovePs) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DbResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDbs := "[]*DbInfo{"
+	for _, f := range this.Dbs {
+		repeatedStringForDbs += strings.Replace(f.String(), "DbInfo", "DbInfo", 1) + ","
+	}
+	repeatedStringForDbs += "}"
+	s := strings.Join([]string{`&DbResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Dbs:` + repeatedStringForDbs + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SpaceField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SpaceField{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Array:` + fmt.Sprintf("%v", this.Array) + `,`,
+		`Dimension:` + fmt.Sprintf("%v", this.Dimension) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`StoreType:` + fmt.Sprintf("%v", this.StoreType) + `,`,
+		`StoreParam:` + fmt.Sprintf("%v", this.StoreParam) + `,`,
+		`ModelId:` + fmt.Sprintf("%v", this.ModelId) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SpaceEngine) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SpaceEngine{`,
+		`IndexSize:` + fmt.Sprintf("%v", this.IndexSize) + `,`,
+		`MetricType:` + fmt.Sprintf("%v", this.MetricType) + `,`,
+		`RetrievalType:` + fmt.Sprintf("%v", this.RetrievalType) + `,`,
+		`RetrievalTypes:` + fmt.Sprintf("%v", this.RetrievalTypes) + `,`,
+		`RetrievalParam:` + fmt.Sprintf("%v", this.RetrievalParam) + `,`,
+		`RetrievalParams:` + fmt.Sprintf("%v", this.RetrievalParams) + `,`,
+		`IdType:` + fmt.Sprintf("%v", this.IdType) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SpacePartition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SpacePartition{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`LeaderId:` + fmt.Sprintf("%v", this.LeaderId) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SpaceInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*SpaceField{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(f.String(), "SpaceField", "SpaceField", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	repeatedStringForPartitions := "[]*SpacePartition{"
+	for _, f := range this.Partitions {
+		repeatedStringForPartitions += strings.Replace(f.String(), "SpacePartition", "SpacePartition", 1) + ","
+	}
+	repeatedStringForPartitions += "}"
+	s := strings.Join([]string{`&SpaceInfo{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DbId:` + fmt.Sprintf("%v", this.DbId) + `,`,
+		`PartitionNum:` + fmt.Sprintf("%v", this.PartitionNum) + `,`,
+		`ReplicaNum:` + fmt.Sprintf("%v", this.ReplicaNum) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Engine:` + strings.Replace(this.Engine.String(), "SpaceEngine", "SpaceEngine", 1) + `,`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Partitions:` + repeatedStringForPartitions + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SpaceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SpaceRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`Space:` + strings.Replace(this.Space.String(), "SpaceInfo", "SpaceInfo", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SpaceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSpaces := "[]*SpaceInfo{"
+	for _, f := range this.Spaces {
+		repeatedStringForSpaces += strings.Replace(f.String(), "SpaceInfo", "SpaceInfo", 1) + ","
+	}
+	repeatedStringForSpaces += "}"
+	s := strings.Join([]string{`&SpaceResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Spaces:` + repeatedStringForSpaces + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]*Item{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&GetResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`PrimaryKey:` + fmt.Sprintf("%v", this.PrimaryKey) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]*Item{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&DeleteResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]*Item{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&BulkResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkStreamResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]*Item{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(fmt.Sprintf("%v", f), "Item", "Item", 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&BulkStreamResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Batch:` + fmt.Sprintf("%v", this.Batch) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ForceMergeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ForceMergeResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Shards:` + strings.Replace(this.Shards.String(), "SearchStatus", "SearchStatus", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelByQueryeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelByQueryeResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`DelNum:` + fmt.Sprintf("%v", this.DelNum) + `,`,
+		`IdsStr:` + fmt.Sprintf("%v", this.IdsStr) + `,`,
+		`IdsLong:` + fmt.Sprintf("%v", this.IdsLong) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FieldStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FieldStats{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Distinct:` + fmt.Sprintf("%v", this.Distinct) + `,`,
+		`Sketch:` + fmt.Sprintf("%v", this.Sketch) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CountResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFieldStats := "[]*FieldStats{"
+	for _, f := range this.FieldStats {
+		repeatedStringForFieldStats += strings.Replace(f.String(), "FieldStats", "FieldStats", 1) + ","
+	}
+	repeatedStringForFieldStats += "}"
+	s := strings.Join([]string{`&CountResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`FieldStats:` + repeatedStringForFieldStats + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FlushResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FlushResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Shards:` + strings.Replace(this.Shards.String(), "SearchStatus", "SearchStatus", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IndexResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IndexResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Shards:` + strings.Replace(this.Shards.String(), "SearchStatus", "SearchStatus", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TermFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TermFilter{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`IsUnion:` + fmt.Sprintf("%v", this.IsUnion) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RangeFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RangeFilter{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`LowerValue:` + fmt.Sprintf("%v", this.LowerValue) + `,`,
+		`UpperValue:` + fmt.Sprintf("%v", this.UpperValue) + `,`,
+		`IncludeLower:` + fmt.Sprintf("%v", this.IncludeLower) + `,`,
+		`IncludeUpper:` + fmt.Sprintf("%v", this.IncludeUpper) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FilterClause) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FilterClause{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`IncludeLower:` + fmt.Sprintf("%v", this.IncludeLower) + `,`,
+		`IncludeUpper:` + fmt.Sprintf("%v", this.IncludeUpper) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BoolFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMust := "[]*BoolFilter{"
+	for _, f := range this.Must {
+		repeatedStringForMust += strings.Replace(f.String(), "BoolFilter", "BoolFilter", 1) + ","
+	}
+	repeatedStringForMust += "}"
+	repeatedStringForShould := "[]*BoolFilter{"
+	for _, f := range this.Should {
+		repeatedStringForShould += strings.Replace(f.String(), "BoolFilter", "BoolFilter", 1) + ","
+	}
+	repeatedStringForShould += "}"
+	repeatedStringForMustNot := "[]*BoolFilter{"
+	for _, f := range this.MustNot {
+		repeatedStringForMustNot += strings.Replace(f.String(), "BoolFilter", "BoolFilter", 1) + ","
+	}
+	repeatedStringForMustNot += "}"
+	s := strings.Join([]string{`&BoolFilter{`,
+		`Clause:` + strings.Replace(this.Clause.String(), "FilterClause", "FilterClause", 1) + `,`,
+		`Must:` + repeatedStringForMust + `,`,
+		`Should:` + repeatedStringForShould + `,`,
+		`MustNot:` + repeatedStringForMustNot + `,`,
+		`MinimumShouldMatch:` + fmt.Sprintf("%v", this.MinimumShouldMatch) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SortField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SortField{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VectorQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VectorQuery{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`MinScore:` + fmt.Sprintf("%v", this.MinScore) + `,`,
+		`MaxScore:` + fmt.Sprintf("%v", this.MaxScore) + `,`,
+		`Boost:` + fmt.Sprintf("%v", this.Boost) + `,`,
+		`HasBoost:` + fmt.Sprintf("%v", this.HasBoost) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`RetrievalType:` + fmt.Sprintf("%v", this.RetrievalType) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetrievalParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetrievalParameters{`,
+		`MetricType:` + fmt.Sprintf("%v", this.MetricType) + `,`,
+		`Nprobe:` + fmt.Sprintf("%v", this.Nprobe) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVecFields := "[]*VectorQuery{"
+	for _, f := range this.VecFields {
+		repeatedStringForVecFields += strings.Replace(f.String(), "VectorQuery", "VectorQuery", 1) + ","
+	}
+	repeatedStringForVecFields += "}"
+	repeatedStringForRangeFilters := "[]*RangeFilter{"
+	for _, f := range this.RangeFilters {
+		repeatedStringForRangeFilters += strings.Replace(f.String(), "RangeFilter", "RangeFilter", 1) + ","
+	}
+	repeatedStringForRangeFilters += "}"
+	repeatedStringForTermFilters := "[]*TermFilter{"
+	for _, f := range this.TermFilters {
+		repeatedStringForTermFilters += strings.Replace(f.String(), "TermFilter", "TermFilter", 1) + ","
+	}
+	repeatedStringForTermFilters += "}"
+	repeatedStringForSortFields := "[]*SortField{"
+	for _, f := range this.SortFields {
+		repeatedStringForSortFields += strings.Replace(f.String(), "SortField", "SortField", 1) + ","
+	}
+	repeatedStringForSortFields += "}"
+	keysForSortFieldMap := make([]string, 0, len(this.SortFieldMap))
+	for k, _ := range this.SortFieldMap {
+		keysForSortFieldMap = append(keysForSortFieldMap, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSortFieldMap)
+	mapStringForSortFieldMap := "map[string]string{"
+	for _, k := range keysForSortFieldMap {
+		mapStringForSortFieldMap += fmt.Sprintf("%v: %v,", k, this.SortFieldMap[k])
+	}
+	mapStringForSortFieldMap += "}"
+	s := strings.Join([]string{`&SearchRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`ReqNum:` + fmt.Sprintf("%v", this.ReqNum) + `,`,
+		`TopN:` + fmt.Sprintf("%v", this.TopN) + `,`,
+		`IsBruteSearch:` + fmt.Sprintf("%v", this.IsBruteSearch) + `,`,
+		`VecFields:` + repeatedStringForVecFields + `,`,
+		`Fields:` + fmt.Sprintf("%v", this.Fields) + `,`,
+		`RangeFilters:` + repeatedStringForRangeFilters + `,`,
+		`TermFilters:` + repeatedStringForTermFilters + `,`,
+		`OnlineLogLevel:` + fmt.Sprintf("%v", this.OnlineLogLevel) + `,`,
+		`RetrievalParams:` + fmt.Sprintf("%v", this.RetrievalParams) + `,`,
+		`HasRank:` + fmt.Sprintf("%v", this.HasRank) + `,`,
+		`MultiVectorRank:` + fmt.Sprintf("%v", this.MultiVectorRank) + `,`,
+		`ParallelBasedOnQuery:` + fmt.Sprintf("%v", this.ParallelBasedOnQuery) + `,`,
+		`L2Sqrt:` + fmt.Sprintf("%v", this.L2Sqrt) + `,`,
+		`IvfFlat:` + fmt.Sprintf("%v", this.IvfFlat) + `,`,
+		`IsVectorValue:` + fmt.Sprintf("%v", this.IsVectorValue) + `,`,
+		`SortFieldMap:` + mapStringForSortFieldMap + `,`,
+		`SortFields:` + repeatedStringForSortFields + `,`,
+		`SearchAfter:` + strings.Replace(this.SearchAfter.String(), "SearchAfter", "SearchAfter", 1) + `,`,
+		`BoolFilter:` + strings.Replace(this.BoolFilter.String(), "BoolFilter", "BoolFilter", 1) + `,`,
+		`RangeSearch:` + strings.Replace(this.RangeSearch.String(), "RangeSearch", "RangeSearch", 1) + `,`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`DenyPartialResults:` + fmt.Sprintf("%v", this.DenyPartialResults) + `,`,
+		`PartitionTimeoutMs:` + fmt.Sprintf("%v", this.PartitionTimeoutMs) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchAfter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchAfter{`,
+		`SortValues:` + fmt.Sprintf("%v", this.SortValues) + `,`,
+		`PKey:` + fmt.Sprintf("%v", this.PKey) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RangeSearch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RangeSearch{`,
+		`MaxResults:` + fmt.Sprintf("%v", this.MaxResults) + `,`,
+		`Returned:` + fmt.Sprintf("%v", this.Returned) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResultItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFields := "[]*Field{"
+	for _, f := range this.Fields {
+		repeatedStringForFields += strings.Replace(fmt.Sprintf("%v", f), "Field", "Field", 1) + ","
+	}
+	repeatedStringForFields += "}"
+	s := strings.Join([]string{`&ResultItem{`,
+		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
+		`Fields:` + repeatedStringForFields + `,`,
+		`Extra:` + fmt.Sprintf("%v", this.Extra) + `,`,
+		`PKey:` + fmt.Sprintf("%v", this.PKey) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResultItems := "[]*ResultItem{"
+	for _, f := range this.ResultItems {
+		repeatedStringForResultItems += strings.Replace(f.String(), "ResultItem", "ResultItem", 1) + ","
+	}
+	repeatedStringForResultItems += "}"
+	keysForExplain := make([]uint32, 0, len(this.Explain))
+	for k, _ := range this.Explain {
+		keysForExplain = append(keysForExplain, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForExplain)
+	mapStringForExplain := "map[uint32]string{"
+	for _, k := range keysForExplain {
+		mapStringForExplain += fmt.Sprintf("%v: %v,", k, this.Explain[k])
+	}
+	mapStringForExplain += "}"
+	s := strings.Join([]string{`&SearchResult{`,
+		`TotalHits:` + fmt.Sprintf("%v", this.TotalHits) + `,`,
+		`MaxScore:` + fmt.Sprintf("%v", this.MaxScore) + `,`,
+		`MaxTook:` + fmt.Sprintf("%v", this.MaxTook) + `,`,
+		`MaxTookId:` + fmt.Sprintf("%v", this.MaxTookId) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "SearchStatus", "SearchStatus", 1) + `,`,
+		`Msg:` + fmt.Sprintf("%v", this.Msg) + `,`,
+		`ResultItems:` + repeatedStringForResultItems + `,`,
+		`PID:` + fmt.Sprintf("%v", this.PID) + `,`,
+		`Explain:` + mapStringForExplain + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`TopN:` + fmt.Sprintf("%v", this.TopN) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`Truncated:` + fmt.Sprintf("%v", this.Truncated) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*SearchResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(f.String(), "SearchResult", "SearchResult", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	repeatedStringForProfiles := "[]*SearchProfile{"
+	for _, f := range this.Profiles {
+		repeatedStringForProfiles += strings.Replace(f.String(), "SearchProfile", "SearchProfile", 1) + ","
+	}
+	repeatedStringForProfiles += "}"
+	keysForSortFieldMap := make([]string, 0, len(this.SortFieldMap))
+	for k, _ := range this.SortFieldMap {
+		keysForSortFieldMap = append(keysForSortFieldMap, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSortFieldMap)
+	mapStringForSortFieldMap := "map[string]string{"
+	for _, k := range keysForSortFieldMap {
+		mapStringForSortFieldMap += fmt.Sprintf("%v: %v,", k, this.SortFieldMap[k])
+	}
+	mapStringForSortFieldMap += "}"
+	s := strings.Join([]string{`&SearchResponse{`,
+		`Head:` + strings.Replace(this.Head.String(), "ResponseHead", "ResponseHead", 1) + `,`,
+		`Results:` + repeatedStringForResults + `,`,
+		`OnlineLogMessage:` + fmt.Sprintf("%v", this.OnlineLogMessage) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`FlatBytes:` + fmt.Sprintf("%v", this.FlatBytes) + `,`,
+		`SortFieldMap:` + mapStringForSortFieldMap + `,`,
+		`TopSize:` + fmt.Sprintf("%v", this.TopSize) + `,`,
+		`Profiles:` + repeatedStringForProfiles + `,`,
+		`RouterProfile:` + strings.Replace(this.RouterProfile.String(), "RouterProfile", "RouterProfile", 1) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForIndexParams := make([]string, 0, len(this.IndexParams))
+	for k, _ := range this.IndexParams {
+		keysForIndexParams = append(keysForIndexParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIndexParams)
+	mapStringForIndexParams := "map[string]int64{"
+	for _, k := range keysForIndexParams {
+		mapStringForIndexParams += fmt.Sprintf("%v: %v,", k, this.IndexParams[k])
+	}
+	mapStringForIndexParams += "}"
+	s := strings.Join([]string{`&SearchProfile{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`NodeId:` + fmt.Sprintf("%v", this.NodeId) + `,`,
+		`QueueMs:` + fmt.Sprintf("%v", this.QueueMs) + `,`,
+		`FilterMs:` + fmt.Sprintf("%v", this.FilterMs) + `,`,
+		`FilterDocs:` + fmt.Sprintf("%v", this.FilterDocs) + `,`,
+		`AnnMs:` + fmt.Sprintf("%v", this.AnnMs) + `,`,
+		`Candidates:` + fmt.Sprintf("%v", this.Candidates) + `,`,
+		`IndexParams:` + mapStringForIndexParams + `,`,
+		`FetchMs:` + fmt.Sprintf("%v", this.FetchMs) + `,`,
+		`EngineMs:` + fmt.Sprintf("%v", this.EngineMs) + `,`,
+		`PostFilterMs:` + fmt.Sprintf("%v", this.PostFilterMs) + `,`,
+		`RpcMs:` + fmt.Sprintf("%v", this.RpcMs) + `,`,
+		`DeserializeMs:` + fmt.Sprintf("%v", this.DeserializeMs) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RouterProfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RouterProfile{`,
+		`MergeMs:` + fmt.Sprintf("%v", this.MergeMs) + `,`,
+		`SerializeMs:` + fmt.Sprintf("%v", this.SerializeMs) + `,`,
+		`TookMs:` + fmt.Sprintf("%v", this.TookMs) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFailures := "[]*PartitionFailure{"
+	for _, f := range this.Failures {
+		repeatedStringForFailures += strings.Replace(f.String(), "PartitionFailure", "PartitionFailure", 1) + ","
+	}
+	repeatedStringForFailures += "}"
+	s := strings.Join([]string{`&SearchStatus{`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`Successful:` + fmt.Sprintf("%v", this.Successful) + `,`,
+		`Msg:` + fmt.Sprintf("%v", this.Msg) + `,`,
+		`Failures:` + repeatedStringForFailures + `,`,
+		`Coverage:` + fmt.Sprintf("%v", this.Coverage) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionFailure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionFailure{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MSearchRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForSearchRequests := "[]*SearchRequest{"
-	for _, f := range this.SearchRequests {
-		repeatedStringForSearchRequests += strings.Replace(f.String(), "SearchRequest", "SearchRequest", 1) + ","
+	repeatedStringForSearchRequests := "[]*SearchRequest{"
+	for _, f := range this.SearchRequests {
+		repeatedStringForSearchRequests += strings.Replace(f.String(), "SearchRequest", "SearchRequest", 1) + ","
+	}
+	repeatedStringForSearchRequests += "}"
+	s := strings.Join([]string{`&MSearchRequest{`,
+		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
+		`SearchRequests:` + repeatedStringForSearchRequests + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRouterGrpc(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RequestHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeOutMs", wireType)
+			}
+			m.TimeOutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeOutMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Params[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &Error{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Params[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKeys = append(m.PrimaryKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKeys = append(m.PrimaryKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Doc == nil {
+				m.Doc = &Document{}
+			}
+			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Doc == nil {
+				m.Doc = &Document{}
+			}
+			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Docs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Docs = append(m.Docs, &Document{})
+			if err := m.Docs[len(m.Docs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceMergeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceMergeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropBeforeRebuild", wireType)
+			}
+			m.DropBeforeRebuild = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DropBeforeRebuild |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitCpu", wireType)
+			}
+			m.LimitCpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitCpu |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Describe", wireType)
+			}
+			m.Describe = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Describe |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByIDsFeatureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByIDsFeatureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByIDsFeatureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchRequest == nil {
+				m.SearchRequest = &SearchRequest{}
+			}
+			if err := m.SearchRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKeys = append(m.PrimaryKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DbInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DbInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DbInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ps = append(m.Ps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DbRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DbRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DbRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Db", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Db == nil {
+				m.Db = &DbInfo{}
+			}
+			if err := m.Db.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PsIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovePs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemovePs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DbResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DbResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DbResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dbs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dbs = append(m.Dbs, &DbInfo{})
+			if err := m.Dbs[len(m.Dbs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Index = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Array = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimension", wireType)
+			}
+			m.Dimension = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dimension |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreParam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreParam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceEngine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceEngine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceEngine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexSize", wireType)
+			}
+			m.IndexSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalTypes = append(m.RetrievalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalParam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalParam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetrievalParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpacePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpacePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpacePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderId", wireType)
+			}
+			m.LeaderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Replicas = append(m.Replicas, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRouterGrpc
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRouterGrpc
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Replicas) == 0 {
+					m.Replicas = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Replicas = append(m.Replicas, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			}
+			m.DbId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNum", wireType)
+			}
+			m.PartitionNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionNum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaNum", wireType)
+			}
+			m.ReplicaNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicaNum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &SpaceField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Engine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Engine == nil {
+				m.Engine = &SpaceEngine{}
+			}
+			if err := m.Engine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &SpacePartition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &RequestHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Space == nil {
+				m.Space = &SpaceInfo{}
+			}
+			if err := m.Space.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spaces = append(m.Spaces, &SpaceInfo{})
+			if err := m.Spaces[len(m.Spaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	repeatedStringForSearchRequests += "}"
-	s := strings.Join([]string{`&MSearchRequest{`,
-		`Head:` + strings.Replace(this.Head.String(), "RequestHead", "RequestHead", 1) + `,`,
-		`SearchRequests:` + repeatedStringForSearchRequests + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func valueToStringRouterGrpc(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RequestHead) Unmarshal(dAtA []byte) error {
+func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7409,17 +17309,17 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeOutMs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			m.TimeOutMs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7429,16 +17329,33 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeOutMs |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7448,29 +17365,82 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserName = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7480,29 +17450,33 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			var stringLen uint64
+			m.Batch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7512,29 +17486,16 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Batch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7544,29 +17505,82 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceMergeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceMergeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7576,27 +17590,31 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientType = string(dAtA[iNdEx:postIndex])
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7623,103 +17641,12 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = make(map[string]string)
+			if m.Shards == nil {
+				m.Shards = &SearchStatus{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRouterGrpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRouterGrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRouterGrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Shards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Params[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7743,7 +17670,7 @@ func (m *RequestHead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseHead) Unmarshal(dAtA []byte) error {
+func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7766,17 +17693,53 @@ func (m *ResponseHead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelByQueryeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelByQueryeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelNum", wireType)
 			}
-			var msglen int
+			m.DelNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7786,33 +17749,16 @@ func (m *ResponseHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DelNum |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Err == nil {
-				m.Err = &Error{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdsStr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -7822,29 +17768,27 @@ func (m *ResponseHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			m.IdsStr = append(m.IdsStr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowRouterGrpc
@@ -7854,43 +17798,51 @@ func (m *ResponseHead) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRouterGrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				m.IdsLong = append(m.IdsLong, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRouterGrpc
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRouterGrpc
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.IdsLong) == 0 {
+					m.IdsLong = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRouterGrpc
@@ -7900,41 +17852,16 @@ func (m *ResponseHead) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						v |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.IdsLong = append(m.IdsLong, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdsLong", wireType)
 			}
-			m.Params[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -7957,7 +17884,7 @@ func (m *ResponseHead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *FieldStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7980,17 +17907,17 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8000,31 +17927,78 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &RequestHead{}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Min = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8052,7 +18026,60 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKeys = append(m.PrimaryKeys, string(dAtA[iNdEx:postIndex]))
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distinct", wireType)
+			}
+			m.Distinct = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Distinct |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sketch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sketch = append(m.Sketch[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sketch == nil {
+				m.Sketch = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8076,7 +18103,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *CountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8099,10 +18126,10 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8135,17 +18162,36 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Head == nil {
-				m.Head = &RequestHead{}
+				m.Head = &ResponseHead{}
 			}
 			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8155,23 +18201,25 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKeys = append(m.PrimaryKeys, string(dAtA[iNdEx:postIndex]))
+			m.FieldStats = append(m.FieldStats, &FieldStats{})
+			if err := m.FieldStats[len(m.FieldStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8195,7 +18243,7 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddRequest) Unmarshal(dAtA []byte) error {
+func (m *FlushResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8218,10 +18266,10 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8254,15 +18302,15 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Head == nil {
-				m.Head = &RequestHead{}
+				m.Head = &ResponseHead{}
 			}
 			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8289,10 +18337,10 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Doc == nil {
-				m.Doc = &Document{}
+			if m.Shards == nil {
+				m.Shards = &SearchStatus{}
 			}
-			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8318,7 +18366,7 @@ func (m *AddRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *IndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8341,10 +18389,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8377,15 +18425,15 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Head == nil {
-				m.Head = &RequestHead{}
+				m.Head = &ResponseHead{}
 			}
 			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Doc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8412,10 +18460,10 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Doc == nil {
-				m.Doc = &Document{}
+			if m.Shards == nil {
+				m.Shards = &SearchStatus{}
 			}
-			if err := m.Doc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8441,7 +18489,7 @@ func (m *UpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkRequest) Unmarshal(dAtA []byte) error {
+func (m *TermFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8464,17 +18512,17 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TermFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TermFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8484,33 +18532,29 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &RequestHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Docs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8520,26 +18564,45 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Docs = append(m.Docs, &Document{})
-			if err := m.Docs[len(m.Docs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUnion", wireType)
+			}
+			m.IsUnion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsUnion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -8562,7 +18625,7 @@ func (m *BulkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForceMergeRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8585,17 +18648,17 @@ func (m *ForceMergeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForceMergeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8605,84 +18668,97 @@ func (m *ForceMergeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &RequestHead{}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerValue", wireType)
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FlushRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
+			m.LowerValue = append(m.LowerValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.LowerValue == nil {
+				m.LowerValue = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperValue", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlushRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			m.UpperValue = append(m.UpperValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpperValue == nil {
+				m.UpperValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeLower", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8692,28 +18768,32 @@ func (m *FlushRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Head == nil {
-				m.Head = &RequestHead{}
+			m.IncludeLower = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeUpper", wireType)
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.IncludeUpper = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -8736,7 +18816,7 @@ func (m *FlushRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexRequest) Unmarshal(dAtA []byte) error {
+func (m *FilterClause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8759,17 +18839,36 @@ func (m *IndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterClause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterClause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FilterClause_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8779,33 +18878,29 @@ func (m *IndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &RequestHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropBeforeRebuild", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			m.DropBeforeRebuild = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8815,16 +18910,29 @@ func (m *IndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DropBeforeRebuild |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitCpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeLower", wireType)
 			}
-			m.LimitCpu = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8834,16 +18942,17 @@ func (m *IndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LimitCpu |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.IncludeLower = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Describe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeUpper", wireType)
 			}
-			m.Describe = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -8853,11 +18962,12 @@ func (m *IndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Describe |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IncludeUpper = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -8880,7 +18990,7 @@ func (m *IndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *BoolFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8903,15 +19013,15 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BoolFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BoolFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8938,16 +19048,16 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
+			if m.Clause == nil {
+				m.Clause = &FilterClause{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Clause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Must", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8974,65 +19084,14 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Must = append(m.Must, &BoolFilter{})
+			if err := m.Must[len(m.Must)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Should", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9059,18 +19118,16 @@ func (m *AddResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Should = append(m.Should, &BoolFilter{})
+			if err := m.Should[len(m.Should)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MustNot", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9080,24 +19137,45 @@ func (m *AddResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = string(dAtA[iNdEx:postIndex])
+			m.MustNot = append(m.MustNot, &BoolFilter{})
+			if err := m.MustNot[len(m.MustNot)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumShouldMatch", wireType)
+			}
+			m.MinimumShouldMatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumShouldMatch |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -9120,7 +19198,7 @@ func (m *AddResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *SortField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9143,17 +19221,49 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9163,28 +19273,12 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Type = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -9207,7 +19301,7 @@ func (m *UpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9230,17 +19324,17 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VectorQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VectorQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9250,33 +19344,29 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9286,82 +19376,83 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinScore", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRouterGrpc
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if (iNdEx + skippy) > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MinScore = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScore", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BulkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxScore = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boost", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Boost = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasBoost", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BulkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.HasBoost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasBoost |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9371,33 +19462,29 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9407,25 +19494,23 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RetrievalType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9449,7 +19534,7 @@ func (m *BulkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForceMergeResponse) Unmarshal(dAtA []byte) error {
+func (m *RetrievalParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9472,17 +19557,17 @@ func (m *ForceMergeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForceMergeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetrievalParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetrievalParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricType", wireType)
 			}
-			var msglen int
+			m.MetricType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9492,64 +19577,30 @@ func (m *ForceMergeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MetricType |= RetrievalParameters_DistanceMetricType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nprobe", wireType)
 			}
-			var msglen int
+			m.Nprobe = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Shards == nil {
-				m.Shards = &SearchStatus{}
-			}
-			if err := m.Shards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nprobe |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -9572,7 +19623,7 @@ func (m *ForceMergeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9595,10 +19646,10 @@ func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelByQueryeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelByQueryeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9631,7 +19682,7 @@ func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Head == nil {
-				m.Head = &ResponseHead{}
+				m.Head = &RequestHead{}
 			}
 			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9639,9 +19690,9 @@ func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqNum", wireType)
 			}
-			m.DelNum = 0
+			m.ReqNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9651,16 +19702,16 @@ func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DelNum |= int32(b&0x7F) << shift
+				m.ReqNum |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdsStr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
 			}
-			var stringLen uint64
+			m.TopN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9670,154 +19721,33 @@ func (m *DelByQueryeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopN |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdsStr = append(m.IdsStr, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRouterGrpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.IdsLong = append(m.IdsLong, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRouterGrpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRouterGrpc
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRouterGrpc
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBruteSearch", wireType)
+			}
+			m.IsBruteSearch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.IdsLong) == 0 {
-					m.IdsLong = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRouterGrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.IdsLong = append(m.IdsLong, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsBruteSearch |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdsLong", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FlushResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FlushResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VecFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9844,18 +19774,16 @@ func (m *FlushResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VecFields = append(m.VecFields, &VectorQuery{})
+			if err := m.VecFields[len(m.VecFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -9865,82 +19793,27 @@ func (m *FlushResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Shards == nil {
-				m.Shards = &SearchStatus{}
-			}
-			if err := m.Shards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IndexResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IndexResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeFilters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9967,16 +19840,14 @@ func (m *IndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RangeFilters = append(m.RangeFilters, &RangeFilter{})
+			if err := m.RangeFilters[len(m.RangeFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TermFilters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10003,67 +19874,14 @@ func (m *IndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shards == nil {
-				m.Shards = &SearchStatus{}
-			}
-			if err := m.Shards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TermFilters = append(m.TermFilters, &TermFilter{})
+			if err := m.TermFilters[len(m.TermFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TermFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TermFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TermFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlineLogLevel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10091,13 +19909,13 @@ func (m *TermFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.OnlineLogLevel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalParams", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10107,31 +19925,29 @@ func (m *TermFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.RetrievalParams = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsUnion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRank", wireType)
 			}
-			m.IsUnion = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10141,67 +19957,17 @@ func (m *TermFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IsUnion |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RangeFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RangeFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RangeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			m.HasRank = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiVectorRank", wireType)
 			}
-			var stringLen uint64
+			m.MultiVectorRank = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10211,29 +19977,16 @@ func (m *RangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MultiVectorRank |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Field = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerValue", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelBasedOnQuery", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10243,31 +19996,17 @@ func (m *RangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LowerValue = append(m.LowerValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.LowerValue == nil {
-				m.LowerValue = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperValue", wireType)
+			m.ParallelBasedOnQuery = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2Sqrt", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10277,29 +20016,35 @@ func (m *RangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.L2Sqrt = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IvfFlat", wireType)
 			}
-			m.UpperValue = append(m.UpperValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.UpperValue == nil {
-				m.UpperValue = []byte{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.IvfFlat = bool(v != 0)
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeLower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVectorValue", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10316,12 +20061,12 @@ func (m *RangeFilter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IncludeLower = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludeUpper", wireType)
+			m.IsVectorValue = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortFieldMap", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10331,68 +20076,124 @@ func (m *RangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludeUpper = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortField) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SortFieldMap == nil {
+				m.SortFieldMap = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortField: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortField: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SortFieldMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SortFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10402,29 +20203,31 @@ func (m *SortField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.SortFields = append(m.SortFields, &SortField{})
+			if err := m.SortFields[len(m.SortFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAfter", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10434,68 +20237,33 @@ func (m *SortField) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Type = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VectorQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRouterGrpc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SearchAfter == nil {
+				m.SearchAfter = &SearchAfter{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SearchAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VectorQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VectorQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolFilter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10505,29 +20273,33 @@ func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.BoolFilter == nil {
+				m.BoolFilter = &BoolFilter{}
+			}
+			if err := m.BoolFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSearch", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10537,64 +20309,33 @@ func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinScore", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.MinScore = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxScore", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.MaxScore = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Boost", wireType)
+			if m.RangeSearch == nil {
+				m.RangeSearch = &RangeSearch{}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.RangeSearch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Boost = float64(math.Float64frombits(v))
-		case 6:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasBoost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
-			m.HasBoost = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10604,16 +20345,17 @@ func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HasBoost |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			m.Profile = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyPartialResults", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10623,29 +20365,17 @@ func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalType", wireType)
+			m.DenyPartialResults = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTimeoutMs", wireType)
 			}
-			var stringLen uint64
+			m.PartitionTimeoutMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10655,24 +20385,11 @@ func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PartitionTimeoutMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RetrievalType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -10695,7 +20412,7 @@ func (m *VectorQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetrievalParameters) Unmarshal(dAtA []byte) error {
+func (m *SearchAfter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10718,17 +20435,17 @@ func (m *RetrievalParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetrievalParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchAfter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetrievalParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchAfter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortValues", wireType)
 			}
-			m.MetricType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10738,16 +20455,29 @@ func (m *RetrievalParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MetricType |= RetrievalParameters_DistanceMetricType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SortValues = append(m.SortValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nprobe", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PKey", wireType)
 			}
-			m.Nprobe = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10757,11 +20487,24 @@ func (m *RetrievalParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nprobe |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -10784,7 +20527,7 @@ func (m *RetrievalParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+func (m *RangeSearch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10807,17 +20550,17 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RangeSearch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RangeSearch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResults", wireType)
 			}
-			var msglen int
+			m.MaxResults = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10827,33 +20570,16 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxResults |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Head == nil {
-				m.Head = &RequestHead{}
-			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReqNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
 			}
-			m.ReqNum = 0
+			m.Returned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10863,16 +20589,16 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReqNum |= int32(b&0x7F) << shift
+				m.Returned |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			m.TopN = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10882,33 +20608,77 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopN |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBruteSearch", wireType)
+			m.Stream = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.IsBruteSearch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IsBruteSearch |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResultItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResultItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResultItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VecFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10935,14 +20705,14 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VecFields = append(m.VecFields, &VectorQuery{})
-			if err := m.VecFields[len(m.VecFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fields = append(m.Fields, &Field{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10970,13 +20740,13 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			m.Extra = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -10986,31 +20756,29 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RangeFilters = append(m.RangeFilters, &RangeFilter{})
-			if err := m.RangeFilters[len(m.RangeFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TermFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11020,63 +20788,82 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TermFilters = append(m.TermFilters, &TermFilter{})
-			if err := m.TermFilters[len(m.TermFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlineLogLevel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnlineLogLevel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetrievalParams", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalHits", wireType)
+			}
+			m.TotalHits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11086,29 +20873,27 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TotalHits |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScore", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RetrievalParams = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxScore = float64(math.Float64frombits(v))
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRank", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTook", wireType)
 			}
-			var v int
+			m.MaxTook = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11118,17 +20903,16 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxTook |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasRank = bool(v != 0)
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiVectorRank", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTookId", wireType)
 			}
-			m.MultiVectorRank = 0
+			m.MaxTookId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11138,16 +20922,16 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MultiVectorRank |= int32(b&0x7F) << shift
+				m.MaxTookId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelBasedOnQuery", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11157,17 +20941,33 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ParallelBasedOnQuery = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L2Sqrt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &SearchStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11177,17 +20977,29 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.L2Sqrt = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IvfFlat", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultItems", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11197,17 +21009,31 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IvfFlat = bool(v != 0)
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultItems = append(m.ResultItems, &ResultItem{})
+			if err := m.ResultItems[len(m.ResultItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsVectorValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
 			}
-			var v int
+			m.PID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11217,15 +21043,14 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsVectorValue = bool(v != 0)
-		case 17:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortFieldMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11252,10 +21077,10 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SortFieldMap == nil {
-				m.SortFieldMap = make(map[string]string)
+			if m.Explain == nil {
+				m.Explain = make(map[uint32]string)
 			}
-			var mapkey string
+			var mapkey uint32
 			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
@@ -11276,7 +21101,6 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRouterGrpc
@@ -11286,24 +21110,11 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
+						mapkey |= uint32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
@@ -11348,13 +21159,52 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.SortFieldMap[mapkey] = mapvalue
+			m.Explain[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 18:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			}
+			m.TopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopN |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11364,26 +21214,44 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SortFields = append(m.SortFields, &SortField{})
-			if err := m.SortFields[len(m.SortFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -11406,7 +21274,7 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResultItem) Unmarshal(dAtA []byte) error {
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11429,26 +21297,171 @@ func (m *ResultItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResultItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResultItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &ResponseHead{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &SearchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlineLogMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnlineLogMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouterGrpc
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Score = float64(math.Float64frombits(v))
-		case 2:
+			m.FlatBytes = append(m.FlatBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.FlatBytes == nil {
+				m.FlatBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SortFieldMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11475,16 +21488,109 @@ func (m *ResultItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, &Field{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.SortFieldMap == nil {
+				m.SortFieldMap = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRouterGrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRouterGrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.SortFieldMap[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extra", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopSize", wireType)
 			}
-			var stringLen uint64
+			m.TopSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11494,29 +21600,16 @@ func (m *ResultItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Extra = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11526,29 +21619,31 @@ func (m *ResultItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PKey = string(dAtA[iNdEx:postIndex])
+			m.Profiles = append(m.Profiles, &SearchProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RouterProfile", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11558,24 +21653,26 @@ func (m *ResultItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			if m.RouterProfile == nil {
+				m.RouterProfile = &RouterProfile{}
+			}
+			if err := m.RouterProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -11600,7 +21697,7 @@ func (m *ResultItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchResult) Unmarshal(dAtA []byte) error {
+func (m *SearchProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11623,17 +21720,17 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalHits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
 			}
-			m.TotalHits = 0
+			m.PartitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11643,101 +21740,16 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalHits |= int32(b&0x7F) << shift
+				m.PartitionId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxScore", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.MaxScore = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTook", wireType)
-			}
-			m.MaxTook = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTook |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTookId", wireType)
-			}
-			m.MaxTookId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTookId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &SearchStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var stringLen uint64
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11747,29 +21759,38 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueMs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.QueueMs = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResultItems", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FilterMs = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterDocs", wireType)
 			}
-			var msglen int
+			m.FilterDocs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11779,31 +21800,27 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FilterDocs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnMs", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResultItems = append(m.ResultItems, &ResultItem{})
-			if err := m.ResultItems[len(m.ResultItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AnnMs = float64(math.Float64frombits(v))
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
-			m.PID = 0
+			m.Candidates = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -11813,14 +21830,14 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PID |= uint32(b&0x7F) << shift
+				m.Candidates |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Explain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11847,11 +21864,11 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Explain == nil {
-				m.Explain = make(map[uint32]string)
+			if m.IndexParams == nil {
+				m.IndexParams = make(map[string]int64)
 			}
-			var mapkey uint32
-			var mapvalue string
+			var mapkey string
+			var mapvalue int64
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -11871,6 +21888,7 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRouterGrpc
@@ -11880,13 +21898,25 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRouterGrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowRouterGrpc
@@ -11896,24 +21926,11 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapvalue |= int64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -11929,47 +21946,63 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Explain[mapkey] = mapvalue
+			m.IndexParams[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FetchMs = float64(math.Float64frombits(v))
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EngineMs", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = bool(v != 0)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EngineMs = float64(math.Float64frombits(v))
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostFilterMs", wireType)
 			}
-			m.TopN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopN |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PostFilterMs = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RpcMs = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeserializeMs", wireType)
 			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DeserializeMs = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -11992,7 +22025,7 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+func (m *RouterProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12015,53 +22048,101 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouterProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouterProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeMs", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MergeMs = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerializeMs", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SerializeMs = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TookMs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TookMs = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &ResponseHead{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouterGrpc
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var msglen int
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -12071,31 +22152,16 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Total |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, &SearchResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnlineLogMessage", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var stringLen uint64
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -12105,29 +22171,16 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Failed |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRouterGrpc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OnlineLogMessage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
 			}
-			var v int
+			m.Successful = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -12137,17 +22190,16 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Successful |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Timeout = bool(v != 0)
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -12157,29 +22209,27 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRouterGrpc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FlatBytes = append(m.FlatBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.FlatBytes == nil {
-				m.FlatBytes = []byte{}
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SortFieldMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12206,123 +22256,22 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SortFieldMap == nil {
-				m.SortFieldMap = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRouterGrpc
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRouterGrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRouterGrpc
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRouterGrpc(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRouterGrpc
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Failures = append(m.Failures, &PartitionFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SortFieldMap[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopSize", wireType)
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coverage", wireType)
 			}
-			m.TopSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Coverage = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
@@ -12345,7 +22294,7 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchStatus) Unmarshal(dAtA []byte) error {
+func (m *PartitionFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12368,17 +22317,17 @@ func (m *SearchStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
 			}
-			m.Total = 0
+			m.PartitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -12388,16 +22337,16 @@ func (m *SearchStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Total |= int32(b&0x7F) << shift
+				m.PartitionId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Failed = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRouterGrpc
@@ -12407,33 +22356,14 @@ func (m *SearchStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				m.Code |= ErrorEnum(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
-			}
-			m.Successful = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRouterGrpc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12461,8 +22391,28 @@ func (m *SearchStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouterGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRouterGrpc(dAtA[iNdEx:])
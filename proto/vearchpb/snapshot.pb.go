@@ -27,6 +27,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// compression of the data of a snapshot msg
+type SnapshotCompression int32
+
+const (
+	SnapshotCompression_NoCompression SnapshotCompression = 0
+	SnapshotCompression_Zstd          SnapshotCompression = 1
+)
+
+var SnapshotCompression_name = map[int32]string{
+	0: "NoCompression",
+	1: "Zstd",
+}
+
+var SnapshotCompression_value = map[string]int32{
+	"NoCompression": 0,
+	"Zstd":          1,
+}
+
+func (x SnapshotCompression) String() string {
+	return proto.EnumName(SnapshotCompression_name, int32(x))
+}
+
+func (SnapshotCompression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0c8aab8e59648e0b, []int{0}
+}
+
 // snapshot status
 type SnapshotStatus int32
 
@@ -56,7 +82,7 @@ func (x SnapshotStatus) String() string {
 }
 
 func (SnapshotStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_0c8aab8e59648e0b, []int{0}
+	return fileDescriptor_0c8aab8e59648e0b, []int{1}
 }
 
 // snapshot msg
@@ -66,10 +92,27 @@ type SnapshotMsg struct {
 	// file info
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	// status
-	Status               SnapshotStatus `protobuf:"varint,3,opt,name=status,proto3,enum=SnapshotStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Status SnapshotStatus `protobuf:"varint,3,opt,name=status,proto3,enum=SnapshotStatus" json:"status,omitempty"`
+	// file name relative to the data path of the engine
+	RelName string `protobuf:"bytes,4,opt,name=rel_name,json=relName,proto3" json:"rel_name,omitempty"`
+	// offset of the uncompressed data in the file
+	Offset   int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	FileSize int64 `protobuf:"varint,6,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// modification time of the file on the leader, unix nano
+	ModTime     int64               `protobuf:"varint,7,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	Compression SnapshotCompression `protobuf:"varint,8,opt,name=compression,proto3,enum=SnapshotCompression" json:"compression,omitempty"`
+	// set on the last chunk of a file
+	FileEnd bool `protobuf:"varint,9,opt,name=file_end,json=fileEnd,proto3" json:"file_end,omitempty"`
+	// crc32 castagnoli of the whole file, set with file_end
+	Checksum uint32 `protobuf:"varint,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// set on the start msg, the follower reports the files it has received
+	// for this snapshot id to the leader
+	SnapshotId uint64 `protobuf:"varint,11,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	// the follower reported the file, its data is not sent
+	Skipped              bool     `protobuf:"varint,12,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SnapshotMsg) Reset()      { *m = SnapshotMsg{} }
@@ -105,6 +148,7 @@ func (m *SnapshotMsg) XXX_DiscardUnknown() {
 var xxx_messageInfo_SnapshotMsg proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("SnapshotCompression", SnapshotCompression_name, SnapshotCompression_value)
 	proto.RegisterEnum("SnapshotStatus", SnapshotStatus_name, SnapshotStatus_value)
 	proto.RegisterType((*SnapshotMsg)(nil), "SnapshotMsg")
 }
@@ -112,25 +156,36 @@ func init() {
 func init() { proto.RegisterFile("snapshot.proto", fileDescriptor_0c8aab8e59648e0b) }
 
 var fileDescriptor_0c8aab8e59648e0b = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2b, 0xce, 0x4b, 0x2c,
-	0x28, 0xce, 0xc8, 0x2f, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x97, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0x0b, 0x27, 0x95,
-	0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0x51, 0xae, 0x94, 0xce, 0xc5, 0x1d, 0x0c, 0x35, 0xc0,
-	0xb7, 0x38, 0x5d, 0x48, 0x9a, 0x8b, 0x33, 0x2d, 0x33, 0x27, 0x35, 0x3e, 0x2f, 0x31, 0x37, 0x55,
-	0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x03, 0x24, 0xe0, 0x97, 0x98, 0x9b, 0x2a, 0x24, 0xc4,
-	0xc5, 0x92, 0x92, 0x58, 0x92, 0x28, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x13, 0x04, 0x66, 0x0b, 0xa9,
-	0x73, 0xb1, 0x15, 0x97, 0x24, 0x96, 0x94, 0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x19, 0xf1,
-	0xeb, 0xc1, 0x8c, 0x0b, 0x06, 0x0b, 0x07, 0x41, 0xa5, 0xb5, 0xec, 0xb9, 0xf8, 0x50, 0x65, 0x84,
-	0x38, 0xb9, 0x58, 0x43, 0xf3, 0x42, 0x8b, 0x53, 0x05, 0x18, 0x40, 0xcc, 0xe0, 0x92, 0xc4, 0xa2,
-	0x12, 0x01, 0x46, 0x21, 0x6e, 0x2e, 0xf6, 0xa0, 0xd2, 0xbc, 0xbc, 0xcc, 0xbc, 0x74, 0x01, 0x26,
-	0x21, 0x2e, 0x2e, 0x36, 0xb7, 0xcc, 0xbc, 0xcc, 0xe2, 0x0c, 0x01, 0x66, 0x27, 0x87, 0x13, 0x0f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0x78, 0xf0, 0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39, 0x86, 0x1f,
-	0x0f, 0xe5, 0x18, 0x1b, 0x1e, 0xc9, 0x31, 0xae, 0x78, 0x24, 0xc7, 0xb8, 0xe3, 0x91, 0x1c, 0xc3,
-	0x81, 0x47, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x1e, 0x8c, 0x51, 0x1c, 0x65, 0xa9, 0x89, 0x45, 0xc9, 0x19, 0x05,
-	0x49, 0x49, 0x6c, 0x60, 0x2f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x68, 0x84, 0xd7, 0x7b,
-	0x33, 0x01, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x8e, 0xd4, 0x3c,
+	0x14, 0xc5, 0xe3, 0x99, 0xd9, 0x24, 0x73, 0xb3, 0x3b, 0x5f, 0x3e, 0x83, 0x90, 0x59, 0x24, 0x13,
+	0xd1, 0x10, 0xad, 0xc4, 0xac, 0xb4, 0x48, 0xb4, 0x20, 0x10, 0x08, 0x0a, 0xb6, 0xc8, 0xb0, 0xcd,
+	0x36, 0xa3, 0x4c, 0xe2, 0x49, 0xac, 0x1d, 0xdb, 0x51, 0xec, 0x50, 0x6c, 0xc5, 0x63, 0xf0, 0x08,
+	0x3c, 0x02, 0x25, 0xe5, 0x96, 0x94, 0x94, 0x9b, 0xf0, 0x02, 0x94, 0x14, 0x14, 0x28, 0x9e, 0x3f,
+	0x2c, 0x12, 0xdd, 0x3d, 0xe7, 0x5c, 0xfd, 0x7c, 0x64, 0x1b, 0x26, 0x5a, 0xa6, 0x95, 0x2e, 0x95,
+	0x99, 0x56, 0xb5, 0x32, 0xea, 0xf0, 0x51, 0xc1, 0x4d, 0xd9, 0x2c, 0xa6, 0x99, 0x12, 0xc7, 0x85,
+	0x2a, 0xd4, 0xb1, 0xb5, 0x17, 0xcd, 0xd2, 0x2a, 0x2b, 0xec, 0xb4, 0x5e, 0x7f, 0xf0, 0x6b, 0x00,
+	0xc1, 0x6c, 0x43, 0x78, 0xab, 0x0b, 0x7c, 0x0f, 0xc6, 0x4b, 0xbe, 0x62, 0x73, 0x99, 0x0a, 0x46,
+	0x50, 0x84, 0xe2, 0x71, 0xe2, 0xf7, 0xc6, 0x69, 0x2a, 0x18, 0xc6, 0x30, 0xca, 0x53, 0x93, 0x92,
+	0x41, 0x84, 0xe2, 0xfd, 0xc4, 0xce, 0xf8, 0x21, 0xb8, 0xda, 0xa4, 0xa6, 0xd1, 0x64, 0x18, 0xa1,
+	0x78, 0x72, 0xf2, 0xdf, 0x74, 0x8b, 0x9b, 0x59, 0x3b, 0xd9, 0xc4, 0xf8, 0x2e, 0xf8, 0x35, 0x5b,
+	0xad, 0xc1, 0x23, 0x0b, 0xf6, 0x6a, 0xb6, 0xb2, 0xdc, 0x3b, 0xe0, 0xaa, 0xe5, 0x52, 0x33, 0x43,
+	0xf6, 0x22, 0x14, 0x0f, 0x93, 0x8d, 0xda, 0x95, 0xd1, 0xfc, 0x92, 0x11, 0xd7, 0x46, 0xb6, 0xcc,
+	0x8c, 0x5f, 0xb2, 0x9e, 0x27, 0x54, 0x3e, 0x37, 0x5c, 0x30, 0xe2, 0xd9, 0xcc, 0x13, 0x2a, 0x7f,
+	0xc7, 0x05, 0xc3, 0x4f, 0x20, 0xc8, 0x94, 0xa8, 0x6a, 0xa6, 0x35, 0x57, 0x92, 0xf8, 0xb6, 0xd8,
+	0xed, 0x5d, 0xb1, 0x17, 0x7f, 0xb2, 0xe4, 0xe6, 0x62, 0x8f, 0xb4, 0xe7, 0x31, 0x99, 0x93, 0x71,
+	0x84, 0x62, 0x3f, 0xf1, 0x7a, 0xfd, 0x52, 0xe6, 0xf8, 0x10, 0xfc, 0xac, 0x64, 0xd9, 0x85, 0x6e,
+	0x04, 0x81, 0x08, 0xc5, 0x07, 0xc9, 0x4e, 0xe3, 0xfb, 0x10, 0x6c, 0x1f, 0x61, 0xce, 0x73, 0x12,
+	0x44, 0x28, 0x1e, 0x25, 0xb0, 0xb5, 0xde, 0xe4, 0x98, 0x80, 0xa7, 0x2f, 0x78, 0x55, 0xb1, 0x9c,
+	0xec, 0xaf, 0xb1, 0x1b, 0x79, 0x74, 0x02, 0xb7, 0xfe, 0xd1, 0x0a, 0xff, 0x0f, 0x07, 0xa7, 0xea,
+	0x86, 0x11, 0x3a, 0xd8, 0x87, 0xd1, 0xb9, 0x36, 0x79, 0x88, 0x8e, 0x9e, 0xc2, 0xe4, 0xef, 0x2b,
+	0xc6, 0x63, 0xd8, 0x3b, 0x93, 0x67, 0x9a, 0x85, 0x4e, 0x3f, 0xce, 0x4c, 0x5a, 0x9b, 0x10, 0xe1,
+	0x00, 0xbc, 0xa4, 0x91, 0x92, 0xcb, 0x22, 0x1c, 0x60, 0x00, 0xf7, 0x15, 0x97, 0x5c, 0x97, 0xe1,
+	0xf0, 0xf9, 0xb3, 0xab, 0x96, 0x3a, 0xdf, 0x5a, 0xea, 0x5c, 0xb7, 0xd4, 0xf9, 0xd1, 0x52, 0xe7,
+	0x67, 0x4b, 0xd1, 0x87, 0x8e, 0xa2, 0x4f, 0x1d, 0x45, 0x9f, 0x3b, 0xea, 0x7c, 0xe9, 0xa8, 0x73,
+	0xd5, 0x51, 0xf4, 0xb5, 0xa3, 0xe8, 0xba, 0xa3, 0xe8, 0xe3, 0x77, 0xea, 0xbc, 0x46, 0xe7, 0xfe,
+	0x7b, 0x96, 0xd6, 0x59, 0x59, 0x2d, 0x16, 0xae, 0xfd, 0x3c, 0x8f, 0x7f, 0x0f, 0x00, 0x11, 0x04,
+	0x62, 0x8a, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (this *SnapshotMsg) Equal(that interface{}) bool {
@@ -161,6 +216,33 @@ func (this *SnapshotMsg) Equal(that interface{}) bool {
 	if this.Status != that1.Status {
 		return false
 	}
+	if this.RelName != that1.RelName {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if this.FileSize != that1.FileSize {
+		return false
+	}
+	if this.ModTime != that1.ModTime {
+		return false
+	}
+	if this.Compression != that1.Compression {
+		return false
+	}
+	if this.FileEnd != that1.FileEnd {
+		return false
+	}
+	if this.Checksum != that1.Checksum {
+		return false
+	}
+	if this.SnapshotId != that1.SnapshotId {
+		return false
+	}
+	if this.Skipped != that1.Skipped {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -190,6 +272,63 @@ func (m *SnapshotMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SnapshotId != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Checksum != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Checksum))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FileEnd {
+		i--
+		if m.FileEnd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Compression != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ModTime != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.ModTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Offset != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RelName) > 0 {
+		i -= len(m.RelName)
+		copy(dAtA[i:], m.RelName)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.RelName)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Status != 0 {
 		i = encodeVarintSnapshot(dAtA, i, uint64(m.Status))
 		i--
@@ -232,8 +371,26 @@ func NewPopulatedSnapshotMsg(r randySnapshot, easy bool) *SnapshotMsg {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	this.Status = SnapshotStatus([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.RelName = string(randStringSnapshot(r))
+	this.Offset = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Offset *= -1
+	}
+	this.FileSize = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.FileSize *= -1
+	}
+	this.ModTime = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.ModTime *= -1
+	}
+	this.Compression = SnapshotCompression([]int32{0, 1}[r.Intn(2)])
+	this.FileEnd = bool(bool(r.Intn(2) == 0))
+	this.Checksum = uint32(r.Uint32())
+	this.SnapshotId = uint64(uint64(r.Uint32()))
+	this.Skipped = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedSnapshot(r, 4)
+		this.XXX_unrecognized = randUnrecognizedSnapshot(r, 13)
 	}
 	return this
 }
@@ -327,6 +484,34 @@ func (m *SnapshotMsg) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovSnapshot(uint64(m.Status))
 	}
+	l = len(m.RelName)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovSnapshot(uint64(m.Offset))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovSnapshot(uint64(m.FileSize))
+	}
+	if m.ModTime != 0 {
+		n += 1 + sovSnapshot(uint64(m.ModTime))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovSnapshot(uint64(m.Compression))
+	}
+	if m.FileEnd {
+		n += 2
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovSnapshot(uint64(m.Checksum))
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovSnapshot(uint64(m.SnapshotId))
+	}
+	if m.Skipped {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -347,6 +532,15 @@ func (this *SnapshotMsg) String() string {
 		`FileName:` + fmt.Sprintf("%v", this.FileName) + `,`,
 		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`RelName:` + fmt.Sprintf("%v", this.RelName) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`FileSize:` + fmt.Sprintf("%v", this.FileSize) + `,`,
+		`ModTime:` + fmt.Sprintf("%v", this.ModTime) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`FileEnd:` + fmt.Sprintf("%v", this.FileEnd) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`SnapshotId:` + fmt.Sprintf("%v", this.SnapshotId) + `,`,
+		`Skipped:` + fmt.Sprintf("%v", this.Skipped) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -474,6 +668,192 @@ func (m *SnapshotMsg) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModTime", wireType)
+			}
+			m.ModTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= SnapshotCompression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileEnd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FileEnd = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
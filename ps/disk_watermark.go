@@ -0,0 +1,81 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ps
+
+import (
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/ps/psutil"
+	"github.com/vearch/vearch/ps/storage/raftstore"
+	"github.com/vearch/vearch/util/log"
+	"github.com/vearch/vearch/util/runtime/os"
+	"github.com/vearch/vearch/util/slice"
+)
+
+// diskWatermark returns the watermark the fullest of datas is above, its used
+// percent and the data dirs above the flood stage watermark
+func diskWatermark(datas []string) (string, float64, []string) {
+	used := 0.0
+	low, high, flood := config.Conf().PS.DiskWatermarks()
+	var floodDatas []string
+	for _, data := range datas {
+		total, avail, err := os.DiskUsage(data)
+		if err != nil || total == 0 {
+			log.Error("disk usage of data dir:[%s] err:[%v]", data, err)
+			continue
+		}
+		percent := float64(total-avail) * 100 / float64(total)
+		if percent >= flood {
+			floodDatas = append(floodDatas, data)
+		}
+		if percent > used {
+			used = percent
+		}
+	}
+	switch {
+	case used >= flood:
+		return entity.DiskWatermarkFlood, used, floodDatas
+	case used >= high:
+		return entity.DiskWatermarkHigh, used, floodDatas
+	case used >= low:
+		return entity.DiskWatermarkLow, used, floodDatas
+	}
+	return "", used, floodDatas
+}
+
+// checkDiskWatermark sets the disk watermark of server and the partitions in
+// its data dirs above the flood stage, it returns true when they changed and
+// server should be put to master again
+func (s *Server) checkDiskWatermark(server *entity.Server) bool {
+	watermark, used, floodDatas := diskWatermark(config.Conf().GetDatas())
+	server.DiskUsedPercent = used
+	raftstore.SetDiskFloodStage(floodDatas)
+	floodPartitionIds := make([]entity.PartitionID, 0)
+	for _, data := range floodDatas {
+		floodPartitionIds = append(floodPartitionIds, psutil.PartitionIDs(data)...)
+	}
+	floodChanged := !slice.EqualUint32(floodPartitionIds, server.FloodPartitionIds)
+	server.FloodPartitionIds = floodPartitionIds
+	if watermark == server.DiskWatermark {
+		return floodChanged
+	}
+	if entity.DiskWatermarkLevel(watermark) > entity.DiskWatermarkLevel(server.DiskWatermark) {
+		log.Error("disk used %.2f%% of ps:[%d] is above the %s watermark", used, s.nodeID, watermark)
+	} else {
+		log.Warn("disk used %.2f%% of ps:[%d] is back to watermark [%s] from [%s]", used, s.nodeID, watermark, server.DiskWatermark)
+	}
+	server.DiskWatermark = watermark
+	return true
+}
@@ -35,6 +35,24 @@ type Reader interface {
 	Capacity(ctx context.Context) (int64, error)
 
 	Search(ctx context.Context, request *vearchpb.SearchRequest, resp *vearchpb.SearchResponse) error
+
+	// Count counts the docs matching the filters of request
+	Count(ctx context.Context, request *vearchpb.SearchRequest) (int64, error)
+
+	// FieldStats counts the docs matching the filters of request and collects
+	// the statistics of request.Fields over them
+	FieldStats(ctx context.Context, request *vearchpb.SearchRequest) (int64, []*FieldStats, error)
+}
+
+// FieldStats is what the engine collected of a field, Min and Max are raw
+// values as the engine stores them and Sketch the HyperLogLog registers of
+// the values
+type FieldStats struct {
+	Field  string
+	Count  int64
+	Min    []byte
+	Max    []byte
+	Sketch []byte
 }
 
 // Writer is the write interface to an engine's data.
@@ -59,18 +77,30 @@ type EngineStatus struct {
 	MinIndexedNum int32
 }
 
+// MemoryInfo is the memory used by the engine in bytes
+type MemoryInfo struct {
+	TableMem      int64
+	IndexMem      int64
+	VectorMem     int64
+	FieldRangeMem int64
+	BitmapMem     int64
+}
+
 // Engine is the interface that wraps the core operations of a document store.
 type Engine interface {
 	Reader() Reader
 	Writer() Writer
 	//return three value, field to vearchpb.Field , new Schema info , error
 	NewSnapshot() (proto.Snapshot, error)
-	ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) error
+	// resume reports the files an interrupted snapshot transfer has
+	// received to the leader
+	ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator, resume func(*entity.SnapshotResume) error) error
 	Optimize() error
 	RebuildIndex(int, int, int) error
 	Rebuild(int, int, int) error
 	IndexInfo() (int, int, int)
 	EngineStatus(status *EngineStatus) error
+	MemoryInfo(info *MemoryInfo) error
 	Close()
 	HasClosed() bool
 
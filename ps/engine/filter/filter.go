@@ -0,0 +1,376 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package filter evaluates the boolean filter tree of a search request
+// against the fields of the hits returned by the engine.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+// Matcher is a compiled boolean filter tree.
+type Matcher struct {
+	root   *node
+	fields []string
+}
+
+type node struct {
+	clause    *clause
+	must      []*node
+	should    []*node
+	mustNot   []*node
+	minShould int
+}
+
+type clause struct {
+	typ          vearchpb.FilterClause_Type
+	field        string
+	fieldType    entity.FieldType
+	array        bool
+	strs         []string
+	ints         []int64
+	floats       []float64
+	lower, upper string
+	includeLower bool
+	includeUpper bool
+}
+
+// New compiles bf and checks every clause against the space properties.
+func New(bf *vearchpb.BoolFilter, proMap map[string]*entity.SpaceProperties) (*Matcher, error) {
+	m := &Matcher{}
+	seen := make(map[string]bool)
+	root, err := m.compile(bf, proMap, seen)
+	if err != nil {
+		return nil, err
+	}
+	m.root = root
+	return m, nil
+}
+
+// Fields returns the fields the filter reads, they must be fetched with the hits.
+func (m *Matcher) Fields() []string {
+	return m.fields
+}
+
+// Match reports whether a hit with fields matches the filter.
+func (m *Matcher) Match(fields []*vearchpb.Field) bool {
+	values := make(map[string][]byte, len(fields))
+	for _, f := range fields {
+		values[f.Name] = f.Value
+	}
+	return m.root.match(values)
+}
+
+func (m *Matcher) compile(bf *vearchpb.BoolFilter, proMap map[string]*entity.SpaceProperties, seen map[string]bool) (*node, error) {
+	if bf == nil {
+		return nil, fmt.Errorf("bool filter is empty")
+	}
+	n := &node{minShould: int(bf.MinimumShouldMatch)}
+	if bf.Clause != nil {
+		c, err := compileClause(bf.Clause, proMap)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[c.field] {
+			seen[c.field] = true
+			m.fields = append(m.fields, c.field)
+		}
+		n.clause = c
+		return n, nil
+	}
+	if len(bf.Must)+len(bf.Should)+len(bf.MustNot) == 0 {
+		return nil, fmt.Errorf("bool filter should have must, should or must_not clauses")
+	}
+	if n.minShould < 0 || n.minShould > len(bf.Should) {
+		return nil, fmt.Errorf("bool filter minimum_should_match:[%d] out of range [0, %d]", n.minShould, len(bf.Should))
+	}
+	if n.minShould == 0 && len(bf.Should) > 0 {
+		n.minShould = 1
+	}
+	for _, children := range []struct {
+		src []*vearchpb.BoolFilter
+		dst *[]*node
+	}{{bf.Must, &n.must}, {bf.Should, &n.should}, {bf.MustNot, &n.mustNot}} {
+		for _, child := range children.src {
+			cn, err := m.compile(child, proMap, seen)
+			if err != nil {
+				return nil, err
+			}
+			*children.dst = append(*children.dst, cn)
+		}
+	}
+	return n, nil
+}
+
+func isNumeric(fieldType entity.FieldType) bool {
+	switch fieldType {
+	case entity.FieldType_INT, entity.FieldType_LONG, entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+		return true
+	}
+	return false
+}
+
+func isInteger(fieldType entity.FieldType) bool {
+	return fieldType == entity.FieldType_INT || fieldType == entity.FieldType_LONG
+}
+
+func compileClause(fc *vearchpb.FilterClause, proMap map[string]*entity.SpaceProperties) (*clause, error) {
+	field := proMap[fc.Field]
+	if field == nil {
+		return nil, fmt.Errorf("%s filter field:[%s] not found in mapping", clauseName(fc.Type), fc.Field)
+	}
+	if field.FieldType == entity.FieldType_VECTOR {
+		return nil, fmt.Errorf("%s filter field:[%s] is a vector field", clauseName(fc.Type), fc.Field)
+	}
+	c := &clause{typ: fc.Type, field: fc.Field, fieldType: field.FieldType, array: field.Array}
+	switch fc.Type {
+	case vearchpb.FilterClause_EXISTS, vearchpb.FilterClause_MISSING:
+		return c, nil
+	case vearchpb.FilterClause_PREFIX:
+		if field.FieldType != entity.FieldType_STRING {
+			return nil, fmt.Errorf("prefix filter field:[%s] should be string", fc.Field)
+		}
+		if len(fc.Values) != 1 {
+			return nil, fmt.Errorf("prefix filter field:[%s] should have one value", fc.Field)
+		}
+	case vearchpb.FilterClause_CONTAINS_ALL, vearchpb.FilterClause_CONTAINS_ANY:
+		if field.FieldType != entity.FieldType_STRING || !field.Array {
+			return nil, fmt.Errorf("%s filter field:[%s] should be string array", clauseName(fc.Type), fc.Field)
+		}
+	case vearchpb.FilterClause_IN:
+		if !isNumeric(field.FieldType) {
+			return nil, fmt.Errorf("in filter field:[%s] should be numeric", fc.Field)
+		}
+	case vearchpb.FilterClause_TERM:
+		if field.FieldType != entity.FieldType_STRING && !isNumeric(field.FieldType) {
+			return nil, fmt.Errorf("term filter field:[%s] should be string or numeric", fc.Field)
+		}
+	case vearchpb.FilterClause_RANGE:
+		if !isNumeric(field.FieldType) {
+			return nil, fmt.Errorf("range filter field:[%s] should be numeric", fc.Field)
+		}
+		if len(fc.Values) != 2 {
+			return nil, fmt.Errorf("range filter field:[%s] should have lower and upper bound", fc.Field)
+		}
+		if fc.Values[0] == "" && fc.Values[1] == "" {
+			return nil, fmt.Errorf("range filter field:[%s] has no bound", fc.Field)
+		}
+		c.lower, c.upper = fc.Values[0], fc.Values[1]
+		c.includeLower, c.includeUpper = fc.IncludeLower, fc.IncludeUpper
+		for _, v := range fc.Values {
+			if v == "" {
+				continue
+			}
+			if err := c.addNumber(v); err != nil {
+				return nil, err
+			}
+		}
+		return c, nil
+	default:
+		return nil, fmt.Errorf("unknown filter type:[%v]", fc.Type)
+	}
+
+	if len(fc.Values) == 0 {
+		return nil, fmt.Errorf("%s filter field:[%s] has no value", clauseName(fc.Type), fc.Field)
+	}
+	if field.FieldType == entity.FieldType_STRING {
+		c.strs = fc.Values
+		return c, nil
+	}
+	for _, v := range fc.Values {
+		if err := c.addNumber(v); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *clause) addNumber(v string) error {
+	if isInteger(c.fieldType) {
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s filter field:[%s] value:[%s] is not an integer", clauseName(c.typ), c.field, v)
+		}
+		c.ints = append(c.ints, i)
+		return nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("%s filter field:[%s] value:[%s] is not a number", clauseName(c.typ), c.field, v)
+	}
+	if c.fieldType == entity.FieldType_FLOAT {
+		// float fields are stored as float32, compare at that precision
+		f = float64(float32(f))
+	}
+	c.floats = append(c.floats, f)
+	return nil
+}
+
+func clauseName(typ vearchpb.FilterClause_Type) string {
+	return strings.ToLower(typ.String())
+}
+
+func (n *node) match(values map[string][]byte) bool {
+	if n.clause != nil {
+		return n.clause.match(values)
+	}
+	for _, c := range n.must {
+		if !c.match(values) {
+			return false
+		}
+	}
+	for _, c := range n.mustNot {
+		if c.match(values) {
+			return false
+		}
+	}
+	if len(n.should) > 0 {
+		matched := 0
+		for _, c := range n.should {
+			if c.match(values) {
+				matched++
+				if matched >= n.minShould {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (c *clause) match(values map[string][]byte) bool {
+	value, found := values[c.field]
+	if c.fieldType == entity.FieldType_STRING {
+		var strs []string
+		if found && len(value) > 0 {
+			if c.array {
+				strs = strings.Split(string(value), string([]byte{'\001'}))
+			} else {
+				strs = []string{string(value)}
+			}
+		}
+		return c.matchStrings(strs)
+	}
+
+	exists := found && len(value) > 0
+	switch c.typ {
+	case vearchpb.FilterClause_EXISTS:
+		return exists
+	case vearchpb.FilterClause_MISSING:
+		return !exists
+	}
+	if !exists {
+		return false
+	}
+
+	if isInteger(c.fieldType) {
+		var v int64
+		if c.fieldType == entity.FieldType_INT {
+			v = int64(cbbytes.Bytes2Int32(value))
+		} else {
+			v = cbbytes.Bytes2Int(value)
+		}
+		if c.typ == vearchpb.FilterClause_RANGE {
+			i := 0
+			if c.lower != "" {
+				if v < c.ints[i] || (v == c.ints[i] && !c.includeLower) {
+					return false
+				}
+				i++
+			}
+			if c.upper != "" {
+				if v > c.ints[i] || (v == c.ints[i] && !c.includeUpper) {
+					return false
+				}
+			}
+			return true
+		}
+		for _, x := range c.ints {
+			if v == x {
+				return true
+			}
+		}
+		return false
+	}
+
+	v := cbbytes.ByteToFloat64(value)
+	if c.typ == vearchpb.FilterClause_RANGE {
+		i := 0
+		if c.lower != "" {
+			if v < c.floats[i] || (v == c.floats[i] && !c.includeLower) {
+				return false
+			}
+			i++
+		}
+		if c.upper != "" {
+			if v > c.floats[i] || (v == c.floats[i] && !c.includeUpper) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, x := range c.floats {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *clause) matchStrings(strs []string) bool {
+	switch c.typ {
+	case vearchpb.FilterClause_EXISTS:
+		return len(strs) > 0
+	case vearchpb.FilterClause_MISSING:
+		return len(strs) == 0
+	case vearchpb.FilterClause_PREFIX:
+		for _, s := range strs {
+			if strings.HasPrefix(s, c.strs[0]) {
+				return true
+			}
+		}
+		return false
+	case vearchpb.FilterClause_CONTAINS_ALL:
+		for _, want := range c.strs {
+			if !contains(strs, want) {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, want := range c.strs {
+			if contains(strs, want) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func contains(strs []string, s string) bool {
+	for _, v := range strs {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+var proMap = map[string]*entity.SpaceProperties{
+	"age":   {FieldType: entity.FieldType_INT},
+	"price": {FieldType: entity.FieldType_FLOAT},
+	"name":  {FieldType: entity.FieldType_STRING},
+	"tags":  {FieldType: entity.FieldType_STRING, Array: true},
+	"vec":   {FieldType: entity.FieldType_VECTOR},
+}
+
+func leaf(typ vearchpb.FilterClause_Type, field string, values ...string) *vearchpb.BoolFilter {
+	return &vearchpb.BoolFilter{Clause: &vearchpb.FilterClause{Type: typ, Field: field, Values: values}}
+}
+
+func doc(age int32, price float32, name string, tags string) []*vearchpb.Field {
+	return []*vearchpb.Field{
+		{Name: "age", Value: cbbytes.Int32ToByte(age)},
+		{Name: "price", Value: cbbytes.Float32ToByte(price)},
+		{Name: "name", Value: []byte(name)},
+		{Name: "tags", Value: []byte(tags)},
+	}
+}
+
+func TestMatch(t *testing.T) {
+	rangeAge := leaf(vearchpb.FilterClause_RANGE, "age", "18", "")
+	rangeAge.Clause.IncludeLower = true
+	bf := &vearchpb.BoolFilter{
+		Must: []*vearchpb.BoolFilter{
+			rangeAge,
+			{Should: []*vearchpb.BoolFilter{
+				leaf(vearchpb.FilterClause_PREFIX, "name", "jo"),
+				leaf(vearchpb.FilterClause_CONTAINS_ALL, "tags", "a", "b"),
+			}},
+		},
+		MustNot: []*vearchpb.BoolFilter{
+			leaf(vearchpb.FilterClause_TERM, "price", "0.1"),
+			leaf(vearchpb.FilterClause_MISSING, "name"),
+		},
+	}
+	m, err := New(bf, proMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Fields()) != 4 {
+		t.Fatalf("filter fields %v", m.Fields())
+	}
+
+	cases := []struct {
+		fields []*vearchpb.Field
+		match  bool
+	}{
+		{doc(18, 1, "john", ""), true},
+		{doc(17, 1, "john", ""), false},
+		{doc(30, 1, "mike", "a\001c"), false},
+		{doc(30, 1, "mike", "b\001a"), true},
+		{doc(30, 0.1, "john", ""), false},
+		{doc(30, 1, "", "a\001b"), false},
+	}
+	for i, c := range cases {
+		if m.Match(c.fields) != c.match {
+			t.Fatalf("case %d expect match %v", i, c.match)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	invalid := []*vearchpb.BoolFilter{
+		{},
+		leaf(vearchpb.FilterClause_TERM, "unknown", "a"),
+		leaf(vearchpb.FilterClause_TERM, "vec", "a"),
+		leaf(vearchpb.FilterClause_PREFIX, "age", "1"),
+		leaf(vearchpb.FilterClause_IN, "name", "a"),
+		leaf(vearchpb.FilterClause_IN, "age", "a"),
+		leaf(vearchpb.FilterClause_CONTAINS_ANY, "name", "a"),
+		leaf(vearchpb.FilterClause_RANGE, "age", "", ""),
+		{Should: []*vearchpb.BoolFilter{leaf(vearchpb.FilterClause_EXISTS, "name")}, MinimumShouldMatch: 2},
+	}
+	for i, bf := range invalid {
+		if _, err := New(bf, proMap); err == nil {
+			t.Fatalf("case %d expect error", i)
+		}
+	}
+}
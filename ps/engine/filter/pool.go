@@ -0,0 +1,45 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package filter
+
+import "math"
+
+// PoolGrowth is the factor the pool of hits grows by between two searches
+const PoolGrowth = 4
+
+// FillPage runs search with a pool of hits growing from pool until the hits
+// kept by the post filter fill the page. search reports short when a query
+// kept less than topN hits although the engine returned the whole pool, so
+// that the partition may hold more. It stops growing at maxPool and returns
+// truncated when the page is still short there.
+func FillPage(pool, maxPool int32, search func(pool int32) (short bool, err error)) (truncated bool, err error) {
+	if maxPool < pool {
+		maxPool = pool
+	}
+	for {
+		short, err := search(pool)
+		if err != nil || !short {
+			return false, err
+		}
+		if pool >= maxPool {
+			return true, nil
+		}
+		if pool > math.MaxInt32/PoolGrowth || pool*PoolGrowth > maxPool {
+			pool = maxPool
+		} else {
+			pool *= PoolGrowth
+		}
+	}
+}
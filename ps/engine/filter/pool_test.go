@@ -0,0 +1,129 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/vearch/vearch/ps/engine/sortorder"
+)
+
+type poolHit struct {
+	name string
+	key  string
+}
+
+func (h poolHit) values() (sortorder.SortValues, sortorder.SortValue) {
+	return sortorder.SortValues{&sortorder.StringSortValue{Val: h.name}}, &sortorder.StringSortValue{Val: h.key}
+}
+
+// TestFillPageSearchAfter walks a string sort, which is not pushed down to
+// the engine, page by page: the engine returns the first hits of the pool in
+// score order and the post filter keeps the ones after the cursor.
+func TestFillPageSearchAfter(t *testing.T) {
+	const docNum, topN = 100, 10
+	// names follow the score order loosely and are shared by several docs
+	hits := make([]poolHit, docNum)
+	for i := range hits {
+		hits[i] = poolHit{name: fmt.Sprintf("n%03d", i/2+i%5*3), key: fmt.Sprintf("k%03d", i)}
+	}
+	so := sortorder.SortOrder{&sortorder.SortField{Field: "name"}}
+
+	var cursor *sortorder.SearchAfter
+	seen := make(map[string]bool)
+	for pages := 0; ; pages++ {
+		if pages > docNum {
+			t.Fatalf("search after does not move on")
+		}
+		var page []poolHit
+		truncated, err := FillPage(topN, 4*docNum, func(pool int32) (bool, error) {
+			returned := hits
+			if int(pool) < len(returned) {
+				returned = returned[:pool]
+			}
+			page = page[:0]
+			for _, h := range returned {
+				if vs, key := h.values(); cursor == nil || so.After(vs, key, cursor) {
+					page = append(page, h)
+				}
+			}
+			sort.SliceStable(page, func(i, j int) bool {
+				vi, ki := page[i].values()
+				vj, kj := page[j].values()
+				return so.CompareWithKey(vi, ki, vj, kj) < 0
+			})
+			if len(page) > topN {
+				page = page[:topN]
+			}
+			return len(page) < topN && len(returned) == int(pool), nil
+		})
+		if err != nil || truncated {
+			t.Fatalf("fill page %d, truncated: %v, err: %v", pages, truncated, err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		left := 0
+		for _, h := range hits {
+			if vs, key := h.values(); cursor == nil || so.After(vs, key, cursor) {
+				left++
+			}
+		}
+		if len(page) < topN && len(page) < left {
+			t.Fatalf("page %d has %d hits with %d left after the cursor", pages, len(page), left)
+		}
+		for i, h := range page {
+			if seen[h.key] {
+				t.Fatalf("page %d returns %s again", pages, h.key)
+			}
+			seen[h.key] = true
+			vs, key := h.values()
+			if i == 0 && cursor != nil && !so.After(vs, key, cursor) {
+				t.Fatalf("page %d starts at %s before the cursor", pages, h.key)
+			}
+		}
+		last := page[len(page)-1]
+		vs, key := last.values()
+		cursor = &sortorder.SearchAfter{Values: vs, Key: key}
+	}
+	if len(seen) < docNum/2 {
+		t.Fatalf("walked %d hits", len(seen))
+	}
+}
+
+func TestFillPageTruncated(t *testing.T) {
+	var pools []int32
+	truncated, err := FillPage(10, 100, func(pool int32) (bool, error) {
+		pools = append(pools, pool)
+		return true, nil
+	})
+	if err != nil || !truncated {
+		t.Fatalf("truncated: %v, err: %v", truncated, err)
+	}
+	if fmt.Sprint(pools) != "[10 40 100]" {
+		t.Fatalf("pools: %v", pools)
+	}
+
+	pools = pools[:0]
+	truncated, err = FillPage(10, 100, func(pool int32) (bool, error) {
+		pools = append(pools, pool)
+		return pool < 40, nil
+	})
+	if err != nil || truncated || fmt.Sprint(pools) != "[10 40]" {
+		t.Fatalf("truncated: %v, err: %v, pools: %v", truncated, err, pools)
+	}
+}
@@ -204,6 +204,20 @@ func (ge *gammaEngine) EngineStatus(status *engine.EngineStatus) error {
 	return nil
 }
 
+func (ge *gammaEngine) MemoryInfo(info *engine.MemoryInfo) error {
+	if ge.gamma == nil {
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_IS_CLOSED, nil)
+	}
+	var mi gamma.MemoryInfo
+	gamma.GetEngineMemoryInfo(ge.gamma, &mi)
+	info.TableMem = mi.TableMem
+	info.IndexMem = mi.IndexMem
+	info.VectorMem = mi.VectorMem
+	info.FieldRangeMem = mi.FieldRangeMem
+	info.BitmapMem = mi.BitmapMem
+	return nil
+}
+
 func (ge *gammaEngine) BuildIndex() error {
 	indexLocker.Lock()
 	defer indexLocker.Unlock()
@@ -32,7 +32,9 @@ import (
 	"github.com/vearch/vearch/ps/engine"
 	"github.com/vearch/vearch/util/cbbytes"
 	"github.com/vearch/vearch/util/log"
+	"github.com/vearch/vearch/util/tracer"
 	"github.com/vearch/vearch/util/vearchlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const indexSn = "sn"
@@ -120,6 +122,40 @@ func (ri *readerImpl) DocCount(ctx context.Context) (uint64, error) {
 	return uint64(docNum), nil
 }
 
+func (ri *readerImpl) Count(ctx context.Context, request *vearchpb.SearchRequest) (int64, error) {
+	ri.engine.counter.Incr()
+	defer ri.engine.counter.Decr()
+
+	gammaEngine := ri.engine.gamma
+	if gammaEngine == nil {
+		return 0, vearchlog.LogErrAndReturn(vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_IS_CLOSED, nil))
+	}
+	code, count := gamma.Count(gammaEngine, gamma.SearchRequestSerialize(request))
+	if code != 0 {
+		return 0, vearchpb.NewErrorInfo(vearchpb.ErrorEnum_GAMMA_SEARCH_OTHER_ERR, fmt.Sprintf("gamma count return err: %d", code))
+	}
+	return count, nil
+}
+
+func (ri *readerImpl) FieldStats(ctx context.Context, request *vearchpb.SearchRequest) (int64, []*engine.FieldStats, error) {
+	ri.engine.counter.Incr()
+	defer ri.engine.counter.Decr()
+
+	gammaEngine := ri.engine.gamma
+	if gammaEngine == nil {
+		return 0, nil, vearchlog.LogErrAndReturn(vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_IS_CLOSED, nil))
+	}
+	var fieldStats gamma.FieldStats
+	if code := gamma.GetFieldStats(gammaEngine, gamma.SearchRequestSerialize(request), &fieldStats); code != 0 {
+		return 0, nil, vearchpb.NewErrorInfo(vearchpb.ErrorEnum_GAMMA_SEARCH_OTHER_ERR, fmt.Sprintf("gamma field stats return err: %d", code))
+	}
+	stats := make([]*engine.FieldStats, 0, len(fieldStats.Stats))
+	for _, s := range fieldStats.Stats {
+		stats = append(stats, &engine.FieldStats{Field: s.Field, Count: s.Count, Min: s.Min, Max: s.Max, Sketch: s.Sketch})
+	}
+	return fieldStats.Count, stats, nil
+}
+
 func (ri *readerImpl) Capacity(ctx context.Context) (int64, error) {
 	ri.engine.counter.Incr()
 	defer ri.engine.counter.Decr()
@@ -161,7 +197,10 @@ func (ri *readerImpl) Search(ctx context.Context, request *vearchpb.SearchReques
 	reqByte := gamma.SearchRequestSerialize(request)
 	serializeCostTime := (time.Since(startTime).Seconds()) * 1000
 	gammaStartTime := time.Now()
+	_, span := tracer.Start(ctx, "engine search", attribute.Int("req_num", int(request.ReqNum)), attribute.Int("topn", int(request.TopN)))
 	code, respByte := gamma.Search(ri.engine.gamma, reqByte)
+	span.SetAttributes(attribute.Int("code", code))
+	span.End()
 	gammaCostTime := (time.Since(gammaStartTime).Seconds()) * 1000
 	response.FlatBytes = respByte
 	serializeCostTimeStr := strconv.FormatFloat(serializeCostTime, 'f', -1, 64)
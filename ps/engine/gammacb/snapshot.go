@@ -1,16 +1,25 @@
 package gammacb
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 	"math"
 	"os"
 	"path/filepath"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
 	protobuf "github.com/golang/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/fileutil"
@@ -20,11 +29,30 @@ import (
 const (
 	// 每次传输10M
 	buf_size = 1024000 * 10
+
+	// the follower applies a snapshot into path + stagingSuffix, it is kept
+	// when the transfer is interrupted so that the next one resumes
+	stagingSuffix = ".snapshot"
+	// files of the staging dir received completely
+	manifestName = "snapshot.manifest"
 )
 
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
 var _ proto.Snapshot = &GammaSnapshot{}
 
+// sendingSnapshots are the snapshots being sent by their id, a follower
+// resuming one reports the files it has received under the id
+var sendingSnapshots = struct {
+	sync.Mutex
+	m      map[uint64]*GammaSnapshot
+	nextID uint64
+}{m: make(map[uint64]*GammaSnapshot), nextID: uint64(time.Now().UnixNano())}
+
 type GammaSnapshot struct {
+	id           uint64
+	partitionID  entity.PartitionID
+	started      bool
 	sn           int64
 	index        int64
 	path         string
@@ -32,12 +60,33 @@ type GammaSnapshot struct {
 	absFileNames []string
 	reader       *os.File
 	size         int64
+
+	// the files the follower has received, reported after the start msg
+	resumeMu sync.Mutex
+	resumed  map[string]*entity.SnapshotFile
+
+	// the file being sent
+	relName  string
+	fileSize int64
+	modTime  int64
+	offset   int64
+	crc      hash.Hash32
+	encoder  *zstd.Encoder
+	// counted in the snapshots sent by the ps until closed
+	sending bool
 }
 
 func (g *GammaSnapshot) Next() ([]byte, error) {
 	var err error
 	defer errutil.CatchError(&err)
-	if int(g.index) >= len(g.absFileNames) && g.size == 0 {
+	if !g.started {
+		g.started = true
+		return protobuf.Marshal(&vearchpb.SnapshotMsg{
+			Status:     vearchpb.SnapshotStatus_Start,
+			SnapshotId: g.id,
+		})
+	}
+	if int(g.index) >= len(g.absFileNames) && g.size == 0 && g.reader == nil {
 		log.Debug("leader send over, leader finish snapshot.")
 		snapShotMsg := &vearchpb.SnapshotMsg{
 			Status: vearchpb.SnapshotStatus_Finish,
@@ -54,7 +103,11 @@ func (g *GammaSnapshot) Next() ([]byte, error) {
 		g.index = g.index + 1
 		log.Debug("g.index is [%+v] ", g.index)
 		log.Debug("g.absFileNames length is [%+v] ", len(g.absFileNames))
-		info, _ := os.Stat(filePath)
+		info, err := os.Stat(filePath)
+		if err != nil {
+			errutil.ThrowError(err)
+			return nil, err
+		}
 		if info.IsDir() {
 			log.Debug("dir:[%s] name:[%s] is dir , so skip sync", g.path, info.Name())
 			snapShotMsg := &vearchpb.SnapshotMsg{
@@ -62,7 +115,30 @@ func (g *GammaSnapshot) Next() ([]byte, error) {
 			}
 			return protobuf.Marshal(snapShotMsg)
 		}
+		relName, err := filepath.Rel(g.path, filePath)
+		if err != nil {
+			errutil.ThrowError(err)
+			return nil, err
+		}
+		modTime := info.ModTime().UnixNano()
+		if f := g.resumedFile(relName); f != nil && f.Size == info.Size() && f.ModTime == modTime && modTime != 0 {
+			log.Debug("follower has received file [%s], skip it", relName)
+			return protobuf.Marshal(&vearchpb.SnapshotMsg{
+				FileName: filePath,
+				Status:   vearchpb.SnapshotStatus_Running,
+				RelName:  relName,
+				FileSize: info.Size(),
+				ModTime:  modTime,
+				FileEnd:  true,
+				Skipped:  true,
+			})
+		}
+		g.relName = relName
 		g.size = info.Size()
+		g.fileSize = info.Size()
+		g.modTime = modTime
+		g.offset = 0
+		g.crc = crc32.New(crcTable)
 		reader, err := os.Open(filePath)
 		log.Debug("next reader info [%+v],path [%s],name [%s]", info, g.path, filePath)
 		if err != nil {
@@ -73,13 +149,32 @@ func (g *GammaSnapshot) Next() ([]byte, error) {
 	}
 
 	byteData := make([]byte, int64(math.Min(buf_size, float64(g.size))))
-	size, err := g.reader.Read(byteData)
+	size, err := io.ReadFull(g.reader, byteData)
 	if err != nil {
 		errutil.ThrowError(err)
 		return nil, err
 	}
 	g.size = g.size - int64(size)
 	log.Debug("current g.size [%+v], info size [%+v]", g.size, size)
+	// snapshot proto msg
+	snapShotMsg := &vearchpb.SnapshotMsg{
+		FileName: g.absFileNames[g.index-1],
+		Data:     byteData,
+		Status:   vearchpb.SnapshotStatus_Running,
+		RelName:  g.relName,
+		Offset:   g.offset,
+		FileSize: g.fileSize,
+		ModTime:  g.modTime,
+	}
+	g.offset += int64(size)
+	_, _ = g.crc.Write(byteData)
+	if g.encoder != nil && len(byteData) > 0 {
+		if compressed := g.encoder.EncodeAll(byteData, nil); len(compressed) < len(byteData) {
+			snapShotMsg.Data = compressed
+			snapShotMsg.Compression = vearchpb.SnapshotCompression_Zstd
+		}
+	}
+	throttle.waitSend(len(snapShotMsg.Data))
 	if g.size == 0 {
 		if err := g.reader.Close(); err != nil {
 			errutil.ThrowError(err)
@@ -87,24 +182,52 @@ func (g *GammaSnapshot) Next() ([]byte, error) {
 			return nil, err
 		}
 		g.reader = nil
-	}
-	// snapshot proto msg
-	snapShotMsg := &vearchpb.SnapshotMsg{
-		FileName: g.absFileNames[g.index-1],
-		Data:     byteData,
-		Status:   vearchpb.SnapshotStatus_Running,
+		snapShotMsg.FileEnd = true
+		snapShotMsg.Checksum = g.crc.Sum32()
 	}
 	return protobuf.Marshal(snapShotMsg)
 }
 
+func (g *GammaSnapshot) resumedFile(relName string) *entity.SnapshotFile {
+	g.resumeMu.Lock()
+	defer g.resumeMu.Unlock()
+	return g.resumed[relName]
+}
+
+// ResumeSnapshot makes the snapshot being sent to a follower skip the files
+// the follower has received before
+func ResumeSnapshot(resume *entity.SnapshotResume) error {
+	sendingSnapshots.Lock()
+	g := sendingSnapshots.m[resume.SnapshotID]
+	sendingSnapshots.Unlock()
+	if g == nil || g.partitionID != resume.PartitionID {
+		return fmt.Errorf("snapshot [%d] of partition [%d] is not being sent", resume.SnapshotID, resume.PartitionID)
+	}
+	g.resumeMu.Lock()
+	g.resumed = resume.Files
+	g.resumeMu.Unlock()
+	log.Info("resume snapshot [%d] of partition [%d], the follower has received [%d] files", resume.SnapshotID, resume.PartitionID, len(resume.Files))
+	return nil
+}
+
 func (g *GammaSnapshot) ApplyIndex() uint64 {
 	return uint64(g.sn)
 }
 
 func (g *GammaSnapshot) Close() {
+	sendingSnapshots.Lock()
+	delete(sendingSnapshots.m, g.id)
+	sendingSnapshots.Unlock()
+	if g.sending {
+		g.sending = false
+		atomic.AddInt64(&throttle.sending, -1)
+	}
 	if g.reader != nil {
 		_ = g.reader.Close()
 	}
+	if g.encoder != nil {
+		_ = g.encoder.Close()
+	}
 }
 
 func (ge *gammaEngine) NewSnapshot() (proto.Snapshot, error) {
@@ -131,13 +254,247 @@ func (ge *gammaEngine) NewSnapshot() (proto.Snapshot, error) {
 	if sn < 0 {
 		return nil, fmt.Errorf("read sn:[%d] less than zero", sn)
 	}
-	return &GammaSnapshot{path: ge.path, sn: sn, infos: infos, absFileNames: absFileNames}, nil
+	snapshot := &GammaSnapshot{path: ge.path, partitionID: ge.partitionID, sn: sn, infos: infos, absFileNames: absFileNames}
+	switch compression := config.Conf().PS.SnapshotCompression; compression {
+	case "zstd":
+		if snapshot.encoder, err = zstd.NewWriter(nil); err != nil {
+			return nil, err
+		}
+	case "":
+	default:
+		return nil, fmt.Errorf("unknown snapshot compression:[%s]", compression)
+	}
+	snapshot.sending = true
+	atomic.AddInt64(&throttle.sending, 1)
+	sendingSnapshots.Lock()
+	sendingSnapshots.nextID++
+	snapshot.id = sendingSnapshots.nextID
+	sendingSnapshots.m[snapshot.id] = snapshot
+	sendingSnapshots.Unlock()
+	return snapshot, nil
 }
 
-func (ge *gammaEngine) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) error {
-	var err error
+// snapshotApplier writes a snapshot into the staging dir of an engine and
+// swaps it in when the snapshot is complete. Files of an interrupted transfer
+// already in the staging dir are not written again, the leader does not send
+// them when the follower reports them in time.
+type snapshotApplier struct {
+	path    string
+	staging string
+	// the files of the staging dir received completely
+	manifest map[string]*entity.SnapshotFile
+	// the files of this snapshot
+	received map[string]bool
+
+	out     *os.File
+	relName string
+	// the file is in the manifest and its data is skipped
+	skip bool
+	// bytes of the file written
+	written int64
+	crc     hash.Hash32
+	decoder *zstd.Decoder
+}
+
+func newSnapshotApplier(path string) (*snapshotApplier, error) {
+	a := &snapshotApplier{
+		path:     path,
+		staging:  path + stagingSuffix,
+		manifest: make(map[string]*entity.SnapshotFile),
+		received: make(map[string]bool),
+	}
+	if err := os.MkdirAll(a.staging, os.ModePerm); err != nil {
+		return nil, err
+	}
+	b, err := ioutil.ReadFile(filepath.Join(a.staging, manifestName))
+	if err == nil {
+		if err := json.Unmarshal(b, &a.manifest); err != nil {
+			log.Warn("snapshot manifest of [%s] is broken, receive all files, err: %v", a.staging, err)
+			a.manifest = make(map[string]*entity.SnapshotFile)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(a.manifest) > 0 {
+		log.Info("resume snapshot into [%s], [%d] files received", a.staging, len(a.manifest))
+	}
+	return a, nil
+}
+
+func (a *snapshotApplier) saveManifest() error {
+	b, err := json.Marshal(a.manifest)
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(a.staging, manifestName)
+	if err := ioutil.WriteFile(name+".tmp", b, 0660); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+func (a *snapshotApplier) closeFile() error {
+	if a.out == nil {
+		return nil
+	}
+	err := a.out.Close()
+	a.out = nil
+	return err
+}
+
+func (a *snapshotApplier) apply(msg *vearchpb.SnapshotMsg) error {
+	relName := msg.RelName
+	if relName == "" {
+		// sent by an old leader with the same data path
+		var err error
+		if relName, err = filepath.Rel(a.path, msg.FileName); err != nil {
+			return err
+		}
+	}
+	if msg.Skipped {
+		if err := a.closeFile(); err != nil {
+			return err
+		}
+		a.relName = ""
+		if f := a.manifest[relName]; f == nil || f.Size != msg.FileSize || f.ModTime != msg.ModTime {
+			return fmt.Errorf("leader skipped snapshot file [%s] not received", relName)
+		}
+		a.received[relName] = true
+		return nil
+	}
+	if relName != a.relName {
+		if err := a.closeFile(); err != nil {
+			return err
+		}
+		a.relName = relName
+		a.received[relName] = true
+		f := a.manifest[relName]
+		a.skip = f != nil && f.Size == msg.FileSize && f.ModTime == msg.ModTime && msg.ModTime != 0
+		if !a.skip {
+			delete(a.manifest, relName)
+			fileName := filepath.Join(a.staging, relName)
+			if err := os.MkdirAll(filepath.Dir(fileName), os.ModePerm); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(fileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0660)
+			if err != nil {
+				return err
+			}
+			a.out = out
+			a.written = 0
+			a.crc = crc32.New(crcTable)
+		}
+	}
+
+	if a.skip {
+		if msg.FileEnd && msg.Checksum != a.manifest[relName].Checksum {
+			delete(a.manifest, relName)
+			if err := a.saveManifest(); err != nil {
+				return err
+			}
+			return fmt.Errorf("snapshot file [%s] changed on the leader, receive it again", relName)
+		}
+		return nil
+	}
+
+	data := msg.Data
+	if msg.Compression == vearchpb.SnapshotCompression_Zstd {
+		if a.decoder == nil {
+			decoder, err := zstd.NewReader(nil)
+			if err != nil {
+				return err
+			}
+			a.decoder = decoder
+		}
+		var err error
+		if data, err = a.decoder.DecodeAll(msg.Data, nil); err != nil {
+			return fmt.Errorf("decompress snapshot file [%s] err: %v", relName, err)
+		}
+	}
+	// an old leader sends no file size and offsets
+	if msg.FileSize > 0 && msg.Offset != a.written {
+		return fmt.Errorf("snapshot file [%s] chunk at offset [%d], [%d] bytes written", relName, msg.Offset, a.written)
+	}
+	log.Debug("write file path is [%s] ,name is [%s], size is [%d]", a.staging, relName, len(data))
+	if _, err := a.out.Write(data); err != nil {
+		return err
+	}
+	a.written += int64(len(data))
+	_, _ = a.crc.Write(data)
+	if !msg.FileEnd {
+		return nil
+	}
+	if a.written != msg.FileSize {
+		return fmt.Errorf("snapshot file [%s] of [%d] bytes, [%d] bytes written", relName, msg.FileSize, a.written)
+	}
+
+	if err := a.out.Sync(); err != nil {
+		return err
+	}
+	if err := a.closeFile(); err != nil {
+		return err
+	}
+	if msg.Checksum != a.crc.Sum32() {
+		return fmt.Errorf("snapshot file [%s] checksum mismatch, expect [%d] got [%d]", relName, msg.Checksum, a.crc.Sum32())
+	}
+	a.manifest[relName] = &entity.SnapshotFile{Size: msg.FileSize, ModTime: msg.ModTime, Checksum: msg.Checksum}
+	return a.saveManifest()
+}
+
+// finish removes the files not in this snapshot and swaps the staging dir in
+func (a *snapshotApplier) finish() error {
+	if err := a.closeFile(); err != nil {
+		return err
+	}
+	fileNames, err := fileutil.GetAllFileNames(a.staging)
+	if err != nil {
+		return err
+	}
+	for _, fileName := range fileNames {
+		relName, err := filepath.Rel(a.staging, fileName)
+		if err != nil {
+			return err
+		}
+		if !a.received[relName] {
+			if err := os.Remove(fileName); err != nil {
+				return err
+			}
+		}
+	}
+
+	old := a.path + ".old"
+	if err := os.RemoveAll(old); err != nil {
+		return err
+	}
+	if _, err := os.Stat(a.path); err == nil {
+		if err := os.Rename(a.path, old); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(a.staging, a.path); err != nil {
+		return err
+	}
+	return os.RemoveAll(old)
+}
+
+func (a *snapshotApplier) close() {
+	_ = a.closeFile()
+	if a.decoder != nil {
+		a.decoder.Close()
+	}
+}
+
+// ApplySnapshot applies the snapshot of iter, resume reports the files
+// received by an interrupted transfer to the leader
+func (ge *gammaEngine) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator, resume func(*entity.SnapshotResume) error) (err error) {
 	defer errutil.CatchError(&err)
-	var out *os.File
+	applier, err := newSnapshotApplier(ge.path)
+	errutil.ThrowError(err)
+	defer applier.close()
+	atomic.AddInt64(&throttle.receiving, 1)
+	defer atomic.AddInt64(&throttle.receiving, -1)
 	for {
 		bs, err := iter.Next()
 		if err != nil && err != io.EOF {
@@ -151,43 +508,29 @@ func (ge *gammaEngine) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator
 		msg := &vearchpb.SnapshotMsg{}
 		err = protobuf.Unmarshal(bs, msg)
 		errutil.ThrowError(err)
-		if msg.Status == vearchpb.SnapshotStatus_Finish {
-			if out != nil {
-				if err := out.Close(); err != nil {
-					errutil.ThrowError(err)
-					return err
+		throttle.waitRecv(len(msg.Data))
+		if msg.Status == vearchpb.SnapshotStatus_Start {
+			// sent by a leader which skips the files reported
+			if msg.SnapshotId != 0 && len(applier.manifest) > 0 && resume != nil {
+				err = resume(&entity.SnapshotResume{PartitionID: ge.partitionID, SnapshotID: msg.SnapshotId, Files: applier.manifest})
+				if err != nil {
+					log.Warn("report received snapshot files of partition [%d] to the leader err: %v", ge.partitionID, err)
 				}
-				out = nil
 			}
+			continue
+		}
+		if msg.Status == vearchpb.SnapshotStatus_Finish {
+			err = applier.finish()
+			errutil.ThrowError(err)
 			log.Debug("follower receive finish.")
 			return nil
 		}
-		if msg.Data == nil || len(msg.Data) == 0 {
+		// dirs are created with their files, an old leader sends no file_end
+		if len(msg.Data) == 0 && !msg.FileEnd {
 			log.Debug("msg data is nil.")
 			continue
 		}
-		if out != nil {
-			if err := out.Close(); err != nil {
-				errutil.ThrowError(err)
-				return err
-			}
-			out = nil
-		}
-		// create dir
-		fileDir := filepath.Dir(msg.FileName)
-		_, exist := os.Stat(fileDir)
-		if os.IsNotExist(exist) {
-			log.Debug("create dir [%+v]", fileDir)
-			err := os.MkdirAll(fileDir, os.ModePerm)
-			errutil.ThrowError(err)
-		}
-		// create file, append write mode
-		if out, err = os.OpenFile(msg.FileName, os.O_CREATE|os.O_APPEND|os.O_RDWR, 0660); err != nil {
-			errutil.ThrowError(err)
-			return err
-		}
-		log.Debug("write file path is [%s] ,name is [%s], size is [%d]", ge.path, msg.FileName, len(msg.Data))
-		if _, err = out.Write(msg.Data); err != nil {
+		if err = applier.apply(msg); err != nil {
 			errutil.ThrowError(err)
 			return err
 		}
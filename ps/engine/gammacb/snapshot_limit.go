@@ -0,0 +1,94 @@
+package gammacb
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/util/ratelimit"
+)
+
+// snapshotThrottle limits the bytes per second of the snapshots sent and
+// received by this ps, shared by all its partitions
+type snapshotThrottle struct {
+	once  sync.Once
+	mu    sync.RWMutex
+	limit entity.SnapshotLimit
+	send  ratelimit.RateLimit
+	recv  ratelimit.RateLimit
+
+	sentBytes int64
+	recvBytes int64
+	sending   int64
+	receiving int64
+}
+
+var throttle = &snapshotThrottle{}
+
+// init sets the rates of the config, they are read on first use as the
+// config is not loaded when the package is initialized
+func (t *snapshotThrottle) init() {
+	t.once.Do(func() {
+		limit := entity.SnapshotLimit{}
+		if cfg := config.Conf(); cfg != nil && cfg.PS != nil {
+			limit.SendRate, limit.RecvRate = cfg.PS.SnapshotSendRate, cfg.PS.SnapshotRecvRate
+		}
+		t.set(limit)
+	})
+}
+
+func newRateLimit(rate int64) ratelimit.RateLimit {
+	if rate <= 0 {
+		return ratelimit.NewNullRateLimit()
+	}
+	// a second of traffic may be sent at once
+	return ratelimit.NewBucketRateLimit(int(rate), rate, 0)
+}
+
+func (t *snapshotThrottle) set(limit entity.SnapshotLimit) {
+	send, recv := newRateLimit(limit.SendRate), newRateLimit(limit.RecvRate)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limit, t.send, t.recv = limit, send, recv
+}
+
+func (t *snapshotThrottle) waitSend(n int) {
+	t.init()
+	t.mu.RLock()
+	send := t.send
+	t.mu.RUnlock()
+	send.Wait(int64(n))
+	atomic.AddInt64(&t.sentBytes, int64(n))
+}
+
+func (t *snapshotThrottle) waitRecv(n int) {
+	t.init()
+	t.mu.RLock()
+	recv := t.recv
+	t.mu.RUnlock()
+	recv.Wait(int64(n))
+	atomic.AddInt64(&t.recvBytes, int64(n))
+}
+
+// SetSnapshotLimit changes the snapshot rates of this ps, the snapshots in
+// progress are throttled by the new rates from their next chunk
+func SetSnapshotLimit(limit entity.SnapshotLimit) {
+	throttle.init()
+	throttle.set(limit)
+}
+
+// SnapshotStats returns the snapshot rates and traffic of this ps
+func SnapshotStats() *entity.SnapshotStats {
+	throttle.init()
+	throttle.mu.RLock()
+	limit := throttle.limit
+	throttle.mu.RUnlock()
+	return &entity.SnapshotStats{
+		SnapshotLimit: limit,
+		SentBytes:     atomic.LoadInt64(&throttle.sentBytes),
+		RecvBytes:     atomic.LoadInt64(&throttle.recvBytes),
+		Sending:       atomic.LoadInt64(&throttle.sending),
+		Receiving:     atomic.LoadInt64(&throttle.receiving),
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+package sortorder
+
+// SearchAfter is the position of the last hit of a previous page, the sort
+// values of that hit and its primary key, which breaks ties between hits
+// that are equal on every sort.
+type SearchAfter struct {
+	Values SortValues
+	Key    SortValue
+}
+
+// CompareWithKey compares two hits like Compare and falls back to ascending
+// primary key order when they are equal on every sort.
+func (so SortOrder) CompareWithKey(a SortValues, aKey SortValue, b SortValues, bKey SortValue) int {
+	if c := so.Compare(a, b); c != 0 {
+		return c
+	}
+	if aKey == nil || bKey == nil {
+		return 0
+	}
+	return aKey.Compare(bKey)
+}
+
+// After reports whether the hit with sort values vs and primary key key
+// sorts strictly after sa.
+func (so SortOrder) After(vs SortValues, key SortValue, sa *SearchAfter) bool {
+	return so.CompareWithKey(vs, key, sa.Values, sa.Key) > 0
+}
@@ -125,3 +125,30 @@ func TestGeoDistanceSortValue(t *testing.T) {
 		t.Fatal("int compare faield")
 	}
 }
+
+func TestSearchAfter(t *testing.T) {
+	so := SortOrder{&SortScore{Desc: true}, &SortField{Field: "age", Desc: false}}
+	sa := &SearchAfter{
+		Values: SortValues{&FloatSortValue{Val: 0.8}, &IntSortValue{Val: 20}},
+		Key:    &StringSortValue{Val: "b"},
+	}
+
+	hits := []struct {
+		values SortValues
+		key    string
+		after  bool
+	}{
+		{SortValues{&FloatSortValue{Val: 0.9}, &IntSortValue{Val: 30}}, "z", false},
+		{SortValues{&FloatSortValue{Val: 0.7}, &IntSortValue{Val: 10}}, "a", true},
+		{SortValues{&FloatSortValue{Val: 0.8}, &IntSortValue{Val: 19}}, "z", false},
+		{SortValues{&FloatSortValue{Val: 0.8}, &IntSortValue{Val: 21}}, "a", true},
+		{SortValues{&FloatSortValue{Val: 0.8}, &IntSortValue{Val: 20}}, "a", false},
+		{SortValues{&FloatSortValue{Val: 0.8}, &IntSortValue{Val: 20}}, "b", false},
+		{SortValues{&FloatSortValue{Val: 0.8}, &IntSortValue{Val: 20}}, "c", true},
+	}
+	for i, h := range hits {
+		if so.After(h.values, &StringSortValue{Val: h.key}, sa) != h.after {
+			t.Fatalf("search after hit %d expect %v", i, h.after)
+		}
+	}
+}
@@ -27,11 +27,13 @@ import (
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/ps/engine"
+	"github.com/vearch/vearch/ps/engine/gammacb"
 	"github.com/vearch/vearch/util/cbjson"
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/metrics/mserver"
 	"github.com/vearch/vearch/util/server/rpc/handler"
+	"github.com/vearch/vearch/util/slowlog"
 )
 
 func ExportToRpcAdminHandler(server *Server) {
@@ -72,6 +74,24 @@ func ExportToRpcAdminHandler(server *Server) {
 	if err := server.rpcServer.RegisterName(handler.NewChain(client.EngineCfgHandler, handler.DefaultPanicHandler, nil, initAdminHandler, &EngineCfgHandler{server: server}), ""); err != nil {
 		panic(err)
 	}
+	if err := server.rpcServer.RegisterName(handler.NewChain(client.SlowLogHandler, handler.DefaultPanicHandler, nil, initAdminHandler, new(SlowLogHandler)), ""); err != nil {
+		panic(err)
+	}
+	if err := server.rpcServer.RegisterName(handler.NewChain(client.SnapshotLimitHandler, handler.DefaultPanicHandler, nil, initAdminHandler, new(SnapshotLimitHandler)), ""); err != nil {
+		panic(err)
+	}
+	if err := server.rpcServer.RegisterName(handler.NewChain(client.SnapshotResumeHandler, handler.DefaultPanicHandler, nil, initAdminHandler, new(SnapshotResumeHandler)), ""); err != nil {
+		panic(err)
+	}
+	if err := server.rpcServer.RegisterName(handler.NewChain(client.MovePartitionHandler, handler.DefaultPanicHandler, nil, initAdminHandler, &MovePartitionHandler{server: server}), ""); err != nil {
+		panic(err)
+	}
+	if err := server.rpcServer.RegisterName(handler.NewChain(client.ReplicaCheckHandler, handler.DefaultPanicHandler, psErrorChange, initAdminHandler, &ReplicaCheckHandler{server: server}), ""); err != nil {
+		panic(err)
+	}
+	if err := server.rpcServer.RegisterName(handler.NewChain(client.LocalPartitionsHandler, handler.DefaultPanicHandler, nil, initAdminHandler, &LocalPartitionsHandler{server: server}), ""); err != nil {
+		panic(err)
+	}
 }
 
 type InitAdminHandler struct {
@@ -223,6 +243,57 @@ func (pih *PartitionInfoHandler) Execute(ctx context.Context, req *vearchpb.Part
 	return nil
 }
 
+type SlowLogHandler int
+
+// Execute returns the slow log entries of this ps
+func (*SlowLogHandler) Execute(ctx context.Context, req *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	data, err := json.Marshal(slowlog.Default().Recent(0, "", ""))
+	if err != nil {
+		return err
+	}
+	reply.Data = data
+	return nil
+}
+
+type SnapshotLimitHandler int
+
+// Execute sets the snapshot rates of this ps when the type is create, and
+// returns its snapshot stats
+func (*SnapshotLimitHandler) Execute(ctx context.Context, req *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	if req.Type == vearchpb.OpType_CREATE {
+		limit := entity.SnapshotLimit{}
+		if err := json.Unmarshal(req.Data, &limit); err != nil {
+			return vearchpb.NewError(vearchpb.ErrorEnum_RPC_PARAM_ERROR, err)
+		}
+		log.Info("set snapshot limit to %+v", limit)
+		gammacb.SetSnapshotLimit(limit)
+	}
+	data, err := json.Marshal(gammacb.SnapshotStats())
+	if err != nil {
+		return err
+	}
+	reply.Data = data
+	return nil
+}
+
+type SnapshotResumeHandler int
+
+// Execute makes the snapshot this ps sends to a follower skip the files the
+// follower has received before
+func (*SnapshotResumeHandler) Execute(ctx context.Context, req *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	resume := new(entity.SnapshotResume)
+	if err := json.Unmarshal(req.Data, resume); err != nil {
+		return vearchpb.NewError(vearchpb.ErrorEnum_RPC_PARAM_ERROR, err)
+	}
+	if err := gammacb.ResumeSnapshot(resume); err != nil {
+		return vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err)
+	}
+	return nil
+}
+
 type StatsHandler struct {
 	server *Server
 }
@@ -231,6 +302,7 @@ func (sh *StatsHandler) Execute(ctx context.Context, req *vearchpb.PartitionData
 	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
 	stats := mserver.NewServerStats()
 	stats.ActiveConn = len(sh.server.rpcServer.ActiveClientConn())
+	stats.Snapshot = gammacb.SnapshotStats()
 	stats.PartitionInfos = make([]*entity.PartitionInfo, 0, 1)
 	sh.server.RangePartition(func(pid entity.PartitionID, store PartitionStore) {
 		defer func() {
@@ -277,6 +349,79 @@ func (sh *StatsHandler) Execute(ctx context.Context, req *vearchpb.PartitionData
 	return nil
 }
 
+type MovePartitionHandler struct {
+	server *Server
+}
+
+// Execute moves a partition to another data dir of this ps
+func (mh *MovePartitionHandler) Execute(ctx context.Context, req *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	reqObj := new(entity.MovePartition)
+	if err := cbjson.Unmarshal(req.Data, reqObj); err != nil {
+		return vearchpb.NewError(vearchpb.ErrorEnum_RPC_PARAM_ERROR, err)
+	}
+	return mh.server.MovePartition(ctx, req.PartitionID, reqObj.DataDir)
+}
+
+type ReplicaCheckHandler struct {
+	server *Server
+}
+
+// Execute proposes a replica check when the index is 0, or returns the
+// checksum of the replica at the index, null when it has not applied it yet
+func (rh *ReplicaCheckHandler) Execute(ctx context.Context, req *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	reqObj := new(entity.ReplicaCheck)
+	if err := cbjson.Unmarshal(req.Data, reqObj); err != nil {
+		return vearchpb.NewError(vearchpb.ErrorEnum_RPC_PARAM_ERROR, err)
+	}
+
+	store := rh.server.GetPartition(req.PartitionID)
+	if store == nil {
+		msg := fmt.Sprintf("partition not found, partitionId:[%d]", req.PartitionID)
+		log.Error("%s", msg)
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_NOT_EXIST, errors.New(msg))
+	}
+
+	var sum *entity.ReplicaChecksum
+	var err error
+	if reqObj.Index == 0 {
+		sum, err = store.ProposeChecksum(ctx, reqObj.Buckets)
+	} else {
+		sum, err = store.Checksum(reqObj.Index)
+	}
+	if err != nil {
+		return err
+	}
+	if reply.Data, err = json.Marshal(sum); err != nil {
+		return err
+	}
+	return nil
+}
+
+type LocalPartitionsHandler struct {
+	server *Server
+}
+
+// Execute adopts the partition left in a data dir with the space of the
+// request when the type is create, or returns the partitions in the data dirs
+func (lh *LocalPartitionsHandler) Execute(ctx context.Context, req *vearchpb.PartitionData, reply *vearchpb.PartitionData) error {
+	reply.Err = &vearchpb.Error{Code: vearchpb.ErrorEnum_SUCCESS}
+	if req.Type == vearchpb.OpType_CREATE {
+		space := new(entity.Space)
+		if err := cbjson.Unmarshal(req.Data, space); err != nil {
+			return vearchpb.NewError(vearchpb.ErrorEnum_RPC_PARAM_ERROR, err)
+		}
+		return lh.server.AdoptPartition(ctx, space, req.PartitionID)
+	}
+	data, err := cbjson.Marshal(lh.server.LocalPartitions())
+	if err != nil {
+		return err
+	}
+	reply.Data = data
+	return nil
+}
+
 type ChangeMemberHandler struct {
 	server *Server
 }
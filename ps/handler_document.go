@@ -24,8 +24,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/opentracing/opentracing-go"
-	"github.com/opentracing/opentracing-go/ext"
 	"github.com/smallnest/rpcx/share"
 	"github.com/spf13/cast"
 	"github.com/vearch/vearch/client"
@@ -36,7 +34,11 @@ import (
 	"github.com/vearch/vearch/ps/engine/mapping"
 	"github.com/vearch/vearch/util/cbbytes"
 	"github.com/vearch/vearch/util/log"
+	"github.com/vearch/vearch/util/monitoring"
 	"github.com/vearch/vearch/util/server/rpc/handler"
+	"github.com/vearch/vearch/util/slowlog"
+	"github.com/vearch/vearch/util/tracer"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/atomic"
 )
 
@@ -107,10 +109,16 @@ func (handler *UnaryHandler) Execute(ctx context.Context, req *vearchpb.Partitio
 	if config.LogInfoPrintSwitch {
 		defer cost("UnaryHandler: "+method, time.Now())
 	}
-	if spanCtx, err := opentracing.GlobalTracer().Extract(opentracing.TextMap, opentracing.TextMapCarrier(reqMap)); err == nil {
-		span := opentracing.StartSpan("server-execute", ext.RPCServerOption(spanCtx))
-		defer span.Finish()
-	}
+	m := monitoring.StartRequest(monitoring.RolePS, reqMap[client.HeadDbName], reqMap[client.HeadSpaceName], method)
+	defer func() { m.End(reply.Err) }()
+	ctx, span := tracer.Start(tracer.Extract(ctx, reqMap), "ps "+method, attribute.Int64("partition_id", int64(req.PartitionID)))
+	defer func() {
+		if reply.Err != nil {
+			tracer.End(span, vearchpb.NewErrorInfo(reply.Err.Code, reply.Err.Msg))
+			return
+		}
+		tracer.End(span, nil)
+	}()
 	timeout := handler.server.rpcTimeOut * 1000
 	if s, ok := reqMap[string(entity.RPC_TIME_OUT)]; ok {
 		if t, ok := strconv.Atoi(s); ok == nil {
@@ -160,10 +168,12 @@ func (handler *UnaryHandler) execute(ctx context.Context, req *vearchpb.Partitio
 		}
 	}()
 
+	queueStart := time.Now()
 	handler.server.concurrent <- true
 	defer func() {
 		<-handler.server.concurrent
 	}()
+	queued := time.Since(queueStart)
 	select {
 	case <-ctx.Done():
 		// if this context is timeout, return immediately
@@ -204,7 +214,15 @@ func (handler *UnaryHandler) execute(ctx context.Context, req *vearchpb.Partitio
 			if req.SearchResponse == nil {
 				req.SearchResponse = &vearchpb.SearchResponse{}
 			}
-			search(ctx, store, req.SearchRequest, req.SearchResponse)
+			var profile *vearchpb.SearchProfile
+			if req.SearchRequest.Profile {
+				profile = &vearchpb.SearchProfile{
+					PartitionId: req.PartitionID,
+					NodeId:      uint64(handler.server.nodeID),
+					QueueMs:     queued.Seconds() * 1000,
+				}
+			}
+			search(ctx, store, req.SearchRequest, req.SearchResponse, profile)
 		case client.BulkSearchHandler:
 			if req.SearchResponses == nil || len(req.SearchResponses) == 0 {
 				searchResps := make([]*vearchpb.SearchResponse, 0)
@@ -227,6 +245,11 @@ func (handler *UnaryHandler) execute(ctx context.Context, req *vearchpb.Partitio
 				req.DelByQueryResponse = &vearchpb.DelByQueryeResponse{DelNum: 0}
 			}
 			deleteByQuery(ctx, store, req.SearchRequest, req.DelByQueryResponse)
+		case client.CountHandler, client.FieldStatsHandler:
+			if req.CountResponse == nil {
+				req.CountResponse = &vearchpb.CountResponse{}
+			}
+			count(ctx, store, req.SearchRequest, req.CountResponse, method == client.FieldStatsHandler)
 		case client.FlushHandler:
 			flush(ctx, store, req.Err)
 		default:
@@ -333,11 +356,50 @@ func update(ctx context.Context, store PartitionStore, items []*vearchpb.Item) {
 	}
 }
 
-func search(ctx context.Context, store PartitionStore, request *vearchpb.SearchRequest, response *vearchpb.SearchResponse) {
+// search fills profile with the time of each stage when it is not nil
+func search(ctx context.Context, store PartitionStore, request *vearchpb.SearchRequest, response *vearchpb.SearchResponse, profile *vearchpb.SearchProfile) {
 	startTime := time.Now()
-	if err := store.Search(ctx, request, response); err != nil {
-		log.Error("search doc failed, err: [%s]", err.Error())
-		response.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
+	// the router sends the slow log threshold of the space when it is enabled
+	reqMap, _ := ctx.Value(share.ReqMetaDataKey).(map[string]string)
+	if threshold, _ := strconv.ParseInt(reqMap[client.SlowLogThreshold], 10, 64); threshold > 0 {
+		normalized := slowlog.NormalizeSearch(request)
+		defer func() {
+			if took := time.Since(startTime).Milliseconds(); took >= threshold {
+				addSlowSearch(store, reqMap[client.HeadDbName], normalized, response, took, threshold)
+			}
+		}()
+	}
+	if profile != nil {
+		request.OnlineLogLevel = gamma.OnlineLogLevelProfile
+	}
+	if postFilter, err := newSearchPostFilter(store, request); err != nil {
+		log.Error("search post filter failed, err: [%s]", err.Error())
+		response.Head = &vearchpb.ResponseHead{Err: vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, err).GetError()}
+	} else if postFilter != nil {
+		if err := postFilter.search(ctx, store, request, response, profile); err != nil {
+			log.Error("search doc failed, err: [%s]", err.Error())
+			if response.Head == nil {
+				response.Head = &vearchpb.ResponseHead{}
+			}
+			response.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
+		}
+	} else {
+		engineStart := time.Now()
+		err := store.Search(ctx, request, response)
+		setFilterStats(request, response)
+		if profile != nil {
+			profile.EngineMs = time.Since(engineStart).Seconds() * 1000
+			if err := gamma.DeSerializeProfile(response.FlatBytes, profile); err != nil {
+				log.Warn("read search profile failed, err: [%s]", err.Error())
+			}
+		}
+		if err != nil {
+			log.Error("search doc failed, err: [%s]", err.Error())
+			response.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
+		}
+	}
+	if profile != nil {
+		response.Profiles = []*vearchpb.SearchProfile{profile}
 	}
 	handlerCostTime := (time.Now().Sub(startTime).Seconds()) * 1000
 	handlerCostTimeStr := strconv.FormatFloat(handlerCostTime, 'f', -1, 64)
@@ -355,6 +417,36 @@ func search(ctx context.Context, store PartitionStore, request *vearchpb.SearchR
 	}()
 }
 
+func addSlowSearch(store PartitionStore, dbName string, request *slowlog.Request, response *vearchpb.SearchResponse, took, threshold int64) {
+	space := store.GetSpace()
+	entry := &slowlog.Entry{
+		Role:        monitoring.RolePS,
+		DbName:      dbName,
+		SpaceName:   space.Name,
+		Operation:   "search",
+		TookMs:      took,
+		ThresholdMs: threshold,
+		PartitionID: store.GetPartition().Id,
+		Request:     request,
+	}
+	results := response.Results
+	if len(results) == 0 && response.FlatBytes != nil {
+		// the engine result is serialized when there is no post filter
+		decoded := &vearchpb.SearchResponse{}
+		gamma.DeSerialize(response.FlatBytes, decoded)
+		results = decoded.Results
+	}
+	for _, result := range results {
+		entry.ResultCount += len(result.ResultItems)
+	}
+	if response.Head != nil && response.Head.Params != nil {
+		scanned, _ := strconv.ParseInt(response.Head.Params[client.FilterScanned], 10, 64)
+		matched, _ := strconv.ParseInt(response.Head.Params[client.FilterMatched], 10, 64)
+		entry.FilterSelectivity = slowlog.Selectivity(scanned, matched)
+	}
+	slowlog.Default().Add(entry)
+}
+
 func bulkSearch(ctx context.Context, store PartitionStore, request []*vearchpb.SearchRequest, response []*vearchpb.SearchResponse) {
 	wg := sync.WaitGroup{}
 	for i, req := range request {
@@ -370,7 +462,18 @@ func bulkSearch(ctx context.Context, store PartitionStore, request []*vearchpb.S
 				}
 			}()
 
-			if err := store.Search(ctx, req, resp); err != nil {
+			if postFilter, err := newSearchPostFilter(store, req); err != nil {
+				log.Error("search post filter failed, err: [%s]", err.Error())
+				resp.Head = &vearchpb.ResponseHead{Err: vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, err).GetError()}
+			} else if postFilter != nil {
+				if err := postFilter.search(ctx, store, req, resp, nil); err != nil {
+					log.Error("search doc failed, err: [%s]", err.Error())
+					if resp.Head == nil {
+						resp.Head = &vearchpb.ResponseHead{}
+					}
+					resp.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
+				}
+			} else if err := store.Search(ctx, req, resp); err != nil {
 				log.Error("search doc failed, err: [%s]", err.Error())
 				resp.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
 			}
@@ -415,9 +518,69 @@ func flush(ctx context.Context, store PartitionStore, error *vearchpb.Error) {
 	}
 }
 
+// count counts the documents matching the filters of req exactly, with
+// withStats the engine also collects the statistics of req.Fields over them.
+func count(ctx context.Context, store PartitionStore, req *vearchpb.SearchRequest, resp *vearchpb.CountResponse, withStats bool) {
+	resp.Head = &vearchpb.ResponseHead{}
+	reader := store.GetEngine().Reader()
+	if !withStats {
+		docNum, err := reader.Count(ctx, req)
+		if err != nil {
+			resp.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
+			return
+		}
+		resp.Count = docNum
+		return
+	}
+
+	space := store.GetSpace()
+	proMap := space.SpaceProperties
+	if proMap == nil {
+		proMap, _ = entity.UnmarshalPropertyJSON(space.Properties)
+	}
+	for _, field := range req.Fields {
+		if pro := proMap[field]; pro == nil || pro.FieldType == entity.FieldType_VECTOR {
+			resp.Head.Err = vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, fmt.Sprintf("field:[%s] not found in mapping", field)).GetError()
+			return
+		}
+	}
+	docNum, stats, err := reader.FieldStats(ctx, req)
+	if err != nil {
+		log.Error("count field stats failed, err: [%s]", err.Error())
+		resp.Head.Err = vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err).GetError()
+		return
+	}
+	resp.Count = docNum
+	for _, s := range stats {
+		fs := &vearchpb.FieldStats{Field: s.Field, Count: s.Count, Sketch: s.Sketch}
+		if s.Count > 0 {
+			// the engine splits array values, min and max are single elements
+			if values := client.FieldValueStrings(s.Min, proMap[s.Field]); len(values) > 0 {
+				fs.Min = values[0]
+			}
+			if values := client.FieldValueStrings(s.Max, proMap[s.Field]); len(values) > 0 {
+				fs.Max = values[0]
+			}
+		}
+		fs.Distinct = client.EstimateDistinct(fs.Sketch)
+		resp.FieldStats = append(resp.FieldStats, fs)
+	}
+}
+
 func deleteByQuery(ctx context.Context, store PartitionStore, req *vearchpb.SearchRequest, resp *vearchpb.DelByQueryeResponse) {
 	searchResponse := &vearchpb.SearchResponse{}
-	if err := store.Search(ctx, req, searchResponse); err != nil {
+	postFilter, err := newSearchPostFilter(store, req)
+	if err != nil {
+		log.Error("deleteByQuery post filter failed, err: [%s]", err.Error())
+		resp.Head = &vearchpb.ResponseHead{Err: vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, err).GetError()}
+		return
+	}
+	if postFilter != nil {
+		err = postFilter.search(ctx, store, req, searchResponse, nil)
+	} else {
+		err = store.Search(ctx, req, searchResponse)
+	}
+	if err != nil {
 		log.Error("deleteByQuery search doc failed, err: [%s]", err.Error())
 		head := &vearchpb.ResponseHead{Err: &vearchpb.Error{Code: vearchpb.ErrorEnum_DELETE_BY_QUERY_SERACH_ERR, Msg: "deleteByQuery search doc failed"}}
 		resp.Head = head
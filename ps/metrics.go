@@ -0,0 +1,97 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ps
+
+import (
+	"strconv"
+
+	prometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/ps/engine"
+	"github.com/vearch/vearch/util/log"
+)
+
+// partitionCollector exports the engine and raft state of the partitions of this ps
+type partitionCollector struct {
+	server *Server
+
+	docNum      *prometheus.Desc
+	indexStatus *prometheus.Desc
+	indexLag    *prometheus.Desc
+	raftIndex   *prometheus.Desc
+	memory      *prometheus.Desc
+}
+
+func newPartitionCollector(server *Server) *partitionCollector {
+	labels := []string{"space", "partition_id"}
+	return &partitionCollector{
+		server:      server,
+		docNum:      prometheus.NewDesc("vearch_ps_partition_doc_num", "documents of the partition", labels, nil),
+		indexStatus: prometheus.NewDesc("vearch_ps_partition_index_status", "index status of the engine, 2 is indexed", labels, nil),
+		indexLag:    prometheus.NewDesc("vearch_ps_partition_index_lag", "documents not indexed yet", labels, nil),
+		raftIndex:   prometheus.NewDesc("vearch_ps_partition_raft_index", "raft commit and applied index", append(labels, "type"), nil),
+		memory:      prometheus.NewDesc("vearch_ps_engine_memory_bytes", "memory used by the engine", append(labels, "type"), nil),
+	}
+}
+
+func (c *partitionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.docNum
+	ch <- c.indexStatus
+	ch <- c.indexLag
+	ch <- c.raftIndex
+	ch <- c.memory
+}
+
+func (c *partitionCollector) Collect(ch chan<- prometheus.Metric) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("collect partition metrics err:[%v]", r)
+		}
+	}()
+	c.server.RangePartition(func(pid entity.PartitionID, store PartitionStore) {
+		space := store.GetSpace()
+		labels := []string{space.Name, strconv.FormatUint(uint64(pid), 10)}
+
+		if eng := store.GetEngine(); eng != nil && !eng.HasClosed() {
+			status := &engine.EngineStatus{}
+			if err := eng.EngineStatus(status); err == nil {
+				lag := status.DocNum - status.MinIndexedNum
+				if lag < 0 {
+					lag = 0
+				}
+				ch <- prometheus.MustNewConstMetric(c.docNum, prometheus.GaugeValue, float64(status.DocNum), labels...)
+				ch <- prometheus.MustNewConstMetric(c.indexStatus, prometheus.GaugeValue, float64(status.IndexStatus), labels...)
+				ch <- prometheus.MustNewConstMetric(c.indexLag, prometheus.GaugeValue, float64(lag), labels...)
+			}
+			info := &engine.MemoryInfo{}
+			if err := eng.MemoryInfo(info); err == nil {
+				for typ, v := range map[string]int64{
+					"table":       info.TableMem,
+					"index":       info.IndexMem,
+					"vector":      info.VectorMem,
+					"field_range": info.FieldRangeMem,
+					"bitmap":      info.BitmapMem,
+				} {
+					ch <- prometheus.MustNewConstMetric(c.memory, prometheus.GaugeValue, float64(v), append(labels, typ)...)
+				}
+			}
+		}
+
+		if status := store.Status(); status != nil {
+			ch <- prometheus.MustNewConstMetric(c.raftIndex, prometheus.GaugeValue, float64(status.Commit), append(labels, "commit")...)
+			ch <- prometheus.MustNewConstMetric(c.raftIndex, prometheus.GaugeValue, float64(status.Applied), append(labels, "applied")...)
+		}
+	})
+}
@@ -36,6 +36,9 @@ type Base interface {
 	// Destroy close partition store if it running currently.
 	Close() error
 
+	// FlushAndClose flushes the engine and closes the store
+	FlushAndClose() error
+
 	// Destroy close partition store if it running currently and remove all data file from filesystem.
 	Destroy() error
 
@@ -81,6 +84,10 @@ type PartitionStore interface {
 
 	Flush(ctx context.Context) error
 
+	ProposeChecksum(ctx context.Context, buckets uint32) (*entity.ReplicaChecksum, error)
+
+	Checksum(index uint64) (*entity.ReplicaChecksum, error)
+
 	Search(ctx context.Context, query *vearchpb.SearchRequest, response *vearchpb.SearchResponse) error
 }
 
@@ -146,10 +153,56 @@ func (s *Server) LoadPartition(ctx context.Context, pid entity.PartitionID, spac
 	return store, nil
 }
 
+// LocalPartitions returns the partitions found in the data dirs of this ps,
+// whether they are loaded or not
+func (s *Server) LocalPartitions() []*entity.PartitionMeta {
+	metas := make([]*entity.PartitionMeta, 0)
+	for _, data := range config.Conf().GetDatas() {
+		for _, pid := range psutil.PartitionIDs(data) {
+			space, err := psutil.LoadPartitionMeta(data, pid)
+			if err != nil {
+				log.Error("load meta of partition:[%d] in data dir:[%s] err:[%v]", pid, data, err)
+				continue
+			}
+			modTime, err := psutil.PartitionMetaModTime(data, pid)
+			if err != nil {
+				log.Error("stat meta of partition:[%d] in data dir:[%s] err:[%v]", pid, data, err)
+				continue
+			}
+			metas = append(metas, &entity.PartitionMeta{PartitionID: pid, DataDir: data, Loaded: s.GetPartition(pid) != nil, Space: space, ModTime: modTime.Unix()})
+		}
+	}
+	return metas
+}
+
+// AdoptPartition loads a partition left in a data dir of this ps with the
+// space of the master, unlike CreatePartition its data is never removed
+func (s *Server) AdoptPartition(ctx context.Context, space *entity.Space, pid entity.PartitionID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.GetPartition(pid) != nil {
+		return nil
+	}
+	if err := s.checkMoving(pid); err != nil {
+		return err
+	}
+	if psutil.PartitionDataDir(config.Conf().GetDatas(), pid) == "" {
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_NOT_EXIST, fmt.Errorf("partition [%d] is in no data dir", pid))
+	}
+	log.Info("adopt partition:[%d] of space:[%s]", pid, space.Name)
+	_, err := s.LoadPartition(ctx, pid, []*entity.Space{space})
+	return err
+}
+
 func (s *Server) CreatePartition(ctx context.Context, space *entity.Space, pid entity.PartitionID) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if err := s.checkMoving(pid); err != nil {
+		return err
+	}
+
 	store, err := raftstore.CreateStore(ctx, pid, s.nodeID, space, s.raftServer, s, s.client)
 	if err != nil {
 		return err
@@ -182,6 +235,10 @@ func (s *Server) DeleteReplica(id entity.PartitionID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.deleteMoving(id) {
+		return
+	}
+
 	if p, ok := s.partitions.Load(id); ok {
 		s.partitions.Delete(id)
 		if partition, is := p.(PartitionStore); is {
@@ -192,7 +249,9 @@ func (s *Server) DeleteReplica(id entity.PartitionID) {
 		}
 	}
 
-	psutil.ClearPartition(config.Conf().GetDataDirBySlot(config.PS, id), id)
+	if path := psutil.PartitionDataDir(config.Conf().GetDatas(), id); path != "" {
+		psutil.ClearPartition(path, id)
+	}
 	log.Info("delete partition[%d] success", id)
 }
 
@@ -200,6 +259,10 @@ func (s *Server) DeletePartition(id entity.PartitionID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.deleteMoving(id) {
+		return
+	}
+
 	if p, ok := s.partitions.Load(id); ok {
 		if partition, is := p.(PartitionStore); is {
 			for _, r := range partition.GetPartition().Replicas {
@@ -216,7 +279,9 @@ func (s *Server) DeletePartition(id entity.PartitionID) {
 		}
 	}
 
-	psutil.ClearPartition(config.Conf().GetDataDirBySlot(config.PS, id), id)
+	if path := psutil.PartitionDataDir(config.Conf().GetDatas(), id); path != "" {
+		psutil.ClearPartition(path, id)
+	}
 	log.Info("delete partition:[%d] success", id)
 
 	// delete partition cache
@@ -225,6 +290,93 @@ func (s *Server) DeletePartition(id entity.PartitionID) {
 	}
 }
 
+// checkMoving returns an error when partition id is being moved, must be
+// called with mu held
+func (s *Server) checkMoving(id entity.PartitionID) error {
+	if _, ok := s.moving[id]; ok {
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_HAS_TASK_NOW, fmt.Errorf("partition [%d] is moving to another data dir", id))
+	}
+	return nil
+}
+
+// deleteMoving marks partition id deleted when it is being moved, the move
+// removes its files when it ends. It must be called with mu held.
+func (s *Server) deleteMoving(id entity.PartitionID) bool {
+	if _, ok := s.moving[id]; !ok {
+		return false
+	}
+	log.Info("partition:[%d] is moving, delete it when the move ends", id)
+	s.moving[id] = true
+	return true
+}
+
+// MovePartition moves partition id to data dir dataDir of this ps. It is
+// closed while its files are copied and then reopened with its raft log, so
+// it catches up with the other replicas without a membership change. The
+// partition is reopened where it was when the flush or the copy fails.
+func (s *Server) MovePartition(ctx context.Context, id entity.PartitionID, dataDir string) error {
+	s.mu.Lock()
+	if !psutil.IsDataDir(config.Conf().GetDatas(), dataDir) {
+		s.mu.Unlock()
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, fmt.Errorf("[%s] is not a data dir of this ps", dataDir))
+	}
+	if err := s.checkMoving(id); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	store := s.GetPartition(id)
+	if store == nil {
+		s.mu.Unlock()
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_NOT_EXIST, nil)
+	}
+	from := store.GetPartition().Path
+	if psutil.IsDataDir([]string{from}, dataDir) {
+		s.mu.Unlock()
+		return nil
+	}
+	space := store.GetSpace()
+	s.moving[id] = false
+	s.partitions.Delete(id)
+	s.mu.Unlock()
+
+	// the copy may take long, the other partitions are created and deleted
+	// meanwhile
+	log.Info("move partition:[%d] from [%s] to [%s]", id, from, dataDir)
+	moveErr := store.FlushAndClose()
+	if moveErr != nil {
+		log.Error("flush partition:[%d] before move err:[%v], reopen it in [%s]", id, moveErr, from)
+	} else if moveErr = psutil.MovePartitionPaths(from, dataDir, id); moveErr != nil {
+		log.Error("move partition:[%d] err:[%v], reopen it in [%s]", id, moveErr, from)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deleted := s.moving[id]
+	delete(s.moving, id)
+	if deleted {
+		if path := psutil.PartitionDataDir(config.Conf().GetDatas(), id); path != "" {
+			psutil.ClearPartition(path, id)
+		}
+		log.Info("delete partition:[%d] moved to [%s] success", id, dataDir)
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_NOT_EXIST, nil)
+	}
+
+	newStore, err := raftstore.CreateStore(ctx, id, s.nodeID, &space, s.raftServer, s, s.client)
+	if err != nil {
+		return err
+	}
+	newStore.RsStatusC = s.replicasStatusC
+	if err = newStore.Start(); err != nil {
+		return err
+	}
+	s.partitions.Store(id, newStore)
+	if moveErr != nil {
+		return moveErr
+	}
+	log.Info("move partition:[%d] to [%s] success", id, dataDir)
+	return nil
+}
+
 func (s *Server) PartitionNum() int {
 	var count int
 	s.partitions.Range(func(key, value interface{}) bool {
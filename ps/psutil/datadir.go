@@ -0,0 +1,177 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package psutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/util/log"
+	vos "github.com/vearch/vearch/util/runtime/os"
+)
+
+// a data dir with less available space than this is not chosen for new partitions
+const minDataDirAvail = 1 << 30
+
+// PartitionDataDir returns the data dir of datas holding partition id, "" if none
+func PartitionDataDir(datas []string, id entity.PartitionID) string {
+	for _, data := range datas {
+		_, _, meta := GetPartitionPaths(data, id)
+		if _, err := os.Stat(meta); err == nil {
+			return data
+		}
+	}
+	return ""
+}
+
+// IsDataDir reports whether dir is one of datas
+func IsDataDir(datas []string, dir string) bool {
+	for _, data := range datas {
+		if filepath.Clean(data) == filepath.Clean(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionNum is the number of partitions in data dir
+func partitionNum(data string) int {
+	dir, err := os.ReadDir(filepath.Join(data, "meta"))
+	if err != nil {
+		return 0
+	}
+	n := 0
+	for _, fi := range dir {
+		if fi.IsDir() {
+			n++
+		}
+	}
+	return n
+}
+
+// PartitionIDs returns the ids of the partitions in data dir
+func PartitionIDs(data string) []entity.PartitionID {
+	dir, err := os.ReadDir(filepath.Join(data, "meta"))
+	if err != nil {
+		return nil
+	}
+	ids := make([]entity.PartitionID, 0, len(dir))
+	for _, fi := range dir {
+		if !fi.IsDir() {
+			continue
+		}
+		id, err := strconv.ParseUint(fi.Name(), 10, 32)
+		if err != nil {
+			log.Warn("unknown partition dir:[%s] in data dir:[%s]", fi.Name(), data)
+			continue
+		}
+		ids = append(ids, entity.PartitionID(id))
+	}
+	return ids
+}
+
+// ChooseDataDir returns the data dir for a new partition. It is the dir with
+// the most available space for each of its partitions, dirs which are almost
+// full are only chosen when all of them are.
+func ChooseDataDir(datas []string) string {
+	if len(datas) == 1 {
+		return datas[0]
+	}
+	best, bestScore, bestFull := "", 0.0, true
+	for _, data := range datas {
+		if err := os.MkdirAll(data, os.ModePerm); err != nil {
+			log.Error("create data dir:[%s] err:[%v]", data, err)
+			continue
+		}
+		total, avail, err := vos.DiskUsage(data)
+		if err != nil {
+			log.Error("disk usage of data dir:[%s] err:[%v]", data, err)
+			continue
+		}
+		full := avail < minDataDirAvail && float64(avail) < float64(total)*0.05
+		score := float64(avail) / float64(partitionNum(data)+1)
+		if best == "" || (bestFull && !full) || (full == bestFull && score > bestScore) {
+			best, bestScore, bestFull = data, score, full
+		}
+	}
+	if best == "" {
+		return datas[0]
+	}
+	return best
+}
+
+// MovePartitionPaths copies the files of partition id from data dir from to
+// data dir to and removes them from from. The meta is copied last and
+// removed first, so the partition is in one dir only once it is complete.
+func MovePartitionPaths(from, to string, id entity.PartitionID) error {
+	fromData, fromRaft, fromMeta := GetPartitionPaths(from, id)
+	toData, toRaft, toMeta := GetPartitionPaths(to, id)
+	for _, p := range [][2]string{{fromData, toData}, {fromRaft, toRaft}, {fromMeta, toMeta}} {
+		if err := os.RemoveAll(p[1]); err != nil {
+			return err
+		}
+		if err := copyDir(p[0], p[1]); err != nil {
+			ClearPartition(to, id)
+			return fmt.Errorf("copy [%s] to [%s] err: %v", p[0], p[1], err)
+		}
+	}
+	if err := os.RemoveAll(fromMeta); err != nil {
+		return err
+	}
+	ClearPartition(from, id)
+	return nil
+}
+
+func copyDir(from, to string) error {
+	return filepath.Walk(from, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(from, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(to, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(from, to string, mode os.FileMode) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(to, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err = out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -141,7 +141,13 @@ func partitionPath(data string, id entity.PartitionID, pathType string) string {
 	return filepath.Join(data, pathType, fmt.Sprintf("%d", id))
 }
 
-func CreatePartitionPaths(dataPath string, space *entity.Space, id entity.PartitionID) (data, raft, meta string, err error) {
+// CreatePartitionPaths creates the paths of partition id in the data dir
+// already holding it, or in the one chosen by ChooseDataDir for a new partition
+func CreatePartitionPaths(datas []string, space *entity.Space, id entity.PartitionID) (dataPath, data, raft, meta string, err error) {
+	if dataPath = PartitionDataDir(datas, id); dataPath == "" {
+		dataPath = ChooseDataDir(datas)
+		log.Info("partition:[%d] is created in data dir:[%s]", id, dataPath)
+	}
 	data, raft, meta = GetPartitionPaths(dataPath, id)
 
 	if err = os.MkdirAll(data, os.ModePerm); err != nil {
@@ -184,6 +190,16 @@ func LoadPartitionMeta(dataPath string, id entity.PartitionID) (*entity.Space, e
 	return space, nil
 }
 
+// PartitionMetaModTime returns when the meta of the partition was last saved
+func PartitionMetaModTime(dataPath string, id entity.PartitionID) (time.Time, error) {
+	_, _, meta := GetPartitionPaths(dataPath, id)
+	fi, err := os.Stat(path.Join(meta, "meta.txt"))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fi.ModTime(), nil
+}
+
 func SavePartitionMeta(dataPath string, id entity.PartitionID, space *entity.Space) error {
 	_, _, meta := GetPartitionPaths(dataPath, id)
 	bytes, err := cbjson.Marshal(space)
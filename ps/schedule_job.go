@@ -19,11 +19,11 @@ import (
 	"time"
 
 	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/master/store"
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/ps/psutil"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/slice"
-	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 // this job for heartbeat master 1m once
@@ -48,7 +48,7 @@ func (s *Server) StartHeartbeatJob() {
 				CommitID:     config.GetCommitID(),
 			},
 		}
-		var leaseId clientv3.LeaseID = 0
+		var leaseId store.LeaseID = 0
 		var lastPartitionIds []entity.PartitionID
 
 		if s.stopping.Get() {
@@ -56,6 +56,7 @@ func (s *Server) StartHeartbeatJob() {
 		}
 
 		server.PartitionIds = psutil.GetAllPartitions(config.Conf().GetDatas())
+		s.checkDiskWatermark(server)
 		ctx := context.Background()
 		keepaliveC, err := s.client.Master().KeepAlive(ctx, server)
 		if err != nil {
@@ -75,11 +76,12 @@ func (s *Server) StartHeartbeatJob() {
 				}
 
 				server.PartitionIds = psutil.GetAllPartitions(config.Conf().GetDatas())
-				if slice.EqualUint32(lastPartitionIds, server.PartitionIds) {
+				watermarkChanged := s.checkDiskWatermark(server)
+				if slice.EqualUint32(lastPartitionIds, server.PartitionIds) && !watermarkChanged {
 					// log.Debug("PartitionIds not change, do nothing!")
 					continue
 				}
-				log.Info("server.PartitionIds has changed, need to put server to topo again!, leaseId: [%d]", leaseId)
+				log.Info("server.PartitionIds or disk watermark has changed, need to put server to topo again!, leaseId: [%d]", leaseId)
 
 				if err := s.client.Master().PutServerWithLeaseID(ctx, server, leaseId); err != nil {
 					log.Error("PutServerWithLeaseID[leaseId: %d] err:", leaseId, err.Error())
@@ -0,0 +1,214 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package ps
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/engine/sdk/go/gamma"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/ps/engine"
+	"github.com/vearch/vearch/ps/engine/filter"
+	"github.com/vearch/vearch/ps/engine/sortorder"
+	"github.com/vearch/vearch/util/log"
+)
+
+// postFilterMaxPool bounds the hits one engine search returns to the post
+// filter, a page still short there is returned truncated
+const postFilterMaxPool = 100000
+
+// searchPostFilter drops engine hits by what the engine can not evaluate
+// itself: the search_after cursor. The bool filter is a docid bitmap of the
+// engine, applied before the search.
+type searchPostFilter struct {
+	space       entity.Space
+	idIsLong    bool
+	topN        int32
+	pool        int32
+	searchAfter *sortorder.SearchAfter
+	sortOrder   sortorder.SortOrder
+	// the queries which kept less than topN of a whole pool
+	short []bool
+}
+
+// newSearchPostFilter returns nil if request needs no post filtering.
+func newSearchPostFilter(store PartitionStore, request *vearchpb.SearchRequest) (*searchPostFilter, error) {
+	if request.SearchAfter == nil {
+		return nil, nil
+	}
+	space := store.GetSpace()
+	pf := &searchPostFilter{
+		space:    space,
+		idIsLong: space.Engine != nil && strings.EqualFold(space.Engine.IdType, "long"),
+		topN:     request.TopN,
+		pool:     request.TopN,
+	}
+	searchAfter, err := client.GetSearchAfter(request.SearchAfter, &pf.space, pf.idIsLong, request.SortFields)
+	if err != nil {
+		return nil, err
+	}
+	pf.searchAfter = searchAfter
+	for _, sortF := range request.SortFields {
+		pf.sortOrder = append(pf.sortOrder, &sortorder.SortField{Field: sortF.Field, Desc: sortF.Type})
+	}
+	return pf, nil
+}
+
+// search runs the engine search of request with a growing pool of hits until
+// the post filter keeps topN of them for every query, the partition has no
+// more hits or the pool reaches postFilterMaxPool. Then the short results are
+// marked truncated. Errors of the filter are returned as PARAM_ERROR, the
+// engine took and the filter took are added to profile when it is not nil.
+func (pf *searchPostFilter) search(ctx context.Context, store PartitionStore, request *vearchpb.SearchRequest, response *vearchpb.SearchResponse, profile *vearchpb.SearchProfile) error {
+	var status engine.EngineStatus
+	if err := store.GetEngine().EngineStatus(&status); err != nil {
+		return err
+	}
+	// the engine never returns more hits than the docids it has given
+	docs := status.MaxDocid + 1
+	pool, maxPool := pf.pool, int32(postFilterMaxPool)
+	if pool > docs && docs >= pf.topN {
+		pool = docs
+	}
+	if maxPool > docs {
+		maxPool = docs
+	}
+	defer func() { request.TopN = pf.topN }()
+
+	truncated, err := filter.FillPage(pool, maxPool, func(pool int32) (bool, error) {
+		response.FlatBytes, response.Results = nil, nil
+		request.TopN = pool
+		engineStart := time.Now()
+		err := store.Search(ctx, request, response)
+		if profile != nil {
+			profile.EngineMs += time.Since(engineStart).Seconds() * 1000
+			// read before the post filter deserializes the flat bytes
+			if err := gamma.DeSerializeProfile(response.FlatBytes, profile); err != nil {
+				log.Warn("read search profile failed, err: [%s]", err.Error())
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+		setFilterStats(request, response)
+		filterStart := time.Now()
+		if err := pf.apply(request, response); err != nil {
+			return false, vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, err)
+		}
+		if profile != nil {
+			profile.PostFilterMs += time.Since(filterStart).Seconds() * 1000
+		}
+		for _, short := range pf.short {
+			if short {
+				return pool < docs, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if truncated {
+		for i, result := range response.Results {
+			if i < len(pf.short) && pf.short[i] {
+				result.Truncated = true
+			}
+		}
+	}
+	return nil
+}
+
+// apply filters the hits of response, the engine search of which asked for
+// request.TopN hits. The engine result is deserialized here, so the hits left
+// are returned in Results instead of FlatBytes.
+func (pf *searchPostFilter) apply(request *vearchpb.SearchRequest, response *vearchpb.SearchResponse) error {
+	pf.short = nil
+	if response.FlatBytes == nil {
+		return nil
+	}
+	gamma.DeSerialize(response.FlatBytes, response)
+	response.FlatBytes = nil
+
+	for _, result := range response.Results {
+		hits := len(result.ResultItems)
+		items := result.ResultItems[:0]
+		// sort values and primary keys of the kept items
+		var sortValues []sortorder.SortValues
+		var keys []sortorder.SortValue
+		for _, item := range result.ResultItems {
+			_, values, pKey, err := client.GetSource(item, &pf.space, pf.idIsLong, request.SortFieldMap, request.SortFields)
+			if err != nil {
+				return err
+			}
+			key := client.PKeySortValue(pKey, pf.idIsLong)
+			if !pf.sortOrder.After(values, key, pf.searchAfter) {
+				continue
+			}
+			sortValues = append(sortValues, values)
+			keys = append(keys, key)
+			items = append(items, item)
+		}
+		// the engine returns the pool in score order, a page after the cursor
+		// is the first topN hits of it in the sort order
+		sort.Stable(&sortedHits{so: pf.sortOrder, items: items, values: sortValues, keys: keys})
+		if pf.topN > 0 && int32(len(items)) > pf.topN {
+			items = items[:pf.topN]
+		}
+		result.ResultItems = items
+		pf.short = append(pf.short, pf.topN > 0 && int32(len(items)) < pf.topN && int32(hits) >= request.TopN)
+	}
+	return nil
+}
+
+// setFilterStats copies the docs the engine checked and kept by the bool
+// filter of request into the head of response, for the slow log. It reads the
+// engine result, so it runs before that is deserialized.
+func setFilterStats(request *vearchpb.SearchRequest, response *vearchpb.SearchResponse) {
+	if request.BoolFilter == nil || response.Head == nil {
+		return
+	}
+	scanned, matched := gamma.DeSerializeFilterStats(response.FlatBytes)
+	if response.Head.Params == nil {
+		response.Head.Params = make(map[string]string)
+	}
+	response.Head.Params[client.FilterScanned] = strconv.FormatInt(scanned, 10)
+	response.Head.Params[client.FilterMatched] = strconv.FormatInt(matched, 10)
+}
+
+// sortedHits sorts the hits kept after a search_after cursor
+type sortedHits struct {
+	so     sortorder.SortOrder
+	items  []*vearchpb.ResultItem
+	values []sortorder.SortValues
+	keys   []sortorder.SortValue
+}
+
+func (h *sortedHits) Len() int { return len(h.items) }
+
+func (h *sortedHits) Less(i, j int) bool {
+	return h.so.CompareWithKey(h.values[i], h.keys[i], h.values[j], h.keys[j]) < 0
+}
+
+func (h *sortedHits) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.values[i], h.values[j] = h.values[j], h.values[i]
+	h.keys[i], h.keys[j] = h.keys[j], h.keys[i]
+}
@@ -23,8 +23,10 @@ import (
 	"time"
 
 	"github.com/cubefs/cubefs/depends/tiglabs/raft"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/monitor"
 	"github.com/vearch/vearch/proto/entity"
 	_ "github.com/vearch/vearch/ps/engine/gammacb"
 	"github.com/vearch/vearch/ps/psutil"
@@ -63,6 +65,10 @@ type Server struct {
 	concurrent      chan bool
 	concurrentNum   int
 	rpcTimeOut      int
+
+	// the partitions being moved between data dirs, guarded by mu, true
+	// when the partition is deleted during the move
+	moving map[entity.PartitionID]bool
 }
 
 // NewServer create server instance
@@ -76,6 +82,7 @@ func NewServer(ctx context.Context) *Server {
 	s := &Server{
 		client:          cli,
 		raftResolver:    raftstore.NewRaftResolver(),
+		moving:          make(map[entity.PartitionID]bool),
 		changeLeaderC:   changeLeaderC,
 		replicasStatusC: replicasStatusC,
 	}
@@ -121,10 +128,12 @@ func (s *Server) Start() error {
 	s.ip = server.Ip
 	mserver.SetIp(server.Ip, true)
 
-	// create raft server
-	s.raftServer, err = raftstore.StartRaftServer(nodeId, s.ip, s.raftResolver)
-	if err != nil {
-		log.Panic(fmt.Sprintf("ps StartRaftServer error :%v", err))
+	// create raft server, the partitions of a standalone ps have none
+	if !config.Conf().Global.Standalone {
+		s.raftServer, err = raftstore.StartRaftServer(nodeId, s.ip, s.raftResolver)
+		if err != nil {
+			log.Panic(fmt.Sprintf("ps StartRaftServer error :%v", err))
+		}
 	}
 
 	// create and recover partitions
@@ -144,6 +153,11 @@ func (s *Server) Start() error {
 	ExportToRpcHandler(s)
 	ExportToRpcAdminHandler(s)
 
+	if port := config.Conf().PS.MonitorPort; port > 0 {
+		prometheus.MustRegister(newPartitionCollector(s))
+		monitor.Register(nil, nil, port)
+	}
+
 	log.Info("vearch server successful startup...")
 
 	s.wg.Wait()
@@ -25,6 +25,8 @@ import (
 	"github.com/vearch/vearch/ps/psutil"
 	"github.com/vearch/vearch/util/cbjson"
 	"github.com/vearch/vearch/util/log"
+	"github.com/vearch/vearch/util/tracer"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // replicas status,behind leader or equal leader
@@ -86,7 +88,10 @@ func (s *Store) Apply(command []byte, index uint64) (resp interface{}, err error
 		panic(err)
 	}
 
+	_, span := tracer.Start(tracer.Extract(s.Ctx, raftCmd.Trace), "raft apply",
+		attribute.String("type", raftCmd.Type.String()), attribute.Int64("index", int64(index)))
 	resp = s.innerApply(command, index, raftCmd)
+	tracer.End(span, resp.(*RaftApplyResponse).Err)
 
 	if err := raftCmd.Close(); err != nil {
 		log.Error(err.Error())
@@ -125,6 +130,8 @@ func (s *Store) innerApply(command []byte, index uint64, raftCmd *vearchpb.RaftC
 		flushC, err := s.Engine.Writer().Commit(s.Ctx, int64(index))
 		resp.FlushC = flushC
 		resp.Err = err
+	case vearchpb.CmdType_CHECKSUM:
+		s.applyChecksum(index, raftCmd, resp)
 	default:
 		log.Error("unsupported command[%s]", raftCmd.Type)
 		resp.SetErr(fmt.Errorf("unsupported command[%s]", raftCmd.Type))
@@ -58,12 +58,16 @@ type Store struct {
 	raftDiffCount uint64
 	RsStatusC     chan *ReplicasStatusEntry
 	RsStatusMap   sync.Map
+	checksums     checksumList
+	// the log and the index of the commands applied without raft
+	localMu    sync.Mutex
+	localLog   *wal.Storage
+	localIndex uint64
 }
 
 // CreateStore create an instance of Store.
 func CreateStore(ctx context.Context, pID entity.PartitionID, nodeID entity.NodeID, space *entity.Space, raftServer *raft.RaftServer, eventListener EventListener, client *client.Client) (*Store, error) {
-	path := config.Conf().GetDataDirBySlot(config.PS, pID)
-	dataPath, raftPath, metaPath, err := psutil.CreatePartitionPaths(path, space, pID) //FIXME: it will double writer space when load space
+	path, dataPath, raftPath, metaPath, err := psutil.CreatePartitionPaths(config.Conf().GetDatas(), space, pID) //FIXME: it will double writer space when load space
 
 	if err != nil {
 		return nil, err
@@ -137,6 +141,16 @@ func (s *Store) Start() (err error) {
 
 	s.Partition.SetStatus(entity.PA_READONLY)
 
+	if s.isLocal() {
+		if err = s.startLocal(apply); err != nil {
+			s.Engine.Close()
+			return err
+		}
+		s.startFlushJob()
+		s.startTruncateJob(apply)
+		return nil
+	}
+
 	raftStore, err := wal.NewStorage(s.RaftPath, nil)
 	if err != nil {
 		s.Engine.Close()
@@ -179,7 +193,11 @@ func (s *Store) Start() (err error) {
 
 // Destroy close partition store if it running currently.
 func (s *Store) Close() error {
-	if err := s.RaftServer.RemoveRaft(uint64(s.Partition.Id)); err != nil {
+	if s.isLocal() {
+		s.localMu.Lock()
+		defer s.localMu.Unlock()
+		defer s.closeLocal()
+	} else if err := s.RaftServer.RemoveRaft(uint64(s.Partition.Id)); err != nil {
 		log.Error("close raft server err : %s , Partition.Id: %d", err.Error(), s.Partition.Id)
 		return err
 	}
@@ -193,6 +211,35 @@ func (s *Store) Close() error {
 	return nil
 }
 
+// FlushAndClose stops the raft of partition, flushes what it applied to the
+// engine and closes the store. It returns once the engine has closed its
+// files, they are complete unless the flush fails.
+func (s *Store) FlushAndClose() error {
+	if s.isLocal() {
+		s.localMu.Lock()
+		defer s.localMu.Unlock()
+		defer s.closeLocal()
+	} else if err := s.RaftServer.RemoveRaft(uint64(s.Partition.Id)); err != nil {
+		log.Error("close raft server err : %s , Partition.Id: %d", err.Error(), s.Partition.Id)
+		return err
+	}
+	defer func() {
+		s.CtxCancel()
+		s.Partition.SetStatus(entity.PA_CLOSED)
+	}()
+	if s.Engine == nil {
+		return nil
+	}
+	err := s.Engine.Writer().Flush(s.Ctx, s.Sn)
+	s.Engine.Close()
+	// wait engine close
+	for i := 1; !s.Engine.HasClosed(); i++ {
+		time.Sleep(1 * time.Second)
+		log.Debug("wait stop engine times:[%d]", i)
+	}
+	return err
+}
+
 // Destroy close partition store if it running currently and remove all data file from filesystem.
 func (s *Store) Destroy() (err error) {
 	if err = s.Close(); err != nil {
@@ -217,14 +264,23 @@ func (s *Store) IsLeader() bool {
 }
 
 func (s *Store) Status() *raft.Status {
+	if s.isLocal() {
+		return s.localStatus()
+	}
 	return s.RaftServer.Status(uint64(s.Partition.Id))
 }
 
 func (s *Store) GetLeader() (entity.NodeID, uint64) {
+	if s.isLocal() {
+		return s.NodeID, 1
+	}
 	return s.RaftServer.LeaderTerm(uint64(s.Partition.Id))
 }
 
 func (s *Store) TryToLeader() error {
+	if s.isLocal() {
+		return nil
+	}
 	future := s.RaftServer.TryToLeader(uint64(s.Partition.Id))
 	response, err := future.Response()
 	if response != nil && response.(*RaftApplyResponse).Err != nil {
@@ -234,6 +290,9 @@ func (s *Store) TryToLeader() error {
 }
 
 func (s *Store) GetUnreachable(id uint64) []uint64 {
+	if s.isLocal() {
+		return nil
+	}
 	return s.RaftServer.GetUnreachable(id)
 }
 
@@ -247,6 +306,9 @@ func (s *Store) RemoveDataPath() (err error) {
 }
 
 func (s *Store) ChangeMember(changeType proto.ConfChangeType, server *entity.Server) error {
+	if s.isLocal() {
+		return s.errLocal("change member")
+	}
 	id := uint64(s.Partition.Id)
 
 	peer := proto.Peer{
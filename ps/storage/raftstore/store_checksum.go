@@ -0,0 +1,189 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package raftstore
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/ps/engine/mapping"
+	"github.com/vearch/vearch/util/log"
+)
+
+// checksumKeep is the number of checksums a replica keeps for the checker
+const checksumKeep = 16
+
+// checksumChunk is the number of docids a checksum command hashes, so that a
+// replica applies the other commands between two chunks
+const checksumChunk = 10000
+
+// checksumList is the checksums of the last checks applied by a replica
+type checksumList struct {
+	sync.Mutex
+	list []*entity.ReplicaChecksum
+	// the bucket check whose chunks are being applied
+	pending *entity.ReplicaChecksum
+}
+
+func (c *checksumList) add(sum *entity.ReplicaChecksum) {
+	c.Lock()
+	defer c.Unlock()
+	c.list = append(c.list, sum)
+	if len(c.list) > checksumKeep {
+		c.list = c.list[len(c.list)-checksumKeep:]
+	}
+}
+
+func (c *checksumList) get(index uint64) (sum *entity.ReplicaChecksum, pending bool) {
+	c.Lock()
+	defer c.Unlock()
+	for _, sum := range c.list {
+		if sum.Index == index {
+			return sum, false
+		}
+	}
+	return nil, c.pending != nil && c.pending.Index == index
+}
+
+// ProposeChecksum makes all the replicas compute their checksum when they
+// apply the same raft indexes, it returns the one of the leader. A bucket
+// check is proposed as a command per chunk of docids, its index is the one
+// of the first command.
+func (s *Store) ProposeChecksum(ctx context.Context, buckets uint32) (*entity.ReplicaChecksum, error) {
+	var index uint64
+	for start := int32(0); ; start += checksumChunk {
+		if err := ctx.Err(); err != nil {
+			return nil, vearchpb.NewError(vearchpb.ErrorEnum_TIMEOUT, err)
+		}
+		if err := s.checkWritable(); err != nil {
+			return nil, err
+		}
+		raftCmd := vearchpb.CreateRaftCommand()
+		raftCmd.Type = vearchpb.CmdType_CHECKSUM
+		raftCmd.ChecksumBuckets = buckets
+		raftCmd.ChecksumIndex = index
+		raftCmd.ChecksumStart = start
+		data, err := raftCmd.Marshal()
+		if e := raftCmd.Close(); e != nil {
+			log.Error("raft cmd close err : %s", e.Error())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.submit(data)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Err != nil || resp.Checksum != nil {
+			return resp.Checksum, resp.Err
+		}
+		index = resp.ChecksumIndex
+	}
+}
+
+// Checksum returns the checksum computed by the replica when it applied
+// index, it is nil when the replica has not applied it yet
+func (s *Store) Checksum(index uint64) (*entity.ReplicaChecksum, error) {
+	sum, pending := s.checksums.get(index)
+	if sum != nil || pending || uint64(s.Sn) < index {
+		return sum, nil
+	}
+	return nil, fmt.Errorf("partition:[%d] applied index:[%d] without keeping its checksum", s.Partition.Id, index)
+}
+
+// applyChecksum counts the documents and, when buckets is not 0, sums the
+// hashes of their fields by the bucket of their primary key. The documents
+// are hashed by chunks of docids, one per command, and the checksum is kept
+// when the last chunk is applied. The replicas applying the same log have the
+// same docids, so a document written between two chunks is hashed twice or
+// not at all the same way by all of them.
+func (s *Store) applyChecksum(index uint64, cmd *vearchpb.RaftCommand, resp *RaftApplyResponse) {
+	s.checksums.Lock()
+	sum := s.checksums.pending
+	s.checksums.Unlock()
+
+	if cmd.ChecksumIndex == 0 {
+		sum = &entity.ReplicaChecksum{NodeID: s.NodeID, Index: index}
+		count, err := s.Engine.Reader().DocCount(s.Ctx)
+		if err != nil {
+			sum.Error = err.Error()
+		}
+		sum.DocCount = count
+		if err != nil || cmd.ChecksumBuckets == 0 {
+			s.checksums.add(sum)
+			resp.Checksum = sum
+			return
+		}
+		sum.Buckets = make([]uint64, cmd.ChecksumBuckets)
+	} else if sum == nil || sum.Index != cmd.ChecksumIndex {
+		// the replica restarted or installed a snapshot during the check
+		sum = &entity.ReplicaChecksum{NodeID: s.NodeID, Index: cmd.ChecksumIndex, Error: "check interrupted on the replica"}
+	}
+
+	_, _, maxDocid := s.Engine.IndexInfo()
+	end := int(cmd.ChecksumStart) + checksumChunk
+	if end > maxDocid {
+		end = maxDocid
+	}
+	for docID := int(cmd.ChecksumStart); docID < end && sum.Error == ""; docID++ {
+		doc := &vearchpb.Document{PKey: strconv.Itoa(docID)}
+		// deleted documents are not found
+		if err := s.Engine.Reader().GetDoc(s.Ctx, doc, true); err != nil {
+			continue
+		}
+		key, hash := docHash(doc)
+		sum.Buckets[key%uint32(len(sum.Buckets))] += hash
+	}
+
+	s.checksums.Lock()
+	if end < maxDocid {
+		s.checksums.pending = sum
+		s.checksums.Unlock()
+		resp.ChecksumIndex = sum.Index
+		return
+	}
+	s.checksums.pending = nil
+	s.checksums.Unlock()
+	s.checksums.add(sum)
+	resp.Checksum = sum
+	log.Info("partition:[%d] checksum of index:[%d] docs:[%d] buckets:[%d]", s.Partition.Id, sum.Index, sum.DocCount, len(sum.Buckets))
+}
+
+// docHash returns the hash of the primary key and the one of all the fields
+// of a document, the fields are hashed in the order of their names
+func docHash(doc *vearchpb.Document) (uint32, uint64) {
+	fields := make([]*vearchpb.Field, len(doc.Fields))
+	copy(fields, doc.Fields)
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+
+	key, hash := fnv.New32a(), fnv.New64a()
+	for _, field := range fields {
+		if field.Name == mapping.IdField {
+			key.Write(field.Value)
+		}
+		hash.Write([]byte(field.Name))
+		hash.Write([]byte{0})
+		hash.Write(field.Value)
+		hash.Write([]byte{0})
+	}
+	return key.Sum32(), hash.Sum64()
+}
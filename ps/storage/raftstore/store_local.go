@@ -0,0 +1,171 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package raftstore
+
+import (
+	"fmt"
+
+	"github.com/cubefs/cubefs/depends/tiglabs/raft"
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/storage/wal"
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/log"
+)
+
+// A store without raft server is the only replica of its partition in a
+// standalone cluster. It appends the commands to a local log, the raft wal
+// used without raft, before it applies them to the engine, so what the
+// engine has not flushed is applied again from the log when the store
+// starts. The log is truncated behind the flushes as the raft log is.
+
+// localReplaySize is the bytes of logged commands read at a time on start
+const localReplaySize = 4 << 20
+
+// isLocal is whether the store applies its commands without raft
+func (s *Store) isLocal() bool {
+	return s.RaftServer == nil
+}
+
+// submit applies the command through raft, or logs and applies it when local
+func (s *Store) submit(data []byte) (*RaftApplyResponse, error) {
+	if s.isLocal() {
+		s.localMu.Lock()
+		defer s.localMu.Unlock()
+		if s.Partition.GetStatus() == entity.PA_CLOSED {
+			return nil, vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_IS_CLOSED, nil)
+		}
+		entry := &proto.Entry{Type: proto.EntryNormal, Term: 1, Index: s.localIndex + 1, Data: data}
+		if err := s.localLog.StoreEntries([]*proto.Entry{entry}); err != nil {
+			return nil, fmt.Errorf("partition[%d] log command %d err: %v", s.Partition.Id, entry.Index, err)
+		}
+		s.localIndex = entry.Index
+		resp, err := s.Apply(data, entry.Index)
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*RaftApplyResponse), nil
+	}
+
+	future := s.RaftServer.Submit(uint64(s.Partition.Id), data)
+	resp, err := future.Response()
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*RaftApplyResponse), nil
+}
+
+// startLocal opens the local log, applies the commands logged after apply,
+// the index the engine has flushed, and makes the store the leader of its
+// partition
+func (s *Store) startLocal(apply int64) (err error) {
+	if len(s.Partition.Replicas) > 1 {
+		return fmt.Errorf("partition[%d] has replicas %v, standalone ps can only have one", s.Partition.Id, s.Partition.Replicas)
+	}
+	if s.localLog, err = wal.NewStorage(s.RaftPath, nil); err != nil {
+		return fmt.Errorf("start partition[%d] open local log error: %s", s.Partition.Id, err.Error())
+	}
+	if err = s.replayLocal(uint64(apply)); err != nil {
+		s.localLog.Close()
+		return err
+	}
+	log.Info("partition[%d] started without raft, flushed index: %d, applied index: %d", s.Partition.Id, apply, s.localIndex)
+	s.HandleLeaderChange(uint64(s.NodeID))
+	return nil
+}
+
+// replayLocal applies the logged commands after the flushed index, a log
+// ending before it, as one lost or of a partition written before it was
+// kept, starts over after it
+func (s *Store) replayLocal(flushed uint64) error {
+	s.localIndex = flushed
+	s.Sn = int64(flushed)
+	first, err := s.localLog.FirstIndex()
+	if err != nil {
+		return err
+	}
+	last, err := s.localLog.LastIndex()
+	if err != nil {
+		return err
+	}
+	if last <= flushed {
+		if last < flushed {
+			return s.localLog.ApplySnapshot(proto.SnapshotMeta{Index: flushed, Term: 1})
+		}
+		return nil
+	}
+	if first > flushed+1 {
+		return fmt.Errorf("partition[%d] local log starts at %d after the flushed index %d", s.Partition.Id, first, flushed)
+	}
+	for lo := flushed + 1; lo <= last; {
+		entries, _, err := s.localLog.Entries(lo, last+1, localReplaySize)
+		if err != nil {
+			return fmt.Errorf("partition[%d] read local log from %d err: %v", s.Partition.Id, lo, err)
+		}
+		if len(entries) == 0 {
+			return fmt.Errorf("partition[%d] local log has no command %d", s.Partition.Id, lo)
+		}
+		for _, entry := range entries {
+			if _, err := s.Apply(entry.Data, entry.Index); err != nil {
+				return err
+			}
+			s.localIndex = entry.Index
+		}
+		lo = s.localIndex + 1
+	}
+	log.Info("partition[%d] applied the local log from %d to %d", s.Partition.Id, flushed+1, last)
+	return nil
+}
+
+// truncateLocal drops the logged commands up to index, they are flushed
+func (s *Store) truncateLocal(index uint64) error {
+	s.localMu.Lock()
+	defer s.localMu.Unlock()
+	if s.Partition.GetStatus() == entity.PA_CLOSED {
+		return vearchpb.NewError(vearchpb.ErrorEnum_PARTITION_IS_CLOSED, nil)
+	}
+	return s.localLog.Truncate(index)
+}
+
+// closeLocal closes the local log, the caller holds localMu
+func (s *Store) closeLocal() {
+	if s.localLog != nil {
+		s.localLog.Close()
+	}
+}
+
+// localStatus is the raft status of a single replica which is its leader
+func (s *Store) localStatus() *raft.Status {
+	index := uint64(s.Sn)
+	return &raft.Status{
+		ID:      uint64(s.Partition.Id),
+		NodeID:  uint64(s.NodeID),
+		Leader:  uint64(s.NodeID),
+		Term:    1,
+		Index:   index,
+		Commit:  index,
+		Applied: index,
+		Vote:    uint64(s.NodeID),
+		State:   "StateLeader",
+		Replicas: map[uint64]*raft.ReplicaStatus{
+			uint64(s.NodeID): {Match: index, Commit: index, Next: index + 1, State: "ReplicaStateReplicate", Active: true},
+		},
+	}
+}
+
+// errLocal is returned by the raft operations a local store does not have
+func (s *Store) errLocal(op string) error {
+	return fmt.Errorf("partition[%d] of a standalone ps has no raft to %s", s.Partition.Id, op)
+}
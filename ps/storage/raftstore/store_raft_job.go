@@ -38,6 +38,9 @@ var fct int32 // flush count threshold
 // truncate is raft log truncate.
 func (s *Store) startTruncateJob(initLastFlushIndex int64) {
 	truncateFunc := func(truncIndex int64) error {
+		if s.isLocal() {
+			return s.truncateLocal(uint64(truncIndex))
+		}
 		// get raft peers status
 		snapPeers := s.RaftServer.GetPendingReplica(uint64(s.Partition.Id))
 		if len(snapPeers) > 0 {
@@ -15,9 +15,13 @@
 package raftstore
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
+	"github.com/vearch/vearch/client"
+	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/util/errutil"
 	"github.com/vearch/vearch/util/log"
 )
@@ -42,20 +46,34 @@ func (s *Store) ApplySnapshot(peers []proto.Peer, iter proto.SnapIterator) (err
 		i++
 		log.Debug("wait stop engine times:[%d]", i)
 	}
-	log.Debug("engine has stop, begin remove engine data.")
-	// remove engine data dir
-	err = s.RemoveDataPath()
-	errutil.ThrowError(err)
-	log.Debug("remove engine data path")
-	// apply snapshot
-	err = s.GetEngine().ApplySnapshot(peers, iter)
-	if err == nil {
-		log.Debug("store info is [%+v]", s)
-	} else {
+	log.Debug("engine has stop, begin apply snapshot.")
+	// the engine applies the snapshot into a staging dir and swaps it with
+	// its data path when complete, so an interrupted transfer keeps the data
+	// and the engine is opened on it again
+	if err = s.GetEngine().ApplySnapshot(peers, iter, s.resumeSnapshot); err != nil {
+		log.Error("partition [%d] apply snapshot err: %v, reopen the engine on its data", s.Partition.Id, err)
+		if rebuildErr := s.ReBuildEngine(); rebuildErr != nil {
+			log.Error("partition [%d] reopen the engine after a failed snapshot err: %v", s.Partition.Id, rebuildErr)
+		}
 		errutil.ThrowError(err)
 	}
+	log.Debug("store info is [%+v]", s)
 	err = s.ReBuildEngine()
 	log.Debug("rebuild engine after store info is [%+v]", s)
 	errutil.ThrowError(err)
 	return err
 }
+
+// resumeSnapshot reports the files received by an interrupted snapshot
+// transfer to the leader, which does not send them again
+func (s *Store) resumeSnapshot(resume *entity.SnapshotResume) error {
+	leader, _ := s.RaftServer.LeaderTerm(uint64(s.Partition.Id))
+	if leader == 0 {
+		return fmt.Errorf("partition [%d] has no leader", s.Partition.Id)
+	}
+	server, err := s.Client.Master().QueryServer(context.Background(), entity.NodeID(leader))
+	if err != nil {
+		return err
+	}
+	return client.ResumeSnapshot(server.RpcAddr(), resume)
+}
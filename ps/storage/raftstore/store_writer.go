@@ -17,18 +17,57 @@ package raftstore
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/vearch/vearch/config"
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/ps/psutil"
 	"github.com/vearch/vearch/util/cbjson"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/runtime/os"
+	"github.com/vearch/vearch/util/tracer"
 	"github.com/vearch/vearch/util/vearchlog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// diskFloodStage are the data dirs of this ps above the flood stage
+// watermark, documents of their partitions can only be deleted
+var diskFloodStage = struct {
+	sync.RWMutex
+	datas []string
+}{}
+
+// SetDiskFloodStage is called by the disk watermark job of ps
+func SetDiskFloodStage(datas []string) {
+	diskFloodStage.Lock()
+	defer diskFloodStage.Unlock()
+	diskFloodStage.datas = datas
+}
+
+// checkDiskFloodStage returns an error when the data dir of the partition is
+// above the flood stage, or master set it read only for another replica
+func (s *Store) checkDiskFloodStage() error {
+	_, _, flood := config.Conf().PS.DiskWatermarks()
+	diskFloodStage.RLock()
+	local := psutil.IsDataDir(diskFloodStage.datas, s.Partition.Path)
+	diskFloodStage.RUnlock()
+	if local {
+		return vearchpb.NewError(vearchpb.ErrorEnum_DISK_FLOOD_STAGE, fmt.Errorf("disk of partition [%d] is above the flood stage watermark %v%%, it is read only until documents are deleted or disk is added", s.Partition.Id, flood))
+	}
+	space := s.GetSpace()
+	if p := space.GetPartition(s.Partition.Id); p != nil && p.ReadOnly {
+		return vearchpb.NewError(vearchpb.ErrorEnum_DISK_FLOOD_STAGE, fmt.Errorf("a replica of partition [%d] is above the flood stage watermark %v%%, it is read only until documents are deleted or disk is added", s.Partition.Id, flood))
+	}
+	return nil
+}
+
 type RaftApplyResponse struct {
-	FlushC chan error
-	Err    error
+	FlushC   chan error
+	Checksum *entity.ReplicaChecksum
+	// the index of a bucket check with chunks left to apply
+	ChecksumIndex uint64
+	Err           error
 }
 
 func (r *RaftApplyResponse) SetErr(err error) *RaftApplyResponse {
@@ -66,15 +105,13 @@ func (s *Store) UpdateSpace(ctx context.Context, space *entity.Space) error {
 		return err
 	}
 
-	future := s.RaftServer.Submit(uint64(s.Partition.Id), data)
-
-	response, err := future.Response()
+	response, err := s.submit(data)
 	if err != nil {
 		return err
 	}
 
-	if response.(*RaftApplyResponse).Err != nil {
-		return response.(*RaftApplyResponse).Err
+	if response.Err != nil {
+		return response.Err
 	}
 
 	return nil
@@ -86,6 +123,9 @@ func (s *Store) Write(ctx context.Context, request *vearchpb.DocCmd) (err error)
 	}
 
 	if request.Type == vearchpb.OpType_BULK || request.Type == vearchpb.OpType_REPLACE {
+		if err = s.checkDiskFloodStage(); err != nil {
+			return err
+		}
 		if s.Partition.ResourceExhausted {
 			err = fmt.Errorf("ResourceExhausted")
 			return err
@@ -99,9 +139,14 @@ func (s *Store) Write(ctx context.Context, request *vearchpb.DocCmd) (err error)
 		}
 	}
 
+	ctx, span := tracer.Start(ctx, "raft submit", attribute.String("type", request.Type.String()))
+	defer func() { tracer.End(span, err) }()
+
 	raftCmd := vearchpb.CreateRaftCommand()
 	raftCmd.Type = vearchpb.CmdType_WRITE
 	raftCmd.WriteCommand = request
+	raftCmd.Trace = make(map[string]string)
+	tracer.Inject(ctx, raftCmd.Trace)
 
 	data, err := raftCmd.Marshal()
 	if err != nil {
@@ -123,13 +168,12 @@ func (s *Store) Write(ctx context.Context, request *vearchpb.DocCmd) (err error)
 
 // raft submit do
 func (s *Store) RaftSubmit(data []byte) (err error) {
-	future := s.RaftServer.Submit(uint64(s.Partition.Id), data)
-	resp, err := future.Response()
+	resp, err := s.submit(data)
 	if err != nil {
 		return err
 	}
-	if resp.(*RaftApplyResponse).Err != nil {
-		return resp.(*RaftApplyResponse).Err
+	if resp.Err != nil {
+		return resp.Err
 	}
 	return nil
 }
@@ -156,18 +200,16 @@ func (s *Store) Flush(ctx context.Context) error {
 		log.Error("raft cmd close err : %s", e.Error())
 	}
 
-	future := s.RaftServer.Submit(uint64(s.Partition.Id), data)
-
-	response, err := future.Response()
+	response, err := s.submit(data)
 	if err != nil {
 		return err
 	}
 
-	if response.(*RaftApplyResponse).Err != nil {
-		return response.(*RaftApplyResponse).Err
+	if response.Err != nil {
+		return response.Err
 	}
 
-	err = <-response.(*RaftApplyResponse).FlushC
+	err = <-response.FlushC
 	if err != nil {
 		return err
 	}
@@ -17,12 +17,14 @@ package document
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/opentracing/opentracing-go"
 	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/config"
 	"github.com/vearch/vearch/monitor"
@@ -31,8 +33,11 @@ import (
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/router/document/resp"
 	"github.com/vearch/vearch/util"
+	"github.com/vearch/vearch/util/cbjson"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/netutil"
+	"github.com/vearch/vearch/util/slowlog"
+	"github.com/vearch/vearch/util/tracer"
 	"github.com/vearch/vearch/util/uuid"
 )
 
@@ -48,6 +53,8 @@ const (
 	IDIsLong            = "IDIsLong"
 	QueryIsOnlyID       = "QueryIsOnlyID"
 	URLQueryTimeout     = "timeout"
+
+	defaultSlowLogSize = 100
 )
 
 type DocumentHandler struct {
@@ -165,18 +172,30 @@ func (handler *DocumentHandler) ExportToServer() error {
 	// bulk: /$dbName/$spaceName/_query_byids_feture
 	handler.httpServer.HandlesMethods([]string{http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_query_byids_feature", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handlerQueryDocByIdsFeature}, nil)
 
+	// recommend: /$dbName/$spaceName/_recommend
+	handler.httpServer.HandlesMethods([]string{http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_recommend", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleRecommend}, nil)
+
 	// bulk: /$dbName/$spaceName/_query_byids_feture
 	handler.httpServer.HandlesMethods([]string{http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_bulk_search", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleBulkSearchDoc}, nil)
 
 	// delete: /$dbName/$spaceName/_delete_by_query
 	handler.httpServer.HandlesMethods([]string{http.MethodDelete, http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_delete_by_query", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleDeleteByQuery}, nil)
 
+	// count: /$dbName/$spaceName/_count
+	handler.httpServer.HandlesMethods([]string{http.MethodGet, http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_count", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleCount}, nil)
+
+	// field stats: /$dbName/$spaceName/_field_stats
+	handler.httpServer.HandlesMethods([]string{http.MethodGet, http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_field_stats", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleFieldStats}, nil)
+
 	// forcemerge space: /$dbName/$spaceName/_forcemerge
 	handler.httpServer.HandlesMethods([]string{http.MethodPost}, fmt.Sprintf("/{%s}/{%s}/_forcemerge", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleForceMerge}, nil)
 
 	// update doc: /$dbName/$spaceName/_log_collect
 	handler.httpServer.HandlesMethods([]string{http.MethodPost, http.MethodPut}, fmt.Sprintf("/{%s}/{%s}/_log_print_switch", URLParamDbName, URLParamSpaceName), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleLogPrintSwitch}, nil)
 
+	// slow queries of this router and all ps: /_slowlog?db_name=&space_name=&size=
+	handler.httpServer.HandlesMethods([]string{http.MethodGet}, "/_slowlog", []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleSlowLog}, nil)
+
 	// get doc: /$dbName/$spaceName/$docId
 	handler.httpServer.HandlesMethods([]string{http.MethodGet}, fmt.Sprintf("/{%s}/{%s}/{%s}", URLParamDbName, URLParamSpaceName, URLParamID), []netutil.HandleContinued{handler.handleTimeout, handler.handleAuth, handler.handleGetDoc}, nil)
 
@@ -200,6 +219,7 @@ func (handler *DocumentHandler) ExportToServer() error {
 func (handler *DocumentHandler) handleTimeout(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
 	messageID := uuid.FlakeUUID()
 	ctx = context.WithValue(ctx, entity.MessageID, messageID)
+	ctx = tracer.ExtractHeader(ctx, r.Header)
 	return ctx, true
 }
 
@@ -261,8 +281,8 @@ func (handler *DocumentHandler) handleGetDoc(ctx context.Context, w http.Respons
 	startTime := time.Now()
 	operateName := "handleGetDoc"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.GetRequest{}
 	args.Head = setRequestHead(params, r)
 	args.PrimaryKeys = strings.Split(params.ByName(URLParamID), ",")
@@ -280,8 +300,8 @@ func (handler *DocumentHandler) handleGetDocByPartition(ctx context.Context, w h
 	startTime := time.Now()
 	operateName := "handleGetDocByPartition"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.GetRequest{}
 	args.Head = setRequestHead(params, r)
 	args.PrimaryKeys = strings.Split(params.ByName(URLParamID), ",")
@@ -345,8 +365,8 @@ func (handler *DocumentHandler) handleBulk(ctx context.Context, w http.ResponseW
 	startTime := time.Now()
 	operateName := "handleBulk"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.BulkRequest{}
 	args.Head = setRequestHead(params, r)
 	space, err := handler.client.Space(ctx, args.Head.DbName, args.Head.SpaceName)
@@ -401,13 +421,23 @@ func (handler *DocumentHandler) handleFlush(ctx context.Context, w http.Response
 	return ctx, true
 }
 
+// sendPartialResultsErr replies the error of a search that failed on some
+// partitions with allow_partial_results false
+func sendPartialResultsErr(ctx context.Context, w http.ResponseWriter, head *vearchpb.ResponseHead) bool {
+	if head == nil || head.Err == nil || head.Err.Code != vearchpb.ErrorEnum_PARTIAL_RESULTS {
+		return false
+	}
+	resp.SendErrorRootCause(ctx, w, http.StatusInternalServerError, head.Err.Code.String(), head.Err.Msg)
+	return true
+}
+
 // handleSearchDoc for search by param
 func (handler *DocumentHandler) handleSearchDoc(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
 	startTime := time.Now()
 	operateName := "handleSearchDoc"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.SearchRequest{}
 	args.Head = setRequestHead(params, r)
 	if args.Head.Params == nil {
@@ -428,16 +458,36 @@ func (handler *DocumentHandler) handleSearchDoc(ctx context.Context, w http.Resp
 		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
 		return ctx, false
 	}
+	if args.RangeSearch != nil && args.RangeSearch.Stream {
+		handler.streamRangeSearch(ctx, w, args, space)
+		return ctx, true
+	}
 	serviceStart := time.Now()
 	searchResp := handler.docService.search(ctx, args)
 	serviceCost := time.Since(serviceStart)
+	if sendPartialResultsErr(ctx, w, searchResp.Head) {
+		return ctx, true
+	}
 
 	var bs []byte
 	if searchResp.Results == nil || len(searchResp.Results) == 0 {
 		searchStatus := vearchpb.SearchStatus{Failed: 0, Successful: 0, Total: 0}
 		bs, err = SearchNullToContent(searchStatus, serviceCost)
 	} else {
+		if args.RangeSearch != nil {
+			if err = nextRangeSearchPage(args, searchResp.Results[0]); err != nil {
+				resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
+				return ctx, true
+			}
+		}
+		serializeStart := time.Now()
 		bs, err = ToContent(searchResp.Results[0], args.Head, serviceCost, space)
+		if searchResp.RouterProfile != nil {
+			searchResp.RouterProfile.SerializeMs = time.Since(serializeStart).Seconds() * 1000
+		}
+	}
+	if err == nil && args.Profile {
+		bs, err = ProfileToContent(bs, searchResp.Profiles, searchResp.RouterProfile)
 	}
 
 	if err != nil {
@@ -451,13 +501,93 @@ func (handler *DocumentHandler) handleSearchDoc(ctx context.Context, w http.Resp
 	return ctx, true
 }
 
+// streamRangeSearch writes every page of a range search into one chunked
+// response, each page is merged from the partitions and flushed before the
+// next one is searched after its last hit. The timeout of the request bounds
+// the whole stream. An error before the first hit is replied as usual, after
+// it the status is already sent and the error is only in the trailer.
+func (handler *DocumentHandler) streamRangeSearch(ctx context.Context, w http.ResponseWriter, args *vearchpb.SearchRequest, space *entity.Space) {
+	m := startRequest(args.Head, "range_search_stream")
+	ctx, cancel := setTimeOut(ctx, args.Head)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	flusher, _ := w.(http.Flusher)
+	started := false
+	start := func() {
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			w.WriteHeader(http.StatusOK)
+			io.WriteString(w, `{"hits":[`)
+		}
+	}
+	search := func(page *vearchpb.SearchRequest) *vearchpb.SearchResponse {
+		return handler.docService.search(ctx, page)
+	}
+	total, truncated, streamErr := streamRangeSearchPages(args, space, deadline, search, func(sr *vearchpb.SearchResult) error {
+		content, err := DocToContent(sr.ResultItems, args.Head, space)
+		if err != nil {
+			return err
+		}
+		if started {
+			io.WriteString(w, ",")
+		}
+		start()
+		w.Write(content)
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+
+	errCode := vearchpb.ErrorEnum_SUCCESS
+	if streamErr != nil {
+		errCode = vearchpb.ErrorEnum_INTERNAL_ERROR
+		if vErr, ok := streamErr.(*vearchpb.VearchErr); ok {
+			errCode = vErr.GetError().Code
+		}
+		log.Error("stream range search of space [%s] failed after %d hits, err: [%s]", space.Name, total, streamErr.Error())
+	}
+	m.End(&vearchpb.Error{Code: errCode})
+	if streamErr != nil && !started {
+		resp.SendErrorRootCause(ctx, w, http.StatusInternalServerError, errCode.String(), streamErr.Error())
+		return
+	}
+
+	start()
+	var builder = cbjson.ContentBuilderFactory()
+	builder.Field("total")
+	builder.ValueNumeric(int64(total))
+	builder.More()
+	builder.Field("truncated")
+	builder.ValueBool(truncated)
+	builder.More()
+	builder.Field("code")
+	if streamErr == nil {
+		builder.ValueNumeric(0)
+		builder.More()
+		builder.Field("msg")
+		builder.ValueString("success")
+	} else {
+		builder.ValueNumeric(int64(errCode))
+		builder.More()
+		builder.Field("msg")
+		builder.ValueString(streamErr.Error())
+	}
+	tail, _ := builder.Output()
+	io.WriteString(w, "],")
+	w.Write(tail)
+	io.WriteString(w, "}")
+}
+
 // handleMSearchDoc for search by param
 func (handler *DocumentHandler) handleMSearchDoc(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
 	startTime := time.Now()
 	operateName := "handleMSearchDoc"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.SearchRequest{}
 	args.Head = setRequestHead(params, r)
 	if args.Head.Params == nil {
@@ -482,6 +612,9 @@ func (handler *DocumentHandler) handleMSearchDoc(ctx context.Context, w http.Res
 	serviceStart := time.Now()
 	searchRes := handler.docService.search(ctx, args)
 	serviceCost := time.Since(serviceStart)
+	if sendPartialResultsErr(ctx, w, searchRes.Head) {
+		return ctx, true
+	}
 	contentStartTime := time.Now()
 	log.Info("handleMSearchDoc service cost:[%f]", serviceCost.Seconds()*1000)
 	bs, err := ToContents(searchRes.Results, args.Head, serviceCost, space)
@@ -566,8 +699,8 @@ func (handler *DocumentHandler) handleMSearchIdsDoc(ctx context.Context, w http.
 	startTime := time.Now()
 	operateName := "handleMSearchIdsDoc"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.SearchRequest{}
 	args.Head = setRequestHead(params, r)
 	if args.Head.Params != nil {
@@ -597,6 +730,9 @@ func (handler *DocumentHandler) handleMSearchIdsDoc(ctx context.Context, w http.
 	searchRes := handler.docService.search(ctx, args)
 	serviceEnd := time.Now()
 	serviceCost := serviceEnd.Sub(serviceStart)
+	if sendPartialResultsErr(ctx, w, searchRes.Head) {
+		return ctx, true
+	}
 
 	bs, err := ToContentIds(searchRes.Results, space)
 	if err != nil {
@@ -648,8 +784,8 @@ func (handler *DocumentHandler) handlerQueryDocByIds(ctx context.Context, w http
 	startTime := time.Now()
 	operateName := "handlerQueryDocByIds"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.GetRequest{}
 	args.Head = setRequestHead(params, r)
 	if args.Head.Params == nil {
@@ -691,8 +827,8 @@ func (handler *DocumentHandler) handlerQueryDocByIdsFeature(ctx context.Context,
 	startTime := time.Now()
 	operateName := "handlerQueryDocByIdsFeature"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.GetRequest{}
 	args.Head = setRequestHead(params, r)
 	if args.Head.Params == nil {
@@ -757,6 +893,9 @@ func (handler *DocumentHandler) handlerQueryDocByIdsFeature(ctx context.Context,
 	searchRes := handler.docService.search(ctx, searchArgs)
 	serviceEnd := time.Now()
 	serviceCost := serviceEnd.Sub(serviceStart)
+	if sendPartialResultsErr(ctx, w, searchRes.Head) {
+		return ctx, true
+	}
 
 	bs, err := ToContents(searchRes.Results, args.Head, serviceCost, space)
 	if err != nil {
@@ -770,13 +909,63 @@ func (handler *DocumentHandler) handlerQueryDocByIdsFeature(ctx context.Context,
 	return ctx, true
 }
 
+// handleRecommend searches documents like the positive and unlike the negative example documents
+func (handler *DocumentHandler) handleRecommend(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
+	startTime := time.Now()
+	operateName := "handleRecommend"
+	defer monitor.Profiler(operateName, startTime)
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
+	head := setRequestHead(params, r)
+	if head.Params == nil {
+		head.Params = make(map[string]string)
+	}
+	space, err := handler.docService.getSpace(ctx, head.DbName, head.SpaceName)
+	if space == nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "dbName or spaceName param not build db or space")
+		return ctx, true
+	}
+	if err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
+		return ctx, false
+	}
+	reqBody, err := netutil.GetReqBody(r)
+	if err != nil || len(reqBody) == 0 {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "query param is null")
+		return ctx, false
+	}
+	rec, err := handler.docRecommendParse(ctx, reqBody, space, head)
+	if err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
+		return ctx, false
+	}
+
+	serviceStart := time.Now()
+	searchResp := handler.docService.search(ctx, rec.searchReq)
+	serviceCost := time.Since(serviceStart)
+	if searchResp.Head != nil && searchResp.Head.Err != nil && searchResp.Head.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", searchResp.Head.Err.Msg)
+		return ctx, true
+	}
+
+	bs, err := ToContent(rec.results(space, searchResp), rec.searchReq.Head, serviceCost, space)
+	if err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
+		return ctx, true
+	}
+	resp.SendJsonBytes(ctx, w, bs)
+	log.Debug("handleRecommend total use :[%f] service use :[%f]",
+		time.Since(startTime).Seconds()*1000, serviceCost.Seconds()*1000)
+	return ctx, true
+}
+
 // handleBulkSearchDoc query byids
 func (handler *DocumentHandler) handleBulkSearchDoc(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
 	startTime := time.Now()
 	operateName := "handleBulkSearchDoc"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.SearchRequest{}
 	args.Head = setRequestHead(params, r)
 	if args.Head.Params == nil {
@@ -884,7 +1073,7 @@ func (handler *DocumentHandler) handleDeleteByQuery(ctx context.Context, w http.
 		return ctx, false
 	}
 
-	if args.TermFilters == nil && args.RangeFilters == nil {
+	if args.TermFilters == nil && args.RangeFilters == nil && args.BoolFilter == nil {
 		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "query filter is null")
 		return ctx, false
 	}
@@ -904,6 +1093,63 @@ func (handler *DocumentHandler) handleDeleteByQuery(ctx context.Context, w http.
 	return ctx, true
 }
 
+// handleCount returns the exact number of documents matching the filter
+func (handler *DocumentHandler) handleCount(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
+	startTime := time.Now()
+	defer monitor.Profiler("handleCount", startTime)
+	return handler.doCount(ctx, w, r, params, false)
+}
+
+// handleFieldStats returns count, min, max and the estimated distinct count of fields over the documents matching the filter
+func (handler *DocumentHandler) handleFieldStats(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
+	startTime := time.Now()
+	defer monitor.Profiler("handleFieldStats", startTime)
+	return handler.doCount(ctx, w, r, params, true)
+}
+
+func (handler *DocumentHandler) doCount(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams, withStats bool) (context.Context, bool) {
+	args := &vearchpb.SearchRequest{}
+	args.Head = setRequestHead(params, r)
+	if args.Head.Params == nil {
+		args.Head.Params = make(map[string]string)
+	}
+
+	space, err := handler.docService.getSpace(ctx, args.Head.DbName, args.Head.SpaceName)
+	if space == nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "dbName or spaceName param not build db or space")
+		return ctx, true
+	}
+	if err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "query Cache space null")
+		return ctx, false
+	}
+
+	if idIsLong(space) {
+		args.Head.Params["idIsLong"] = "true"
+	} else {
+		args.Head.Params["idIsLong"] = "false"
+	}
+
+	if err = docCountParse(r, space, args, withStats); err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
+		return ctx, false
+	}
+
+	handlerType := client.CountHandler
+	if withStats {
+		handlerType = client.FieldStatsHandler
+	}
+	countResp := handler.docService.count(ctx, args, handlerType)
+	countBytes, err := countResult(countResp, withStats)
+	if err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", err.Error())
+		return ctx, true
+	}
+
+	resp.SendJsonBytes(ctx, w, countBytes)
+	return ctx, true
+}
+
 // handleLogPrintSwitch log print switch
 func (handler *DocumentHandler) handleLogPrintSwitch(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
 	startTime := time.Now()
@@ -926,12 +1172,65 @@ func (handler *DocumentHandler) handleLogPrintSwitch(ctx context.Context, w http
 	}
 }
 
+// handleSlowLog returns the recent slow queries of this router and of all ps,
+// newest first
+func (handler *DocumentHandler) handleSlowLog(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
+	query := r.URL.Query()
+	dbName, spaceName := query.Get(URLParamDbName), query.Get(URLParamSpaceName)
+	size := defaultSlowLogSize
+	if s := query.Get("size"); s != "" {
+		var err error
+		if size, err = strconv.Atoi(s); err != nil || size <= 0 {
+			resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", fmt.Sprintf("size [%s] should be a positive integer", s))
+			return ctx, false
+		}
+	}
+
+	entries := slowlog.Default().Recent(size, dbName, spaceName)
+	servers, err := handler.client.Master().QueryServers(ctx)
+	if err != nil {
+		resp.SendErrorRootCause(ctx, w, http.StatusInternalServerError, "", err.Error())
+		return ctx, false
+	}
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+	)
+	for _, s := range servers {
+		wg.Add(1)
+		go func(addr string) {
+			defer wg.Done()
+			psEntries, err := client.SlowLog(addr)
+			if err != nil {
+				log.Error("get slow log of ps [%s] err: [%v]", addr, err)
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			for _, e := range psEntries {
+				if (dbName == "" || e.DbName == dbName) && (spaceName == "" || e.SpaceName == spaceName) {
+					e.Node = addr
+					entries = append(entries, e)
+				}
+			}
+		}(s.RpcAddr())
+	}
+	wg.Wait()
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Time.After(entries[j].Time) })
+	if len(entries) > size {
+		entries = entries[:size]
+	}
+	resp.SendJsonHttpReplySuccess(ctx, w, entries)
+	return ctx, true
+}
+
 func (handler *DocumentHandler) handleDocumentUpsert(ctx context.Context, w http.ResponseWriter, r *http.Request, params netutil.UriParams) (context.Context, bool) {
 	startTime := time.Now()
 	operateName := "handleDocumentUpsert"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.BulkRequest{}
 	args.Head = setRequestHeadParams(params, r)
 	docRequest, dbName, spaceName, err := documentHeadParse(r)
@@ -971,8 +1270,8 @@ func (handler *DocumentHandler) handleDocumentQuery(ctx context.Context, w http.
 	startTime := time.Now()
 	operateName := "handleDocumentQuery"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.SearchRequest{}
 	args.Head = setRequestHeadParams(params, r)
 	if args.Head.Params == nil {
@@ -1051,7 +1350,7 @@ func (handler *DocumentHandler) handleDocumentQuery(ctx context.Context, w http.
 			return ctx, true
 		}
 	} else {
-		if args.TermFilters == nil && args.RangeFilters == nil {
+		if args.TermFilters == nil && args.RangeFilters == nil && args.BoolFilter == nil {
 			resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "document/query query condition must be one of the [document_ids, filter]")
 			return ctx, false
 		}
@@ -1059,6 +1358,9 @@ func (handler *DocumentHandler) handleDocumentQuery(ctx context.Context, w http.
 	serviceStart := time.Now()
 	searchResp := handler.docService.search(ctx, args)
 	serviceCost := time.Since(serviceStart)
+	if sendPartialResultsErr(ctx, w, searchResp.Head) {
+		return ctx, true
+	}
 
 	var bs []byte
 	if searchResp.Results == nil || len(searchResp.Results) == 0 {
@@ -1082,8 +1384,8 @@ func (handler *DocumentHandler) handleDocumentSearch(ctx context.Context, w http
 	startTime := time.Now()
 	operateName := "handleDocumentSearch"
 	defer monitor.Profiler(operateName, startTime)
-	span, ctx := opentracing.StartSpanFromContext(ctx, operateName)
-	defer span.Finish()
+	ctx, span := tracer.Start(ctx, operateName)
+	defer span.End()
 	args := &vearchpb.SearchRequest{}
 	args.Head = setRequestHeadParams(params, r)
 	if args.Head.Params == nil {
@@ -1178,6 +1480,9 @@ func (handler *DocumentHandler) handleDocumentSearch(ctx context.Context, w http
 	serviceStart := time.Now()
 	searchResp := handler.docService.search(ctx, args)
 	serviceCost := time.Since(serviceStart)
+	if sendPartialResultsErr(ctx, w, searchResp.Head) {
+		return ctx, true
+	}
 
 	var bs []byte
 	if searchResp.Results == nil || len(searchResp.Results) == 0 {
@@ -1270,7 +1575,7 @@ func (handler *DocumentHandler) handleDocumentDelete(ctx context.Context, w http
 			return ctx, true
 		}
 	} else {
-		if args.TermFilters == nil && args.RangeFilters == nil {
+		if args.TermFilters == nil && args.RangeFilters == nil && args.BoolFilter == nil {
 			resp.SendErrorRootCause(ctx, w, http.StatusBadRequest, "", "document/delete query condition must be one of the [document_ids, filter]")
 			return ctx, false
 		}
@@ -745,6 +745,54 @@ func docSearchParse(r *http.Request, space *entity.Space, searchReq *vearchpb.Se
 	return
 }
 
+// docCountParse parses the body of _count and _field_stats, an optional
+// filter query and for field stats the fields to collect.
+func docCountParse(r *http.Request, space *entity.Space, searchReq *vearchpb.SearchRequest, withStats bool) (err error) {
+	reqBody, err := netutil.GetReqBody(r)
+	if err != nil {
+		return
+	}
+
+	countDoc := struct {
+		Query  json.RawMessage `json:"query,omitempty"`
+		Fields []string        `json:"fields,omitempty"`
+	}{}
+	if len(reqBody) > 0 {
+		if err = cbjson.Unmarshal(reqBody, &countDoc); err != nil {
+			err = fmt.Errorf("query param convert json err: [%s]", string(reqBody))
+			return
+		}
+	}
+	if err = parseQuery(countDoc.Query, searchReq, space); err != nil {
+		return
+	}
+	if len(searchReq.VecFields) > 0 {
+		return fmt.Errorf("query param vector is not supported, only filter is allowed")
+	}
+
+	if !withStats {
+		return nil
+	}
+	if len(countDoc.Fields) == 0 {
+		return fmt.Errorf("query param fields is null")
+	}
+	proMap := space.SpaceProperties
+	if proMap == nil {
+		proMap, _ = entity.UnmarshalPropertyJSON(space.Properties)
+	}
+	for _, field := range countDoc.Fields {
+		pro := proMap[field]
+		if pro == nil {
+			return fmt.Errorf("field:[%s] not found in mapping", field)
+		}
+		if pro.FieldType == entity.FieldType_VECTOR {
+			return fmt.Errorf("field:[%s] is vector, stats are not supported", field)
+		}
+	}
+	searchReq.Fields = countDoc.Fields
+	return nil
+}
+
 func docSearchByIdsParse(r *http.Request, space *entity.Space) (fieldsParam []string, ids []string, reqBodyByte []byte, err error) {
 	reqBody, err := netutil.GetReqBody(r)
 	if err != nil {
@@ -855,7 +903,10 @@ func docSearchByFeaturesParse(space *entity.Space, reqBody []byte, searchReq *ve
 	if err != nil {
 		return
 	}
-	err = parseQuery(queryByte, searchReq, space)
+	if err = parseQuery(queryByte, searchReq, space); err != nil {
+		return
+	}
+	err = searchAfterPushDown(searchReq, space)
 	return
 }
 
@@ -1071,7 +1122,10 @@ func documentRequestVectorParse(space *entity.Space, searchDoc *request.SearchDo
 	if err != nil {
 		return
 	}
-	err = parseQuery(queryByte, searchReq, space)
+	if err = parseQuery(queryByte, searchReq, space); err != nil {
+		return
+	}
+	err = searchAfterPushDown(searchReq, space)
 	return
 }
 
@@ -16,6 +16,7 @@ package document
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -23,10 +24,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cast"
+	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/request"
 	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/ps/engine/filter"
 	"github.com/vearch/vearch/ps/engine/mapping"
 	"github.com/vearch/vearch/ps/engine/sortorder"
 	"github.com/vearch/vearch/router/document/rutil"
@@ -36,19 +40,20 @@ import (
 )
 
 const (
-	URLQueryFrom            = "from"
-	URLQuerySize            = "size"
-	UrlQueryRouting         = "routing"
-	UrlQueryTypedKey        = "typed_keys"
-	UrlQueryVersion         = "version"
-	UrlQueryRetryOnConflict = "retry_on_conflict"
-	UrlQueryOpType          = "op_type"
-	UrlQueryRefresh         = "refresh"
-	UrlQueryURISort         = "sort"
-	UrlQueryTimeout         = "timeout"
-	LoadBalance             = "load_balance"
-	DefaultSzie             = 50
-	URLQueryRefresh         = "refresh"
+	URLQueryFrom                 = "from"
+	URLQuerySize                 = "size"
+	UrlQueryRouting              = "routing"
+	UrlQueryTypedKey             = "typed_keys"
+	UrlQueryVersion              = "version"
+	UrlQueryRetryOnConflict      = "retry_on_conflict"
+	UrlQueryOpType               = "op_type"
+	UrlQueryRefresh              = "refresh"
+	UrlQueryURISort              = "sort"
+	UrlQueryTimeout              = "timeout"
+	LoadBalance                  = "load_balance"
+	DefaultSzie                  = 50
+	DefaultRangeSearchMaxResults = 10000
+	URLQueryRefresh              = "refresh"
 )
 
 type VectorQuery struct {
@@ -91,6 +96,7 @@ func parseQuery(data []byte, req *vearchpb.SearchRequest, space *entity.Space) e
 	vqs := make([]*vearchpb.VectorQuery, 0)
 	rfs := make([]*vearchpb.RangeFilter, 0)
 	tfs := make([]*vearchpb.TermFilter, 0)
+	bfs := make([]*vearchpb.BoolFilter, 0)
 
 	var reqNum int
 
@@ -141,7 +147,24 @@ func parseQuery(data []byte, req *vearchpb.SearchRequest, space *entity.Space) e
 					tfs = append(tfs, filter)
 				}
 			}
+		} else if boolBytes, ok := tmp["bool"]; ok {
+			bf, err := parseBool(boolBytes)
+			if err != nil {
+				return err
+			}
+			bfs = append(bfs, bf)
+		} else {
+			return fmt.Errorf("unknown filter:[%s], should be range, term or bool", string(filterBytes))
+		}
+	}
+
+	if len(bfs) > 0 {
+		root := &vearchpb.BoolFilter{Must: bfs}
+		if _, err := filter.New(root, proMap); err != nil {
+			return err
 		}
+		rfs, tfs = boolFilterPushDown(root, proMap, rfs, tfs)
+		req.BoolFilter = root
 	}
 
 	if len(vqs) > 0 {
@@ -576,6 +599,209 @@ func parseRange(data []byte, proMap map[string]*entity.SpaceProperties) (*vearch
 
 }
 
+// parseBool parses a bool filter, its must, should and must_not clauses are
+// leaf filters or nested bool filters:
+//
+//	{"must": [{"range": {"age": {"gte": 18}}}, {"bool": {"should": [...]}}],
+//	 "must_not": [{"missing": {"field": "name"}}], "minimum_should_match": 1}
+func parseBool(data []byte) (*vearchpb.BoolFilter, error) {
+	temp := struct {
+		Must               []json.RawMessage `json:"must"`
+		Should             []json.RawMessage `json:"should"`
+		MustNot            []json.RawMessage `json:"must_not"`
+		MinimumShouldMatch int32             `json:"minimum_should_match"`
+	}{}
+	d := json.NewDecoder(bytes.NewBuffer(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&temp); err != nil {
+		return nil, fmt.Errorf("bool filter:[%s] err: %v", string(data), err)
+	}
+
+	bf := &vearchpb.BoolFilter{MinimumShouldMatch: temp.MinimumShouldMatch}
+	for _, children := range []struct {
+		src []json.RawMessage
+		dst *[]*vearchpb.BoolFilter
+	}{{temp.Must, &bf.Must}, {temp.Should, &bf.Should}, {temp.MustNot, &bf.MustNot}} {
+		for _, childBytes := range children.src {
+			child, err := parseBoolClause(childBytes)
+			if err != nil {
+				return nil, err
+			}
+			*children.dst = append(*children.dst, child)
+		}
+	}
+	return bf, nil
+}
+
+var filterClauseTypes = map[string]vearchpb.FilterClause_Type{
+	"term":         vearchpb.FilterClause_TERM,
+	"range":        vearchpb.FilterClause_RANGE,
+	"exists":       vearchpb.FilterClause_EXISTS,
+	"missing":      vearchpb.FilterClause_MISSING,
+	"prefix":       vearchpb.FilterClause_PREFIX,
+	"in":           vearchpb.FilterClause_IN,
+	"contains_all": vearchpb.FilterClause_CONTAINS_ALL,
+	"contains_any": vearchpb.FilterClause_CONTAINS_ANY,
+}
+
+func parseBoolClause(data []byte) (*vearchpb.BoolFilter, error) {
+	tmp := make(map[string]json.RawMessage)
+	if err := cbjson.Unmarshal(data, &tmp); err != nil {
+		return nil, err
+	}
+	if len(tmp) != 1 {
+		return nil, fmt.Errorf("bool filter clause:[%s] should have exactly one filter", string(data))
+	}
+	for name, clauseBytes := range tmp {
+		if name == "bool" {
+			return parseBool(clauseBytes)
+		}
+		typ, ok := filterClauseTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown bool filter clause:[%s]", name)
+		}
+		clause, err := parseFilterClause(typ, clauseBytes)
+		if err != nil {
+			return nil, err
+		}
+		return &vearchpb.BoolFilter{Clause: clause}, nil
+	}
+	return nil, nil
+}
+
+func parseFilterClause(typ vearchpb.FilterClause_Type, data []byte) (*vearchpb.FilterClause, error) {
+	name := strings.ToLower(typ.String())
+	tmp := make(map[string]interface{})
+	d := json.NewDecoder(bytes.NewBuffer(data))
+	d.UseNumber()
+	if err := d.Decode(&tmp); err != nil {
+		return nil, fmt.Errorf("%s filter:[%s] err: %v", name, string(data), err)
+	}
+
+	if typ == vearchpb.FilterClause_EXISTS || typ == vearchpb.FilterClause_MISSING {
+		field, ok := tmp["field"].(string)
+		if !ok || len(tmp) != 1 {
+			return nil, fmt.Errorf("%s filter should be {\"field\": name}", name)
+		}
+		return &vearchpb.FilterClause{Type: typ, Field: field}, nil
+	}
+	if len(tmp) != 1 {
+		return nil, fmt.Errorf("%s filter:[%s] should have exactly one field", name, string(data))
+	}
+
+	clause := &vearchpb.FilterClause{Type: typ}
+	for field, rv := range tmp {
+		clause.Field = field
+		if typ == vearchpb.FilterClause_RANGE {
+			rm, ok := rv.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("range filter field:[%s] should be an object", field)
+			}
+			lower, upper := "", ""
+			for k, v := range rm {
+				switch k {
+				case "gt", "gte", "from":
+					lower = cast.ToString(v)
+					clause.IncludeLower = k == "gte" || (k == "from" && cast.ToBool(rm["include_lower"]))
+				case "lt", "lte", "to":
+					upper = cast.ToString(v)
+					clause.IncludeUpper = k == "lte" || (k == "to" && cast.ToBool(rm["include_upper"]))
+				case "include_lower", "include_upper":
+				default:
+					return nil, fmt.Errorf("range filter field:[%s] unknown bound:[%s]", field, k)
+				}
+			}
+			clause.Values = []string{lower, upper}
+			continue
+		}
+
+		var values []interface{}
+		if ia, ok := rv.([]interface{}); ok {
+			values = ia
+		} else {
+			values = []interface{}{rv}
+		}
+		for _, v := range values {
+			switch v.(type) {
+			case string, json.Number:
+				clause.Values = append(clause.Values, cast.ToString(v))
+			default:
+				return nil, fmt.Errorf("%s filter field:[%s] value:[%v] should be number or string", name, field, v)
+			}
+		}
+	}
+	return clause, nil
+}
+
+// boolFilterPushDown hands the clauses every hit must satisfy on an indexed
+// field to the engine as range and term filters, so the engine evaluates the
+// whole tree only on the docs the field index leaves.
+func boolFilterPushDown(bf *vearchpb.BoolFilter, proMap map[string]*entity.SpaceProperties, rfs []*vearchpb.RangeFilter, tfs []*vearchpb.TermFilter) ([]*vearchpb.RangeFilter, []*vearchpb.TermFilter) {
+	indexed := func(field string) *entity.SpaceProperties {
+		fd := proMap[field]
+		if fd == nil || fd.Option&entity.FieldOption_Index != entity.FieldOption_Index {
+			return nil
+		}
+		return fd
+	}
+
+	for _, child := range bf.Must {
+		if child.Clause == nil {
+			if len(child.Should) == 0 {
+				rfs, tfs = boolFilterPushDown(child, proMap, rfs, tfs)
+			}
+			continue
+		}
+		clause := child.Clause
+		fd := indexed(clause.Field)
+		if fd == nil {
+			continue
+		}
+		switch {
+		case clause.Type == vearchpb.FilterClause_TERM && fd.FieldType == entity.FieldType_STRING:
+			tfs = append(tfs, &vearchpb.TermFilter{Field: clause.Field, Value: []byte(strings.Join(clause.Values, "\001")), IsUnion: 1})
+		case clause.Type == vearchpb.FilterClause_CONTAINS_ALL:
+			tfs = append(tfs, &vearchpb.TermFilter{Field: clause.Field, Value: []byte(strings.Join(clause.Values, "\001")), IsUnion: 0})
+		case clause.Type == vearchpb.FilterClause_CONTAINS_ANY:
+			tfs = append(tfs, &vearchpb.TermFilter{Field: clause.Field, Value: []byte(strings.Join(clause.Values, "\001")), IsUnion: 1})
+		case clause.Type == vearchpb.FilterClause_RANGE:
+			rv := make(map[string]json.Number)
+			if clause.Values[0] != "" {
+				if clause.IncludeLower {
+					rv["gte"] = json.Number(clause.Values[0])
+				} else {
+					rv["gt"] = json.Number(clause.Values[0])
+				}
+			}
+			if clause.Values[1] != "" {
+				if clause.IncludeUpper {
+					rv["lte"] = json.Number(clause.Values[1])
+				} else {
+					rv["lt"] = json.Number(clause.Values[1])
+				}
+			}
+			rangeBytes, err := json.Marshal(map[string]map[string]json.Number{clause.Field: rv})
+			if err != nil {
+				continue
+			}
+			if rf, err := parseRange(rangeBytes, proMap); err == nil {
+				rfs = append(rfs, rf)
+			}
+		}
+	}
+
+	for _, child := range bf.MustNot {
+		clause := child.Clause
+		if clause == nil || clause.Type != vearchpb.FilterClause_TERM {
+			continue
+		}
+		if fd := indexed(clause.Field); fd != nil && fd.FieldType == entity.FieldType_STRING {
+			tfs = append(tfs, &vearchpb.TermFilter{Field: clause.Field, Value: []byte(strings.Join(clause.Values, "\001")), IsUnion: 2})
+		}
+	}
+	return rfs, tfs
+}
+
 func parseTerm(data []byte, proMap map[string]*entity.SpaceProperties) (*vearchpb.TermFilter, error) {
 	tmp := make(map[string]interface{})
 	err := json.Unmarshal(data, &tmp)
@@ -724,6 +950,9 @@ func searchParamToSearchPb(searchDoc *request.SearchDocumentRequest, searchReq *
 	searchReq.RetrievalParams = string(searchDoc.RetrievalParam)
 	searchReq.Fields = searchDoc.Fields
 	searchReq.IsBruteSearch = searchDoc.IsBruteSearch
+	searchReq.Profile = searchDoc.Profile
+	searchReq.DenyPartialResults = searchDoc.AllowPartialResults != nil && !*searchDoc.AllowPartialResults
+	searchReq.PartitionTimeoutMs = searchDoc.PartitionTimeout
 
 	metricType := ""
 	if searchDoc.RetrievalParam != nil {
@@ -886,14 +1115,289 @@ func searchParamToSearchPb(searchDoc *request.SearchDocumentRequest, searchReq *
 	}
 
 	searchReq.Head.Params["load_balance"] = searchDoc.LoadBalance
+	returned, err := parseRangeSearchCursor(searchDoc)
+	if err != nil {
+		return err
+	}
+	if err := parseSearchAfter(searchDoc, searchReq, space); err != nil {
+		return err
+	}
 	if !idFeature {
 		parseErr := parseQuery(searchDoc.Query, searchReq, space)
 		if parseErr != nil {
 			return parseErr
 		}
+		if err := searchAfterPushDown(searchReq, space); err != nil {
+			return err
+		}
 	}
 
 	searchUrlParamParse(searchReq)
+	return parseRangeSearch(searchDoc, searchReq, returned, idFeature)
+}
+
+// rangeSearchCursor is the opaque cursor of a range search page, the
+// search_after of its last hit and the number of hits returned so far.
+type rangeSearchCursor struct {
+	SearchAfter []interface{} `json:"search_after"`
+	Returned    int32         `json:"returned"`
+}
+
+// parseRangeSearchCursor decodes the cursor of a range search into the
+// search_after of the request and returns the number of hits already returned.
+func parseRangeSearchCursor(searchDoc *request.SearchDocumentRequest) (int32, error) {
+	rs := searchDoc.RangeSearch
+	if rs == nil || rs.Cursor == "" {
+		return 0, nil
+	}
+	if len(searchDoc.SearchAfter) > 0 {
+		return 0, fmt.Errorf("query param range_search cursor can not be used with search_after")
+	}
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(rs.Cursor)
+	if err != nil {
+		return 0, fmt.Errorf("query param range_search cursor [%s] is invalid", rs.Cursor)
+	}
+	cursor := struct {
+		SearchAfter json.RawMessage `json:"search_after"`
+		Returned    int32           `json:"returned"`
+	}{}
+	if err := json.Unmarshal(cursorBytes, &cursor); err != nil || len(cursor.SearchAfter) == 0 {
+		return 0, fmt.Errorf("query param range_search cursor [%s] is invalid", rs.Cursor)
+	}
+	searchDoc.SearchAfter = cursor.SearchAfter
+	return cursor.Returned, nil
+}
+
+// parseRangeSearch checks a range search and caps its page so that no more
+// than max_results hits are returned over all pages.
+func parseRangeSearch(searchDoc *request.SearchDocumentRequest, searchReq *vearchpb.SearchRequest, returned int32, idFeature bool) error {
+	rs := searchDoc.RangeSearch
+	if rs == nil {
+		return nil
+	}
+	if idFeature {
+		return fmt.Errorf("query param range_search not support search by document ids")
+	}
+	if searchDoc.From > 0 {
+		return fmt.Errorf("query param range_search can not be used with from")
+	}
+	if searchReq.ReqNum > 1 || len(searchReq.VecFields) != 1 {
+		return fmt.Errorf("query param range_search should have exactly one vector")
+	}
+	vq := searchReq.VecFields[0]
+	if vq.MinScore == -math.MaxFloat64 && vq.MaxScore == math.MaxFloat64 {
+		return fmt.Errorf("query param range_search should set min_score or max_score of vector [%s]", vq.Name)
+	}
+	if len(searchReq.SortFields) != 1 || searchReq.SortFields[0].Field != "_score" {
+		return fmt.Errorf("query param range_search only support sort by _score")
+	}
+	maxResults := rs.MaxResults
+	if maxResults <= 0 {
+		maxResults = DefaultRangeSearchMaxResults
+	}
+	if maxResults > math.MaxInt32 {
+		return fmt.Errorf("query param range_search max_results [%d] is too large", rs.MaxResults)
+	}
+	if returned < 0 || returned >= int32(maxResults) {
+		return fmt.Errorf("query param range_search cursor has already returned max_results [%d] hits", maxResults)
+	}
+	if searchReq.TopN <= 0 {
+		searchReq.TopN = DefaultSzie
+	}
+	if remain := int32(maxResults) - returned; searchReq.TopN > remain {
+		searchReq.TopN = remain
+	}
+	searchReq.RangeSearch = &vearchpb.RangeSearch{MaxResults: int32(maxResults), Returned: returned, Stream: rs.Stream}
+	return nil
+}
+
+// nextRangeSearchPage sets the cursor of a range search result, a short page
+// is the last one and a full page at max_results is truncated.
+func nextRangeSearchPage(searchReq *vearchpb.SearchRequest, sr *vearchpb.SearchResult) error {
+	rs := searchReq.RangeSearch
+	items := sr.ResultItems
+	if len(items) < int(searchReq.TopN) || len(items) == 0 {
+		return nil
+	}
+	returned := rs.Returned + int32(len(items))
+	if returned >= rs.MaxResults {
+		sr.Truncated = true
+		return nil
+	}
+	last := items[len(items)-1]
+	cursor := rangeSearchCursor{
+		SearchAfter: []interface{}{json.Number(strconv.FormatFloat(last.Score, 'g', -1, 64)), last.PKey},
+		Returned:    returned,
+	}
+	cursorBytes, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	sr.Cursor = base64.RawURLEncoding.EncodeToString(cursorBytes)
+	return nil
+}
+
+// streamRangeSearchPages searches the pages of a range search one after the
+// other until one has no cursor, each after the last hit of the page before,
+// and hands the pages with hits to emit. args is left as it is, every page is
+// searched with a copy of it and the time left before deadline as timeout.
+// It returns the hits emitted and whether a page was truncated.
+func streamRangeSearchPages(args *vearchpb.SearchRequest, space *entity.Space, deadline time.Time,
+	search func(*vearchpb.SearchRequest) *vearchpb.SearchResponse, emit func(*vearchpb.SearchResult) error) (total int32, truncated bool, err error) {
+	page := proto.Clone(args).(*vearchpb.SearchRequest)
+	for {
+		left := time.Until(deadline)
+		if left <= 0 {
+			return total, truncated, vearchpb.NewErrorInfo(vearchpb.ErrorEnum_TIMEOUT, fmt.Sprintf("range search stream timed out after %d hits", total))
+		}
+		page.Head.TimeOutMs = left.Milliseconds() + 1
+
+		searchResp := search(page)
+		if head := searchResp.Head; head != nil && head.Err != nil && head.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+			return total, truncated, vearchpb.NewErrorInfo(head.Err.Code, head.Err.Msg)
+		}
+		if len(searchResp.Results) == 0 {
+			return total, truncated, nil
+		}
+		sr := searchResp.Results[0]
+		if err := nextRangeSearchPage(page, sr); err != nil {
+			return total, truncated, err
+		}
+		if len(sr.ResultItems) > 0 {
+			if err := emit(sr); err != nil {
+				return total, truncated, err
+			}
+		}
+		total += int32(len(sr.ResultItems))
+		// a page is truncated at max_results, which leaves it without cursor,
+		// or by the bool filter of a partition
+		truncated = truncated || sr.Truncated
+		if sr.Cursor == "" {
+			return total, truncated, nil
+		}
+
+		last := sr.ResultItems[len(sr.ResultItems)-1]
+		page = proto.Clone(args).(*vearchpb.SearchRequest)
+		page.SearchAfter = &vearchpb.SearchAfter{SortValues: []string{strconv.FormatFloat(last.Score, 'g', -1, 64)}, PKey: last.PKey}
+		if err := searchAfterPushDown(page, space); err != nil {
+			return total, truncated, err
+		}
+		rs := page.RangeSearch
+		rs.Returned = args.RangeSearch.Returned + total
+		if remain := rs.MaxResults - rs.Returned; page.TopN > remain {
+			page.TopN = remain
+		}
+	}
+}
+
+// parseSearchAfter parses the search_after cursor, the sort values of the last
+// hit of the previous page followed by its primary key.
+func parseSearchAfter(searchDoc *request.SearchDocumentRequest, searchReq *vearchpb.SearchRequest, space *entity.Space) error {
+	if len(searchDoc.SearchAfter) == 0 {
+		return nil
+	}
+	if searchDoc.From > 0 {
+		return fmt.Errorf("query param search_after can not be used with from")
+	}
+
+	values := make([]interface{}, 0)
+	d := json.NewDecoder(bytes.NewBuffer(searchDoc.SearchAfter))
+	d.UseNumber()
+	if err := d.Decode(&values); err != nil {
+		return fmt.Errorf("query param search_after should be an array, err: %v", err)
+	}
+	if len(values) != len(searchReq.SortFields)+1 {
+		return fmt.Errorf("query param search_after should have %d sort values and the primary key, but got %d values", len(searchReq.SortFields), len(values))
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		switch value := v.(type) {
+		case json.Number:
+			strs = append(strs, value.String())
+		case string:
+			strs = append(strs, value)
+		default:
+			return fmt.Errorf("query param search_after value [%v] should be number or string", v)
+		}
+	}
+	searchAfter := &vearchpb.SearchAfter{SortValues: strs[:len(strs)-1], PKey: strs[len(strs)-1]}
+	if _, err := client.GetSearchAfter(searchAfter, space, idIsLong(space), searchReq.SortFields); err != nil {
+		return err
+	}
+	searchReq.SearchAfter = searchAfter
+	return nil
+}
+
+// searchAfterPushDown narrows the engine search to the hits which may sort
+// after the cursor, so that each partition spends its topN on them. It only
+// bounds the first sort inclusively, ties are dropped by the partition server.
+// The engine returns the hits of a vector search in score order, a pool of
+// them sorted by a field is not the field order of the partition, so pages
+// of such a search are only exact sorted by _score.
+func searchAfterPushDown(searchReq *vearchpb.SearchRequest, space *entity.Space) error {
+	searchAfter := searchReq.SearchAfter
+	if searchAfter == nil || len(searchReq.SortFields) == 0 {
+		return nil
+	}
+	if searchReq.ReqNum > 1 {
+		return fmt.Errorf("query param search_after not support batch vectors")
+	}
+	if len(searchReq.VecFields) > 0 {
+		for _, sortF := range searchReq.SortFields {
+			if sortF.Field != "_score" {
+				return fmt.Errorf("query param search_after of a vector search only supports sort by _score, not by [%s]", sortF.Field)
+			}
+		}
+	}
+
+	first, value := searchReq.SortFields[0], searchAfter.SortValues[0]
+	if first.Field == "_score" {
+		// the score of a multi vector search is the sum of the fields
+		if len(searchReq.VecFields) != 1 || searchReq.VecFields[0].HasBoost != 0 {
+			return nil
+		}
+		score, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		vq := searchReq.VecFields[0]
+		if first.Type {
+			vq.MaxScore = math.Min(vq.MaxScore, score)
+		} else {
+			vq.MinScore = math.Max(vq.MinScore, score)
+		}
+		return nil
+	}
+
+	proMap := space.SpaceProperties
+	if proMap == nil {
+		spacePro, _ := entity.UnmarshalPropertyJSON(space.Properties)
+		proMap = spacePro
+	}
+	field := proMap[first.Field]
+	if field == nil || field.Option&entity.FieldOption_Index != entity.FieldOption_Index {
+		return nil
+	}
+	switch field.FieldType {
+	case entity.FieldType_INT, entity.FieldType_LONG, entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+	default:
+		return nil
+	}
+
+	op := "gte"
+	if first.Type {
+		op = "lte"
+	}
+	rangeBytes, err := json.Marshal(map[string]map[string]json.Number{first.Field: {op: json.Number(value)}})
+	if err != nil {
+		return err
+	}
+	filter, err := parseRange(rangeBytes, proMap)
+	if err != nil {
+		return err
+	}
+	searchReq.RangeFilters = append(searchReq.RangeFilters, filter)
 	return nil
 }
 
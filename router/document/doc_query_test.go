@@ -0,0 +1,172 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package document
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+func rangeSearchArgs(topN, maxResults int32) *vearchpb.SearchRequest {
+	return &vearchpb.SearchRequest{
+		Head:        &vearchpb.RequestHead{TimeOutMs: 1000},
+		TopN:        topN,
+		SortFields:  []*vearchpb.SortField{{Field: "_score", Type: true}},
+		VecFields:   []*vearchpb.VectorQuery{{Name: "vec", MinScore: 0.65, MaxScore: math.MaxFloat32}},
+		RangeSearch: &vearchpb.RangeSearch{MaxResults: maxResults, Stream: true},
+	}
+}
+
+// scoreTies searches hits sorted by descending score then primary key like
+// the partitions do, most of them share a score with the hits around them.
+func scoreTies(t *testing.T, hits []*vearchpb.ResultItem) func(*vearchpb.SearchRequest) *vearchpb.SearchResponse {
+	return func(page *vearchpb.SearchRequest) *vearchpb.SearchResponse {
+		vq := page.VecFields[0]
+		sr := &vearchpb.SearchResult{}
+		for _, h := range hits {
+			if int32(len(sr.ResultItems)) >= page.TopN {
+				break
+			}
+			if h.Score > vq.MaxScore || h.Score < vq.MinScore {
+				continue
+			}
+			if sa := page.SearchAfter; sa != nil {
+				score, err := strconv.ParseFloat(sa.SortValues[0], 64)
+				if err != nil {
+					t.Fatalf("search_after score [%s]: %v", sa.SortValues[0], err)
+				}
+				// the engine bound keeps the hits tied with the cursor
+				if score > vq.MaxScore {
+					t.Fatalf("search_after score %v above max_score %v", score, vq.MaxScore)
+				}
+				if h.Score > score || (h.Score == score && h.PKey <= sa.PKey) {
+					continue
+				}
+			}
+			sr.ResultItems = append(sr.ResultItems, h)
+		}
+		return &vearchpb.SearchResponse{Results: []*vearchpb.SearchResult{sr}}
+	}
+}
+
+func TestStreamRangeSearchPagesTies(t *testing.T) {
+	var hits []*vearchpb.ResultItem
+	for i := 0; i < 20; i++ {
+		// five hits per score, so ties run across the pages of 3 hits
+		hits = append(hits, &vearchpb.ResultItem{PKey: fmt.Sprintf("k%02d", i), Score: 0.9 - float64(i/5)/10})
+	}
+	args := rangeSearchArgs(3, 100)
+
+	var got []string
+	total, truncated, err := streamRangeSearchPages(args, &entity.Space{}, time.Now().Add(time.Minute), scoreTies(t, hits), func(sr *vearchpb.SearchResult) error {
+		for _, item := range sr.ResultItems {
+			got = append(got, item.PKey)
+		}
+		return nil
+	})
+	if err != nil || truncated {
+		t.Fatalf("truncated: %v, err: %v", truncated, err)
+	}
+	// the hits below min_score are not in range
+	want := 15
+	if int(total) != want || len(got) != want {
+		t.Fatalf("total %d, got %d hits, want %d", total, len(got), want)
+	}
+	for i, pKey := range got {
+		if pKey != hits[i].PKey {
+			t.Fatalf("hit %d is %s, want %s", i, pKey, hits[i].PKey)
+		}
+	}
+	if args.SearchAfter != nil || args.TopN != 3 || args.Head.TimeOutMs != 1000 || args.VecFields[0].MaxScore != math.MaxFloat32 {
+		t.Fatalf("args changed: %v", args)
+	}
+}
+
+func TestStreamRangeSearchPagesMaxResults(t *testing.T) {
+	var hits []*vearchpb.ResultItem
+	for i := 0; i < 20; i++ {
+		hits = append(hits, &vearchpb.ResultItem{PKey: fmt.Sprintf("k%02d", i), Score: 0.9})
+	}
+	total, truncated, err := streamRangeSearchPages(rangeSearchArgs(3, 7), &entity.Space{}, time.Now().Add(time.Minute), scoreTies(t, hits), func(sr *vearchpb.SearchResult) error {
+		return nil
+	})
+	if err != nil || !truncated || total != 7 {
+		t.Fatalf("total: %d, truncated: %v, err: %v", total, truncated, err)
+	}
+}
+
+func TestStreamRangeSearchPagesDeadline(t *testing.T) {
+	var hits []*vearchpb.ResultItem
+	for i := 0; i < 20; i++ {
+		hits = append(hits, &vearchpb.ResultItem{PKey: fmt.Sprintf("k%02d", i), Score: 0.9})
+	}
+	deadline := time.Now().Add(50 * time.Millisecond)
+	search := scoreTies(t, hits)
+	total, _, err := streamRangeSearchPages(rangeSearchArgs(3, 100), &entity.Space{}, deadline, func(page *vearchpb.SearchRequest) *vearchpb.SearchResponse {
+		if page.Head.TimeOutMs <= 0 || page.Head.TimeOutMs > 51 {
+			t.Fatalf("page timeout %d ms", page.Head.TimeOutMs)
+		}
+		time.Sleep(20 * time.Millisecond)
+		return search(page)
+	}, func(sr *vearchpb.SearchResult) error {
+		return nil
+	})
+	vErr, ok := err.(*vearchpb.VearchErr)
+	if !ok || vErr.GetError().Code != vearchpb.ErrorEnum_TIMEOUT {
+		t.Fatalf("err: %v", err)
+	}
+	if total == 0 || total >= 20 {
+		t.Fatalf("total: %d", total)
+	}
+}
+
+func TestSearchAfterPushDownSorts(t *testing.T) {
+	searchAfter := func(sortFields ...*vearchpb.SortField) *vearchpb.SearchRequest {
+		values := make([]string, len(sortFields))
+		for i := range values {
+			values[i] = "0.8"
+		}
+		return &vearchpb.SearchRequest{
+			ReqNum:      1,
+			SortFields:  sortFields,
+			VecFields:   []*vearchpb.VectorQuery{{Name: "vec", MinScore: -1, MaxScore: math.MaxFloat32}},
+			SearchAfter: &vearchpb.SearchAfter{SortValues: values, PKey: "k"},
+		}
+	}
+
+	req := searchAfter(&vearchpb.SortField{Field: "_score", Type: true})
+	if err := searchAfterPushDown(req, &entity.Space{}); err != nil {
+		t.Fatalf("score sort: %v", err)
+	}
+	if req.VecFields[0].MaxScore != 0.8 {
+		t.Fatalf("max_score %v, want the score of the cursor", req.VecFields[0].MaxScore)
+	}
+
+	// a field sort of a pool in score order is not the order of the partition
+	for _, sortFields := range [][]*vearchpb.SortField{
+		{{Field: "field_int", Type: true}},
+		{{Field: "_score", Type: true}, {Field: "_id"}},
+	} {
+		if err := searchAfterPushDown(searchAfter(sortFields...), &entity.Space{}); err == nil {
+			t.Fatalf("search_after sorted by %v succeeded", sortFields)
+		}
+	}
+}
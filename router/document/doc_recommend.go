@@ -0,0 +1,417 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/request"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+const (
+	RecommendAverageVector = "average_vector"
+	RecommendRocchio       = "rocchio"
+	RecommendBestScore     = "best_score"
+
+	DefaultRocchioBeta  = 0.75
+	DefaultRocchioGamma = 0.15
+)
+
+// recommendation is a parsed _recommend request: the search to run and the
+// vectors of the example documents it was built from.
+type recommendation struct {
+	strategy string
+	field    string
+	size     int32
+	positive [][]float32
+	negative [][]float32
+	excludes map[string]struct{}
+	// vectorValue is false when the candidate vectors were only fetched to
+	// score them against the negative examples
+	vectorValue bool
+	searchReq   *vearchpb.SearchRequest
+}
+
+// parseRecommendIds reads a list of document ids, numbers for spaces with
+// long ids.
+func parseRecommendIds(data json.RawMessage, space *entity.Space) ([]string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if idIsLong(space) {
+		longIds := make([]int64, 0)
+		if err := json.Unmarshal(data, &longIds); err != nil {
+			return nil, fmt.Errorf("query param ids should be long array, err: %v", err)
+		}
+		ids := make([]string, 0, len(longIds))
+		for _, id := range longIds {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		return ids, nil
+	}
+	ids := make([]string, 0)
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("query param ids should be string array, err: %v", err)
+	}
+	return ids, nil
+}
+
+// recommendField returns the vector field to recommend by, the only vector
+// field of the space when none is given.
+func recommendField(field string, space *entity.Space) (string, error) {
+	proMap := space.SpaceProperties
+	if proMap == nil {
+		proMap, _ = entity.UnmarshalPropertyJSON(space.Properties)
+	}
+	if field != "" {
+		if pro := proMap[field]; pro == nil || pro.FieldType != entity.FieldType_VECTOR {
+			return "", fmt.Errorf("query param field:[%s] is not vector type", field)
+		}
+		return field, nil
+	}
+	for name, pro := range proMap {
+		if pro.FieldType != entity.FieldType_VECTOR {
+			continue
+		}
+		if field != "" {
+			return "", fmt.Errorf("query param field is null and space has more than one vector field")
+		}
+		field = name
+	}
+	if field == "" {
+		return "", fmt.Errorf("space has no vector field")
+	}
+	return field, nil
+}
+
+// meanVector averages vectors, nil when there are none.
+func meanVector(vectors [][]float32) []float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+	mean := make([]float32, len(vectors[0]))
+	for _, v := range vectors {
+		for i := range mean {
+			mean[i] += v[i]
+		}
+	}
+	for i := range mean {
+		mean[i] /= float32(len(vectors))
+	}
+	return mean
+}
+
+// recommendVector combines the examples into one query vector. The average
+// vector moves the mean of the positives away from the mean of the negatives
+// by their difference, rocchio weights both means by beta and gamma.
+func recommendVector(strategy string, positive, negative [][]float32, beta, gamma float64) []float32 {
+	pos, neg := meanVector(positive), meanVector(negative)
+	query := make([]float32, len(pos))
+	for i := range query {
+		switch strategy {
+		case RecommendRocchio:
+			query[i] = float32(beta) * pos[i]
+			if neg != nil {
+				query[i] -= float32(gamma) * neg[i]
+			}
+		default:
+			query[i] = pos[i]
+			if neg != nil {
+				query[i] += pos[i] - neg[i]
+			}
+		}
+	}
+	return query
+}
+
+// vectorScore scores two vectors like the engine does, inner product or the
+// squared euclidean distance.
+func vectorScore(a, b []float32, l2, l2Sqrt bool) float64 {
+	var score float64
+	for i := range a {
+		if l2 {
+			d := float64(a[i] - b[i])
+			score += d * d
+		} else {
+			score += float64(a[i] * b[i])
+		}
+	}
+	if l2 && l2Sqrt {
+		score = math.Sqrt(score)
+	}
+	return score
+}
+
+// normalizeVector scales a vector to unit length for normalized fields.
+func normalizeVector(v []float32) []float32 {
+	var sum float64
+	for _, f := range v {
+		sum += float64(f * f)
+	}
+	if sum == 0 {
+		return v
+	}
+	norm := float32(math.Sqrt(sum))
+	normal := make([]float32, len(v))
+	for i, f := range v {
+		normal[i] = f / norm
+	}
+	return normal
+}
+
+// docRecommendParse fetches the example documents and turns the request into
+// a search: one query vector for average_vector and rocchio, one batch query
+// per positive example for best_score.
+func (handler *DocumentHandler) docRecommendParse(ctx context.Context, reqBody []byte, space *entity.Space, head *vearchpb.RequestHead) (*recommendation, error) {
+	if space.Engine != nil && space.Engine.RetrievalType == "BINARYIVF" {
+		return nil, fmt.Errorf("recommend not support binary vector")
+	}
+	recReq := &request.RecommendRequest{}
+	if err := json.Unmarshal(reqBody, recReq); err != nil {
+		return nil, fmt.Errorf("query param convert json err: [%s]", string(reqBody))
+	}
+	rec := &recommendation{strategy: recReq.Strategy, excludes: make(map[string]struct{}), vectorValue: recReq.VectorValue}
+	switch rec.strategy {
+	case "":
+		rec.strategy = RecommendAverageVector
+	case RecommendAverageVector, RecommendRocchio, RecommendBestScore:
+	default:
+		return nil, fmt.Errorf("query param strategy [%s] should be one of [%s, %s, %s]", rec.strategy, RecommendAverageVector, RecommendRocchio, RecommendBestScore)
+	}
+	if recReq.From > 0 || len(recReq.SearchAfter) > 0 || recReq.RangeSearch != nil {
+		return nil, fmt.Errorf("recommend not support from, search_after and range_search")
+	}
+	field, err := recommendField(recReq.Field, space)
+	if err != nil {
+		return nil, err
+	}
+	rec.field = field
+	positive, err := parseRecommendIds(recReq.Positive, space)
+	if err != nil {
+		return nil, err
+	}
+	if len(positive) == 0 {
+		return nil, fmt.Errorf("query param positive is null")
+	}
+	negative, err := parseRecommendIds(recReq.Negative, space)
+	if err != nil {
+		return nil, err
+	}
+
+	getReq := &vearchpb.GetRequest{Head: head, PrimaryKeys: append(append([]string{}, positive...), negative...)}
+	reply := handler.docService.getDocs(ctx, getReq)
+	if reply.Head != nil && reply.Head.Err != nil && reply.Head.Err.Code != vearchpb.ErrorEnum_SUCCESS {
+		return nil, vearchpb.NewErrorInfo(reply.Head.Err.Code, reply.Head.Err.Msg)
+	}
+	vectors := make(map[string][]float32)
+	for _, item := range reply.Items {
+		if item == nil || item.Doc == nil || (item.Err != nil && item.Err.Code != vearchpb.ErrorEnum_SUCCESS) {
+			continue
+		}
+		floatFeatureMap, _, err := GetVectorFieldValue(item.Doc, space)
+		if err != nil {
+			return nil, err
+		}
+		if vector := floatFeatureMap[field]; len(vector) > 0 {
+			vectors[item.Doc.PKey] = vector
+		}
+	}
+	for _, id := range positive {
+		rec.excludes[id] = struct{}{}
+		if vector, ok := vectors[id]; ok {
+			rec.positive = append(rec.positive, vector)
+		}
+	}
+	for _, id := range negative {
+		rec.excludes[id] = struct{}{}
+		if vector, ok := vectors[id]; ok {
+			rec.negative = append(rec.negative, vector)
+		}
+	}
+	if len(rec.positive) == 0 {
+		return nil, fmt.Errorf("positive documents %v not found", positive)
+	}
+
+	var feature []float32
+	if rec.strategy == RecommendBestScore {
+		for _, vector := range rec.positive {
+			feature = append(feature, vector...)
+		}
+	} else {
+		beta, gamma := DefaultRocchioBeta, DefaultRocchioGamma
+		if recReq.Beta != nil {
+			beta = *recReq.Beta
+		}
+		if recReq.Gamma != nil {
+			gamma = *recReq.Gamma
+		}
+		feature = recommendVector(rec.strategy, rec.positive, rec.negative, beta, gamma)
+	}
+
+	queryMap := make(map[string]json.RawMessage)
+	if len(recReq.Query) > 0 {
+		if err := json.Unmarshal(recReq.Query, &queryMap); err != nil {
+			return nil, fmt.Errorf("unmarshal err:[%s] , query:[%s]", err.Error(), string(recReq.Query))
+		}
+	}
+	for _, key := range []string{request.QueryAnd, request.QuerySum, request.QueryVector} {
+		if _, ok := queryMap[key]; ok {
+			return nil, fmt.Errorf("recommend query only support filter, the vector is made of the examples")
+		}
+	}
+	vectorBytes, err := json.Marshal([]map[string]interface{}{{"field": field, "feature": feature}})
+	if err != nil {
+		return nil, err
+	}
+	queryMap[request.QueryVector] = vectorBytes
+	if recReq.Query, err = json.Marshal(queryMap); err != nil {
+		return nil, err
+	}
+
+	searchReq := &vearchpb.SearchRequest{Head: head}
+	if err := searchParamToSearchPb(&recReq.SearchDocumentRequest, searchReq, space, false); err != nil {
+		return nil, err
+	}
+	if len(searchReq.SortFields) != 1 || searchReq.SortFields[0].Field != "_score" {
+		return nil, fmt.Errorf("recommend only support sort by _score")
+	}
+	// the examples are searched too, fetch more hits to drop them
+	rec.size = searchReq.TopN
+	searchReq.TopN += int32(len(rec.excludes))
+	if rec.strategy == RecommendBestScore && len(rec.negative) > 0 {
+		searchReq.IsVectorValue = true
+		hasField := false
+		for _, f := range searchReq.Fields {
+			hasField = hasField || f == field
+		}
+		if !hasField {
+			searchReq.Fields = append(searchReq.Fields, field)
+		}
+	}
+	rec.searchReq = searchReq
+	return rec, nil
+}
+
+// results drops the example documents from the search results. For
+// best_score the results of all positive examples are merged keeping the best
+// score of each hit, and a hit closer to a negative example than to every
+// positive one is dropped.
+func (rec *recommendation) results(space *entity.Space, searchResp *vearchpb.SearchResponse) *vearchpb.SearchResult {
+	// L2 scores are distances sorted ascending
+	desc := rec.searchReq.SortFields[0].Type
+	better := func(a, b float64) bool {
+		if desc {
+			return a > b
+		}
+		return a < b
+	}
+
+	merged := &vearchpb.SearchResult{Status: &vearchpb.SearchStatus{}}
+	best := make(map[string]*vearchpb.ResultItem)
+	items := make([]*vearchpb.ResultItem, 0)
+	for _, sr := range searchResp.Results {
+		if sr == nil {
+			continue
+		}
+		if sr.Status != nil {
+			merged.Status = sr.Status
+		}
+		merged.Timeout = merged.Timeout || sr.Timeout
+		merged.Msg = sr.Msg
+		for _, item := range sr.ResultItems {
+			if _, ok := rec.excludes[item.PKey]; ok {
+				continue
+			}
+			if prev, ok := best[item.PKey]; ok {
+				if better(item.Score, prev.Score) {
+					*prev = *item
+				}
+				continue
+			}
+			best[item.PKey] = item
+			items = append(items, item)
+		}
+	}
+
+	if rec.strategy == RecommendBestScore && len(rec.negative) > 0 {
+		normal := false
+		proMap := space.SpaceProperties
+		if proMap == nil {
+			proMap, _ = entity.UnmarshalPropertyJSON(space.Properties)
+		}
+		if pro := proMap[rec.field]; pro != nil && pro.Format != nil && (*pro.Format == "normalization" || *pro.Format == "normal") {
+			normal = true
+		}
+		l2 := !desc
+		kept := items[:0]
+		for _, item := range items {
+			var vector []float32
+			for i, fv := range item.Fields {
+				if fv.Name != rec.field {
+					continue
+				}
+				if len(fv.Value) > 4 {
+					vector, _, _ = cbbytes.ByteToVector(fv.Value)
+				}
+				if !rec.vectorValue {
+					item.Fields = append(item.Fields[:i], item.Fields[i+1:]...)
+				}
+				break
+			}
+			if vector == nil {
+				kept = append(kept, item)
+				continue
+			}
+			if normal {
+				vector = normalizeVector(vector)
+			}
+			closerToNegative := false
+			for _, neg := range rec.negative {
+				if normal {
+					neg = normalizeVector(neg)
+				}
+				if better(vectorScore(vector, neg, l2, rec.searchReq.L2Sqrt), item.Score) {
+					closerToNegative = true
+					break
+				}
+			}
+			if !closerToNegative {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+
+	if len(searchResp.Results) > 1 {
+		sort.SliceStable(items, func(i, j int) bool { return better(items[i].Score, items[j].Score) })
+	}
+	if int32(len(items)) > rec.size {
+		items = items[:rec.size]
+	}
+	merged.ResultItems = items
+	merged.TotalHits = int32(len(items))
+	if len(items) > 0 {
+		merged.MaxScore = items[0].Score
+	}
+	return merged
+}
@@ -0,0 +1,177 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package document
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+func vectorsEqual(a, b []float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(float64(a[i]-b[i])) > 1e-6 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMeanVector(t *testing.T) {
+	tests := []struct {
+		name    string
+		vectors [][]float32
+		want    []float32
+	}{
+		{"none", nil, nil},
+		{"one", [][]float32{{1, 2}}, []float32{1, 2}},
+		{"three", [][]float32{{1, 0}, {2, 3}, {0, 3}}, []float32{1, 2}},
+	}
+	for _, tt := range tests {
+		if got := meanVector(tt.vectors); !vectorsEqual(got, tt.want) || (got == nil) != (tt.want == nil) {
+			t.Errorf("%s: mean %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRecommendVector(t *testing.T) {
+	positive := [][]float32{{1, 0}, {3, 2}}
+	negative := [][]float32{{0, 4}}
+	tests := []struct {
+		name        string
+		strategy    string
+		positive    [][]float32
+		negative    [][]float32
+		beta, gamma float64
+		want        []float32
+	}{
+		// the mean of the positives is {2, 1}
+		{"average", RecommendAverageVector, positive, nil, 0, 0, []float32{2, 1}},
+		{"average away from negatives", RecommendAverageVector, positive, negative, 0, 0, []float32{4, -2}},
+		{"rocchio", RecommendRocchio, positive, negative, DefaultRocchioBeta, DefaultRocchioGamma, []float32{1.5, 0.15}},
+		{"rocchio weights", RecommendRocchio, positive, negative, 1, 0.5, []float32{2, -1}},
+		{"rocchio without negatives", RecommendRocchio, positive, nil, 0.5, 1, []float32{1, 0.5}},
+	}
+	for _, tt := range tests {
+		if got := recommendVector(tt.strategy, tt.positive, tt.negative, tt.beta, tt.gamma); !vectorsEqual(got, tt.want) {
+			t.Errorf("%s: query %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// vectorField is a vector field of a hit as the engine returns it
+func vectorField(name string, v []float32) *vearchpb.Field {
+	value := make([]byte, 4+4*len(v))
+	binary.LittleEndian.PutUint32(value, uint32(4*len(v)))
+	for i, f := range v {
+		binary.LittleEndian.PutUint32(value[4+4*i:], math.Float32bits(f))
+	}
+	return &vearchpb.Field{Name: name, Type: vearchpb.FieldType_VECTOR, Value: value}
+}
+
+func hit(pKey string, score float64, fields ...*vearchpb.Field) *vearchpb.ResultItem {
+	return &vearchpb.ResultItem{PKey: pKey, Score: score, Fields: fields}
+}
+
+func TestRecommendResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		rec      *recommendation
+		desc     bool
+		results  [][]*vearchpb.ResultItem
+		want     string
+		noFields bool
+	}{
+		{
+			name: "exclude the examples",
+			rec: &recommendation{
+				strategy: RecommendAverageVector, size: 2,
+				excludes: map[string]struct{}{"p1": {}, "n1": {}},
+			},
+			desc:    true,
+			results: [][]*vearchpb.ResultItem{{hit("p1", 0.99), hit("a", 0.9), hit("n1", 0.8), hit("b", 0.7), hit("c", 0.6)}},
+			want:    "[a:0.9 b:0.7]",
+		},
+		{
+			name: "best score of the positives",
+			rec: &recommendation{
+				strategy: RecommendBestScore, size: 3,
+				excludes: map[string]struct{}{"p1": {}, "p2": {}},
+			},
+			desc: true,
+			results: [][]*vearchpb.ResultItem{
+				{hit("p1", 1), hit("a", 0.5), hit("b", 0.4)},
+				{hit("p2", 1), hit("b", 0.9), hit("c", 0.3)},
+			},
+			want: "[b:0.9 a:0.5 c:0.3]",
+		},
+		{
+			name: "best score of l2 distances",
+			rec: &recommendation{
+				strategy: RecommendBestScore, size: 3,
+				excludes: map[string]struct{}{},
+			},
+			results: [][]*vearchpb.ResultItem{
+				{hit("a", 5), hit("b", 8)},
+				{hit("b", 1), hit("c", 6)},
+			},
+			want: "[b:1 a:5 c:6]",
+		},
+		{
+			name: "drop hits closer to a negative",
+			rec: &recommendation{
+				strategy: RecommendBestScore, size: 3, field: "vec",
+				excludes: map[string]struct{}{},
+				negative: [][]float32{{0, 1}},
+			},
+			desc: true,
+			results: [][]*vearchpb.ResultItem{
+				{hit("a", 0.9, vectorField("vec", []float32{1, 0.1})), hit("b", 0.3, vectorField("vec", []float32{0.3, 0.9}))},
+				{hit("c", 0.5, vectorField("vec", []float32{0.5, 0.5})), hit("d", 0.2)},
+			},
+			// b scores 0.9 with the negative, more than its 0.3, d has no vector
+			want:     "[a:0.9 c:0.5 d:0.2]",
+			noFields: true,
+		},
+	}
+	for _, tt := range tests {
+		tt.rec.searchReq = &vearchpb.SearchRequest{SortFields: []*vearchpb.SortField{{Field: "_score", Type: tt.desc}}}
+		resp := &vearchpb.SearchResponse{}
+		for _, items := range tt.results {
+			resp.Results = append(resp.Results, &vearchpb.SearchResult{ResultItems: items})
+		}
+		merged := tt.rec.results(&entity.Space{SpaceProperties: map[string]*entity.SpaceProperties{}}, resp)
+		got := make([]string, 0, len(merged.ResultItems))
+		for _, item := range merged.ResultItems {
+			got = append(got, fmt.Sprintf("%s:%v", item.PKey, item.Score))
+			if tt.noFields && len(item.Fields) > 0 {
+				t.Errorf("%s: hit %s keeps the vector fetched to score it", tt.name, item.PKey)
+			}
+		}
+		if fmt.Sprint(got) != tt.want {
+			t.Errorf("%s: hits %v, want %s", tt.name, got, tt.want)
+		}
+		if int(merged.TotalHits) != len(got) || (len(got) > 0 && merged.MaxScore != merged.ResultItems[0].Score) {
+			t.Errorf("%s: total hits %d, max score %v", tt.name, merged.TotalHits, merged.MaxScore)
+		}
+	}
+}
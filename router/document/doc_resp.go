@@ -768,6 +768,17 @@ func ToContent(sr *vearchpb.SearchResult, head *vearchpb.RequestHead, took time.
 
 	builder.EndObject()
 
+	if sr.Cursor != "" {
+		builder.More()
+		builder.Field("cursor")
+		builder.ValueString(sr.Cursor)
+	}
+	if sr.Truncated {
+		builder.More()
+		builder.Field("truncated")
+		builder.ValueBool(sr.Truncated)
+	}
+
 	/*if sr.Explain != nil && len(sr.Explain) > 0 {
 		builder.More()
 		builder.Field("_explain")
@@ -780,6 +791,30 @@ func ToContent(sr *vearchpb.SearchResult, head *vearchpb.RequestHead, took time.
 
 }
 
+// ProfileToContent adds the profile of a search to the json object content
+func ProfileToContent(content []byte, profiles []*vearchpb.SearchProfile, routerProfile *vearchpb.RouterProfile) ([]byte, error) {
+	end := bytes.LastIndexByte(content, '}')
+	if end < 0 {
+		return nil, errors.New("search response is not a json object")
+	}
+	if profiles == nil {
+		profiles = make([]*vearchpb.SearchProfile, 0)
+	}
+	profile, err := json.Marshal(struct {
+		Router     *vearchpb.RouterProfile   `json:"router"`
+		Partitions []*vearchpb.SearchProfile `json:"partitions"`
+	}{routerProfile, profiles})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.Write(content[:end])
+	buf.WriteString(`,"profile":`)
+	buf.Write(profile)
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 func DocToContent(dh []*vearchpb.ResultItem, head *vearchpb.RequestHead, space *entity.Space) ([]byte, error) {
 	var builder = cbjson.ContentBuilderFactory()
 	idIsLong := idIsLong(space)
@@ -1208,3 +1243,60 @@ func docPrintLogSwitchResponse(printLogSwitch bool) ([]byte, error) {
 
 	return builder.Output()
 }
+
+func countResult(resp *vearchpb.CountResponse, withStats bool) ([]byte, error) {
+	var builder = cbjson.ContentBuilderFactory()
+
+	builder.BeginObject()
+
+	builder.Field("code")
+	if resp.Head == nil || resp.Head.Err == nil || resp.Head.Err.Code == vearchpb.ErrorEnum_SUCCESS {
+		builder.ValueNumeric(0)
+		builder.More()
+		builder.Field("msg")
+		builder.ValueString("success")
+	} else {
+		builder.ValueNumeric(int64(resp.Head.Err.Code))
+		builder.More()
+		builder.Field("msg")
+		builder.ValueString(resp.Head.Err.Msg)
+		builder.EndObject()
+		return builder.Output()
+	}
+
+	builder.More()
+	builder.Field("count")
+	builder.ValueNumeric(resp.Count)
+
+	if withStats {
+		builder.More()
+		builder.Field("fields")
+		builder.BeginObject()
+		for i, stats := range resp.FieldStats {
+			if i != 0 {
+				builder.More()
+			}
+			builder.Field(stats.Field)
+			builder.BeginObject()
+			builder.Field("count")
+			builder.ValueNumeric(stats.Count)
+			builder.More()
+			builder.Field("distinct")
+			builder.ValueNumeric(stats.Distinct)
+			if stats.Count > 0 {
+				builder.More()
+				builder.Field("min")
+				builder.ValueString(stats.Min)
+				builder.More()
+				builder.Field("max")
+				builder.ValueString(stats.Max)
+			}
+			builder.EndObject()
+		}
+		builder.EndObject()
+	}
+
+	builder.EndObject()
+
+	return builder.Output()
+}
@@ -17,20 +17,39 @@ package document
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/cubefs/cubefs/depends/tiglabs/raft/proto"
 	"github.com/spf13/cast"
 	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/monitor"
+	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/ps/engine/mapping"
+	"github.com/vearch/vearch/util/cbbytes"
 	"github.com/vearch/vearch/util/log"
 	"google.golang.org/grpc"
 )
 
-const defaultTimeOutMs = 1 * 1000
+const (
+	defaultTimeOutMs = 1 * 1000
+	// flush, forcemerge and rebuild wait for every partition
+	indexTimeOutMs = 60 * 1000
+	// bulkStreamInflight is the number of batches of a BulkStream written at
+	// the same time, the stream is not read while they are all busy so that
+	// the client is slowed down by grpc flow control
+	bulkStreamInflight = 4
+)
 
 type Request interface {
 	GetHead() *vearchpb.RequestHead
@@ -273,13 +292,455 @@ func (handler *RpcHandler) Bulk(ctx context.Context, req *vearchpb.BulkRequest)
 	return reply, nil
 }
 
+func (handler *RpcHandler) Upsert(ctx context.Context, req *vearchpb.BulkRequest) (reply *vearchpb.BulkResponse, err error) {
+	defer Cost("Upsert", time.Now())
+	return handler.Bulk(ctx, req)
+}
+
+// Query searches documents by filters only, like /document/query
+func (handler *RpcHandler) Query(ctx context.Context, req *vearchpb.SearchRequest) (reply *vearchpb.SearchResponse, err error) {
+	defer Cost("Query", time.Now())
+	if len(req.VecFields) > 0 {
+		return &vearchpb.SearchResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "query vector param should be null"))}, nil
+	}
+	if req.TermFilters == nil && req.RangeFilters == nil && req.BoolFilter == nil {
+		return &vearchpb.SearchResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "query filter is null"))}, nil
+	}
+	return handler.Search(ctx, req)
+}
+
+func (handler *RpcHandler) DeleteByQuery(ctx context.Context, req *vearchpb.SearchRequest) (reply *vearchpb.DelByQueryeResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = vearchpb.NewError(vearchpb.ErrorEnum_RECOVER, errors.New(cast.ToString(r)))
+		}
+	}()
+	defer Cost("DeleteByQuery", time.Now())
+	if req.TermFilters == nil && req.RangeFilters == nil && req.BoolFilter == nil {
+		return &vearchpb.DelByQueryeResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "query filter is null"))}, nil
+	}
+	space, err := handler.docService.getSpace(ctx, req.Head.DbName, req.Head.SpaceName)
+	if err != nil {
+		return &vearchpb.DelByQueryeResponse{Head: setErrHead(err)}, nil
+	}
+	if req.Head.Params == nil {
+		req.Head.Params = make(map[string]string)
+	}
+	req.Head.Params["queryOnlyId"] = "true"
+	req.Head.Params["idIsLong"] = strconv.FormatBool(idIsLong(space))
+	req.Fields = []string{mapping.IdField}
+
+	ctx, cancel := handler.setTimeout(ctx, req.Head, defaultTimeOutMs)
+	defer cancel()
+	return handler.docService.deleteByQuery(ctx, req), nil
+}
+
+func (handler *RpcHandler) Flush(ctx context.Context, req *vearchpb.FlushRequest) (reply *vearchpb.FlushResponse, err error) {
+	defer Cost("Flush", time.Now())
+	res, err := handler.deal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := res.(*vearchpb.FlushResponse)
+	if !ok {
+		return nil, vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, nil)
+	}
+	return reply, nil
+}
+
+func (handler *RpcHandler) ForceMerge(ctx context.Context, req *vearchpb.ForceMergeRequest) (reply *vearchpb.ForceMergeResponse, err error) {
+	defer Cost("ForceMerge", time.Now())
+	res, err := handler.deal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := res.(*vearchpb.ForceMergeResponse)
+	if !ok {
+		return nil, vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, nil)
+	}
+	return reply, nil
+}
+
+func (handler *RpcHandler) Rebuild(ctx context.Context, req *vearchpb.IndexRequest) (reply *vearchpb.IndexResponse, err error) {
+	defer Cost("Rebuild", time.Now())
+	res, err := handler.deal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reply, ok := res.(*vearchpb.IndexResponse)
+	if !ok {
+		return nil, vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, nil)
+	}
+	return reply, nil
+}
+
+// QueryByIDsFeature searches with the vectors of the documents, like
+// _query_byids_feature. Documents which are not found are skipped.
+func (handler *RpcHandler) QueryByIDsFeature(ctx context.Context, req *vearchpb.QueryByIDsFeatureRequest) (reply *vearchpb.SearchResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = vearchpb.NewError(vearchpb.ErrorEnum_RECOVER, errors.New(cast.ToString(r)))
+		}
+	}()
+	defer Cost("QueryByIDsFeature", time.Now())
+	searchReq := req.SearchRequest
+	if searchReq == nil || searchReq.Head == nil || len(req.PrimaryKeys) == 0 {
+		return &vearchpb.SearchResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "search_request and primary_keys should not be null"))}, nil
+	}
+	space, err := handler.docService.getSpace(ctx, searchReq.Head.DbName, searchReq.Head.SpaceName)
+	if err != nil {
+		return &vearchpb.SearchResponse{Head: setErrHead(err)}, nil
+	}
+	if space.Engine != nil && space.Engine.RetrievalType == "BINARYIVF" {
+		return &vearchpb.SearchResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "query by ids feature not support binary vector"))}, nil
+	}
+
+	getRes, err := handler.Get(ctx, &vearchpb.GetRequest{Head: searchReq.Head, PrimaryKeys: req.PrimaryKeys})
+	if err != nil {
+		return &vearchpb.SearchResponse{Head: setErrHead(err)}, nil
+	}
+	features := make(map[string][]float32)
+	reqNum := 0
+	for _, item := range getRes.Items {
+		if item == nil || item.Doc == nil || (item.Err != nil && item.Err.Code != vearchpb.ErrorEnum_SUCCESS) {
+			continue
+		}
+		floatFeatureMap, _, err := GetVectorFieldValue(item.Doc, space)
+		if err != nil {
+			return &vearchpb.SearchResponse{Head: setErrHead(err)}, nil
+		}
+		for name, feature := range floatFeatureMap {
+			features[name] = append(features[name], feature...)
+		}
+		reqNum++
+	}
+	if reqNum == 0 {
+		return &vearchpb.SearchResponse{Head: newOkHead()}, nil
+	}
+
+	if len(searchReq.VecFields) == 0 {
+		for name := range features {
+			searchReq.VecFields = append(searchReq.VecFields, &vearchpb.VectorQuery{
+				Name:     name,
+				MinScore: -math.MaxFloat64,
+				MaxScore: math.MaxFloat64,
+				Boost:    *defaultBoost,
+			})
+		}
+		searchReq.MultiVectorRank = 1
+	}
+	for _, vq := range searchReq.VecFields {
+		feature := features[vq.Name]
+		if len(feature) == 0 {
+			msg := fmt.Sprintf("QueryByIDsFeature: documents have no vector field [%s]", vq.Name)
+			return &vearchpb.SearchResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, msg))}, nil
+		}
+		if vq.Value, err = cbbytes.FloatArrayByte(feature); err != nil {
+			return &vearchpb.SearchResponse{Head: setErrHead(err)}, nil
+		}
+	}
+	searchReq.ReqNum = int32(reqNum)
+	return handler.Search(ctx, searchReq)
+}
+
+// BulkStream writes the batches of a stream as they arrive, each batch is
+// routed to its partitions and acknowledged with the error of every document.
+// A batch without head uses the head of the first batch.
+func (handler *RpcHandler) BulkStream(stream vearchpb.RouterGRPCService_BulkStreamServer) (err error) {
+	defer Cost("BulkStream", time.Now())
+	ctx := stream.Context()
+	var (
+		wg      sync.WaitGroup
+		sendMu  sync.Mutex
+		sendErr error
+		head    *vearchpb.RequestHead
+	)
+	send := func(resp *vearchpb.BulkStreamResponse) {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		if sendErr == nil {
+			sendErr = stream.Send(resp)
+		}
+	}
+	inflight := make(chan struct{}, bulkStreamInflight)
+	defer wg.Wait()
+
+	for batch := int64(0); ; batch++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.Head != nil {
+			if head == nil || req.Head.DbName != "" {
+				head = req.Head
+			}
+		}
+		if head == nil || head.DbName == "" || head.SpaceName == "" {
+			send(&vearchpb.BulkStreamResponse{Head: setErrHead(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "BulkStream: head with db and space is required")), Batch: batch})
+			continue
+		}
+		req.Head = head
+
+		select {
+		case inflight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func(batch int64, req *vearchpb.BulkRequest) {
+			defer func() {
+				if r := recover(); r != nil {
+					send(&vearchpb.BulkStreamResponse{Head: setErrHead(vearchpb.NewError(vearchpb.ErrorEnum_RECOVER, errors.New(cast.ToString(r)))), Batch: batch})
+				}
+				<-inflight
+				wg.Done()
+			}()
+			reply := handler.docService.bulk(ctx, req)
+			send(&vearchpb.BulkStreamResponse{Head: reply.Head, Batch: batch, Items: reply.Items})
+		}(batch, req)
+
+		sendMu.Lock()
+		err = sendErr
+		sendMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	wg.Wait()
+	return sendErr
+}
+
+// masterCall sends a request to the master API and decodes the data of its
+// reply into data
+func (handler *RpcHandler) masterCall(ctx context.Context, method string, uri string, body interface{}, data interface{}) error {
+	defer Cost("masterCall "+uri, time.Now())
+	reqBody := ""
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, err)
+		}
+		reqBody = string(bs)
+	}
+	resp, err := handler.client.Master().ProxyHTTPRequest(ctx, method, uri, reqBody)
+	if err != nil {
+		log.Error("master request %s %s err: %v", method, uri, err)
+		return err
+	}
+	reply := struct {
+		Code int             `json:"code"`
+		Msg  string          `json:"msg"`
+		Data json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(resp, &reply); err != nil {
+		return vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, fmt.Errorf("decode master reply %s: %v", resp, err))
+	}
+	if reply.Code != vearchpb.ErrCode(vearchpb.ErrorEnum_SUCCESS) {
+		code := vearchpb.ErrorEnum_INTERNAL_ERROR
+		// ErrCode maps an ErrorEnum to the http code by an offset
+		if offset := vearchpb.ErrCode(code) - int(code); reply.Code > offset {
+			code = vearchpb.ErrorEnum(reply.Code - offset)
+		}
+		return vearchpb.NewErrorInfo(code, reply.Msg)
+	}
+	if data == nil || len(reply.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(reply.Data, data)
+}
+
+// clusterCall returns the json data of a cluster report of the master
+func (handler *RpcHandler) clusterCall(ctx context.Context, uri string) *vearchpb.ClusterResponse {
+	var data json.RawMessage
+	if err := handler.masterCall(ctx, http.MethodGet, uri, nil, &data); err != nil {
+		return &vearchpb.ClusterResponse{Head: setErrHead(err)}
+	}
+	return &vearchpb.ClusterResponse{Head: newOkHead(), Body: data}
+}
+
+func (handler *RpcHandler) ListServer(ctx context.Context, req *vearchpb.RequestHead) (*vearchpb.ClusterResponse, error) {
+	return handler.clusterCall(ctx, "/list/server"), nil
+}
+
+func (handler *RpcHandler) ListPartition(ctx context.Context, req *vearchpb.RequestHead) (*vearchpb.ClusterResponse, error) {
+	return handler.clusterCall(ctx, "/list/partition"), nil
+}
+
+func (handler *RpcHandler) ClusterHealth(ctx context.Context, req *vearchpb.RequestHead) (*vearchpb.ClusterResponse, error) {
+	return handler.clusterCall(ctx, "/_cluster/health"), nil
+}
+
+func (handler *RpcHandler) ClusterStats(ctx context.Context, req *vearchpb.RequestHead) (*vearchpb.ClusterResponse, error) {
+	return handler.clusterCall(ctx, "/_cluster/stats"), nil
+}
+
+func (handler *RpcHandler) ListDB(ctx context.Context, req *vearchpb.DbRequest) (*vearchpb.DbResponse, error) {
+	dbs := make([]*entity.DB, 0)
+	err := handler.masterCall(ctx, http.MethodGet, "/list/db", nil, &dbs)
+	return dbReply(err, dbs...), nil
+}
+
+func (handler *RpcHandler) CreateDB(ctx context.Context, req *vearchpb.DbRequest) (*vearchpb.DbResponse, error) {
+	db := &entity.DB{Name: dbName(req)}
+	if req.Db != nil {
+		db.Ps = req.Db.Ps
+	}
+	err := handler.masterCall(ctx, http.MethodPut, "/db/_create", db, db)
+	return dbReply(err, db), nil
+}
+
+func (handler *RpcHandler) GetDB(ctx context.Context, req *vearchpb.DbRequest) (*vearchpb.DbResponse, error) {
+	db := &entity.DB{}
+	err := handler.masterCall(ctx, http.MethodGet, "/db/"+url.PathEscape(dbName(req)), nil, db)
+	return dbReply(err, db), nil
+}
+
+func (handler *RpcHandler) DeleteDB(ctx context.Context, req *vearchpb.DbRequest) (*vearchpb.DbResponse, error) {
+	err := handler.masterCall(ctx, http.MethodDelete, "/db/"+url.PathEscape(dbName(req)), nil, nil)
+	return dbReply(err), nil
+}
+
+func (handler *RpcHandler) ModifyDB(ctx context.Context, req *vearchpb.DbRequest) (*vearchpb.DbResponse, error) {
+	modify := &entity.DBModify{DbName: dbName(req), IPAddr: req.PsIp, Method: proto.ConfAddNode}
+	if req.RemovePs {
+		modify.Method = proto.ConfRemoveNode
+	}
+	db := &entity.DB{}
+	err := handler.masterCall(ctx, http.MethodPost, "/db/modify", modify, db)
+	return dbReply(err, db), nil
+}
+
+func (handler *RpcHandler) ListSpace(ctx context.Context, req *vearchpb.SpaceRequest) (*vearchpb.SpaceResponse, error) {
+	spaces := make([]*entity.Space, 0)
+	err := handler.masterCall(ctx, http.MethodGet, "/list/space?db="+url.QueryEscape(adminHead(req.Head).DbName), nil, &spaces)
+	return spaceReply(err, spaces...), nil
+}
+
+func (handler *RpcHandler) CreateSpace(ctx context.Context, req *vearchpb.SpaceRequest) (*vearchpb.SpaceResponse, error) {
+	info := req.GetSpace()
+	if info == nil {
+		return spaceReply(vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "no space to create")), nil
+	}
+	properties, err := spaceProperties(info.Fields)
+	if err != nil {
+		return spaceReply(err), nil
+	}
+	head := adminHead(req.Head)
+	name := info.Name
+	if name == "" {
+		name = head.SpaceName
+	}
+	body := &spaceBody{
+		Name:         name,
+		PartitionNum: info.PartitionNum,
+		ReplicaNum:   info.ReplicaNum,
+		Engine:       newSpaceEngine(info.Engine),
+		Properties:   properties,
+	}
+	space := &entity.Space{}
+	err = handler.masterCall(ctx, http.MethodPut, "/space/"+url.PathEscape(head.DbName)+"/_create", body, space)
+	return spaceReply(err, space), nil
+}
+
+func (handler *RpcHandler) GetSpace(ctx context.Context, req *vearchpb.SpaceRequest) (*vearchpb.SpaceResponse, error) {
+	space := &entity.Space{}
+	err := handler.masterCall(ctx, http.MethodGet, spaceURI(adminHead(req.Head)), nil, space)
+	return spaceReply(err, space), nil
+}
+
+// UpdateSpace changes the fields and the name of a space, the master takes
+// the whole space so the current one is read first
+func (handler *RpcHandler) UpdateSpace(ctx context.Context, req *vearchpb.SpaceRequest) (*vearchpb.SpaceResponse, error) {
+	space := &entity.Space{}
+	if err := handler.masterCall(ctx, http.MethodGet, spaceURI(adminHead(req.Head)), nil, space); err != nil {
+		return spaceReply(err), nil
+	}
+	info := req.GetSpace()
+	if info == nil {
+		info = &vearchpb.SpaceInfo{}
+	}
+	if info.Name != "" {
+		space.Name = info.Name
+	}
+	if len(info.Fields) > 0 {
+		properties, err := spaceProperties(info.Fields)
+		if err != nil {
+			return spaceReply(err), nil
+		}
+		if space.Properties, err = json.Marshal(properties); err != nil {
+			return spaceReply(vearchpb.NewError(vearchpb.ErrorEnum_PARAM_ERROR, err)), nil
+		}
+	}
+	result := &entity.Space{}
+	err := handler.masterCall(ctx, http.MethodPost, spaceURI(adminHead(req.Head)), space, result)
+	return spaceReply(err, result), nil
+}
+
+func (handler *RpcHandler) DeleteSpace(ctx context.Context, req *vearchpb.SpaceRequest) (*vearchpb.SpaceResponse, error) {
+	err := handler.masterCall(ctx, http.MethodDelete, spaceURI(adminHead(req.Head)), nil, nil)
+	return spaceReply(err), nil
+}
+
+// dbName is the name of db or the db of the head
+func dbName(req *vearchpb.DbRequest) string {
+	if req.Db != nil && req.Db.Name != "" {
+		return req.Db.Name
+	}
+	return adminHead(req.Head).DbName
+}
+
+func adminHead(head *vearchpb.RequestHead) *vearchpb.RequestHead {
+	if head == nil {
+		return &vearchpb.RequestHead{}
+	}
+	return head
+}
+
+func spaceURI(head *vearchpb.RequestHead) string {
+	return "/space/" + url.PathEscape(head.DbName) + "/" + url.PathEscape(head.SpaceName)
+}
+
+func dbReply(err error, dbs ...*entity.DB) *vearchpb.DbResponse {
+	if err != nil {
+		return &vearchpb.DbResponse{Head: setErrHead(err)}
+	}
+	reply := &vearchpb.DbResponse{Head: newOkHead(), Dbs: make([]*vearchpb.DbInfo, 0, len(dbs))}
+	for _, db := range dbs {
+		reply.Dbs = append(reply.Dbs, &vearchpb.DbInfo{Id: db.Id, Name: db.Name, Ps: db.Ps})
+	}
+	return reply
+}
+
+func spaceReply(err error, spaces ...*entity.Space) *vearchpb.SpaceResponse {
+	if err != nil {
+		return &vearchpb.SpaceResponse{Head: setErrHead(err)}
+	}
+	reply := &vearchpb.SpaceResponse{Head: newOkHead(), Spaces: make([]*vearchpb.SpaceInfo, 0, len(spaces))}
+	for _, space := range spaces {
+		info, err := newSpaceInfo(space)
+		if err != nil {
+			return &vearchpb.SpaceResponse{Head: setErrHead(err)}
+		}
+		reply.Spaces = append(reply.Spaces, info)
+	}
+	return reply
+}
+
 func (handler *RpcHandler) deal(ctx context.Context, req Request) (reply interface{}, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = vearchpb.NewError(vearchpb.ErrorEnum_RECOVER, errors.New(cast.ToString(r)))
 		}
 	}()
-	ctx, cancel := handler.setTimeout(ctx, req.GetHead())
+	maxTimeOutMs := int64(defaultTimeOutMs)
+	switch req.(type) {
+	case *vearchpb.FlushRequest, *vearchpb.ForceMergeRequest, *vearchpb.IndexRequest:
+		maxTimeOutMs = indexTimeOutMs
+	}
+	ctx, cancel := handler.setTimeout(ctx, req.GetHead(), maxTimeOutMs)
 	defer func() {
 		if cancel != nil {
 			cancel()
@@ -300,6 +761,12 @@ func (handler *RpcHandler) deal(ctx context.Context, req Request) (reply interfa
 		reply = handler.docService.search(ctx, v)
 	case *vearchpb.MSearchRequest:
 		reply = handler.docService.bulkSearch(ctx, v.SearchRequests)
+	case *vearchpb.FlushRequest:
+		reply = handler.docService.flush(ctx, v)
+	case *vearchpb.ForceMergeRequest:
+		reply = handler.docService.forceMerge(ctx, v)
+	case *vearchpb.IndexRequest:
+		reply = handler.docService.rebuildIndex(ctx, v)
 	default:
 		return nil, vearchpb.NewError(vearchpb.ErrorEnum_METHOD_NOT_IMPLEMENT, nil)
 	}
@@ -312,9 +779,9 @@ func Cost(name string, t time.Time) {
 	log.Debugf("%s cost: [%v]", name, engTime.Sub(t))
 }
 
-func (handler *RpcHandler) setTimeout(ctx context.Context, head *vearchpb.RequestHead) (context.Context, context.CancelFunc) {
-	if head.TimeOutMs < 1 || head.TimeOutMs > defaultTimeOutMs {
-		head.TimeOutMs = defaultTimeOutMs
+func (handler *RpcHandler) setTimeout(ctx context.Context, head *vearchpb.RequestHead, maxTimeOutMs int64) (context.Context, context.CancelFunc) {
+	if head.TimeOutMs < 1 || head.TimeOutMs > maxTimeOutMs {
+		head.TimeOutMs = maxTimeOutMs
 	}
 	return context.WithTimeout(ctx, time.Duration(head.TimeOutMs)*time.Millisecond)
 }
@@ -27,6 +27,8 @@ import (
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/ps/engine/sortorder"
 	"github.com/vearch/vearch/util/log"
+	"github.com/vearch/vearch/util/monitoring"
+	"github.com/vearch/vearch/util/slowlog"
 )
 
 const defaultRpcTimeOut int64 = 10 * 1000 // 10 second
@@ -55,10 +57,12 @@ func setTimeOut(ctx context.Context, head *vearchpb.RequestHead) (context.Contex
 	return context.WithTimeout(ctx, t)
 }
 
-func (docService *docService) getDocs(ctx context.Context, args *vearchpb.GetRequest) *vearchpb.GetResponse {
+func (docService *docService) getDocs(ctx context.Context, args *vearchpb.GetRequest) (reply *vearchpb.GetResponse) {
+	m := startRequest(args.Head, "get")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
-	reply := &vearchpb.GetResponse{Head: newOkHead()}
+	reply = &vearchpb.GetResponse{Head: newOkHead()}
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.GetDocsHandler).SetHead(args.Head).SetSpace().SetDocsByKey(args.PrimaryKeys).PartitionDocs()
 	if request.Err != nil {
@@ -71,10 +75,12 @@ func (docService *docService) getDocs(ctx context.Context, args *vearchpb.GetReq
 	return reply
 }
 
-func (docService *docService) getDocsByPartition(ctx context.Context, args *vearchpb.GetRequest, partitionId string) *vearchpb.GetResponse {
+func (docService *docService) getDocsByPartition(ctx context.Context, args *vearchpb.GetRequest, partitionId string) (reply *vearchpb.GetResponse) {
+	m := startRequest(args.Head, "get")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
-	reply := &vearchpb.GetResponse{Head: newOkHead()}
+	reply = &vearchpb.GetResponse{Head: newOkHead()}
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.GetDocsByPartitionHandler).SetHead(args.Head).SetSpace().SetDocsBySpecifyKey(args.PrimaryKeys).SetSendMap(partitionId)
 	if request.Err != nil {
@@ -87,10 +93,12 @@ func (docService *docService) getDocsByPartition(ctx context.Context, args *vear
 	return reply
 }
 
-func (docService *docService) addDoc(ctx context.Context, args *vearchpb.AddRequest) *vearchpb.AddResponse {
+func (docService *docService) addDoc(ctx context.Context, args *vearchpb.AddRequest) (reply *vearchpb.AddResponse) {
+	m := startRequest(args.Head, "add")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
-	reply := &vearchpb.AddResponse{Head: newOkHead()}
+	reply = &vearchpb.AddResponse{Head: newOkHead()}
 	request := client.NewRouterRequest(ctx, docService.client)
 	docs := make([]*vearchpb.Document, 0)
 	docs = append(docs, args.Doc)
@@ -111,10 +119,12 @@ func (docService *docService) addDoc(ctx context.Context, args *vearchpb.AddRequ
 	return reply
 }
 
-func (docService *docService) updateDoc(ctx context.Context, args *vearchpb.UpdateRequest) *vearchpb.UpdateResponse {
+func (docService *docService) updateDoc(ctx context.Context, args *vearchpb.UpdateRequest) (reply *vearchpb.UpdateResponse) {
+	m := startRequest(args.Head, "update")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
-	reply := &vearchpb.UpdateResponse{Head: newOkHead()}
+	reply = &vearchpb.UpdateResponse{Head: newOkHead()}
 	docs := make([]*vearchpb.Document, 0)
 	docs = append(docs, args.Doc)
 	request := client.NewRouterRequest(ctx, docService.client)
@@ -135,10 +145,12 @@ func (docService *docService) updateDoc(ctx context.Context, args *vearchpb.Upda
 	return reply
 }
 
-func (docService *docService) deleteDocs(ctx context.Context, args *vearchpb.DeleteRequest) *vearchpb.DeleteResponse {
+func (docService *docService) deleteDocs(ctx context.Context, args *vearchpb.DeleteRequest) (reply *vearchpb.DeleteResponse) {
+	m := startRequest(args.Head, "delete")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
-	reply := &vearchpb.DeleteResponse{Head: newOkHead()}
+	reply = &vearchpb.DeleteResponse{Head: newOkHead()}
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.DeleteDocsHandler).SetHead(args.Head).SetSpace().SetDocsByKey(args.PrimaryKeys).SetDocsField().PartitionDocs()
 	if request.Err != nil {
@@ -151,10 +163,12 @@ func (docService *docService) deleteDocs(ctx context.Context, args *vearchpb.Del
 	return reply
 }
 
-func (docService *docService) bulk(ctx context.Context, args *vearchpb.BulkRequest) *vearchpb.BulkResponse {
+func (docService *docService) bulk(ctx context.Context, args *vearchpb.BulkRequest) (reply *vearchpb.BulkResponse) {
+	m := startRequest(args.Head, "bulk")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
-	reply := &vearchpb.BulkResponse{Head: newOkHead()}
+	reply = &vearchpb.BulkResponse{Head: newOkHead()}
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.BatchHandler).SetHead(args.Head).SetSpace().SetDocs(args.Docs).SetDocsField().PartitionDocs()
 	if request.Err != nil {
@@ -167,6 +181,26 @@ func (docService *docService) bulk(ctx context.Context, args *vearchpb.BulkReque
 	return reply
 }
 
+// startRequest starts the metrics of a request of the router
+func startRequest(head *vearchpb.RequestHead, operation string) *monitoring.Request {
+	return monitoring.StartRequest(monitoring.RoleRouter, head.DbName, head.SpaceName, operation)
+}
+
+func countOperation(handlerType string) string {
+	if handlerType == client.FieldStatsHandler {
+		return "field_stats"
+	}
+	return "count"
+}
+
+// headErr is the error of a reply head, nil head is a success
+func headErr(head *vearchpb.ResponseHead) *vearchpb.Error {
+	if head == nil {
+		return nil
+	}
+	return head.Err
+}
+
 // utils
 func setErrHead(err error) *vearchpb.ResponseHead {
 	vErr, ok := err.(*vearchpb.VearchErr)
@@ -185,7 +219,10 @@ func (docService *docService) getSpace(ctx context.Context, dbName string, space
 	return docService.client.Master().Cache().SpaceByCache(ctx, dbName, spaceName)
 }
 
-func (docService *docService) search(ctx context.Context, args *vearchpb.SearchRequest) *vearchpb.SearchResponse {
+func (docService *docService) search(ctx context.Context, args *vearchpb.SearchRequest) (reply *vearchpb.SearchResponse) {
+	startTime := time.Now()
+	m := startRequest(args.Head, "search")
+	defer func() { m.End(headErr(reply.Head)) }()
 	ctx, cancel := setTimeOut(ctx, args.Head)
 	defer cancel()
 	request := client.NewRouterRequest(ctx, docService.client)
@@ -211,10 +248,41 @@ func (docService *docService) search(ctx context.Context, args *vearchpb.SearchR
 	if searchResponse.Head.Err == nil {
 		searchResponse.Head.Err = newOkHead().Err
 	}
+	if space := request.GetSpace(); space.SlowLog != nil && space.SlowLog.ThresholdMs > 0 {
+		if took := time.Since(startTime).Milliseconds(); took >= space.SlowLog.ThresholdMs {
+			addSlowSearch(space, args, searchResponse, request.SearchStat(), took)
+		}
+	}
 
 	return searchResponse
 }
 
+// addSlowSearch keeps a search that took longer than the slow log threshold
+// of its space, with the took of each partition it queried
+func addSlowSearch(space *entity.Space, args *vearchpb.SearchRequest, resp *vearchpb.SearchResponse, stat client.SearchStat, took int64) {
+	entry := &slowlog.Entry{
+		Role:              monitoring.RoleRouter,
+		DbName:            args.Head.DbName,
+		SpaceName:         space.Name,
+		Operation:         "search",
+		TookMs:            took,
+		ThresholdMs:       space.SlowLog.ThresholdMs,
+		PartitionTook:     make(map[uint32]int64, len(stat.PartitionTook)),
+		FilterSelectivity: slowlog.Selectivity(stat.FilterScanned, stat.FilterMatched),
+		Request:           slowlog.NormalizeSearch(args),
+	}
+	for pid, t := range stat.PartitionTook {
+		entry.PartitionTook[uint32(pid)] = t
+	}
+	for _, result := range resp.Results {
+		entry.ResultCount += len(result.ResultItems)
+		if result.MaxTook >= entry.MaxTook {
+			entry.MaxTook, entry.MaxTookID = result.MaxTook, result.MaxTookId
+		}
+	}
+	slowlog.Default().Add(entry)
+}
+
 func (docService *docService) bulkSearch(ctx context.Context, args []*vearchpb.SearchRequest) *vearchpb.SearchResponse {
 	searchResponse := &vearchpb.SearchResponse{}
 	var wg sync.WaitGroup
@@ -244,7 +312,9 @@ func (docService *docService) bulkSearch(ctx context.Context, args []*vearchpb.S
 	return searchResponse
 }
 
-func (docService *docService) flush(ctx context.Context, args *vearchpb.FlushRequest) *vearchpb.FlushResponse {
+func (docService *docService) flush(ctx context.Context, args *vearchpb.FlushRequest) (reply *vearchpb.FlushResponse) {
+	m := startRequest(args.Head, "flush")
+	defer func() { m.End(headErr(reply.Head)) }()
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.FlushHandler).SetHead(args.Head).SetSpace().CommonByPartitions()
 	if request.Err != nil {
@@ -266,7 +336,9 @@ func (docService *docService) flush(ctx context.Context, args *vearchpb.FlushReq
 	return flushResponse
 }
 
-func (docService *docService) forceMerge(ctx context.Context, args *vearchpb.ForceMergeRequest) *vearchpb.ForceMergeResponse {
+func (docService *docService) forceMerge(ctx context.Context, args *vearchpb.ForceMergeRequest) (reply *vearchpb.ForceMergeResponse) {
+	m := startRequest(args.Head, "forcemerge")
+	defer func() { m.End(headErr(reply.Head)) }()
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.ForceMergeHandler).SetHead(args.Head).SetSpace().CommonByPartitions()
 	if request.Err != nil {
@@ -288,7 +360,9 @@ func (docService *docService) forceMerge(ctx context.Context, args *vearchpb.For
 	return forceMergeResponse
 }
 
-func (docService *docService) rebuildIndex(ctx context.Context, args *vearchpb.IndexRequest) *vearchpb.IndexResponse {
+func (docService *docService) rebuildIndex(ctx context.Context, args *vearchpb.IndexRequest) (reply *vearchpb.IndexResponse) {
+	m := startRequest(args.Head, "rebuild")
+	defer func() { m.End(headErr(reply.Head)) }()
 	request := client.NewRouterRequest(ctx, docService.client)
 	request.SetMsgID().SetMethod(client.RebuildIndexHandler).SetHead(args.Head).SetSpace().CommonSetByPartitions(args)
 	if request.Err != nil {
@@ -310,7 +384,9 @@ func (docService *docService) rebuildIndex(ctx context.Context, args *vearchpb.I
 	return indexResponse
 }
 
-func (docService *docService) deleteByQuery(ctx context.Context, args *vearchpb.SearchRequest) *vearchpb.DelByQueryeResponse {
+func (docService *docService) deleteByQuery(ctx context.Context, args *vearchpb.SearchRequest) (reply *vearchpb.DelByQueryeResponse) {
+	m := startRequest(args.Head, "delete_by_query")
+	defer func() { m.End(headErr(reply.Head)) }()
 	request := client.NewRouterRequest(ctx, docService.client)
 	deleteByScalar := false
 	idIsLong := false
@@ -336,3 +412,24 @@ func (docService *docService) deleteByQuery(ctx context.Context, args *vearchpb.
 
 	return delByQueryResponse
 }
+
+func (docService *docService) count(ctx context.Context, args *vearchpb.SearchRequest, handlerType string) (reply *vearchpb.CountResponse) {
+	m := startRequest(args.Head, countOperation(handlerType))
+	defer func() { m.End(headErr(reply.Head)) }()
+	request := client.NewRouterRequest(ctx, docService.client)
+	if args.VecFields != nil {
+		err := fmt.Errorf("count vector param should be null")
+		return &vearchpb.CountResponse{Head: setErrHead(err)}
+	}
+
+	request.SetMsgID().SetMethod(handlerType).SetHead(args.Head).SetSpace().SearchByPartitions(args)
+	if request.Err != nil {
+		return &vearchpb.CountResponse{Head: setErrHead(request.Err)}
+	}
+
+	countResponse := request.CountExecute()
+	if countResponse.Head == nil {
+		countResponse.Head = newOkHead()
+	}
+	return countResponse
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package document
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+// spaceBody is a space of the master create space API
+type spaceBody struct {
+	Name         string                 `json:"name"`
+	PartitionNum int32                  `json:"partition_num,omitempty"`
+	ReplicaNum   int32                  `json:"replica_num,omitempty"`
+	Engine       *spaceEngine           `json:"engine,omitempty"`
+	Properties   map[string]*spaceField `json:"properties"`
+}
+
+type spaceEngine struct {
+	IndexSize       int64           `json:"index_size,omitempty"`
+	MetricType      string          `json:"metric_type,omitempty"`
+	RetrievalType   string          `json:"retrieval_type,omitempty"`
+	RetrievalTypes  []string        `json:"retrieval_types,omitempty"`
+	RetrievalParam  json.RawMessage `json:"retrieval_param,omitempty"`
+	RetrievalParams json.RawMessage `json:"retrieval_params,omitempty"`
+	IdType          string          `json:"id_type,omitempty"`
+}
+
+// spaceField is a field of the properties of a space
+type spaceField struct {
+	Type       string          `json:"type"`
+	Index      bool            `json:"index"`
+	Array      bool            `json:"array,omitempty"`
+	Dimension  int32           `json:"dimension,omitempty"`
+	Format     string          `json:"format,omitempty"`
+	StoreType  string          `json:"store_type,omitempty"`
+	StoreParam json.RawMessage `json:"store_param,omitempty"`
+	ModelId    string          `json:"model_id,omitempty"`
+}
+
+func newSpaceEngine(engine *vearchpb.SpaceEngine) *spaceEngine {
+	if engine == nil {
+		return nil
+	}
+	se := &spaceEngine{
+		IndexSize:      engine.IndexSize,
+		MetricType:     engine.MetricType,
+		RetrievalType:  engine.RetrievalType,
+		RetrievalTypes: engine.RetrievalTypes,
+		IdType:         engine.IdType,
+	}
+	if engine.RetrievalParam != "" {
+		se.RetrievalParam = json.RawMessage(engine.RetrievalParam)
+	}
+	if engine.RetrievalParams != "" {
+		se.RetrievalParams = json.RawMessage(engine.RetrievalParams)
+	}
+	return se
+}
+
+// spaceProperties are the properties of the fields, the json params are
+// checked here as the master would only fail to decode the whole space
+func spaceProperties(fields []*vearchpb.SpaceField) (map[string]*spaceField, error) {
+	properties := make(map[string]*spaceField, len(fields))
+	for _, f := range fields {
+		if f.Name == "" {
+			return nil, vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, "field without name")
+		}
+		if _, ok := properties[f.Name]; ok {
+			return nil, vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, fmt.Sprintf("field %s is repeated", f.Name))
+		}
+		pro := &spaceField{
+			Type:      f.Type,
+			Index:     f.Index,
+			Array:     f.Array,
+			Dimension: f.Dimension,
+			Format:    f.Format,
+			StoreType: f.StoreType,
+			ModelId:   f.ModelId,
+		}
+		if f.StoreParam != "" {
+			if !json.Valid([]byte(f.StoreParam)) {
+				return nil, vearchpb.NewErrorInfo(vearchpb.ErrorEnum_PARAM_ERROR, fmt.Sprintf("store_param of field %s is not json", f.Name))
+			}
+			pro.StoreParam = json.RawMessage(f.StoreParam)
+		}
+		properties[f.Name] = pro
+	}
+	return properties, nil
+}
+
+// newSpaceInfo is the space of the master, the fields are sorted by name
+func newSpaceInfo(space *entity.Space) (*vearchpb.SpaceInfo, error) {
+	info := &vearchpb.SpaceInfo{
+		Id:           space.Id,
+		Name:         space.Name,
+		DbId:         space.DBId,
+		PartitionNum: int32(space.PartitionNum),
+		ReplicaNum:   int32(space.ReplicaNum),
+		Enabled:      space.Enabled != nil && *space.Enabled,
+	}
+	if len(space.Properties) > 0 {
+		pros, err := entity.UnmarshalPropertyJSON(space.Properties)
+		if err != nil {
+			return nil, vearchpb.NewError(vearchpb.ErrorEnum_INTERNAL_ERROR, err)
+		}
+		info.Fields = make([]*vearchpb.SpaceField, 0, len(pros))
+		for name, pro := range pros {
+			f := &vearchpb.SpaceField{
+				Name:      name,
+				Type:      pro.Type,
+				Index:     pro.FieldType == entity.FieldType_VECTOR,
+				Array:     pro.Array,
+				Dimension: int32(pro.Dimension),
+				ModelId:   pro.ModelId,
+			}
+			if pro.Index != nil {
+				f.Index = *pro.Index
+			}
+			if pro.Format != nil {
+				f.Format = *pro.Format
+			}
+			if pro.StoreType != nil {
+				f.StoreType = *pro.StoreType
+			}
+			if len(pro.StoreParam) > 0 {
+				f.StoreParam = string(pro.StoreParam)
+			}
+			info.Fields = append(info.Fields, f)
+		}
+		sort.Slice(info.Fields, func(i, j int) bool { return info.Fields[i].Name < info.Fields[j].Name })
+	}
+	if e := space.Engine; e != nil {
+		info.Engine = &vearchpb.SpaceEngine{
+			IndexSize:       e.IndexSize,
+			MetricType:      e.MetricType,
+			RetrievalType:   e.RetrievalType,
+			RetrievalTypes:  e.RetrievalTypes,
+			RetrievalParam:  string(e.RetrievalParam),
+			RetrievalParams: string(e.RetrievalParams),
+			IdType:          e.IdType,
+		}
+	}
+	for _, p := range space.Partitions {
+		info.Partitions = append(info.Partitions, &vearchpb.SpacePartition{Id: p.Id, LeaderId: p.LeaderID, Replicas: p.Replicas})
+	}
+	return info, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package document
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+func TestSpaceFieldsRoundTrip(t *testing.T) {
+	fields := []*vearchpb.SpaceField{
+		{Name: "age", Type: "integer", Index: true},
+		{Name: "tags", Type: "string", Array: true},
+		{Name: "vec", Type: "vector", Index: true, Dimension: 4, Format: "normalization", StoreType: "RocksDB", StoreParam: `{"cache_size":1024}`},
+	}
+	properties, err := spaceProperties(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := true
+	space := &entity.Space{
+		Id: 3, Name: "space", DBId: 1, PartitionNum: 1, ReplicaNum: 1, Enabled: &enabled,
+		Properties: bs,
+		Engine:     &entity.Engine{IndexSize: 100, RetrievalType: "IVFPQ", RetrievalParam: json.RawMessage(`{"metric_type":"L2"}`)},
+		Partitions: []*entity.Partition{{Id: 9, LeaderID: 2, Replicas: []entity.NodeID{2}}},
+	}
+	info, err := newSpaceInfo(space)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Fields) != len(fields) {
+		t.Fatalf("fields: %v", info.Fields)
+	}
+	for i, f := range fields {
+		if !f.Equal(info.Fields[i]) {
+			t.Errorf("field %s: %v, want %v", f.Name, info.Fields[i], f)
+		}
+	}
+	if !info.Enabled || info.Engine.RetrievalParam != `{"metric_type":"L2"}` || len(info.Partitions) != 1 || info.Partitions[0].LeaderId != 2 {
+		t.Fatalf("space: %v", info)
+	}
+
+	// a vector is indexed unless it was created with index false
+	space.Properties = []byte(`{"vec":{"type":"vector","dimension":4}}`)
+	if info, err = newSpaceInfo(space); err != nil || !info.Fields[0].Index {
+		t.Fatalf("vector without index: %v, err: %v", info, err)
+	}
+
+	if _, err := spaceProperties([]*vearchpb.SpaceField{{Name: "vec", Type: "vector", StoreParam: "{"}}); err == nil {
+		t.Fatal("store_param that is not json should fail")
+	}
+	if _, err := spaceProperties([]*vearchpb.SpaceField{{Name: "a", Type: "long"}, {Name: "a", Type: "long"}}); err == nil {
+		t.Fatal("repeated field should fail")
+	}
+}
@@ -25,11 +25,13 @@ import (
 	"github.com/vearch/vearch/client"
 	"github.com/vearch/vearch/config"
 	"github.com/vearch/vearch/monitor"
+	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/router/document"
 	"github.com/vearch/vearch/util"
 	"github.com/vearch/vearch/util/log"
 	"github.com/vearch/vearch/util/metrics/mserver"
 	"github.com/vearch/vearch/util/netutil"
+	"github.com/vearch/vearch/util/tracer"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -71,7 +73,10 @@ func NewServer(ctx context.Context) (*Server, error) {
 		if err != nil {
 			panic(fmt.Errorf("start rpc server failed to listen: %v", err))
 		}
-		rpcServer = grpc.NewServer()
+		rpcServer = grpc.NewServer(
+			grpc.ChainUnaryInterceptor(tracer.UnaryServerInterceptor, unaryInterceptor),
+			grpc.ChainStreamInterceptor(tracer.StreamServerInterceptor, streamInterceptor),
+		)
 		go func() {
 			if err := rpcServer.Serve(lis); err != nil {
 				panic(fmt.Errorf("start rpc server failed to start: %v", err))
@@ -134,17 +139,13 @@ func (server *Server) Shutdown() {
 
 /* For GRPC */
 var (
-	errMissingMetadata = status.Errorf(codes.InvalidArgument, "missing metadata")
-	errInvalidToken    = status.Errorf(codes.Unauthenticated, "invalid token")
+	errInvalidToken = status.Errorf(codes.Unauthenticated, "Authorization failed, wrong user or password")
 )
 
+// unaryInterceptor authenticates a call by the user and password of its
+// request head, or of its authorization metadata as the http api does
 func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// authentication (token verification)
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, errMissingMetadata
-	}
-	if !valid(md["authorization"]) {
+	if !valid(ctx, requestHead(req)) {
 		return nil, errInvalidToken
 	}
 	m, err := handler(ctx, req)
@@ -154,13 +155,57 @@ func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServ
 	return m, err
 }
 
-// valid validates the authorization.
-func valid(authorization []string) bool {
-	if len(authorization) < 1 {
-		return false
+// authStream authenticates a stream by the head of its first request when
+// the stream has no authorization metadata
+type authStream struct {
+	grpc.ServerStream
+	authed bool
+}
+
+func (s *authStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil || s.authed {
+		return err
+	}
+	if !valid(s.Context(), requestHead(m)) {
+		return errInvalidToken
+	}
+	s.authed = true
+	return nil
+}
+
+func streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &authStream{ServerStream: ss, authed: valid(ss.Context(), nil)})
+}
+
+func requestHead(req interface{}) *vearchpb.RequestHead {
+	switch r := req.(type) {
+	case *vearchpb.RequestHead:
+		return r
+	case *vearchpb.QueryByIDsFeatureRequest:
+		return r.GetSearchRequest().GetHead()
+	case document.Request:
+		return r.GetHead()
+	}
+	return nil
+}
+
+// valid validates the user and password of head, or of the authorization
+// metadata when head has no user, against root and the signkey
+func valid(ctx context.Context, head *vearchpb.RequestHead) bool {
+	if config.Conf().Global.SkipAuth {
+		return true
+	}
+	username, password := "", ""
+	if head != nil {
+		username, password = head.UserName, head.Password
+	}
+	if username == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if authorization := md["authorization"]; len(authorization) > 0 {
+			username, password, _ = util.AuthDecrypt(authorization[0])
+		}
 	}
-	// username, password, err := util.AuthDecrypt(headerData)
-	return true
+	return username == client.Root && password == config.Conf().Global.Signkey
 }
 
 type Limiter struct {
@@ -0,0 +1,130 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package router
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vearch/vearch/config"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func initAuthConfig(t *testing.T, skipAuth bool) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	conf := "[global]\nsignkey = \"secret\"\n"
+	if skipAuth {
+		conf += "skip_auth = true\n"
+	}
+	if err := os.WriteFile(path, []byte(conf), 0600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	config.InitConfig(path)
+}
+
+func TestUnaryAuth(t *testing.T) {
+	initAuthConfig(t, false)
+	root := &vearchpb.RequestHead{UserName: "root", Password: "secret"}
+	withMetadata := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", util.AuthEncrypt("root", "secret")))
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  interface{}
+		ok   bool
+	}{
+		{"head", context.Background(), &vearchpb.SearchRequest{Head: root}, true},
+		{"wrong password", context.Background(), &vearchpb.GetRequest{Head: &vearchpb.RequestHead{UserName: "root", Password: "x"}}, false},
+		{"no head", context.Background(), &vearchpb.GetRequest{}, false},
+		{"space head", context.Background(), root, true},
+		{"query by ids", context.Background(), &vearchpb.QueryByIDsFeatureRequest{SearchRequest: &vearchpb.SearchRequest{Head: root}}, true},
+		{"admin", context.Background(), &vearchpb.DbRequest{Head: &vearchpb.RequestHead{DbName: "db"}}, false},
+		{"admin head", context.Background(), &vearchpb.SpaceRequest{Head: root}, true},
+		{"metadata", withMetadata, &vearchpb.DbRequest{Head: &vearchpb.RequestHead{DbName: "db"}}, true},
+	}
+	for _, tt := range tests {
+		called := false
+		_, err := unaryInterceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})
+		if (err == nil) != tt.ok || called != tt.ok {
+			t.Errorf("%s: err %v, handler called %v", tt.name, err, called)
+		}
+	}
+
+	initAuthConfig(t, true)
+	if _, err := unaryInterceptor(context.Background(), &vearchpb.GetRequest{}, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatalf("skip auth: %v", err)
+	}
+}
+
+// requestStream is a server stream receiving reqs
+type requestStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*vearchpb.BulkRequest
+}
+
+func (s *requestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *requestStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+	*m.(*vearchpb.BulkRequest) = *s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return nil
+}
+
+func TestStreamAuth(t *testing.T) {
+	initAuthConfig(t, false)
+	root := &vearchpb.RequestHead{UserName: "root", Password: "secret", DbName: "db", SpaceName: "space"}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		reqs []*vearchpb.BulkRequest
+		recv int
+	}{
+		{"first head", context.Background(), []*vearchpb.BulkRequest{{Head: root}, {}, {Head: &vearchpb.RequestHead{DbName: "db2"}}}, 3},
+		{"no head", context.Background(), []*vearchpb.BulkRequest{{}, {Head: root}}, 0},
+		{"metadata", metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", util.AuthEncrypt("root", "secret"))), []*vearchpb.BulkRequest{{}, {}}, 2},
+	}
+	for _, tt := range tests {
+		recv := 0
+		err := streamInterceptor(nil, &requestStream{ctx: tt.ctx, reqs: tt.reqs}, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+			for {
+				if err := ss.RecvMsg(new(vearchpb.BulkRequest)); err == io.EOF {
+					return nil
+				} else if err != nil {
+					return err
+				}
+				recv++
+			}
+		})
+		if recv != tt.recv || (err == nil) != (tt.recv > 0) {
+			t.Errorf("%s: received %d requests, err %v", tt.name, recv, err)
+		}
+	}
+}
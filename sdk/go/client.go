@@ -0,0 +1,375 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package vearch is a typed Go client for the router gRPC service.
+//
+// Documents and queries are built with typed values and encoded against the
+// space schema the same way the router encodes json requests, connections are
+// spread over all routers and retriable errors are retried with backoff.
+package vearch
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vearch/vearch/proto/vearchpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxRetries      = 3
+	defaultBackoff         = 100 * time.Millisecond
+	defaultMaxBackoff      = 2 * time.Second
+	defaultRefreshInterval = time.Minute
+)
+
+// Config of a Client, at least one of Routers and DiscoveryURL must be set.
+type Config struct {
+	// Routers are router rpc addresses as host:port
+	Routers []string
+	// DiscoveryURL is the http address of a router or master, the router list
+	// is loaded from its /list/router and refreshed every RefreshInterval
+	DiscoveryURL string
+	// RPCPort is the router rpc_port used with the discovered router ips
+	RPCPort  int
+	User     string
+	Password string
+	// MaxRetries is the number of retries after the first attempt, a negative
+	// value disables retries
+	MaxRetries int
+	// Backoff is the wait before the first retry, it doubles up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Timeout is used for calls whose context has no deadline
+	Timeout         time.Duration
+	RefreshInterval time.Duration
+	DialOptions     []grpc.DialOption
+}
+
+// Client sends requests to the routers over gRPC, it is safe for concurrent use.
+type Client struct {
+	cfg Config
+
+	mu    sync.RWMutex
+	addrs []string
+	conns map[string]*grpc.ClientConn
+	next  uint32
+
+	spaceMu sync.RWMutex
+	spaces  map[string]*Space
+
+	closed chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New connects to the routers of cfg.
+func New(ctx context.Context, cfg Config) (*Client, error) {
+	if len(cfg.Routers) == 0 && cfg.DiscoveryURL == "" {
+		return nil, fmt.Errorf("vearch: no router address or discovery url")
+	}
+	if cfg.DiscoveryURL != "" && cfg.RPCPort <= 0 {
+		return nil, fmt.Errorf("vearch: rpc port is required with discovery url")
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaultMaxRetries
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = defaultBackoff
+	}
+	if cfg.MaxBackoff < cfg.Backoff {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultRefreshInterval
+	}
+	if len(cfg.DialOptions) == 0 {
+		cfg.DialOptions = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	c := &Client{
+		cfg:    cfg,
+		conns:  make(map[string]*grpc.ClientConn),
+		spaces: make(map[string]*Space),
+		closed: make(chan struct{}),
+	}
+	if err := c.refresh(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+	if cfg.DiscoveryURL != "" {
+		c.wg.Add(1)
+		go c.refreshLoop()
+	}
+	return c, nil
+}
+
+// Close stops the router refresh and closes all connections.
+func (c *Client) Close() error {
+	select {
+	case <-c.closed:
+		return nil
+	default:
+		close(c.closed)
+	}
+	c.wg.Wait()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr, conn := range c.conns {
+		conn.Close()
+		delete(c.conns, addr)
+	}
+	c.addrs = nil
+	return nil
+}
+
+func (c *Client) refreshLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(c.cfg.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), c.cfg.RefreshInterval)
+			// keep the current routers when discovery fails
+			_ = c.refresh(ctx)
+			cancel()
+		}
+	}
+}
+
+// refresh dials the new routers and closes the ones that are gone
+func (c *Client) refresh(ctx context.Context) error {
+	addrs := append([]string{}, c.cfg.Routers...)
+	if c.cfg.DiscoveryURL != "" {
+		ips, err := c.discover(ctx)
+		if err != nil && len(addrs) == 0 {
+			return err
+		}
+		for _, ip := range ips {
+			addrs = append(addrs, net.JoinHostPort(ip, strconv.Itoa(c.cfg.RPCPort)))
+		}
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("vearch: no router found")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	conns := make(map[string]*grpc.ClientConn, len(addrs))
+	uniq := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := conns[addr]; ok {
+			continue
+		}
+		conn := c.conns[addr]
+		if conn == nil {
+			var err error
+			if conn, err = grpc.DialContext(ctx, addr, c.cfg.DialOptions...); err != nil {
+				return fmt.Errorf("vearch: dial router %s: %v", addr, err)
+			}
+		}
+		conns[addr] = conn
+		uniq = append(uniq, addr)
+	}
+	for addr, conn := range c.conns {
+		if _, ok := conns[addr]; !ok {
+			conn.Close()
+		}
+	}
+	c.conns, c.addrs = conns, uniq
+	return nil
+}
+
+// discover gets the router ips, a router answers "ip1,ip2" and a master
+// answers {"code":200,"data":["ip1","ip2"]}
+func (c *Client) discover(ctx context.Context) ([]string, error) {
+	url := strings.TrimSuffix(c.cfg.DiscoveryURL, "/")
+	if !strings.Contains(url, "://") {
+		url = "http://" + url
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/list/router", nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.cfg.User != "" {
+		req.SetBasicAuth(c.cfg.User, c.cfg.Password)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vearch: list router: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vearch: list router: status %d: %s", resp.StatusCode, body)
+	}
+
+	text := strings.TrimSpace(string(body))
+	if strings.HasPrefix(text, "{") {
+		ips := make([]string, 0)
+		if err := decodeReply(body, &ips); err != nil {
+			return nil, err
+		}
+		return ips, nil
+	}
+	ips := make([]string, 0)
+	for _, ip := range strings.Split(text, ",") {
+		if ip = strings.TrimSpace(ip); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+	return ips, nil
+}
+
+// pick returns the routers round robin
+func (c *Client) pick() (vearchpb.RouterGRPCServiceClient, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.addrs) == 0 {
+		return nil, fmt.Errorf("vearch: client is closed")
+	}
+	n := atomic.AddUint32(&c.next, 1)
+	return vearchpb.NewRouterGRPCServiceClient(c.conns[c.addrs[int(n)%len(c.addrs)]]), nil
+}
+
+func (c *Client) newHead(db, space string) *vearchpb.RequestHead {
+	return &vearchpb.RequestHead{
+		UserName:  c.cfg.User,
+		Password:  c.cfg.Password,
+		DbName:    db,
+		SpaceName: space,
+		Params:    make(map[string]string),
+	}
+}
+
+// invoke runs call until it succeeds, fails with an error that is not
+// retriable or runs out of retries. head.TimeOutMs is set before each attempt
+// to the time left until the context deadline.
+func (c *Client) invoke(ctx context.Context, head *vearchpb.RequestHead, retry bool,
+	call func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error)) error {
+	if _, ok := ctx.Deadline(); !ok && c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	backoff := c.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		if deadline, ok := ctx.Deadline(); ok {
+			left := time.Until(deadline).Milliseconds()
+			if left <= 0 {
+				return context.DeadlineExceeded
+			}
+			head.TimeOutMs = left
+		}
+
+		cli, err := c.pick()
+		if err != nil {
+			return err
+		}
+		respHead, err := call(ctx, cli)
+		if err == nil {
+			err = headError(respHead)
+		}
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= c.cfg.MaxRetries || !IsRetriable(err) {
+			return err
+		}
+
+		// full jitter keeps clients from retrying in lockstep
+		wait := time.Duration(rand.Int63n(int64(backoff))) + backoff/2
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		if backoff *= 2; backoff > c.cfg.MaxBackoff {
+			backoff = c.cfg.MaxBackoff
+		}
+	}
+}
+
+// Error is an error returned by the router.
+type Error struct {
+	Code vearchpb.ErrorEnum
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("vearch: %s: %s", e.Code.String(), e.Msg)
+}
+
+func headError(head *vearchpb.ResponseHead) error {
+	if head == nil || head.Err == nil {
+		return nil
+	}
+	return itemError(head.Err)
+}
+
+func itemError(e *vearchpb.Error) error {
+	if e == nil || e.Code == vearchpb.ErrorEnum_SUCCESS {
+		return nil
+	}
+	return &Error{Code: e.Code, Msg: e.Msg}
+}
+
+// retriable are the codes of errors caused by routing or load, the request
+// may succeed on another router or once the partition has a leader
+var retriable = map[vearchpb.ErrorEnum]bool{
+	vearchpb.ErrorEnum_SYSBUSY:                      true,
+	vearchpb.ErrorEnum_TIMEOUT:                      true,
+	vearchpb.ErrorEnum_SERVICE_UNAVAILABLE:          true,
+	vearchpb.ErrorEnum_RPC_GET_CLIENT_FAILED:        true,
+	vearchpb.ErrorEnum_RPC_INVOKE_FAILED:            true,
+	vearchpb.ErrorEnum_PARTITION_NOT_LEADER:         true,
+	vearchpb.ErrorEnum_PARTITION_NO_LEADER:          true,
+	vearchpb.ErrorEnum_MASTER_SERVER_IS_NOT_RUNNING: true,
+	vearchpb.ErrorEnum_ROUTER_NO_PS_CLIENT:          true,
+	vearchpb.ErrorEnum_ROUTER_CALL_PS_RPC_ERR:       true,
+	vearchpb.ErrorEnum_Create_RpcClient_Failed:      true,
+	vearchpb.ErrorEnum_Call_RpcClient_Failed:        true,
+}
+
+// IsRetriable reports whether err may succeed when the request is sent again.
+func IsRetriable(err error) bool {
+	if e, ok := err.(*Error); ok {
+		return retriable[e.Code]
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,475 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vearch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+const (
+	idField          = "_id"
+	arraySeparator   = "\001"
+	maxStrLen        = 65535
+	maxIndexedStrLen = 1024
+)
+
+// Document is a document to write, values are checked and encoded against
+// the space schema by Encode.
+type Document struct {
+	// ID is the primary key, the router generates one when it is empty
+	ID     string
+	names  []string
+	values map[string]interface{}
+}
+
+func NewDocument(id string) *Document {
+	return &Document{ID: id, values: make(map[string]interface{})}
+}
+
+func (d *Document) set(name string, v interface{}) *Document {
+	if _, ok := d.values[name]; !ok {
+		d.names = append(d.names, name)
+	}
+	d.values[name] = v
+	return d
+}
+
+// SetInt sets an integer, long, float or double field.
+func (d *Document) SetInt(name string, v int64) *Document { return d.set(name, v) }
+
+// SetFloat sets a float or double field.
+func (d *Document) SetFloat(name string, v float64) *Document { return d.set(name, v) }
+
+func (d *Document) SetString(name, v string) *Document { return d.set(name, v) }
+
+func (d *Document) SetBool(name string, v bool) *Document { return d.set(name, v) }
+
+func (d *Document) SetDate(name string, v time.Time) *Document { return d.set(name, v) }
+
+// SetInts sets an integer or long array field.
+func (d *Document) SetInts(name string, v []int64) *Document { return d.set(name, v) }
+
+// SetFloats sets a float or double array field.
+func (d *Document) SetFloats(name string, v []float64) *Document { return d.set(name, v) }
+
+func (d *Document) SetStrings(name string, v []string) *Document { return d.set(name, v) }
+
+func (d *Document) SetVector(name string, v []float32) *Document { return d.set(name, v) }
+
+// SetBinaryVector sets a vector of a BINARYIVF space, one byte holds 8 dimensions.
+func (d *Document) SetBinaryVector(name string, v []uint8) *Document { return d.set(name, v) }
+
+// Encode converts the document to the router format of space.
+func (d *Document) Encode(space *Space) (*vearchpb.Document, error) {
+	doc := &vearchpb.Document{PKey: d.ID, Fields: make([]*vearchpb.Field, 0, len(d.names))}
+	for _, name := range d.names {
+		pro, err := space.field(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := encodeValue(space, pro, name, d.values[name])
+		if err != nil {
+			return nil, err
+		}
+		opt := vearchpb.FieldOption_Null
+		if pro.Option == entity.FieldOption_Index {
+			opt = vearchpb.FieldOption_Index
+		}
+		doc.Fields = append(doc.Fields, &vearchpb.Field{
+			Name:   name,
+			Type:   vearchpb.FieldType(pro.FieldType),
+			Value:  value,
+			Option: opt,
+		})
+	}
+	return doc, nil
+}
+
+func mismatch(name string, v interface{}, pro *entity.SpaceProperties) error {
+	return fmt.Errorf("vearch: field %s value %v mismatch field type %s", name, v, pro.Type)
+}
+
+// encodeValue encodes v like the router does for json documents
+func encodeValue(space *Space, pro *entity.SpaceProperties, name string, v interface{}) ([]byte, error) {
+	if pro.Array {
+		return encodeArray(pro, name, v)
+	}
+	switch pro.FieldType {
+	case entity.FieldType_STRING:
+		s, ok := v.(string)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		return encodeString(pro, name, s)
+	case entity.FieldType_INT:
+		i, ok := v.(int64)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		if i < math.MinInt32 || i > math.MaxInt32 {
+			return nil, fmt.Errorf("vearch: field %s value %d overflows integer", name, i)
+		}
+		return cbbytes.Int32ToByte(int32(i)), nil
+	case entity.FieldType_LONG:
+		i, ok := v.(int64)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		return cbbytes.Int64ToByte(i), nil
+	case entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+		var f float64
+		switch n := v.(type) {
+		case int64:
+			f = float64(n)
+		case float64:
+			f = n
+		default:
+			return nil, mismatch(name, v, pro)
+		}
+		if pro.FieldType == entity.FieldType_FLOAT {
+			return cbbytes.Float32ToByte(float32(f)), nil
+		}
+		return cbbytes.Float64ToByteNew(f), nil
+	case entity.FieldType_DATE:
+		switch t := v.(type) {
+		case time.Time:
+			return cbbytes.Int64ToByte(t.UnixNano()), nil
+		case int64:
+			// milliseconds like json numbers
+			return cbbytes.Int64ToByte(t * 1e6), nil
+		}
+		return nil, mismatch(name, v, pro)
+	case entity.FieldType_BOOL:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		return cbbytes.BoolToByte(b), nil
+	case entity.FieldType_VECTOR:
+		return encodeVector(space, pro, name, v)
+	}
+	return nil, mismatch(name, v, pro)
+}
+
+func encodeString(pro *entity.SpaceProperties, name, s string) ([]byte, error) {
+	if pro.Index != nil && *pro.Index && len(s) > maxIndexedStrLen {
+		return nil, fmt.Errorf("vearch: field %s indexed string len should less than %d", name, maxIndexedStrLen)
+	} else if len(s) > maxStrLen {
+		return nil, fmt.Errorf("vearch: field %s string len should less than %d", name, maxStrLen)
+	}
+	return []byte(s), nil
+}
+
+// encodeArray encodes a multi-value field, strings are joined by \001 and
+// integers are always 8 bytes
+func encodeArray(pro *entity.SpaceProperties, name string, v interface{}) ([]byte, error) {
+	buf := bytes.Buffer{}
+	switch pro.FieldType {
+	case entity.FieldType_STRING:
+		ss, ok := v.([]string)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		return encodeString(pro, name, strings.Join(ss, arraySeparator))
+	case entity.FieldType_INT, entity.FieldType_LONG:
+		is, ok := v.([]int64)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		for _, i := range is {
+			buf.Write(cbbytes.Int64ToByte(i))
+		}
+	case entity.FieldType_FLOAT, entity.FieldType_DOUBLE:
+		fs, ok := v.([]float64)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		for _, f := range fs {
+			if pro.FieldType == entity.FieldType_FLOAT {
+				buf.Write(cbbytes.Float32ToByte(float32(f)))
+			} else {
+				buf.Write(cbbytes.Float64ToByte(f))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("vearch: field %s type %s can't use as array", name, pro.Type)
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeVector(space *Space, pro *entity.SpaceProperties, name string, v interface{}) ([]byte, error) {
+	if space.binary() {
+		bs, ok := v.([]uint8)
+		if !ok {
+			return nil, mismatch(name, v, pro)
+		}
+		if pro.Dimension > 0 && pro.Dimension/8 != len(bs) {
+			return nil, fmt.Errorf("vearch: field %s vector length err, schema is %d but input %d", name, pro.Dimension, len(bs)*8)
+		}
+		return cbbytes.VectorBinaryToByte(bs)
+	}
+	fs, ok := v.([]float32)
+	if !ok {
+		return nil, mismatch(name, v, pro)
+	}
+	if pro.Dimension > 0 && pro.Dimension != len(fs) {
+		return nil, fmt.Errorf("vearch: field %s vector length err, schema is %d but input %d", name, pro.Dimension, len(fs))
+	}
+	for i, f := range fs {
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return nil, fmt.Errorf("vearch: field %s vector value at %d is %v", name, i, f)
+		}
+	}
+	return cbbytes.VectorToByte(fs)
+}
+
+// Hit is a document returned by Get, Search or Query. Fields hold string,
+// []string, int32, int64, []int64, float32, float64, []float32, []float64,
+// bool, time.Time, []float32 vectors or []int32 binary vectors.
+type Hit struct {
+	ID     string
+	Score  float64
+	Fields map[string]interface{}
+}
+
+// Int returns an integer or long field.
+func (h *Hit) Int(name string) (int64, bool) {
+	switch v := h.Fields[name].(type) {
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+// Float returns a float or double field.
+func (h *Hit) Float(name string) (float64, bool) {
+	switch v := h.Fields[name].(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func (h *Hit) String(name string) (string, bool) {
+	v, ok := h.Fields[name].(string)
+	return v, ok
+}
+
+func (h *Hit) Vector(name string) ([]float32, bool) {
+	v, ok := h.Fields[name].([]float32)
+	return v, ok
+}
+
+func decodeHit(space *Space, pkey string, score float64, fields []*vearchpb.Field) (*Hit, error) {
+	hit := &Hit{ID: pkey, Score: score, Fields: make(map[string]interface{}, len(fields))}
+	for _, f := range fields {
+		if f.Name == idField {
+			if hit.ID == "" {
+				if strings.EqualFold(space.IDType, "long") && len(f.Value) == 8 {
+					hit.ID = strconv.FormatInt(cbbytes.Bytes2Int(f.Value), 10)
+				} else {
+					hit.ID = string(f.Value)
+				}
+			}
+			continue
+		}
+		pro := space.Fields[f.Name]
+		if pro == nil {
+			continue
+		}
+		v, err := decodeValue(space, pro, f.Value)
+		if err != nil {
+			return nil, fmt.Errorf("vearch: decode field %s: %v", f.Name, err)
+		}
+		hit.Fields[f.Name] = v
+	}
+	return hit, nil
+}
+
+func decodeValue(space *Space, pro *entity.SpaceProperties, bs []byte) (interface{}, error) {
+	if pro.Array && pro.FieldType != entity.FieldType_STRING {
+		return decodeArray(pro, bs)
+	}
+	switch pro.FieldType {
+	case entity.FieldType_STRING:
+		if pro.Array {
+			return strings.Split(string(bs), arraySeparator), nil
+		}
+		return string(bs), nil
+	case entity.FieldType_INT:
+		return cbbytes.Bytes2Int32(bs), nil
+	case entity.FieldType_LONG:
+		return cbbytes.Bytes2Int(bs), nil
+	case entity.FieldType_FLOAT:
+		return cbbytes.ByteToFloat32(bs), nil
+	case entity.FieldType_DOUBLE:
+		return cbbytes.ByteToFloat64New(bs), nil
+	case entity.FieldType_DATE:
+		return time.Unix(0, cbbytes.Bytes2Int(bs)), nil
+	case entity.FieldType_BOOL:
+		return len(bs) > 0 && bytes.Count(bs, []byte{0}) != len(bs), nil
+	case entity.FieldType_VECTOR:
+		if space.binary() {
+			v, _, err := cbbytes.ByteToVectorBinary(bs, pro.Dimension)
+			return v, err
+		}
+		v, _, err := cbbytes.ByteToVector(bs)
+		return v, err
+	}
+	return nil, fmt.Errorf("unknown field type %s", pro.Type)
+}
+
+func decodeArray(pro *entity.SpaceProperties, bs []byte) (interface{}, error) {
+	switch pro.FieldType {
+	case entity.FieldType_INT, entity.FieldType_LONG:
+		vs := make([]int64, 0, len(bs)/8)
+		for i := 0; i+8 <= len(bs); i += 8 {
+			vs = append(vs, cbbytes.Bytes2Int(bs[i:i+8]))
+		}
+		return vs, nil
+	case entity.FieldType_FLOAT:
+		return cbbytes.ByteToFloat32Array(bs)
+	case entity.FieldType_DOUBLE:
+		vs := make([]float64, 0, len(bs)/8)
+		for i := 0; i+8 <= len(bs); i += 8 {
+			vs = append(vs, cbbytes.ByteToFloat64New(bs[i:i+8]))
+		}
+		return vs, nil
+	}
+	return nil, fmt.Errorf("field type %s can't use as array", pro.Type)
+}
+
+// WriteResult is the result of writing or deleting one document.
+type WriteResult struct {
+	ID  string
+	Err error
+}
+
+func writeResults(items []*vearchpb.Item) []*WriteResult {
+	results := make([]*WriteResult, 0, len(items))
+	for _, item := range items {
+		r := &WriteResult{Err: itemError(item.Err)}
+		if item.Doc != nil {
+			r.ID = item.Doc.PKey
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// Upsert writes docs, a document with an existing ID replaces it. The request
+// is retried only when every document has an ID, so retries can't duplicate.
+func (c *Client) Upsert(ctx context.Context, db, spaceName string, docs ...*Document) ([]*WriteResult, error) {
+	space, err := c.Space(ctx, db, spaceName)
+	if err != nil {
+		return nil, err
+	}
+	req := &vearchpb.BulkRequest{Head: c.newHead(db, spaceName), Docs: make([]*vearchpb.Document, 0, len(docs))}
+	retry := true
+	for _, d := range docs {
+		doc, err := d.Encode(space)
+		if err != nil {
+			return nil, err
+		}
+		retry = retry && d.ID != ""
+		req.Docs = append(req.Docs, doc)
+	}
+
+	var reply *vearchpb.BulkResponse
+	err = c.invoke(ctx, req.Head, retry, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		r, err := cli.Upsert(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		reply = r
+		return r.Head, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return writeResults(reply.Items), nil
+}
+
+// Get returns the documents of ids that exist.
+func (c *Client) Get(ctx context.Context, db, spaceName string, ids ...string) ([]*Hit, error) {
+	space, err := c.Space(ctx, db, spaceName)
+	if err != nil {
+		return nil, err
+	}
+	req := &vearchpb.GetRequest{Head: c.newHead(db, spaceName), PrimaryKeys: ids}
+	var reply *vearchpb.GetResponse
+	err = c.invoke(ctx, req.Head, true, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		r, err := cli.Get(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		reply = r
+		return r.Head, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*Hit, 0, len(reply.Items))
+	for _, item := range reply.Items {
+		if err := itemError(item.Err); err != nil {
+			if item.Err.Code == vearchpb.ErrorEnum_DOCUMENT_NOT_EXIST {
+				continue
+			}
+			return nil, err
+		}
+		if item.Doc == nil {
+			continue
+		}
+		hit, err := decodeHit(space, item.Doc.PKey, 0, item.Doc.Fields)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+// Delete deletes the documents of ids.
+func (c *Client) Delete(ctx context.Context, db, spaceName string, ids ...string) ([]*WriteResult, error) {
+	req := &vearchpb.DeleteRequest{Head: c.newHead(db, spaceName), PrimaryKeys: ids}
+	var reply *vearchpb.DeleteResponse
+	err := c.invoke(ctx, req.Head, true, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		r, err := cli.Delete(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		reply = r
+		return r.Head, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return writeResults(reply.Items), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vearch
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+func testSpace(t *testing.T) *Space {
+	info := &vearchpb.SpaceInfo{
+		Name: "ts",
+		Fields: []*vearchpb.SpaceField{
+			{Name: "name", Type: "keyword", Index: true},
+			{Name: "tags", Type: "string", Array: true},
+			{Name: "age", Type: "integer", Index: true},
+			{Name: "price", Type: "double"},
+			{Name: "sizes", Type: "long", Array: true},
+			{Name: "created", Type: "date"},
+			{Name: "ok", Type: "bool"},
+			{Name: "vec", Type: "vector", Index: true, Dimension: 4},
+		},
+		Engine: &vearchpb.SpaceEngine{RetrievalType: "IVFPQ", MetricType: "L2"},
+	}
+	space, err := newSpace("db", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return space
+}
+
+func TestDocumentRoundTrip(t *testing.T) {
+	space := testSpace(t)
+	created := time.Unix(1600000000, 0)
+	d := NewDocument("1").
+		SetString("name", "vearch").
+		SetStrings("tags", []string{"a", "b"}).
+		SetInt("age", 7).
+		SetFloat("price", 1.5).
+		SetInts("sizes", []int64{1, 2, 3}).
+		SetDate("created", created).
+		SetBool("ok", true).
+		SetVector("vec", []float32{1, 2, 3, 4})
+
+	doc, err := d.Encode(space)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range doc.Fields {
+		if f.Name == "vec" {
+			// the engine returns vectors with their length in front
+			f.Value = append(cbbytes.UInt32ToByte(uint32(len(f.Value))), f.Value...)
+		}
+		if f.Name == "age" && f.Option != vearchpb.FieldOption_Index {
+			t.Fatalf("age should be indexed")
+		}
+	}
+	hit, err := decodeHit(space, doc.PKey, 0, doc.Fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"name":    "vearch",
+		"tags":    []string{"a", "b"},
+		"age":     int32(7),
+		"price":   1.5,
+		"sizes":   []int64{1, 2, 3},
+		"created": created,
+		"ok":      true,
+		"vec":     []float32{1, 2, 3, 4},
+	}
+	for name, v := range want {
+		if got := hit.Fields[name]; !reflect.DeepEqual(got, v) {
+			t.Errorf("field %s got %v want %v", name, got, v)
+		}
+	}
+}
+
+func TestDocumentMismatch(t *testing.T) {
+	space := testSpace(t)
+	for _, d := range []*Document{
+		NewDocument("1").SetString("age", "7"),
+		NewDocument("1").SetInt("age", 1<<40),
+		NewDocument("1").SetVector("vec", []float32{1, 2}),
+		NewDocument("1").SetString("unknown", "x"),
+	} {
+		if _, err := d.Encode(space); err == nil {
+			t.Errorf("document %v should fail", d.values)
+		}
+	}
+}
+
+func TestQueryBuild(t *testing.T) {
+	space := testSpace(t)
+	req, err := NewQuery().
+		Vector("vec", []float32{1, 2, 3, 4}, []float32{4, 3, 2, 1}).
+		Term("name", "a", "b").
+		Range("age", 18, nil, true, false).
+		Fields("name").
+		Build(space)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.ReqNum != 2 || req.TopN != defaultSize {
+		t.Fatalf("req num %d top n %d", req.ReqNum, req.TopN)
+	}
+	if !reflect.DeepEqual(req.Fields, []string{"name", idField}) {
+		t.Fatalf("fields %v", req.Fields)
+	}
+	if len(req.SortFields) != 1 || req.SortFields[0].Type {
+		t.Fatalf("L2 should sort by score asc: %v", req.SortFields)
+	}
+	if string(req.TermFilters[0].Value) != "a\001b" || req.TermFilters[0].IsUnion != termOr {
+		t.Fatalf("term filter %v", req.TermFilters[0])
+	}
+	rf := req.RangeFilters[0]
+	if cbbytes.Bytes2Int32(rf.LowerValue) != 18 || len(rf.UpperValue) != 4 {
+		t.Fatalf("range filter %v", rf)
+	}
+
+	if _, err := NewQuery().Vector("vec", []float32{1}).Build(space); err == nil {
+		t.Fatal("dimension mismatch should fail")
+	}
+}
+
+func TestDecodeReply(t *testing.T) {
+	if err := decodeReply([]byte(`{"code":200,"msg":"success"}`), nil); err != nil {
+		t.Fatal(err)
+	}
+	code := vearchpb.ErrorEnum_PARTITION_NO_LEADER
+	err := decodeReply([]byte(`{"code":`+strconv.Itoa(vearchpb.ErrCode(code))+`,"msg":"no leader"}`), nil)
+	if e, ok := err.(*Error); !ok || e.Code != code || !IsRetriable(err) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if IsRetriable(&Error{Code: vearchpb.ErrorEnum_PARAM_ERROR}) {
+		t.Fatal("param error is not retriable")
+	}
+}
@@ -0,0 +1,571 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/cbbytes"
+)
+
+const defaultSize = 50
+
+// term filter operators, IsUnion of vearchpb.TermFilter
+const (
+	termAnd int32 = iota
+	termOr
+	termNot
+)
+
+type vectorClause struct {
+	field    string
+	features [][]float32
+	binary   [][]uint8
+	minScore float64
+	maxScore float64
+	boost    float64
+}
+
+type termClause struct {
+	field  string
+	values []string
+	op     int32
+}
+
+type rangeClause struct {
+	field                      string
+	lower, upper               interface{}
+	includeLower, includeUpper bool
+}
+
+type sortClause struct {
+	field string
+	desc  bool
+}
+
+// Query builds a search, it is encoded against the space schema by Build.
+type Query struct {
+	vectors         []*vectorClause
+	terms           []*termClause
+	ranges          []*rangeClause
+	sorts           []*sortClause
+	fields          []string
+	size            int
+	retrievalParams map[string]interface{}
+	brute           bool
+	vectorValue     bool
+	err             error
+}
+
+func NewQuery() *Query {
+	return &Query{size: defaultSize}
+}
+
+func (q *Query) lastVector() *vectorClause {
+	if len(q.vectors) == 0 {
+		q.err = fmt.Errorf("vearch: no vector to set score or boost")
+		return &vectorClause{}
+	}
+	return q.vectors[len(q.vectors)-1]
+}
+
+// Vector searches field by features, each feature is a query of the batch
+// and gets its own list of hits.
+func (q *Query) Vector(field string, features ...[]float32) *Query {
+	q.vectors = append(q.vectors, &vectorClause{field: field, features: features,
+		minScore: -math.MaxFloat64, maxScore: math.MaxFloat64, boost: 1})
+	return q
+}
+
+// BinaryVector searches field of a BINARYIVF space.
+func (q *Query) BinaryVector(field string, features ...[]uint8) *Query {
+	q.vectors = append(q.vectors, &vectorClause{field: field, binary: features,
+		minScore: -math.MaxFloat64, maxScore: math.MaxFloat64, boost: 1})
+	return q
+}
+
+// Score limits the score of hits of the last vector.
+func (q *Query) Score(min, max float64) *Query {
+	vc := q.lastVector()
+	vc.minScore, vc.maxScore = min, max
+	return q
+}
+
+// Boost weights the score of the last vector when several vectors are searched.
+func (q *Query) Boost(boost float64) *Query {
+	q.lastVector().boost = boost
+	return q
+}
+
+// Term matches documents having any of values.
+func (q *Query) Term(field string, values ...string) *Query {
+	q.terms = append(q.terms, &termClause{field: field, values: values, op: termOr})
+	return q
+}
+
+// TermAll matches documents having all of values.
+func (q *Query) TermAll(field string, values ...string) *Query {
+	q.terms = append(q.terms, &termClause{field: field, values: values, op: termAnd})
+	return q
+}
+
+// TermNot matches documents having none of values.
+func (q *Query) TermNot(field string, values ...string) *Query {
+	q.terms = append(q.terms, &termClause{field: field, values: values, op: termNot})
+	return q
+}
+
+// Range matches documents with field between lower and upper, a nil bound is
+// unbounded. Bounds are int, int64, float64 or time.Time for date fields.
+func (q *Query) Range(field string, lower, upper interface{}, includeLower, includeUpper bool) *Query {
+	q.ranges = append(q.ranges, &rangeClause{field: field, lower: lower, upper: upper,
+		includeLower: includeLower, includeUpper: includeUpper})
+	return q
+}
+
+// Fields are the fields to return, all scalar fields by default.
+func (q *Query) Fields(fields ...string) *Query {
+	q.fields = fields
+	return q
+}
+
+func (q *Query) Size(size int) *Query {
+	q.size = size
+	return q
+}
+
+// Sort orders hits by field, _score or _id.
+func (q *Query) Sort(field string, desc bool) *Query {
+	q.sorts = append(q.sorts, &sortClause{field: field, desc: desc})
+	return q
+}
+
+// RetrievalParams are the index search params, like {"nprobe": 20}.
+func (q *Query) RetrievalParams(params map[string]interface{}) *Query {
+	q.retrievalParams = params
+	return q
+}
+
+func (q *Query) Brute(brute bool) *Query {
+	q.brute = brute
+	return q
+}
+
+// VectorValue returns vector fields in hits.
+func (q *Query) VectorValue(vectorValue bool) *Query {
+	q.vectorValue = vectorValue
+	return q
+}
+
+// Build converts the query to the router format of space.
+func (q *Query) Build(space *Space) (*vearchpb.SearchRequest, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	req := &vearchpb.SearchRequest{
+		TopN:          int32(q.size),
+		HasRank:       true,
+		IsVectorValue: q.vectorValue,
+		ReqNum:        1,
+	}
+	if q.brute {
+		req.IsBruteSearch = 1
+	}
+	if req.TopN <= 0 {
+		req.TopN = defaultSize
+	}
+
+	metricType := space.MetricType
+	if q.retrievalParams != nil {
+		bs, err := json.Marshal(q.retrievalParams)
+		if err != nil {
+			return nil, err
+		}
+		req.RetrievalParams = string(bs)
+		if mt, ok := q.retrievalParams["metric_type"].(string); ok {
+			metricType = mt
+		}
+	}
+
+	for i, vc := range q.vectors {
+		vq, reqNum, err := vc.build(space)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && reqNum != int(req.ReqNum) {
+			return nil, fmt.Errorf("vearch: vectors have different batch size %d and %d", req.ReqNum, reqNum)
+		}
+		req.ReqNum = int32(reqNum)
+		req.VecFields = append(req.VecFields, vq)
+	}
+
+	for _, tc := range q.terms {
+		if _, err := space.field(tc.field); err != nil {
+			return nil, err
+		}
+		req.TermFilters = append(req.TermFilters, &vearchpb.TermFilter{
+			Field:   tc.field,
+			Value:   []byte(strings.Join(tc.values, arraySeparator)),
+			IsUnion: tc.op,
+		})
+	}
+
+	for _, rc := range q.ranges {
+		rf, err := rc.build(space)
+		if err != nil {
+			return nil, err
+		}
+		req.RangeFilters = append(req.RangeFilters, rf)
+	}
+
+	if err := q.buildFields(space, req); err != nil {
+		return nil, err
+	}
+	q.buildSort(metricType, req)
+	return req, nil
+}
+
+func (q *Query) buildFields(space *Space, req *vearchpb.SearchRequest) error {
+	if len(q.fields) == 0 {
+		for name, pro := range space.Fields {
+			if pro.FieldType != entity.FieldType_VECTOR || q.vectorValue {
+				req.Fields = append(req.Fields, name)
+			}
+		}
+	} else {
+		for _, name := range q.fields {
+			if name == idField {
+				continue
+			}
+			if _, err := space.field(name); err != nil {
+				return err
+			}
+			req.Fields = append(req.Fields, name)
+		}
+	}
+	req.Fields = append(req.Fields, idField)
+	return nil
+}
+
+// buildSort sorts by score like the router, ascending for L2 distances
+func (q *Query) buildSort(metricType string, req *vearchpb.SearchRequest) {
+	sorts := q.sorts
+	if len(sorts) == 0 {
+		sorts = []*sortClause{{field: "_score", desc: metricType != "L2"}}
+	}
+	req.SortFieldMap = make(map[string]string, len(sorts))
+	for _, sc := range sorts {
+		req.SortFields = append(req.SortFields, &vearchpb.SortField{Field: sc.field, Type: sc.desc})
+		req.SortFieldMap[sc.field] = fmt.Sprint(sc.desc)
+		if sc.field == "_score" || sc.field == idField {
+			continue
+		}
+		found := false
+		for _, f := range req.Fields {
+			found = found || f == sc.field
+		}
+		if !found {
+			req.Fields = append(req.Fields, sc.field)
+		}
+	}
+}
+
+func (vc *vectorClause) build(space *Space) (*vearchpb.VectorQuery, int, error) {
+	pro, err := space.field(vc.field)
+	if err != nil {
+		return nil, 0, err
+	}
+	if pro.FieldType != entity.FieldType_VECTOR {
+		return nil, 0, fmt.Errorf("vearch: field %s is not a vector", vc.field)
+	}
+
+	var (
+		value  []byte
+		reqNum int
+	)
+	if space.binary() {
+		flat := make([]uint8, 0, len(vc.binary)*pro.Dimension/8)
+		for _, f := range vc.binary {
+			if len(f)*8 != pro.Dimension {
+				return nil, 0, fmt.Errorf("vearch: field %s vector length err, schema is %d but input %d", vc.field, pro.Dimension, len(f)*8)
+			}
+			flat = append(flat, f...)
+		}
+		value, err = cbbytes.UInt8ArrayToByteArray(flat)
+		reqNum = len(vc.binary)
+	} else {
+		flat := make([]float32, 0, len(vc.features)*pro.Dimension)
+		for _, f := range vc.features {
+			if len(f) != pro.Dimension {
+				return nil, 0, fmt.Errorf("vearch: field %s vector length err, schema is %d but input %d", vc.field, pro.Dimension, len(f))
+			}
+			flat = append(flat, f...)
+		}
+		value, err = cbbytes.FloatArrayByte(flat)
+		reqNum = len(vc.features)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if reqNum == 0 {
+		return nil, 0, fmt.Errorf("vearch: field %s has no query vector", vc.field)
+	}
+
+	vq := &vearchpb.VectorQuery{
+		Name:          vc.field,
+		Value:         value,
+		MinScore:      vc.minScore,
+		MaxScore:      vc.maxScore,
+		Boost:         vc.boost,
+		RetrievalType: space.RetrievalType,
+	}
+	if pro.Format != nil {
+		vq.Format = *pro.Format
+	}
+	return vq, reqNum, nil
+}
+
+// build encodes the bounds in the field type, missing bounds are the limits
+// of the type like the router does
+func (rc *rangeClause) build(space *Space) (*vearchpb.RangeFilter, error) {
+	pro, err := space.field(rc.field)
+	if err != nil {
+		return nil, err
+	}
+
+	var min, max interface{}
+	switch pro.FieldType {
+	case entity.FieldType_INT:
+		lo, hi := int64(math.MinInt32), int64(math.MaxInt32)
+		if err := rangeInt(rc, &lo, &hi); err != nil {
+			return nil, err
+		}
+		if lo < math.MinInt32 || hi > math.MaxInt32 {
+			return nil, fmt.Errorf("vearch: range of %s overflows integer", rc.field)
+		}
+		min, max = int32(lo), int32(hi)
+	case entity.FieldType_LONG:
+		lo, hi := int64(math.MinInt64), int64(math.MaxInt64)
+		if err := rangeInt(rc, &lo, &hi); err != nil {
+			return nil, err
+		}
+		min, max = lo, hi
+	case entity.FieldType_FLOAT:
+		lo, hi := -math.MaxFloat32, math.MaxFloat32
+		if err := rangeFloat(rc, &lo, &hi); err != nil {
+			return nil, err
+		}
+		min, max = float32(lo), float32(hi)
+	case entity.FieldType_DOUBLE:
+		lo, hi := -math.MaxFloat64, math.MaxFloat64
+		if err := rangeFloat(rc, &lo, &hi); err != nil {
+			return nil, err
+		}
+		min, max = lo, hi
+	case entity.FieldType_DATE:
+		lo, hi := int64(0), int64(math.MaxInt64)
+		for _, b := range []struct {
+			v interface{}
+			p *int64
+		}{{rc.lower, &lo}, {rc.upper, &hi}} {
+			switch t := b.v.(type) {
+			case nil:
+			case time.Time:
+				*b.p = t.UnixNano()
+			default:
+				return nil, fmt.Errorf("vearch: range of date %s should be time.Time", rc.field)
+			}
+		}
+		min, max = lo, hi
+	default:
+		return nil, fmt.Errorf("vearch: field %s can't use range", rc.field)
+	}
+
+	lower, err := cbbytes.ValueToByte(min)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := cbbytes.ValueToByte(max)
+	if err != nil {
+		return nil, err
+	}
+	return &vearchpb.RangeFilter{
+		Field:        rc.field,
+		LowerValue:   lower,
+		UpperValue:   upper,
+		IncludeLower: rc.includeLower,
+		IncludeUpper: rc.includeUpper,
+	}, nil
+}
+
+func rangeInt(rc *rangeClause, lo, hi *int64) error {
+	for _, b := range []struct {
+		v interface{}
+		p *int64
+	}{{rc.lower, lo}, {rc.upper, hi}} {
+		switch n := b.v.(type) {
+		case nil:
+		case int:
+			*b.p = int64(n)
+		case int32:
+			*b.p = int64(n)
+		case int64:
+			*b.p = n
+		default:
+			return fmt.Errorf("vearch: range of %s should be integers, got %v", rc.field, b.v)
+		}
+	}
+	return nil
+}
+
+func rangeFloat(rc *rangeClause, lo, hi *float64) error {
+	for _, b := range []struct {
+		v interface{}
+		p *float64
+	}{{rc.lower, lo}, {rc.upper, hi}} {
+		switch n := b.v.(type) {
+		case nil:
+		case int:
+			*b.p = float64(n)
+		case int64:
+			*b.p = float64(n)
+		case float32:
+			*b.p = float64(n)
+		case float64:
+			*b.p = n
+		default:
+			return fmt.Errorf("vearch: range of %s should be numbers, got %v", rc.field, b.v)
+		}
+	}
+	return nil
+}
+
+func (c *Client) search(ctx context.Context, db, spaceName string, q *Query, query bool) (*Space, *vearchpb.SearchResponse, error) {
+	space, err := c.Space(ctx, db, spaceName)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := q.Build(space)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Head = c.newHead(db, spaceName)
+
+	var reply *vearchpb.SearchResponse
+	err = c.invoke(ctx, req.Head, true, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		var (
+			r   *vearchpb.SearchResponse
+			err error
+		)
+		if query {
+			r, err = cli.Query(ctx, req)
+		} else {
+			r, err = cli.Search(ctx, req)
+		}
+		if err != nil {
+			return nil, err
+		}
+		reply = r
+		return r.Head, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return space, reply, nil
+}
+
+func searchHits(space *Space, result *vearchpb.SearchResult) ([]*Hit, error) {
+	hits := make([]*Hit, 0, len(result.ResultItems))
+	for _, item := range result.ResultItems {
+		hit, err := decodeHit(space, item.PKey, item.Score, item.Fields)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+// Search runs a vector search and returns the hits of each query vector.
+func (c *Client) Search(ctx context.Context, db, spaceName string, q *Query) ([][]*Hit, error) {
+	if len(q.vectors) == 0 {
+		return nil, fmt.Errorf("vearch: search needs a vector, use Query for filters only")
+	}
+	space, reply, err := c.search(ctx, db, spaceName, q, false)
+	if err != nil {
+		return nil, err
+	}
+	results := make([][]*Hit, 0, len(reply.Results))
+	for _, result := range reply.Results {
+		hits, err := searchHits(space, result)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, hits)
+	}
+	return results, nil
+}
+
+// Query returns the documents matching the filters of q, it has no vector.
+func (c *Client) Query(ctx context.Context, db, spaceName string, q *Query) ([]*Hit, error) {
+	space, reply, err := c.search(ctx, db, spaceName, q, true)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([]*Hit, 0)
+	for _, result := range reply.Results {
+		rh, err := searchHits(space, result)
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, rh...)
+	}
+	return hits, nil
+}
+
+// DeleteByQuery deletes the documents matching the filters of q and returns
+// how many were deleted.
+func (c *Client) DeleteByQuery(ctx context.Context, db, spaceName string, q *Query) (int, error) {
+	space, err := c.Space(ctx, db, spaceName)
+	if err != nil {
+		return 0, err
+	}
+	req, err := q.Build(space)
+	if err != nil {
+		return 0, err
+	}
+	req.Head = c.newHead(db, spaceName)
+
+	var reply *vearchpb.DelByQueryeResponse
+	err = c.invoke(ctx, req.Head, true, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		r, err := cli.DeleteByQuery(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		reply = r
+		return r.Head, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(reply.DelNum), nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package vearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/vearch/vearch/proto/entity"
+	"github.com/vearch/vearch/proto/vearchpb"
+	"google.golang.org/grpc"
+)
+
+// field types of a space schema
+const (
+	TypeString  = "string"
+	TypeKeyword = "keyword"
+	TypeInteger = "integer"
+	TypeLong    = "long"
+	TypeFloat   = "float"
+	TypeDouble  = "double"
+	TypeDate    = "date"
+	TypeBool    = "bool"
+	TypeVector  = "vector"
+)
+
+const binaryRetrievalType = "BINARYIVF"
+
+// Space is the schema of a space used to encode documents and queries.
+type Space struct {
+	DB   string
+	Name string
+	// IDType is the type of the primary key, string or long
+	IDType        string
+	RetrievalType string
+	MetricType    string
+	Fields        map[string]*entity.SpaceProperties
+}
+
+func (s *Space) binary() bool {
+	return s.RetrievalType == binaryRetrievalType
+}
+
+func (s *Space) field(name string) (*entity.SpaceProperties, error) {
+	pro := s.Fields[name]
+	if pro == nil {
+		return nil, fmt.Errorf("vearch: field %s is not in space %s", name, s.Name)
+	}
+	return pro, nil
+}
+
+func newSpace(db string, info *vearchpb.SpaceInfo) (*Space, error) {
+	properties := make(map[string]*entity.SpaceProperties, len(info.Fields))
+	for _, f := range info.Fields {
+		index := f.Index
+		pro := &entity.SpaceProperties{
+			Type:      f.Type,
+			Index:     &index,
+			Dimension: int(f.Dimension),
+			ModelId:   f.ModelId,
+			Array:     f.Array,
+		}
+		if f.Format != "" {
+			format := f.Format
+			pro.Format = &format
+		}
+		if f.StoreType != "" {
+			storeType := f.StoreType
+			pro.StoreType = &storeType
+		}
+		if f.StoreParam != "" {
+			pro.StoreParam = json.RawMessage(f.StoreParam)
+		}
+		properties[f.Name] = pro
+	}
+	// the field types are resolved as the master does
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := entity.UnmarshalPropertyJSON(bs)
+	if err != nil {
+		return nil, err
+	}
+	s := &Space{DB: db, Name: info.Name, IDType: "string", Fields: fields}
+	if e := info.Engine; e != nil {
+		if e.IdType != "" {
+			s.IDType = e.IdType
+		}
+		s.RetrievalType = e.RetrievalType
+		s.MetricType = e.MetricType
+		if s.MetricType == "" && e.RetrievalParam != "" {
+			param := struct {
+				MetricType string `json:"metric_type"`
+			}{}
+			if err := json.Unmarshal([]byte(e.RetrievalParam), &param); err == nil {
+				s.MetricType = param.MetricType
+			}
+		}
+	}
+	return s, nil
+}
+
+// Space returns the schema of a space, it is cached after the first call.
+func (c *Client) Space(ctx context.Context, db, name string) (*Space, error) {
+	key := db + "/" + name
+	c.spaceMu.RLock()
+	space := c.spaces[key]
+	c.spaceMu.RUnlock()
+	if space != nil {
+		return space, nil
+	}
+
+	reply, err := c.spaceAdmin(ctx, db, name, nil, true, vearchpb.RouterGRPCServiceClient.GetSpace)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Spaces) == 0 {
+		return nil, fmt.Errorf("vearch: space %s is not in the reply", key)
+	}
+	space, err = newSpace(db, reply.Spaces[0])
+	if err != nil {
+		return nil, err
+	}
+	c.spaceMu.Lock()
+	c.spaces[key] = space
+	c.spaceMu.Unlock()
+	return space, nil
+}
+
+// ForgetSpace drops the cached schema of a space, the next call reloads it.
+func (c *Client) ForgetSpace(db, name string) {
+	c.spaceMu.Lock()
+	delete(c.spaces, db+"/"+name)
+	c.spaceMu.Unlock()
+}
+
+// CreateDB creates a database.
+func (c *Client) CreateDB(ctx context.Context, name string) error {
+	return c.dbAdmin(ctx, name, vearchpb.RouterGRPCServiceClient.CreateDB)
+}
+
+// DropDB deletes a database, it must have no space.
+func (c *Client) DropDB(ctx context.Context, name string) error {
+	return c.dbAdmin(ctx, name, vearchpb.RouterGRPCServiceClient.DeleteDB)
+}
+
+// CreateSpace creates the space built by b in db.
+func (c *Client) CreateSpace(ctx context.Context, db string, b *SpaceBuilder) error {
+	info, err := b.build()
+	if err != nil {
+		return err
+	}
+	c.ForgetSpace(db, b.name)
+	_, err = c.spaceAdmin(ctx, db, b.name, info, false, vearchpb.RouterGRPCServiceClient.CreateSpace)
+	return err
+}
+
+// DropSpace deletes a space and its data.
+func (c *Client) DropSpace(ctx context.Context, db, name string) error {
+	c.ForgetSpace(db, name)
+	_, err := c.spaceAdmin(ctx, db, name, nil, false, vearchpb.RouterGRPCServiceClient.DeleteSpace)
+	return err
+}
+
+type dbMethod func(vearchpb.RouterGRPCServiceClient, context.Context, *vearchpb.DbRequest, ...grpc.CallOption) (*vearchpb.DbResponse, error)
+
+type spaceMethod func(vearchpb.RouterGRPCServiceClient, context.Context, *vearchpb.SpaceRequest, ...grpc.CallOption) (*vearchpb.SpaceResponse, error)
+
+func (c *Client) dbAdmin(ctx context.Context, name string, method dbMethod) error {
+	head := c.newHead(name, "")
+	req := &vearchpb.DbRequest{Head: head, Db: &vearchpb.DbInfo{Name: name}}
+	return c.invoke(ctx, head, false, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		resp, err := method(cli, ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Head, nil
+	})
+}
+
+func (c *Client) spaceAdmin(ctx context.Context, db, name string, info *vearchpb.SpaceInfo, retry bool, method spaceMethod) (*vearchpb.SpaceResponse, error) {
+	head := c.newHead(db, name)
+	req := &vearchpb.SpaceRequest{Head: head, Space: info}
+	var reply *vearchpb.SpaceResponse
+	err := c.invoke(ctx, head, retry, func(ctx context.Context, cli vearchpb.RouterGRPCServiceClient) (*vearchpb.ResponseHead, error) {
+		resp, err := method(cli, ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		reply = resp
+		return resp.Head, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// decodeReply decodes a master json reply, {"code":200,"msg":"","data":...}
+func decodeReply(body []byte, data interface{}) error {
+	reply := struct {
+		Code int64           `json:"code"`
+		Msg  string          `json:"msg"`
+		Data json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return fmt.Errorf("vearch: decode reply %s: %v", body, err)
+	}
+	if success := int64(vearchpb.ErrCode(vearchpb.ErrorEnum_SUCCESS)); reply.Code != success {
+		code := vearchpb.ErrorEnum_INTERNAL_ERROR
+		// ErrCode maps an ErrorEnum to the http code by an offset
+		if offset := int64(vearchpb.ErrCode(code)) - int64(code); reply.Code > offset {
+			code = vearchpb.ErrorEnum(reply.Code - offset)
+		}
+		return &Error{Code: code, Msg: reply.Msg}
+	}
+	if data == nil || len(reply.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(reply.Data, data)
+}
+
+// SpaceBuilder builds the schema of a new space.
+type SpaceBuilder struct {
+	name         string
+	partitionNum int
+	replicaNum   int
+	engine       *vearchpb.SpaceEngine
+	fields       map[string]*vearchpb.SpaceField
+	err          error
+}
+
+// NewSpaceBuilder starts a space with one partition and one replica.
+func NewSpaceBuilder(name string) *SpaceBuilder {
+	return &SpaceBuilder{
+		name:         name,
+		partitionNum: 1,
+		replicaNum:   1,
+		engine:       &vearchpb.SpaceEngine{},
+		fields:       make(map[string]*vearchpb.SpaceField),
+	}
+}
+
+func (b *SpaceBuilder) Partitions(n int) *SpaceBuilder {
+	b.partitionNum = n
+	return b
+}
+
+func (b *SpaceBuilder) Replicas(n int) *SpaceBuilder {
+	b.replicaNum = n
+	return b
+}
+
+// Engine sets the index, param is the retrieval_param like
+// {"metric_type": "L2", "ncentroids": 256, "nsubvector": 32}.
+func (b *SpaceBuilder) Engine(retrievalType string, indexSize int64, param map[string]interface{}) *SpaceBuilder {
+	b.engine.RetrievalType = retrievalType
+	b.engine.IndexSize = indexSize
+	if param != nil {
+		bs, err := json.Marshal(param)
+		if err != nil {
+			b.err = fmt.Errorf("vearch: retrieval_param: %v", err)
+			return b
+		}
+		b.engine.RetrievalParam = string(bs)
+	}
+	return b
+}
+
+// IDType sets the primary key type, string or long.
+func (b *SpaceBuilder) IDType(idType string) *SpaceBuilder {
+	b.engine.IdType = idType
+	return b
+}
+
+// Field adds a scalar field of one of the Type constants.
+func (b *SpaceBuilder) Field(name, typ string, index bool) *SpaceBuilder {
+	b.fields[name] = &vearchpb.SpaceField{Name: name, Type: typ, Index: index}
+	return b
+}
+
+// ArrayField adds a multi-value field of string, integer, long, float or double.
+func (b *SpaceBuilder) ArrayField(name, typ string, index bool) *SpaceBuilder {
+	b.fields[name] = &vearchpb.SpaceField{Name: name, Type: typ, Index: index, Array: true}
+	return b
+}
+
+// VectorField adds an indexed vector field, storeType and format may be empty.
+func (b *SpaceBuilder) VectorField(name string, dimension int, storeType, format string) *SpaceBuilder {
+	b.fields[name] = &vearchpb.SpaceField{
+		Name:      name,
+		Type:      TypeVector,
+		Index:     true,
+		Dimension: int32(dimension),
+		StoreType: storeType,
+		Format:    format,
+	}
+	return b
+}
+
+func (b *SpaceBuilder) build() (*vearchpb.SpaceInfo, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	info := &vearchpb.SpaceInfo{
+		Name:         b.name,
+		PartitionNum: int32(b.partitionNum),
+		ReplicaNum:   int32(b.replicaNum),
+		Engine:       b.engine,
+		Fields:       make([]*vearchpb.SpaceField, 0, len(b.fields)),
+	}
+	for _, f := range b.fields {
+		info.Fields = append(info.Fields, f)
+	}
+	sort.Slice(info.Fields, func(i, j int) bool { return info.Fields[i].Name < info.Fields[j].Name })
+	return info, nil
+}
@@ -81,7 +81,7 @@ func main() {
 	entity.SetPrefixAndSequence(config.Conf().Global.Name)
 	log.Info("The cluster prefix is: %v", entity.PrefixEtcdClusterID)
 	if config.Conf().TracerCfg != nil {
-		closer := tracer.InitJaeger(config.Conf().Global.Name, config.Conf().TracerCfg)
+		closer := tracer.Init(config.Conf().Global.Name, config.Conf().TracerCfg)
 		defer closer.Close()
 	}
 	args := flag.Args()
@@ -99,6 +99,11 @@ func main() {
 		}
 	}
 
+	// a standalone cluster shares its metadata store in one process
+	if config.Conf().Global.Standalone && !tags[allTag] && !(tags[psTag] && tags[masterTag] && tags[routerTag]) {
+		panic(fmt.Sprintf("standalone only runs all of [ps, router, master] in one process, got: %v", args))
+	}
+
 	logName := strings.ToUpper(strings.Join(args, "-"))
 	vearchlog.SetConfig(config.Conf().GetLogFileNum(), 1024*1024*config.Conf().GetLogFileSize())
 	log.Regist(vearchlog.NewVearchLog(config.Conf().GetLogDir(), logName, config.Conf().GetLevel(), false))
@@ -0,0 +1,124 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// fsck checks the metadata of a vearch cluster through its master and fixes
+// the problems found when asked
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+)
+
+var (
+	masterAddr    string
+	user          string
+	password      string
+	fix           bool
+	kind          string
+	deleteOrphans bool
+)
+
+func init() {
+	flag.StringVar(&masterAddr, "master", "http://127.0.0.1:8817", "master api address")
+	flag.StringVar(&user, "user", "root", "user of the master api")
+	flag.StringVar(&password, "password", "", "password of the master api, the signkey of the cluster")
+	flag.BoolVar(&fix, "fix", false, "apply the suggested fixes")
+	flag.StringVar(&kind, "kind", "", "comma separated kinds of the problems to fix, all when empty")
+	flag.BoolVar(&deleteOrphans, "delete-orphans", false, "with fix delete the partitions held by ps which are not stored, once unchanged for the grace period")
+}
+
+type reply struct {
+	Code int64              `json:"code"`
+	Msg  string             `json:"msg"`
+	Data *entity.FsckReport `json:"data"`
+}
+
+func main() {
+	flag.Parse()
+
+	report, err := fsck()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fsck err: %v\n", err)
+		os.Exit(2)
+	}
+
+	left := 0
+	for _, p := range report.Problems {
+		state := "found"
+		switch {
+		case p.Fixed:
+			state = "fixed"
+		case p.Error != "":
+			state = "fix failed: " + p.Error
+		}
+		if !p.Fixed {
+			left++
+		}
+		fmt.Printf("%-18s %s\n%18s fix: %s [%s]\n", p.Kind, p.Detail, "", p.Fix, state)
+	}
+	fmt.Printf("%d problems, %d left\n", len(report.Problems), left)
+	if left > 0 {
+		os.Exit(1)
+	}
+}
+
+func fsck() (*entity.FsckReport, error) {
+	method, query := http.MethodGet, url.Values{}
+	if fix {
+		method = http.MethodPost
+		if kind != "" {
+			query.Set("kind", kind)
+		}
+		if deleteOrphans {
+			query.Set("delete_orphans", "true")
+		}
+	}
+	u := strings.TrimSuffix(masterAddr, "/") + "/_cluster/fsck"
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(user, password)
+
+	resp, err := (&http.Client{Timeout: 10 * time.Minute}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	r := &reply{}
+	if err := json.Unmarshal(body, r); err != nil {
+		return nil, fmt.Errorf("status:[%s] body:[%s] err:[%v]", resp.Status, body, err)
+	}
+	if r.Data == nil {
+		return nil, fmt.Errorf("code:[%d] msg:[%s]", r.Code, r.Msg)
+	}
+	return r.Data, nil
+}
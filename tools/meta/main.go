@@ -0,0 +1,183 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// meta exports the metadata of a vearch cluster through its master, and
+// imports it into an empty cluster
+//
+//	meta [flags] export > cluster.json
+//	meta [flags] -mode adopt -file cluster.json import
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/vearch/vearch/proto/entity"
+)
+
+var (
+	masterAddr string
+	user       string
+	password   string
+	file       string
+	mode       string
+)
+
+func init() {
+	flag.StringVar(&masterAddr, "master", "http://127.0.0.1:8817", "master api address")
+	flag.StringVar(&user, "user", "root", "user of the master api")
+	flag.StringVar(&password, "password", "", "password of the master api, the signkey of the cluster")
+	flag.StringVar(&file, "file", "", "file to write the export to or read the import from, stdout or stdin when empty")
+	flag.StringVar(&mode, "mode", entity.ImportRemap, "import mode, remap to create new ids or adopt to load the partitions left in the ps data dirs")
+}
+
+type reply struct {
+	Code int64           `json:"code"`
+	Msg  string          `json:"msg"`
+	Data json.RawMessage `json:"data"`
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] export|import\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var err error
+	switch flag.Arg(0) {
+	case "export":
+		err = export()
+	case "import":
+		err = imports()
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s err: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+func export() error {
+	data, err := call(http.MethodGet, "/meta/export", nil, nil)
+	if err != nil {
+		return err
+	}
+	meta := &entity.ClusterMeta{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	out = append(out, '\n')
+	if file == "" {
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+	return ioutil.WriteFile(file, out, 0644)
+}
+
+func imports() error {
+	var in io.Reader = os.Stdin
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	body, err := ioutil.ReadAll(in)
+	if err != nil {
+		return err
+	}
+	meta := &entity.ClusterMeta{}
+	if err := json.Unmarshal(body, meta); err != nil {
+		return fmt.Errorf("read metadata document: %v", err)
+	}
+
+	query := url.Values{}
+	query.Set("mode", mode)
+	data, err := call(http.MethodPost, "/meta/import", query, body)
+	if err != nil {
+		return err
+	}
+	report := &entity.ImportReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return err
+	}
+
+	fmt.Printf("mode: %s\n", report.Mode)
+	fmt.Printf("dbs: %s\n", strings.Join(report.DBs, ", "))
+	fmt.Printf("spaces: %s\n", strings.Join(report.Spaces, ", "))
+	if len(report.Users) > 0 {
+		fmt.Printf("users: %s\n", strings.Join(report.Users, ", "))
+	}
+	for nodeID, pids := range report.Adopted {
+		fmt.Printf("node %d adopted partitions %v\n", nodeID, pids)
+	}
+	for _, e := range report.Errors {
+		fmt.Printf("error: %s\n", e)
+	}
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("%d errors", len(report.Errors))
+	}
+	return nil
+}
+
+func call(method, path string, query url.Values, body []byte) (json.RawMessage, error) {
+	u := strings.TrimSuffix(masterAddr, "/") + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(user, password)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := (&http.Client{Timeout: 10 * time.Minute}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	r := &reply{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("status:[%s] body:[%s] err:[%v]", resp.Status, data, err)
+	}
+	if len(r.Data) == 0 || string(r.Data) == "null" {
+		return nil, fmt.Errorf("code:[%d] msg:[%s]", r.Code, r.Msg)
+	}
+	return r.Data, nil
+}
@@ -57,6 +57,7 @@ type ServerStats struct {
 	Err            string                  `json:"err,omitempty"`
 	ActiveConn     int                     `json:"active_conn,omitempty"`
 	PartitionInfos []*entity.PartitionInfo `json:"partition_infos,omitempty"`
+	Snapshot       *entity.SnapshotStats   `json:"snapshot,omitempty"`
 }
 
 func NewMemStats(rss *sysstat.RuntimeStatSampler) *MemStats {
@@ -50,6 +50,9 @@ type MasterMonitor struct {
 	PSLeaderNum     *prometheus.GaugeVec
 	PSPartitionSize *prometheus.GaugeVec
 	PSPartitionDoc  *prometheus.GaugeVec
+	// 0 for none, 1 low, 2 high and 3 flood stage
+	DiskWatermark   *prometheus.GaugeVec
+	DiskUsedPercent *prometheus.GaugeVec
 }
 
 func RegisterMaster(call func(masterMonitor *MasterMonitor)) {
@@ -73,11 +76,14 @@ func RegisterMaster(call func(masterMonitor *MasterMonitor)) {
 		PSLeaderNum:     newGaugeVec("leader_num", "partition has number", "ip"),
 		PSPartitionSize: newGaugeVec("partition_size", "single partition size", "ip", "partition_id"),
 		PSPartitionDoc:  newGaugeVec("partition_doc", "single partition doc number", "ip", "partition_id"),
+		DiskWatermark:   newGaugeVec("disk_watermark", "disk watermark of ps, 0 none, 1 low, 2 high, 3 flood stage", "ip"),
+		DiskUsedPercent: newGaugeVec("disk_used_percent", "disk used percent of the fullest data dir of ps", "ip"),
 	}
 
 	registry := prometheus.NewPedanticRegistry()
 	registry.MustRegister(mm.CPU, mm.Mem, mm.FS, mm.NetIn, mm.NetOut, mm.GC, mm.Routines, mm.ServerNum, mm.DBNum, mm.SpaceNum, mm.SpaceDoc, mm.SpaceSize, mm.PartitionNum)
 	registry.MustRegister(mm.PSLeaderNum, mm.PSPartitionSize, mm.PSPartitionDoc)
+	registry.MustRegister(mm.DiskWatermark, mm.DiskUsedPercent)
 
 	go func() {
 		defer func() {
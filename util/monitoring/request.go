@@ -0,0 +1,74 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package monitoring
+
+import (
+	"time"
+
+	prometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+// roles of the process serving a request
+const (
+	RoleRouter = "router"
+	RolePS     = "ps"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "vearch_request_duration_seconds",
+		Help:    "request latency",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 16),
+	}, []string{"role", "db", "space", "operation"})
+
+	requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "vearch_request_errors_total",
+		Help: "failed requests by error code",
+	}, []string{"role", "db", "space", "operation", "code"})
+
+	requestsInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vearch_requests_in_flight",
+		Help: "requests being served",
+	}, []string{"role", "operation"})
+)
+
+func init() {
+	// the default registry is served by monitor.Register
+	prometheus.MustRegister(requestDuration, requestErrors, requestsInFlight)
+}
+
+// Request measures one request, it is started by StartRequest and must be ended once.
+type Request struct {
+	role      string
+	db        string
+	space     string
+	operation string
+	start     time.Time
+}
+
+func StartRequest(role, db, space, operation string) *Request {
+	requestsInFlight.WithLabelValues(role, operation).Inc()
+	return &Request{role: role, db: db, space: space, operation: operation, start: time.Now()}
+}
+
+// End records the latency of the request, err is nil or SUCCESS when it succeeded
+func (r *Request) End(err *vearchpb.Error) {
+	requestsInFlight.WithLabelValues(r.role, r.operation).Dec()
+	requestDuration.WithLabelValues(r.role, r.db, r.space, r.operation).Observe(time.Since(r.start).Seconds())
+	if err != nil && err.Code != vearchpb.ErrorEnum_SUCCESS {
+		requestErrors.WithLabelValues(r.role, r.db, r.space, r.operation, err.Code.String()).Inc()
+	}
+}
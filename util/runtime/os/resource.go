@@ -59,23 +59,18 @@ func readCgroupMemory() (available, limit uint64, err error) {
 	return available, limit, nil
 }
 
-func CheckResource(path string) (is bool, err error) {
+// DiskUsage returns the total and available bytes of the file system of path
+func DiskUsage(path string) (total, avail uint64, err error) {
 	var stat syscall.Statfs_t
-	err = syscall.Statfs(path, &stat)
-
-	if err != nil {
-		log.Error("syscall.Statfs %s err %v", path, err)
-		return false, nil
-	}
-
-	totalDisk := stat.Blocks * uint64(stat.Bsize) / 1024 / 1024
-	availDisk := stat.Bavail * uint64(stat.Bsize) / 1024 / 1024
-	log.Debug("availDisk %dM, totalDisk %dM", availDisk, totalDisk)
-
-	if !(availDisk > 1024 || float64(availDisk)/float64(totalDisk) > 0.05) {
-		return true, fmt.Errorf("disk space not enough: total [%d]M, avail [%d]M", totalDisk, availDisk)
+	if err = syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
 	}
+	return stat.Blocks * uint64(stat.Bsize), stat.Bavail * uint64(stat.Bsize), nil
+}
 
+// CheckResource reports whether memory is exhausted, disk is checked by the
+// watermarks of ps
+func CheckResource(path string) (is bool, err error) {
 	var info syscall.Sysinfo_t
 
 	if err = syscall.Sysinfo(&info); err != nil {
@@ -22,7 +22,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/opentracing/opentracing-go"
 	"github.com/smallnest/pool"
 	"github.com/smallnest/rpcx/client"
 	"github.com/smallnest/rpcx/share"
@@ -31,10 +30,15 @@ import (
 	"github.com/vearch/vearch/proto/entity"
 	"github.com/vearch/vearch/proto/vearchpb"
 	"github.com/vearch/vearch/util/log"
+	"github.com/vearch/vearch/util/tracer"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var defaultConcurrentNum int = 2000
 
+// handlerTypeKey is client.HandlerType, the handler of the request in the metadata
+const handlerTypeKey = "type"
+
 type RpcClient struct {
 	serverAddress []string
 	clientPool    *pool.Pool
@@ -120,9 +124,19 @@ func (r *RpcClient) Execute(ctx context.Context, servicePath string, args interf
 			}
 			md[string(entity.RPC_TIME_OUT)] = strconv.FormatInt(int64(timeout), 10)
 		}
-		if span := opentracing.SpanFromContext(ctx); span != nil {
-			span.Tracer().Inject(span.Context(), opentracing.TextMap, opentracing.TextMapCarrier(md))
+		// one span for each partition a request is sent to
+		ctx, span := tracer.Start(ctx, "rpc "+md[handlerTypeKey], attribute.String("server", r.GetAddress(0)))
+		if pd, ok := args.(*vearchpb.PartitionData); ok {
+			span.SetAttributes(attribute.Int64("partition_id", int64(pd.PartitionID)))
 		}
+		defer func() {
+			if err == nil && reply.Err != nil {
+				tracer.End(span, vearchpb.NewErrorInfo(reply.Err.Code, reply.Err.Msg))
+				return
+			}
+			tracer.End(span, err)
+		}()
+		tracer.Inject(ctx, md)
 		ctx = context.WithValue(ctx, share.ReqMetaDataKey, md)
 		cli := r.clientPool.Get().(*client.OneClient)
 		defer r.clientPool.Put(cli)
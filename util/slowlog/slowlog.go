@@ -0,0 +1,183 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package slowlog keeps the recent requests that took longer than the slow
+// log threshold of their space.
+package slowlog
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/vearch/vearch/proto/vearchpb"
+	"github.com/vearch/vearch/util/log"
+)
+
+// DefaultCapacity is the number of entries kept by the default log
+const DefaultCapacity = 256
+
+// Entry is one slow request
+type Entry struct {
+	Time        time.Time `json:"time"`
+	Role        string    `json:"role"`
+	Node        string    `json:"node,omitempty"`
+	DbName      string    `json:"db_name"`
+	SpaceName   string    `json:"space_name"`
+	Operation   string    `json:"operation"`
+	TookMs      int64     `json:"took_ms"`
+	ThresholdMs int64     `json:"threshold_ms"`
+	// PartitionID is set by ps, PartitionTook by router
+	PartitionID   uint32           `json:"partition_id,omitempty"`
+	PartitionTook map[uint32]int64 `json:"partition_took,omitempty"`
+	MaxTook       int64            `json:"max_took,omitempty"`
+	MaxTookID     uint32           `json:"max_took_id,omitempty"`
+	// FilterSelectivity is the ratio of docs the engine checked that the bool
+	// filter kept, nil when the request has no bool filter
+	FilterSelectivity *float64 `json:"filter_selectivity,omitempty"`
+	ResultCount       int      `json:"result_count"`
+	Request           *Request `json:"request,omitempty"`
+}
+
+// Log is a ring of the latest entries, it is safe for concurrent use.
+type Log struct {
+	mu      sync.Mutex
+	entries []*Entry
+	next    int
+	full    bool
+}
+
+func New(capacity int) *Log {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return &Log{entries: make([]*Entry, capacity)}
+}
+
+var defaultLog = New(DefaultCapacity)
+
+// Default is the log of this process
+func Default() *Log {
+	return defaultLog
+}
+
+// Add keeps e and writes it to the warn log as one json line.
+func (l *Log) Add(e *Entry) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if b, err := json.Marshal(e); err == nil {
+		log.Warn("slow query: %s", string(b))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries[l.next] = e
+	l.next++
+	if l.next == len(l.entries) {
+		l.next = 0
+		l.full = true
+	}
+}
+
+// Recent returns at most size entries of db and space, newest first. Empty
+// db or space match all, size <= 0 returns all kept entries.
+func (l *Log) Recent(size int, db, space string) []*Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := l.next
+	if l.full {
+		n = len(l.entries)
+	}
+	result := make([]*Entry, 0)
+	for i := 0; i < n; i++ {
+		if size > 0 && len(result) >= size {
+			break
+		}
+		e := l.entries[(l.next-1-i+len(l.entries))%len(l.entries)]
+		if (db == "" || e.DbName == db) && (space == "" || e.SpaceName == space) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// Request is the shape of a search without its vectors and filter values,
+// so that slow queries of the same kind look the same.
+type Request struct {
+	ReqNum          int32         `json:"req_num,omitempty"`
+	TopN            int32         `json:"topN,omitempty"`
+	IsBruteSearch   int32         `json:"is_brute_search,omitempty"`
+	Vectors         []VectorQuery `json:"vectors,omitempty"`
+	TermFilters     []string      `json:"term_filters,omitempty"`
+	RangeFilters    []string      `json:"range_filters,omitempty"`
+	BoolFilter      bool          `json:"bool_filter,omitempty"`
+	SortFields      []SortField   `json:"sort,omitempty"`
+	SearchAfter     bool          `json:"search_after,omitempty"`
+	RangeSearch     bool          `json:"range_search,omitempty"`
+	Fields          []string      `json:"fields,omitempty"`
+	RetrievalParams string        `json:"retrieval_params,omitempty"`
+}
+
+type VectorQuery struct {
+	Field    string  `json:"field"`
+	Bytes    int     `json:"bytes"`
+	MinScore float64 `json:"min_score,omitempty"`
+	MaxScore float64 `json:"max_score,omitempty"`
+	Boost    float64 `json:"boost,omitempty"`
+}
+
+type SortField struct {
+	Field string `json:"field"`
+	Desc  bool   `json:"desc,omitempty"`
+}
+
+// NormalizeSearch returns the shape of req, nil if req is nil.
+func NormalizeSearch(req *vearchpb.SearchRequest) *Request {
+	if req == nil {
+		return nil
+	}
+	r := &Request{
+		ReqNum:          req.ReqNum,
+		TopN:            req.TopN,
+		IsBruteSearch:   req.IsBruteSearch,
+		BoolFilter:      req.BoolFilter != nil,
+		SearchAfter:     req.SearchAfter != nil,
+		RangeSearch:     req.RangeSearch != nil,
+		Fields:          append([]string(nil), req.Fields...),
+		RetrievalParams: req.RetrievalParams,
+	}
+	for _, v := range req.VecFields {
+		r.Vectors = append(r.Vectors, VectorQuery{Field: v.Name, Bytes: len(v.Value), MinScore: v.MinScore, MaxScore: v.MaxScore, Boost: v.Boost})
+	}
+	for _, f := range req.TermFilters {
+		r.TermFilters = append(r.TermFilters, f.Field)
+	}
+	for _, f := range req.RangeFilters {
+		r.RangeFilters = append(r.RangeFilters, f.Field)
+	}
+	for _, f := range req.SortFields {
+		r.SortFields = append(r.SortFields, SortField{Field: f.Field, Desc: f.Type})
+	}
+	return r
+}
+
+// Selectivity is matched / scanned, nil if nothing was scanned
+func Selectivity(scanned, matched int64) *float64 {
+	if scanned <= 0 {
+		return nil
+	}
+	s := float64(matched) / float64(scanned)
+	return &s
+}
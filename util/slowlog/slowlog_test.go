@@ -0,0 +1,71 @@
+// Copyright 2019 The Vearch Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package slowlog
+
+import (
+	"testing"
+
+	"github.com/vearch/vearch/proto/vearchpb"
+)
+
+func TestRecent(t *testing.T) {
+	l := New(3)
+	for i := int64(1); i <= 5; i++ {
+		space := "a"
+		if i%2 == 0 {
+			space = "b"
+		}
+		l.Add(&Entry{DbName: "db", SpaceName: space, TookMs: i})
+	}
+
+	// 1 and 2 are dropped, newest first
+	got := l.Recent(0, "", "")
+	if len(got) != 3 || got[0].TookMs != 5 || got[2].TookMs != 3 {
+		t.Fatalf("recent %v", got)
+	}
+	if got = l.Recent(1, "db", "a"); len(got) != 1 || got[0].TookMs != 5 {
+		t.Fatalf("recent of a %v", got)
+	}
+	if got = l.Recent(0, "db", "b"); len(got) != 1 || got[0].TookMs != 4 {
+		t.Fatalf("recent of b %v", got)
+	}
+	if got = l.Recent(0, "other", ""); len(got) != 0 {
+		t.Fatalf("recent of other db %v", got)
+	}
+}
+
+func TestNormalizeSearch(t *testing.T) {
+	req := &vearchpb.SearchRequest{
+		TopN:         10,
+		VecFields:    []*vearchpb.VectorQuery{{Name: "vec", Value: make([]byte, 16), MinScore: 0.5}},
+		TermFilters:  []*vearchpb.TermFilter{{Field: "tag", Value: []byte("secret")}},
+		RangeFilters: []*vearchpb.RangeFilter{{Field: "age", LowerValue: []byte{1}}},
+		Fields:       []string{"tag"},
+	}
+	r := NormalizeSearch(req)
+	if r.TopN != 10 || len(r.Vectors) != 1 || r.Vectors[0].Bytes != 16 || r.Vectors[0].MinScore != 0.5 {
+		t.Fatalf("vectors %+v", r)
+	}
+	if len(r.TermFilters) != 1 || r.TermFilters[0] != "tag" || len(r.RangeFilters) != 1 || r.RangeFilters[0] != "age" {
+		t.Fatalf("filters %+v", r)
+	}
+	req.Fields = append(req.Fields[:0], "other")
+	if r.Fields[0] != "tag" {
+		t.Fatal("fields should be copied")
+	}
+	if Selectivity(0, 0) != nil || *Selectivity(4, 1) != 0.25 {
+		t.Fatal("selectivity")
+	}
+}
@@ -11,36 +11,176 @@
 // WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
 // implied. See the License for the specific language governing
 // permissions and limitations under the License.
+
+// Package tracer wraps OpenTelemetry, spans are no-ops until Init is called.
 package tracer
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
-	jaeger "github.com/uber/jaeger-client-go"
-	config "github.com/uber/jaeger-client-go/config"
 	vconfig "github.com/vearch/vearch/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	instrumentationName = "github.com/vearch/vearch"
+
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	SamplerConst         = "const"
+	SamplerProbabilistic = "probabilistic"
 )
 
-// InitJaeger returns an instance of Jaeger Tracer that samples 100% of traces and logs all spans to stdout.
-func InitJaeger(service string, c *vconfig.TracerCfg) io.Closer {
-	cfg := &config.Configuration{
-		ServiceName: service,
-		Sampler: &config.SamplerConfig{
-			Type:  c.SampleType,
-			Param: c.SampleParam,
-		},
-		Reporter: &config.ReporterConfig{
-			LocalAgentHostPort:         c.Host,
-			LogSpans:                   false,
-			DisableAttemptReconnecting: false,
-			AttemptReconnectInterval:   1 * time.Minute,
-		},
+var propagator = propagation.TraceContext{}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error { return f() }
+
+// Init installs the global tracer provider of service. Spans are exported to
+// the otlp grpc endpoint c.Host or printed to stdout, by c.Exporter.
+func Init(service string, c *vconfig.TracerCfg) io.Closer {
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch c.Exporter {
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	case ExporterOTLP, "":
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		exporter, err = otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(c.Host), otlptracegrpc.WithInsecure())
+	default:
+		err = fmt.Errorf("unknown exporter %s", c.Exporter)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("ERROR: cannot init tracer: %v\n", err))
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler(c))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	return closerFunc(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	})
+}
+
+// sampler keeps the jaeger style sample_type and sample_param of the config
+func sampler(c *vconfig.TracerCfg) sdktrace.Sampler {
+	switch c.SampleType {
+	case SamplerConst:
+		if c.SampleParam > 0 {
+			return sdktrace.AlwaysSample()
+		}
+		return sdktrace.NeverSample()
+	case SamplerProbabilistic:
+		return sdktrace.TraceIDRatioBased(c.SampleParam)
+	default:
+		return sdktrace.AlwaysSample()
 	}
-	closer, err := cfg.InitGlobalTracer(service, config.Logger(jaeger.StdLogger))
+}
+
+// Start starts a span as a child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on span if it is not nil and ends it.
+func End(span trace.Span, err error) {
 	if err != nil {
-		panic(fmt.Sprintf("ERROR: cannot init Jaeger: %v\n", err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject writes the span context of ctx into the rpc metadata md.
+func Inject(ctx context.Context, md map[string]string) {
+	propagator.Inject(ctx, propagation.MapCarrier(md))
+}
+
+// Extract returns ctx with the remote span context read from the rpc metadata md.
+func Extract(ctx context.Context, md map[string]string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier(md))
+}
+
+// ExtractHeader returns ctx with the remote span context read from http headers.
+func ExtractHeader(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// metadataCarrier reads and writes the span context in grpc metadata
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
 	}
-	return closer
+	return keys
+}
+
+func extractIncoming(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		return propagator.Extract(ctx, metadataCarrier(md))
+	}
+	return ctx
+}
+
+// UnaryServerInterceptor starts a span for each grpc call.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := Start(extractIncoming(ctx), info.FullMethod)
+	resp, err := handler(ctx, req)
+	End(span, err)
+	return resp, err
+}
+
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor starts a span for each grpc stream.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := Start(extractIncoming(ss.Context()), info.FullMethod)
+	err := handler(srv, &tracedStream{ServerStream: ss, ctx: ctx})
+	End(span, err)
+	return err
 }